@@ -32,6 +32,15 @@ const (
 	// some ephemeral controller is managing the resource.
 	EphemeralManagedByAnnotation = "common.ironcore.dev/ephemeral-managed-by"
 
+	// DefaultClassAnnotation is an annotation that can be set to "true" on a MachineClass, VolumeClass or
+	// BucketClass to mark it as the default class for objects that do not specify a class.
+	DefaultClassAnnotation = "common.ironcore.dev/is-default-class"
+
+	// SoftReferenceValidationAnnotation is an annotation that can be set to "true" on a resource to only
+	// warn instead of rejecting the resource if it references objects that do not exist (yet) or are terminating.
+	// This is useful if resources are applied in no particular order, e.g. via GitOps.
+	SoftReferenceValidationAnnotation = "common.ironcore.dev/soft-reference-validation"
+
 	// DefaultEphemeralManager is the default ironcoreephemeral manager.
 	DefaultEphemeralManager = "ephemeral-manager"
 )
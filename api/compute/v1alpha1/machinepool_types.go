@@ -85,6 +85,12 @@ type MachinePoolAddress struct {
 // MachinePoolConditionType is a type a MachinePoolCondition can have.
 type MachinePoolConditionType string
 
+const (
+	// MachinePoolRuntimeCapabilitiesSupported reports whether the machine runtime of a MachinePool supports all
+	// optional capabilities. If not, the message lists the missing capabilities.
+	MachinePoolRuntimeCapabilitiesSupported MachinePoolConditionType = "RuntimeCapabilitiesSupported"
+)
+
 // MachinePoolCondition is one of the conditions of a volume.
 type MachinePoolCondition struct {
 	// Type is the type of the condition.
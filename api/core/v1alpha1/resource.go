@@ -25,6 +25,13 @@ const (
 	ResourceTPS ResourceName = "tps"
 	// ResourceIOPS defines max IOPS in input/output operations per second.
 	ResourceIOPS ResourceName = "iops"
+	// ResourcePublicIPs is the number of public IPs allocated from public IP pools.
+	ResourcePublicIPs ResourceName = "public-ips"
+	// ResourceIPv4Addresses is the number of IPv4 addresses allocated by prefixes.
+	ResourceIPv4Addresses ResourceName = "ipv4-addresses"
+	// ResourceIPv6Subnets is the number of /64 IPv6 subnets allocated by prefixes.
+	// Prefixes longer than /64 count as a single subnet.
+	ResourceIPv6Subnets ResourceName = "ipv6-subnets"
 
 	// ResourcesRequestsPrefix is the prefix used for limiting resource requests in ResourceQuota.
 	ResourcesRequestsPrefix = "requests."
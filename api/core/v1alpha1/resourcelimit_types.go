@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceLimitType is the type of object a ResourceLimitItem applies to.
+type ResourceLimitType string
+
+const (
+	// ResourceLimitTypeVolume applies a ResourceLimitItem to volumes.
+	ResourceLimitTypeVolume ResourceLimitType = "Volume"
+)
+
+// ResourceLimitItem defines the min / max / default resources of a single object of a ResourceLimitType.
+type ResourceLimitItem struct {
+	// Type is the type of object the limits apply to.
+	Type ResourceLimitType `json:"type"`
+	// Min is the minimum amount of resources an object has to request.
+	Min ResourceList `json:"min,omitempty"`
+	// Max is the maximum amount of resources an object may request.
+	Max ResourceList `json:"max,omitempty"`
+	// Default is the amount of resources used for an object that does not request a resource.
+	Default ResourceList `json:"default,omitempty"`
+}
+
+// AllowedClasses restricts the classes of a ClassType that may be used.
+type AllowedClasses struct {
+	// ClassType is the type of class the restriction applies to.
+	ClassType ClassType `json:"classType"`
+	// Names are the names of the classes that may be used.
+	Names []string `json:"names"`
+}
+
+// ResourceLimitSpec defines the desired state of ResourceLimit
+type ResourceLimitSpec struct {
+	// Limits are the ResourceLimitItems enforced for each object.
+	Limits []ResourceLimitItem `json:"limits,omitempty"`
+	// AllowedClasses are the classes that may be used, per ClassType.
+	// If a ClassType is not listed, all classes of that type may be used.
+	AllowedClasses []AllowedClasses `json:"allowedClasses,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceLimit is the Schema for the resourcelimits API
+type ResourceLimit struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ResourceLimitSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceLimitList contains a list of ResourceLimit
+type ResourceLimitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceLimit `json:"items"`
+}
@@ -27,10 +27,33 @@ type PrefixSpec struct {
 	// If ParentRef and ParentSelector is empty, the Prefix is considered a root prefix and thus
 	// allocated by itself.
 	ParentRef *corev1.LocalObjectReference `json:"parentRef,omitempty"`
+	// ParentNamespace is the namespace of the parent referenced by ParentRef.
+	// If unset, the parent is looked up in the namespace of the Prefix.
+	// Allocating from a parent in another namespace requires a PrefixGrant in the parent's namespace.
+	ParentNamespace string `json:"parentNamespace,omitempty"`
 	// ParentSelector is the LabelSelector to use for determining the parent for this Prefix.
 	ParentSelector *metav1.LabelSelector `json:"parentSelector,omitempty"`
+
+	// ReservedRanges are ranges of the prefix that are never allocated to sub-prefixes,
+	// for example gateway, broadcast or DHCP addresses.
+	ReservedRanges []commonv1alpha1.IPRange `json:"reservedRanges,omitempty"`
+	// AllocationStrategy is the strategy used to allocate sub-prefixes by length.
+	// If unset, PrefixAllocationStrategyBestFit is used.
+	AllocationStrategy PrefixAllocationStrategy `json:"allocationStrategy,omitempty"`
 }
 
+// PrefixAllocationStrategy is a strategy to allocate sub-prefixes by length.
+type PrefixAllocationStrategy string
+
+const (
+	// PrefixAllocationStrategyBestFit allocates from the smallest free block that fits the requested length.
+	PrefixAllocationStrategyBestFit PrefixAllocationStrategy = "BestFit"
+	// PrefixAllocationStrategyFirstFit allocates the free block with the lowest address.
+	PrefixAllocationStrategyFirstFit PrefixAllocationStrategy = "FirstFit"
+	// PrefixAllocationStrategyRandom allocates a random free block, spreading allocations over the prefix.
+	PrefixAllocationStrategyRandom PrefixAllocationStrategy = "Random"
+)
+
 // PrefixStatus defines the observed state of Prefix
 type PrefixStatus struct {
 	// Phase is the PrefixPhase of the Prefix.
@@ -40,6 +63,25 @@ type PrefixStatus struct {
 
 	// Used is a list of used prefixes.
 	Used []commonv1alpha1.IPPrefix `json:"used,omitempty"`
+
+	// Utilization reports the address utilization of the prefix.
+	Utilization *PrefixUtilization `json:"utilization,omitempty"`
+}
+
+// PrefixUtilization reports the address utilization of a Prefix.
+// Address counts are decimal strings since they may exceed 64 bits for IPv6 prefixes.
+type PrefixUtilization struct {
+	// Total is the total number of addresses of the prefix.
+	Total string `json:"total,omitempty"`
+	// Reserved is the number of addresses covered by reserved ranges.
+	Reserved string `json:"reserved,omitempty"`
+	// Used is the number of addresses allocated to sub-prefixes.
+	Used string `json:"used,omitempty"`
+	// Free is the number of addresses that are neither reserved nor used.
+	Free string `json:"free,omitempty"`
+	// LargestFreePrefixLength is the length of the largest free block.
+	// It is unset if there are no free addresses.
+	LargestFreePrefixLength *int32 `json:"largestFreePrefixLength,omitempty"`
 }
 
 // PrefixPhase is a phase a Prefix can be in.
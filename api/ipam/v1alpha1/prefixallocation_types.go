@@ -18,9 +18,20 @@ type PrefixAllocationSpec struct {
 	Prefix *commonv1alpha1.IPPrefix `json:"prefix,omitempty"`
 	// PrefixLength is the length of prefix to allocate for this Prefix.
 	PrefixLength int32 `json:"prefixLength,omitempty"`
+	// Range is the exact range of addresses to allocate.
+	Range *commonv1alpha1.IPRange `json:"range,omitempty"`
+	// RangeLength is the number of contiguous addresses to allocate as a range.
+	RangeLength int32 `json:"rangeLength,omitempty"`
+	// IPCount is the number of single addresses to allocate.
+	// The lowest free addresses are allocated, they are not necessarily contiguous.
+	IPCount int32 `json:"ipCount,omitempty"`
 
 	// PrefixRef references the prefix to allocate from.
 	PrefixRef *corev1.LocalObjectReference `json:"prefixRef,omitempty"`
+	// PrefixNamespace is the namespace of the prefix referenced by PrefixRef.
+	// If unset, the prefix is looked up in the namespace of the PrefixAllocation.
+	// Allocating from a prefix in another namespace requires a PrefixGrant in the prefix's namespace.
+	PrefixNamespace string `json:"prefixNamespace,omitempty"`
 	// PrefixSelector selects the prefix to allocate from.
 	PrefixSelector *metav1.LabelSelector `json:"prefixSelector,omitempty"`
 }
@@ -50,6 +61,10 @@ const (
 type PrefixAllocationStatus struct {
 	// Prefix is the allocated prefix, if any
 	Prefix *commonv1alpha1.IPPrefix `json:"prefix,omitempty"`
+	// Range is the allocated range, if any.
+	Range *commonv1alpha1.IPRange `json:"range,omitempty"`
+	// IPs are the allocated addresses, if any.
+	IPs []commonv1alpha1.IP `json:"ips,omitempty"`
 
 	// Phase is the phase of the PrefixAllocation.
 	Phase PrefixAllocationPhase `json:"phase,omitempty"`
@@ -32,6 +32,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&PrefixList{},
 		&PrefixAllocation{},
 		&PrefixAllocationList{},
+		&PrefixGrant{},
+		&PrefixGrantList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
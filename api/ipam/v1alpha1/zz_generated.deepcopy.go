@@ -111,6 +111,11 @@ func (in *PrefixAllocationSpec) DeepCopyInto(out *PrefixAllocationSpec) {
 		in, out := &in.Prefix, &out.Prefix
 		*out = (*in).DeepCopy()
 	}
+	if in.Range != nil {
+		in, out := &in.Range, &out.Range
+		*out = new(commonv1alpha1.IPRange)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PrefixRef != nil {
 		in, out := &in.PrefixRef, &out.PrefixRef
 		*out = new(v1.LocalObjectReference)
@@ -141,6 +146,18 @@ func (in *PrefixAllocationStatus) DeepCopyInto(out *PrefixAllocationStatus) {
 		in, out := &in.Prefix, &out.Prefix
 		*out = (*in).DeepCopy()
 	}
+	if in.Range != nil {
+		in, out := &in.Range, &out.Range
+		*out = new(commonv1alpha1.IPRange)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = make([]commonv1alpha1.IP, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.LastPhaseTransitionTime != nil {
 		in, out := &in.LastPhaseTransitionTime, &out.LastPhaseTransitionTime
 		*out = (*in).DeepCopy()
@@ -158,6 +175,88 @@ func (in *PrefixAllocationStatus) DeepCopy() *PrefixAllocationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixGrant) DeepCopyInto(out *PrefixGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixGrant.
+func (in *PrefixGrant) DeepCopy() *PrefixGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrefixGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixGrantList) DeepCopyInto(out *PrefixGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PrefixGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixGrantList.
+func (in *PrefixGrantList) DeepCopy() *PrefixGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrefixGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixGrantSpec) DeepCopyInto(out *PrefixGrantSpec) {
+	*out = *in
+	out.PrefixRef = in.PrefixRef
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixGrantSpec.
+func (in *PrefixGrantSpec) DeepCopy() *PrefixGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrefixList) DeepCopyInto(out *PrefixList) {
 	*out = *in
@@ -208,6 +307,13 @@ func (in *PrefixSpec) DeepCopyInto(out *PrefixSpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReservedRanges != nil {
+		in, out := &in.ReservedRanges, &out.ReservedRanges
+		*out = make([]commonv1alpha1.IPRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -235,6 +341,11 @@ func (in *PrefixStatus) DeepCopyInto(out *PrefixStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Utilization != nil {
+		in, out := &in.Utilization, &out.Utilization
+		*out = new(PrefixUtilization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -265,3 +376,24 @@ func (in *PrefixTemplateSpec) DeepCopy() *PrefixTemplateSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixUtilization) DeepCopyInto(out *PrefixUtilization) {
+	*out = *in
+	if in.LargestFreePrefixLength != nil {
+		in, out := &in.LargestFreePrefixLength, &out.LargestFreePrefixLength
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixUtilization.
+func (in *PrefixUtilization) DeepCopy() *PrefixUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
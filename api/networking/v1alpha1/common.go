@@ -13,6 +13,22 @@ const (
 
 	// NetworkPluginUserNamePrefix is the prefix all network plugin users should have.
 	NetworkPluginUserNamePrefix = "networking.ironcore.dev:system:networkplugin:"
+
+	// PublicIPPoolPrefixLabel is the label on the ipam Prefixes backing a PublicIPPool.
+	// Its value is the name of the PublicIPPool.
+	PublicIPPoolPrefixLabel = "networking.ironcore.dev/public-ip-pool"
+	// PublicIPPoolAllocationLabel is the label on the ipam Prefixes allocated from a PublicIPPool.
+	// Its value is the name of the PublicIPPool.
+	PublicIPPoolAllocationLabel = "networking.ironcore.dev/public-ip-pool-allocation"
+	// PublicIPPoolClaimerKindLabel is the label on an allocation of a PublicIPPool denoting the kind of the claimer.
+	PublicIPPoolClaimerKindLabel = "networking.ironcore.dev/public-ip-pool-claimer-kind"
+	// PublicIPPoolClaimerNamespaceLabel is the label on an allocation of a PublicIPPool denoting the namespace
+	// of the claimer.
+	PublicIPPoolClaimerNamespaceLabel = "networking.ironcore.dev/public-ip-pool-claimer-namespace"
+	// PublicIPPoolClaimerNameLabel is the label on an allocation of a PublicIPPool denoting the name of the claimer.
+	PublicIPPoolClaimerNameLabel = "networking.ironcore.dev/public-ip-pool-claimer-name"
+	// PublicIPPoolClaimerUIDLabel is the label on an allocation of a PublicIPPool denoting the uid of the claimer.
+	PublicIPPoolClaimerUIDLabel = "networking.ironcore.dev/public-ip-pool-claimer-uid"
 )
 
 // NetworkPluginCommonName constructs the common name for a certificate of a network plugin user.
@@ -25,6 +41,10 @@ func NetworkPluginCommonName(name string) string {
 type EphemeralPrefixSource struct {
 	// PrefixTemplate is the template for the Prefix.
 	PrefixTemplate *ipamv1alpha1.PrefixTemplateSpec `json:"prefixTemplate,omitempty"`
+	// DelegatedPrefixLength is the length of a prefix that is delegated in addition to the ephemeral IP.
+	// The delegated prefix is allocated from the same parent as the ephemeral IP and has its IP family.
+	// Only applicable to ephemeral IP sources.
+	DelegatedPrefixLength *int32 `json:"delegatedPrefixLength,omitempty"`
 }
 
 // EphemeralVirtualIPSource contains the definition to create an ephemeral (i.e. coupled to the lifetime of the
@@ -29,6 +29,9 @@ type LoadBalancerSpec struct {
 	IPs []IPSource `json:"ips,omitempty"`
 	// NetworkRef is the Network this LoadBalancer should belong to.
 	NetworkRef corev1.LocalObjectReference `json:"networkRef"`
+	// PublicIPPoolRefs reference the PublicIPPools to allocate the IPs from, one PublicIPPool per ip family.
+	// Can only be used when Type is LoadBalancerTypePublic. If unset, the IPs are allocated by the provider.
+	PublicIPPoolRefs []corev1.LocalObjectReference `json:"publicIPPoolRefs,omitempty"`
 	// NetworkInterfaceSelector defines the NetworkInterfaces
 	// for which this LoadBalancer should be applied
 	NetworkInterfaceSelector *metav1.LabelSelector `json:"networkInterfaceSelector,omitempty"`
@@ -51,6 +54,8 @@ type LoadBalancerPort struct {
 type LoadBalancerStatus struct {
 	// IPs are the IPs allocated for the load balancer.
 	IPs []commonv1alpha1.IP `json:"ips,omitempty"`
+	// DelegatedPrefixes are the prefixes delegated to the load balancer via its ephemeral IP sources.
+	DelegatedPrefixes []commonv1alpha1.IPPrefix `json:"delegatedPrefixes,omitempty"`
 }
 
 // +genclient
@@ -45,6 +45,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LoadBalancerRoutingList{},
 		&NATGateway{},
 		&NATGatewayList{},
+		&Subnet{},
+		&SubnetList{},
+		&PublicIPPool{},
+		&PublicIPPoolList{},
+		&RouteTable{},
+		&RouteTableList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RouteTableSpec defines the desired state of RouteTable
+type RouteTableSpec struct {
+	// NetworkRef is the Network this RouteTable applies to.
+	NetworkRef corev1.LocalObjectReference `json:"networkRef"`
+	// Routes are the static routes of the RouteTable.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	Routes []Route `json:"routes,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+}
+
+// Route is a static route.
+type Route struct {
+	// Name is the semantical name of the route.
+	Name string `json:"name"`
+	// Destination is the destination prefix of the route.
+	Destination commonv1alpha1.IPPrefix `json:"destination"`
+	// NextHop is the next hop traffic to the destination is sent to.
+	NextHop RouteNextHop `json:"nextHop"`
+}
+
+// RouteNextHop is the next hop of a route. Exactly one of the fields has to be set.
+type RouteNextHop struct {
+	// NetworkInterfaceRef references a NetworkInterface of the Network acting as the next hop,
+	// e.g. a NetworkInterface of an appliance machine.
+	NetworkInterfaceRef *corev1.LocalObjectReference `json:"networkInterfaceRef,omitempty"`
+	// VirtualIPRef references a VirtualIP acting as the next hop.
+	VirtualIPRef *corev1.LocalObjectReference `json:"virtualIPRef,omitempty"`
+	// NATGatewayRef references a NATGateway of the Network acting as the next hop.
+	NATGatewayRef *corev1.LocalObjectReference `json:"natGatewayRef,omitempty"`
+	// NetworkPeeringRef references a peering of the Network by name.
+	// Traffic is routed to the peered Network.
+	NetworkPeeringRef *corev1.LocalObjectReference `json:"networkPeeringRef,omitempty"`
+}
+
+// RouteTableStatus defines the observed state of RouteTable
+type RouteTableStatus struct {
+	// Routes contains the status of the routes of the RouteTable.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	Routes []RouteStatus `json:"routes,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+}
+
+// RouteState is the state a Route can be in.
+// +enum
+type RouteState string
+
+const (
+	// RouteStatePending signals that the route is not yet programmed by the provider.
+	RouteStatePending RouteState = "Pending"
+	// RouteStateApplied signals that the route is programmed by the provider.
+	RouteStateApplied RouteState = "Applied"
+	// RouteStateError signals that the provider failed to program the route.
+	RouteStateError RouteState = "Error"
+)
+
+// RouteStatus is the status of a route.
+type RouteStatus struct {
+	// Name is the name of the route.
+	Name string `json:"name"`
+	// State is the state of the route.
+	State RouteState `json:"state,omitempty"`
+	// Message is a human-readable message indicating details about the state of the route.
+	Message string `json:"message,omitempty"`
+	// LastStateTransitionTime is the last time the State transitioned from one value to another.
+	LastStateTransitionTime *metav1.Time `json:"lastStateTransitionTime,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RouteTable is the Schema for the routetables API
+type RouteTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouteTableSpec   `json:"spec,omitempty"`
+	Status RouteTableStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RouteTableList contains a list of RouteTable
+type RouteTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RouteTable `json:"items"`
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubnetSpec defines the desired state of Subnet
+type SubnetSpec struct {
+	// NetworkRef is the Network this Subnet belongs to.
+	NetworkRef corev1.LocalObjectReference `json:"networkRef"`
+	// Prefixes are the prefixes of the Subnet. At most one prefix per IP family may be specified.
+	// If the referenced Network specifies prefixes, the Subnet prefixes have to be contained in them.
+	Prefixes []commonv1alpha1.IPPrefix `json:"prefixes"`
+}
+
+// SubnetStatus defines the observed state of Subnet
+type SubnetStatus struct {
+	// State is the state of the Subnet.
+	State SubnetState `json:"state,omitempty"`
+	// LastStateTransitionTime is the last time the State transitioned from one value to another.
+	LastStateTransitionTime *metav1.Time `json:"lastStateTransitionTime,omitempty"`
+}
+
+// SubnetState is the state of a Subnet.
+// +enum
+type SubnetState string
+
+const (
+	// SubnetStatePending means the Subnet prefixes are not yet allocated.
+	SubnetStatePending SubnetState = "Pending"
+	// SubnetStateAvailable means all Subnet prefixes are allocated and the Subnet is ready to use.
+	SubnetStateAvailable SubnetState = "Available"
+	// SubnetStateError means the Subnet prefixes could not be allocated, e.g. because they are
+	// not contained in the Network prefixes or overlap with another Subnet.
+	SubnetStateError SubnetState = "Error"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Subnet is the Schema for the subnets API
+type Subnet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubnetSpec   `json:"spec,omitempty"`
+	Status SubnetStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SubnetList contains a list of Subnet
+type SubnetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subnet `json:"items"`
+}
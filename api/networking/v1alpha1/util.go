@@ -3,7 +3,12 @@
 
 package v1alpha1
 
-import "fmt"
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
 
 // NetworkInterfaceVirtualIPName returns the name of a VirtualIP for a NetworkInterface VirtualIPSource.
 func NetworkInterfaceVirtualIPName(nicName string, vipSource VirtualIPSource) string {
@@ -27,11 +32,22 @@ func NetworkInterfacePrefixIPAMPrefixName(nicName string, idx int) string {
 	return fmt.Sprintf("%s-pf-%d", nicName, idx)
 }
 
+// NetworkInterfaceDelegatedPrefixIPAMPrefixName returns the name of a Prefix for a network interface
+// delegated prefix.
+func NetworkInterfaceDelegatedPrefixIPAMPrefixName(nicName string, idx int) string {
+	return fmt.Sprintf("%s-dp-%d", nicName, idx)
+}
+
 // LoadBalancerIPIPAMPrefixName returns the name of a Prefix for a network interface ephemeral prefix.
 func LoadBalancerIPIPAMPrefixName(loadBalancerName string, idx int) string {
 	return fmt.Sprintf("%s-%d", loadBalancerName, idx)
 }
 
+// LoadBalancerDelegatedPrefixIPAMPrefixName returns the name of a Prefix for a load balancer delegated prefix.
+func LoadBalancerDelegatedPrefixIPAMPrefixName(loadBalancerName string, idx int) string {
+	return fmt.Sprintf("%s-dp-%d", loadBalancerName, idx)
+}
+
 // NetworkInterfacePrefixNames returns the name of all ipam prefixes the network interface references.
 func NetworkInterfacePrefixNames(nic *NetworkInterface) []string {
 	var names []string
@@ -42,6 +58,9 @@ func NetworkInterfacePrefixNames(nic *NetworkInterface) []string {
 		}
 
 		names = append(names, NetworkInterfaceIPIPAMPrefixName(nic.Name, i))
+		if nicIP.Ephemeral.DelegatedPrefixLength != nil {
+			names = append(names, NetworkInterfaceDelegatedPrefixIPAMPrefixName(nic.Name, i))
+		}
 	}
 
 	for i, nicPrefix := range nic.Spec.Prefixes {
@@ -65,7 +84,42 @@ func LoadBalancerPrefixNames(loadBalancer *LoadBalancer) []string {
 		}
 
 		names = append(names, LoadBalancerIPIPAMPrefixName(loadBalancer.Name, i))
+		if loadBalancerIP.Ephemeral.DelegatedPrefixLength != nil {
+			names = append(names, LoadBalancerDelegatedPrefixIPAMPrefixName(loadBalancer.Name, i))
+		}
 	}
 
 	return names
 }
+
+// NetworkPrefixIPAMPrefixName returns the name of a Prefix for a network prefix.
+func NetworkPrefixIPAMPrefixName(networkName string, idx int) string {
+	return fmt.Sprintf("%s-nw-%d", networkName, idx)
+}
+
+// SubnetPrefixIPAMPrefixName returns the name of a Prefix for a subnet prefix.
+func SubnetPrefixIPAMPrefixName(subnetName string, idx int) string {
+	return fmt.Sprintf("%s-sn-%d", subnetName, idx)
+}
+
+// SubnetPrefixIndexByIPFamily returns the index of the subnet prefix of the given ip family.
+// If the subnet has no prefix of the given ip family, -1 is returned.
+func SubnetPrefixIndexByIPFamily(subnet *Subnet, ipFamily corev1.IPFamily) int {
+	for i, prefix := range subnet.Spec.Prefixes {
+		if prefix.IP().Family() == ipFamily {
+			return i
+		}
+	}
+	return -1
+}
+
+// PublicIPPoolPrefixIPAMPrefixName returns the name of a Prefix backing a public ip pool prefix.
+func PublicIPPoolPrefixIPAMPrefixName(poolName string, idx int) string {
+	return fmt.Sprintf("%s-%d", poolName, idx)
+}
+
+// PublicIPPoolAllocationIPAMPrefixName returns the name of a Prefix allocated from a public ip pool
+// for the ip with the given index of the claimer with the given uid.
+func PublicIPPoolAllocationIPAMPrefixName(claimerUID types.UID, idx int) string {
+	return fmt.Sprintf("%s-%d", claimerUID, idx)
+}
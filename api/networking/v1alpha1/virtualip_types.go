@@ -15,6 +15,9 @@ type VirtualIPSpec struct {
 	Type VirtualIPType `json:"type"`
 	// IPFamily is the ip family of the VirtualIP.
 	IPFamily corev1.IPFamily `json:"ipFamily"`
+	// PublicIPPoolRef references the PublicIPPool to allocate the IP from.
+	// If unset, the IP is allocated by the provider.
+	PublicIPPoolRef *corev1.LocalObjectReference `json:"publicIPPoolRef,omitempty"`
 
 	// TargetRef references the target for this VirtualIP (currently only NetworkInterface).
 	TargetRef *commonv1alpha1.LocalUIDReference `json:"targetRef,omitempty"`
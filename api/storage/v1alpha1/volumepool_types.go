@@ -43,6 +43,12 @@ const (
 // VolumePoolConditionType is a type a VolumePoolCondition can have.
 type VolumePoolConditionType string
 
+const (
+	// VolumePoolRuntimeCapabilitiesSupported reports whether the volume runtime of a VolumePool supports all
+	// optional capabilities. If not, the message lists the missing capabilities.
+	VolumePoolRuntimeCapabilitiesSupported VolumePoolConditionType = "RuntimeCapabilitiesSupported"
+)
+
 // VolumePoolCondition is one of the conditions of a volume.
 type VolumePoolCondition struct {
 	// Type is the type of the condition.
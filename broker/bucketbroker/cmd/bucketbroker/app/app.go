@@ -7,22 +7,29 @@ import (
 	"context"
 	goflag "flag"
 	"fmt"
-	"net"
 
 	"github.com/ironcore-dev/controller-utils/configutils"
 	"github.com/ironcore-dev/ironcore/broker/bucketbroker/server"
 	"github.com/ironcore-dev/ironcore/broker/common"
+	commongrpc "github.com/ironcore-dev/ironcore/broker/common/grpc"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	iriauth "github.com/ironcore-dev/ironcore/iri/auth"
+	irimetrics "github.com/ironcore-dev/ironcore/iri/metrics"
+	"github.com/ironcore-dev/ironcore/utils/tracing"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
 
 type Options struct {
-	Kubeconfig string
-	Address    string
+	Kubeconfig  string
+	Address     string
+	Auth        iriauth.ServerFlags
+	MetricsAddr string
+	Tracing     tracing.Options
 
 	Namespace          string
 	BucketPoolName     string
@@ -31,7 +38,12 @@ type Options struct {
 
 func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "Path pointing to a kubeconfig file to use.")
-	fs.StringVar(&o.Address, "address", "/var/run/iri-bucketbroker.sock", "Address to listen on.")
+	fs.StringVar(&o.Address, "address", "/var/run/iri-bucketbroker.sock", "Address to listen on. "+
+		"Either a unix socket path or 'tcp://<host>:<port>' to listen via tcp.")
+	o.Auth.BindFlags(fs)
+	fs.StringVar(&o.MetricsAddr, "metrics-bind-address", "0", "The address the metric endpoint binds to. "+
+		"Set to '0' to disable serving metrics.")
+	o.Tracing.BindFlags(fs)
 
 	fs.StringVar(&o.Namespace, "namespace", o.Namespace, "Target Kubernetes namespace to use.")
 	fs.StringVar(&o.BucketPoolName, "bucket-pool-name", o.BucketPoolName, "Name of the target bucket pool to pin buckets to, if any.")
@@ -74,6 +86,17 @@ func Run(ctx context.Context, opts Options) error {
 		return err
 	}
 
+	shutdownTracing, err := tracing.Setup(ctx, "bucketbroker", opts.Tracing)
+	if err != nil {
+		return fmt.Errorf("error setting up tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "Error shutting down tracing")
+		}
+	}()
+	tracing.WrapConfig(cfg)
+
 	srv, err := server.New(cfg, server.Options{
 		Namespace:          opts.Namespace,
 		BucketPoolName:     opts.BucketPoolName,
@@ -83,47 +106,66 @@ func Run(ctx context.Context, opts Options) error {
 		return fmt.Errorf("error creating server: %w", err)
 	}
 
-	log.V(1).Info("Cleaning up any previous socket")
-	if err := common.CleanupSocketIfExists(opts.Address); err != nil {
-		return fmt.Errorf("error cleaning up socket: %w", err)
+	authOpts, err := opts.Auth.ServerOptions()
+	if err != nil {
+		return fmt.Errorf("error getting auth options: %w", err)
+	}
+	authSrvOpts, err := iriauth.GRPCServerOptions(authOpts)
+	if err != nil {
+		return fmt.Errorf("error getting grpc server auth options: %w", err)
 	}
 
-	log.V(1).Info("Start listening on unix socket", "Address", opts.Address)
-	l, err := net.Listen("unix", opts.Address)
+	log.V(1).Info("Start listening", "Address", opts.Address)
+	l, err := common.Listen(opts.Address)
 	if err != nil {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
+	if authOpts.TokenFile != "" && authOpts.TLS == nil && l.Addr().Network() == "tcp" {
+		setupLog.Info("Warning: Bearer tokens are sent over plaintext tcp, set tls-cert-file and tls-key-file to protect them")
+	}
 	defer func() {
 		if err := l.Close(); err != nil {
 			log.Error(err, "Error closing socket")
 		}
 	}()
 
-	grpcSrv := grpc.NewServer(
-		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-			log := log.WithName(info.FullMethod)
-			ctx = ctrl.LoggerInto(ctx, log)
-			log.V(1).Info("Request")
-			resp, err = handler(ctx, req)
-			if err != nil {
-				log.Error(err, "Error handling request")
-			}
-			return resp, err
-		}),
-	)
+	srvOpts := append(irimetrics.ServerOptions(), tracing.ServerOptions()...)
+	srvOpts = append(srvOpts, authSrvOpts...)
+	grpcSrv := grpc.NewServer(append(srvOpts,
+		grpc.ChainUnaryInterceptor(
+			func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+				log := log.WithName(info.FullMethod)
+				ctx = ctrl.LoggerInto(ctx, log)
+				log.V(1).Info("Request")
+				resp, err = handler(ctx, req)
+				if err != nil {
+					log.Error(err, "Error handling request")
+				}
+				return resp, err
+			},
+			commongrpc.ConvertErrors,
+		),
+	)...)
 	iri.RegisterBucketRuntimeServer(grpcSrv, srv)
 
-	setupLog.Info("Starting server", "Address", l.Addr().String())
-	go func() {
-		defer func() {
-			setupLog.Info("Shutting down server")
-			grpcSrv.Stop()
-			setupLog.Info("Shut down server")
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return common.ServeMetrics(ctx, cfg, opts.MetricsAddr)
+	})
+	g.Go(func() error {
+		setupLog.Info("Starting server", "Address", l.Addr().String())
+		go func() {
+			defer func() {
+				setupLog.Info("Shutting down server")
+				grpcSrv.Stop()
+				setupLog.Info("Shut down server")
+			}()
+			<-ctx.Done()
 		}()
-		<-ctx.Done()
-	}()
-	if err := grpcSrv.Serve(l); err != nil {
-		return fmt.Errorf("error serving: %w", err)
-	}
-	return nil
+		if err := grpcSrv.Serve(l); err != nil {
+			return fmt.Errorf("error serving: %w", err)
+		}
+		return nil
+	})
+	return g.Wait()
 }
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	bucketbrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/bucketbroker/api/v1alpha1"
+	brokerwatch "github.com/ironcore-dev/ironcore/broker/common/watch"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/watch"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (s *Server) newBucketHub(watchClient client.WithWatch) *watch.Hub[*iri.Bucket] {
+	return watch.NewHub(s.listBuckets, watch.HubOptions{
+		Notify: brokerwatch.NotifyOnChange(watchClient, &storagev1alpha1.BucketList{},
+			client.InNamespace(s.namespace),
+			client.MatchingLabels{
+				bucketbrokerv1alpha1.ManagerLabel: bucketbrokerv1alpha1.BucketBrokerManager,
+			},
+		),
+	})
+}
+
+func (s *Server) WatchBuckets(req *iri.WatchBucketsRequest, stream iri.BucketRuntime_WatchBucketsServer) error {
+	return s.bucketHub.Watch(stream.Context(), req.ResourceVersion, bucketFilterFunc(req.Filter), func(event watch.Event[*iri.Bucket]) error {
+		return stream.Send(&iri.WatchBucketsResponse{
+			Type:            event.Type,
+			Bucket:          event.Object,
+			ResourceVersion: event.ResourceVersion,
+		})
+	})
+}
+
+func bucketFilterFunc(filter *iri.BucketFilter) func(*iri.Bucket) bool {
+	if filter == nil {
+		return nil
+	}
+
+	sel := labels.SelectorFromSet(filter.LabelSelector)
+	return func(bucket *iri.Bucket) bool {
+		if filter.Id != "" && filter.Id != bucket.Metadata.Id {
+			return false
+		}
+		return sel.Matches(labels.Set(bucket.Metadata.Labels))
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/ironcore-dev/ironcore/broker/bucketbroker/apiutils"
 	"github.com/ironcore-dev/ironcore/broker/common/cleaner"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/watch"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -47,6 +48,8 @@ type Server struct {
 	namespace          string
 	bucketPoolName     string
 	bucketPoolSelector map[string]string
+
+	bucketHub *watch.Hub[*iri.Bucket]
 }
 
 func (s *Server) loggerFrom(ctx context.Context, keysWithValues ...interface{}) logr.Logger {
@@ -115,12 +118,21 @@ func New(cfg *rest.Config, opts Options) (*Server, error) {
 		return nil, fmt.Errorf("error creating client: %w", err)
 	}
 
-	return &Server{
+	watchClient, err := client.NewWithWatch(cfg, client.Options{
+		Scheme: scheme,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating watch client: %w", err)
+	}
+
+	s := &Server{
 		client:             c,
 		namespace:          opts.Namespace,
 		bucketPoolName:     opts.BucketPoolName,
 		bucketPoolSelector: opts.BucketPoolSelector,
-	}, nil
+	}
+	s.bucketHub = s.newBucketHub(watchClient)
+	return s, nil
 }
 
 func (s *Server) getManagedAndCreated(ctx context.Context, name string, obj client.Object) error {
@@ -0,0 +1,13 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package version
+
+const (
+	RuntimeName = "bucketbroker"
+)
+
+var (
+	Version string
+	Commit  string
+)
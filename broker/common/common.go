@@ -6,7 +6,9 @@ package common
 import (
 	"errors"
 	"fmt"
+	"net"
 	"os"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -111,3 +113,20 @@ func CleanupSocketIfExists(address string) error {
 	}
 	return nil
 }
+
+// Listen listens on the given address. Addresses of the form 'tcp://<host>:<port>' are listened on via tcp,
+// any other address is treated as path of a unix socket (optionally prefixed with 'unix://').
+// Any previous socket at the path is cleaned up before listening.
+//
+// Clients dial the same addresses via iri/remote.DialTarget.
+func Listen(address string) (net.Listener, error) {
+	if addr, ok := strings.CutPrefix(address, "tcp://"); ok {
+		return net.Listen("tcp", addr)
+	}
+
+	path := strings.TrimPrefix(address, "unix://")
+	if err := CleanupSocketIfExists(path); err != nil {
+		return nil, fmt.Errorf("error cleaning up socket: %w", err)
+	}
+	return net.Listen("unix", path)
+}
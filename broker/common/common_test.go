@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package common_test
+
+import (
+	"path/filepath"
+
+	. "github.com/ironcore-dev/ironcore/broker/common"
+	"github.com/ironcore-dev/ironcore/iri/remote"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var _ = Describe("Listen", func() {
+	// serve listens on the given address and returns the address clients have to dial.
+	serve := func(address string) string {
+		l, err := Listen(address)
+		Expect(err).NotTo(HaveOccurred())
+
+		srv := grpc.NewServer()
+		healthpb.RegisterHealthServer(srv, health.NewServer())
+		go func() {
+			defer GinkgoRecover()
+			Expect(srv.Serve(l)).To(Succeed())
+		}()
+		DeferCleanup(srv.Stop)
+
+		if l.Addr().Network() == "tcp" {
+			return "tcp://" + l.Addr().String()
+		}
+		return address
+	}
+
+	DescribeTable("should be dialable via the same address",
+		func(ctx SpecContext, address func() string) {
+			dialAddress := serve(address())
+
+			conn, err := grpc.Dial(remote.DialTarget(dialAddress), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(conn.Close)
+
+			res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Status).To(Equal(healthpb.HealthCheckResponse_SERVING))
+		},
+		Entry("tcp address", func() string { return "tcp://127.0.0.1:0" }),
+		Entry("unix socket path", func() string { return filepath.Join(GinkgoT().TempDir(), "iri.sock") }),
+		Entry("unix socket url", func() string { return "unix://" + filepath.Join(GinkgoT().TempDir(), "iri.sock") }),
+	)
+})
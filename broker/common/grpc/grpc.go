@@ -5,11 +5,16 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/go-logr/logr"
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -22,15 +27,64 @@ func InjectLogger(log logr.Logger) grpc.UnaryServerInterceptor {
 	})
 }
 
-// LogRequest logs grpc requests. In case any request returns with status.Code == codes.Unknown, the error is logged.
+// LogRequest logs grpc requests. In case any request returns with status.Code == codes.Unknown or codes.Internal,
+// the error is logged. Other failures are logged at verbosity 1 along with their IRI error reason.
 var LogRequest = grpc.UnaryServerInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 	log := ctrl.LoggerFrom(ctx)
 	log.V(1).Info("Request")
 	resp, err = handler(ctx, req)
 	if err != nil {
-		if code := status.Code(err); code == codes.Unknown {
+		switch code := status.Code(err); code {
+		case codes.Unknown:
 			log.Error(err, "Unknown error handling request")
+		case codes.Internal:
+			log.Error(err, "Internal error handling request")
+		default:
+			log.V(1).Info("Request failed", "Code", code, "Reason", errdetails.Reason(err), "Error", err)
 		}
 	}
 	return resp, err
 })
+
+// ConvertErrors converts Kubernetes API errors returned by the handler using ConvertAPIError.
+var ConvertErrors = grpc.UnaryServerInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	resp, err = handler(ctx, req)
+	return resp, ConvertAPIError(err)
+})
+
+// ConvertAPIError converts a Kubernetes API error contained in err into a status error carrying an IRI error detail,
+// allowing clients to tell invalid requests from exhausted quota and transient failures.
+// Errors that already contain a status or don't contain an API error are returned unchanged.
+func ConvertAPIError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var apiStatus apierrors.APIStatus
+	if !errors.As(err, &apiStatus) {
+		return err
+	}
+
+	var retryAfter time.Duration
+	if seconds, ok := apierrors.SuggestsClientDelay(err); ok {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+
+	switch {
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return errdetails.InvalidSpec("", "%v", err)
+	case apierrors.IsForbidden(err) && apierrors.HasStatusCause(err, corev1alpha1.CauseTypeQuotaExceeded):
+		return errdetails.InsufficientCapacity(retryAfter, "%v", err)
+	case apierrors.IsConflict(err),
+		apierrors.IsServerTimeout(err),
+		apierrors.IsTimeout(err),
+		apierrors.IsTooManyRequests(err),
+		apierrors.IsServiceUnavailable(err):
+		return errdetails.Transient(retryAfter, "%v", err)
+	default:
+		return err
+	}
+}
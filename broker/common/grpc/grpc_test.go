@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc_test
+
+import (
+	"errors"
+	"fmt"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/broker/common/grpc"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/errdetails"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("ConvertAPIError", func() {
+	machineResource := schema.GroupResource{Group: "compute.ironcore.dev", Resource: "machines"}
+
+	quotaExceededError := func() error {
+		err := apierrors.NewForbidden(machineResource, "foo", errors.New("exceeded quota: my-quota"))
+		err.ErrStatus.Details.Causes = append(err.ErrStatus.Details.Causes, metav1.StatusCause{
+			Type:    corev1alpha1.CauseTypeQuotaExceeded,
+			Message: "exceeded quota: my-quota",
+		})
+		return err
+	}
+
+	DescribeTable("should convert api errors",
+		func(err error, expectedCode codes.Code, expectedReason irimeta.ErrorReason) {
+			actualErr := ConvertAPIError(fmt.Errorf("error creating machine: %w", err))
+			Expect(status.Code(actualErr)).To(Equal(expectedCode))
+			Expect(errdetails.Reason(actualErr)).To(Equal(expectedReason))
+		},
+		Entry("invalid",
+			apierrors.NewInvalid(schema.GroupKind{Group: "compute.ironcore.dev", Kind: "Machine"}, "foo", field.ErrorList{
+				field.Required(field.NewPath("spec", "machineClassRef"), "must specify machine class"),
+			}),
+			codes.InvalidArgument, irimeta.ErrorReason_ERROR_REASON_INVALID_SPEC,
+		),
+		Entry("exceeded quota",
+			quotaExceededError(),
+			codes.ResourceExhausted, irimeta.ErrorReason_ERROR_REASON_INSUFFICIENT_CAPACITY,
+		),
+		Entry("conflict",
+			apierrors.NewConflict(machineResource, "foo", errors.New("object was modified")),
+			codes.Unavailable, irimeta.ErrorReason_ERROR_REASON_TRANSIENT,
+		),
+	)
+
+	It("should not treat forbidden errors without quota cause as exceeded quota", func() {
+		err := apierrors.NewForbidden(machineResource, "foo", errors.New("exceeded quota: but not from the quota plugin"))
+		Expect(ConvertAPIError(err)).To(BeIdenticalTo(err))
+	})
+
+	It("should return errors that are no api errors or already contain a status unchanged", func() {
+		err := errors.New("foo")
+		Expect(ConvertAPIError(err)).To(BeIdenticalTo(err))
+
+		statusErr := status.Error(codes.NotFound, "not found")
+		Expect(ConvertAPIError(statusErr)).To(BeIdenticalTo(statusErr))
+		Expect(ConvertAPIError(nil)).To(Succeed())
+	})
+})
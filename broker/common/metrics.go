@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/rest"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+// ServeMetrics serves the controller-runtime metrics registry on the given bind address until the context is done.
+// A bind address of "0" disables serving metrics.
+func ServeMetrics(ctx context.Context, cfg *rest.Config, bindAddress string) error {
+	httpClient, err := rest.HTTPClientFor(cfg)
+	if err != nil {
+		return fmt.Errorf("error creating http client: %w", err)
+	}
+
+	srv, err := metricsserver.NewServer(metricsserver.Options{BindAddress: bindAddress}, cfg, httpClient)
+	if err != nil {
+		return fmt.Errorf("error creating metrics server: %w", err)
+	}
+	if srv == nil {
+		return nil
+	}
+	return srv.Start(ctx)
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NotifyOnChange returns a function that watches the given objects and calls resync on every change.
+// It is intended to be used as iri/watch.HubOptions.Notify, so that brokers report changes of their
+// ironcore objects without waiting for the next resync and only need a long safety resync.
+func NotifyOnChange(c client.WithWatch, list client.ObjectList, opts ...client.ListOption) func(ctx context.Context, resync func()) {
+	return func(ctx context.Context, resync func()) {
+		log := ctrl.LoggerFrom(ctx).WithName("notify")
+
+		wait.UntilWithContext(ctx, func(ctx context.Context) {
+			w, err := c.Watch(ctx, list, opts...)
+			if err != nil {
+				log.Error(err, "Error watching")
+				return
+			}
+			defer w.Stop()
+
+			// Changes between the end of the previous and the start of this watch were not observed.
+			resync()
+			for range w.ResultChan() {
+				resync()
+			}
+		}, 1*time.Second)
+	}
+}
+
+// NotifyAll returns a function that runs all given notify functions concurrently until the context is done.
+// It allows hubs whose objects are assembled from multiple kinds to be notified on changes of any of them.
+func NotifyAll(notifies ...func(ctx context.Context, resync func())) func(ctx context.Context, resync func()) {
+	return func(ctx context.Context, resync func()) {
+		var wg sync.WaitGroup
+		for _, notify := range notifies {
+			wg.Add(1)
+			go func(notify func(ctx context.Context, resync func())) {
+				defer wg.Done()
+				notify(ctx, resync)
+			}(notify)
+		}
+		wg.Wait()
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"errors"
 	goflag "flag"
 	"fmt"
-	"net"
 	"net/http"
 	"net/url"
 
@@ -19,6 +18,9 @@ import (
 	machinebrokerhttp "github.com/ironcore-dev/ironcore/broker/machinebroker/http"
 	"github.com/ironcore-dev/ironcore/broker/machinebroker/server"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	iriauth "github.com/ironcore-dev/ironcore/iri/auth"
+	irimetrics "github.com/ironcore-dev/ironcore/iri/metrics"
+	"github.com/ironcore-dev/ironcore/utils/tracing"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"golang.org/x/sync/errgroup"
@@ -33,6 +35,9 @@ type Options struct {
 	StreamingAddress        string
 	BaseURL                 string
 	BrokerDownwardAPILabels map[string]string
+	Auth                    iriauth.ServerFlags
+	MetricsAddr             string
+	Tracing                 tracing.Options
 
 	Namespace           string
 	MachinePoolName     string
@@ -41,12 +46,17 @@ type Options struct {
 
 func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "Path pointing to a kubeconfig file to use.")
-	fs.StringVar(&o.Address, "address", "/var/run/iri-machinebroker.sock", "Address to listen on.")
+	fs.StringVar(&o.Address, "address", "/var/run/iri-machinebroker.sock", "Address to listen on. "+
+		"Either a unix socket path or 'tcp://<host>:<port>' to listen via tcp.")
 	fs.StringVar(&o.StreamingAddress, "streaming-address", "127.0.0.1:20251", "Address to run the streaming server on")
 	fs.StringVar(&o.BaseURL, "base-url", "", "The base url to construct urls for streaming from. If empty it will be "+
 		"constructed from the streaming-address")
 	fs.StringToStringVar(&o.BrokerDownwardAPILabels, "broker-downward-api-label", nil, "The labels to broker via downward API. "+
 		"Example is for instance to broker \"root-machine-uid\" initially obtained via \"machinepoollet.ironcore.dev/machine-uid\".")
+	o.Auth.BindFlags(fs)
+	fs.StringVar(&o.MetricsAddr, "metrics-bind-address", "0", "The address the metric endpoint binds to. "+
+		"Set to '0' to disable serving metrics.")
+	o.Tracing.BindFlags(fs)
 
 	fs.StringVar(&o.Namespace, "namespace", o.Namespace, "Target Kubernetes namespace to use.")
 	fs.StringVar(&o.MachinePoolName, "machine-pool-name", o.MachinePoolName, "Name of the target machine pool to pin machines to, if any.")
@@ -89,6 +99,17 @@ func Run(ctx context.Context, opts Options) error {
 		return err
 	}
 
+	shutdownTracing, err := tracing.Setup(ctx, "machinebroker", opts.Tracing)
+	if err != nil {
+		return fmt.Errorf("error setting up tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "Error shutting down tracing")
+		}
+	}()
+	tracing.WrapConfig(cfg)
+
 	if opts.Namespace == "" {
 		return fmt.Errorf("must specify namespace")
 	}
@@ -130,6 +151,9 @@ func Run(ctx context.Context, opts Options) error {
 	g.Go(func() error {
 		return runStreamingServer(ctx, setupLog, log, srv, opts)
 	})
+	g.Go(func() error {
+		return common.ServeMetrics(ctx, cfg, opts.MetricsAddr)
+	})
 	return g.Wait()
 }
 
@@ -143,24 +167,34 @@ func runServer(ctx context.Context, setupLog, log logr.Logger, srv *server.Serve
 }
 
 func runGRPCServer(ctx context.Context, setupLog logr.Logger, log logr.Logger, srv *server.Server, opts Options) error {
-	log.V(1).Info("Cleaning up any previous socket")
-	if err := common.CleanupSocketIfExists(opts.Address); err != nil {
-		return fmt.Errorf("error cleaning up socket: %w", err)
+	authOpts, err := opts.Auth.ServerOptions()
+	if err != nil {
+		return fmt.Errorf("error getting auth options: %w", err)
+	}
+	authSrvOpts, err := iriauth.GRPCServerOptions(authOpts)
+	if err != nil {
+		return fmt.Errorf("error getting grpc server auth options: %w", err)
 	}
 
-	grpcSrv := grpc.NewServer(
+	srvOpts := append(irimetrics.ServerOptions(), tracing.ServerOptions()...)
+	srvOpts = append(srvOpts, authSrvOpts...)
+	grpcSrv := grpc.NewServer(append(srvOpts,
 		grpc.ChainUnaryInterceptor(
 			commongrpc.InjectLogger(log),
 			commongrpc.LogRequest,
+			commongrpc.ConvertErrors,
 		),
-	)
+	)...)
 	iri.RegisterMachineRuntimeServer(grpcSrv, srv)
 
-	log.V(1).Info("Start listening on unix socket", "Address", opts.Address)
-	l, err := net.Listen("unix", opts.Address)
+	log.V(1).Info("Start listening", "Address", opts.Address)
+	l, err := common.Listen(opts.Address)
 	if err != nil {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
+	if authOpts.TokenFile != "" && authOpts.TLS == nil && l.Addr().Network() == "tcp" {
+		setupLog.Info("Warning: Bearer tokens are sent over plaintext tcp, set tls-cert-file and tls-key-file to protect them")
+	}
 
 	setupLog.Info("Starting grpc server", "Address", l.Addr().String())
 	go func() {
@@ -87,6 +87,27 @@ func (s *Server) parseIPs(ipStrings []string) ([]commonv1alpha1.IP, error) {
 	return ips, nil
 }
 
+func (s *Server) convertIronCorePrefixesToPrefixes(prefixes []commonv1alpha1.IPPrefix) []string {
+	var res []string
+	for _, prefix := range prefixes {
+		res = append(res, prefix.String())
+	}
+	return res
+}
+
+func (s *Server) parsePrefixes(prefixStrings []string) ([]commonv1alpha1.IPPrefix, error) {
+	var prefixes []commonv1alpha1.IPPrefix
+	for _, prefixString := range prefixStrings {
+		prefix, err := commonv1alpha1.ParseIPPrefix(prefixString)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing prefix %q: %w", prefixString, err)
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes, nil
+}
+
 func (s *Server) optionalOwnerReferences(gvk schema.GroupVersionKind, optionalOwner metav1.Object) []metav1.OwnerReference {
 	if optionalOwner == nil {
 		return nil
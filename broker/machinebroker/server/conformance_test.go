@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/apis/machine"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/conformance"
+	remotemachine "github.com/ironcore-dev/ironcore/iri/remote/machine"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Conformance", func() {
+	_, srv := SetupTest()
+	machineClass := SetupMachineClass()
+
+	var runtime machine.RuntimeService
+
+	BeforeEach(func(ctx SpecContext) {
+		By("creating a machine pool offering the machine class")
+		machinePool := &computev1alpha1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "machine-pool-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, machinePool)).To(Succeed())
+		DeferCleanup(func(ctx context.Context) error {
+			return client.IgnoreNotFound(k8sClient.Delete(ctx, machinePool))
+		})
+
+		base := machinePool.DeepCopy()
+		machinePool.Status.AvailableMachineClasses = []corev1.LocalObjectReference{{Name: machineClass.Name}}
+		machinePool.Status.Capacity = corev1alpha1.ResourceList{
+			corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeMachineClass, machineClass.Name): resource.MustParse("10"),
+		}
+		Expect(k8sClient.Status().Patch(ctx, machinePool, client.MergeFrom(base))).To(Succeed())
+
+		By("serving the server on a unix socket")
+		dir, err := os.MkdirTemp("", "machinebroker")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(os.RemoveAll, dir)
+
+		socket := filepath.Join(dir, "machinebroker.sock")
+		lis, err := net.Listen("unix", socket)
+		Expect(err).NotTo(HaveOccurred())
+
+		grpcSrv := grpc.NewServer()
+		iri.RegisterMachineRuntimeServer(grpcSrv, srv)
+		go func() {
+			defer GinkgoRecover()
+			Expect(grpcSrv.Serve(lis)).To(Succeed())
+		}()
+		DeferCleanup(grpcSrv.Stop)
+
+		runtime, err = remotemachine.NewRemoteRuntime("unix://" + socket)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(runtime.(io.Closer).Close)
+	})
+
+	conformance.DescribeMachineRuntime(func() conformance.MachineRuntimeConfig {
+		return conformance.MachineRuntimeConfig{
+			Runtime:      runtime,
+			MachineClass: machineClass.Name,
+			Image:        "example.org/foo:latest",
+		}
+	})
+})
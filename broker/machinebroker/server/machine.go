@@ -153,10 +153,11 @@ func (s *Server) convertIronCoreNetworkInterfaceAttachment(
 		}
 
 		return &iri.NetworkInterface{
-			Name:       ironcoreMachineNic.Name,
-			NetworkId:  ironcoreNic.Network.Spec.ProviderID,
-			Ips:        ips,
-			Attributes: ironcoreNic.NetworkInterface.Spec.Attributes,
+			Name:              ironcoreMachineNic.Name,
+			NetworkId:         ironcoreNic.Network.Spec.ProviderID,
+			Ips:               ips,
+			Attributes:        ironcoreNic.NetworkInterface.Spec.Attributes,
+			DelegatedPrefixes: s.convertIronCorePrefixesToPrefixes(ironcoreNic.NetworkInterface.Spec.DelegatedPrefixes),
 		}, nil
 	default:
 		return nil, fmt.Errorf("unrecognized ironcore machine network interface %#v", ironcoreMachineNic)
@@ -14,8 +14,12 @@ import (
 	machinebrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/machinebroker/api/v1alpha1"
 	"github.com/ironcore-dev/ironcore/broker/machinebroker/apiutils"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/errdetails"
 	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
 	"github.com/ironcore-dev/ironcore/utils/maps"
+	"github.com/ironcore-dev/ironcore/utils/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -45,7 +49,7 @@ func (s *Server) prepareIronCoreMachinePower(power iri.Power) (computev1alpha1.P
 	case iri.Power_POWER_OFF:
 		return computev1alpha1.PowerOff, nil
 	default:
-		return "", fmt.Errorf("unknown power state %v", power)
+		return "", errdetails.InvalidSpec("power", "unknown power state %v", power)
 	}
 }
 
@@ -255,6 +259,11 @@ func (s *Server) createIronCoreMachine(
 }
 
 func (s *Server) CreateMachine(ctx context.Context, req *iri.CreateMachineRequest) (res *iri.CreateMachineResponse, retErr error) {
+	ctx, span := tracer.Start(ctx, "Server.CreateMachine", trace.WithAttributes(
+		attribute.String("machine.class", req.GetMachine().GetSpec().GetClass()),
+	))
+	defer func() { tracing.End(span, retErr) }()
+
 	log := s.loggerFrom(ctx)
 
 	log.V(1).Info("Getting ironcore machine config")
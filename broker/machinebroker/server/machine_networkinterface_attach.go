@@ -23,10 +23,11 @@ import (
 )
 
 type IronCoreNetworkInterfaceConfig struct {
-	Name       string
-	NetworkID  string
-	IPs        []commonv1alpha1.IP
-	Attributes map[string]string
+	Name              string
+	NetworkID         string
+	IPs               []commonv1alpha1.IP
+	DelegatedPrefixes []commonv1alpha1.IPPrefix
+	Attributes        map[string]string
 }
 
 func (s *Server) getIronCoreNetworkInterfaceConfig(nic *iri.NetworkInterface) (*IronCoreNetworkInterfaceConfig, error) {
@@ -35,11 +36,17 @@ func (s *Server) getIronCoreNetworkInterfaceConfig(nic *iri.NetworkInterface) (*
 		return nil, err
 	}
 
+	delegatedPrefixes, err := s.parsePrefixes(nic.DelegatedPrefixes)
+	if err != nil {
+		return nil, err
+	}
+
 	return &IronCoreNetworkInterfaceConfig{
-		Name:       nic.Name,
-		NetworkID:  nic.NetworkId,
-		IPs:        ips,
-		Attributes: nic.Attributes,
+		Name:              nic.Name,
+		NetworkID:         nic.NetworkId,
+		IPs:               ips,
+		DelegatedPrefixes: delegatedPrefixes,
+		Attributes:        nic.Attributes,
 	}, nil
 }
 
@@ -69,11 +76,12 @@ func (s *Server) createIronCoreNetworkInterface(
 			OwnerReferences: s.optionalOwnerReferences(ironcoreMachineGVK, optIronCoreMachine),
 		},
 		Spec: networkingv1alpha1.NetworkInterfaceSpec{
-			NetworkRef: corev1.LocalObjectReference{Name: ironcoreNetwork.Name},
-			MachineRef: s.optionalLocalUIDReference(optIronCoreMachine),
-			IPFamilies: s.getIronCoreIPsIPFamilies(cfg.IPs),
-			IPs:        s.ironcoreIPsToIronCoreIPSources(cfg.IPs),
-			Attributes: cfg.Attributes,
+			NetworkRef:        corev1.LocalObjectReference{Name: ironcoreNetwork.Name},
+			MachineRef:        s.optionalLocalUIDReference(optIronCoreMachine),
+			IPFamilies:        s.getIronCoreIPsIPFamilies(cfg.IPs),
+			IPs:               s.ironcoreIPsToIronCoreIPSources(cfg.IPs),
+			DelegatedPrefixes: cfg.DelegatedPrefixes,
+			Attributes:        cfg.Attributes,
 		},
 	}
 	log.V(1).Info("Creating ironcore network interface")
@@ -88,14 +96,14 @@ func (s *Server) createIronCoreNetworkInterface(
 	}
 
 	return &computev1alpha1.NetworkInterface{
-			Name: cfg.Name,
-			NetworkInterfaceSource: computev1alpha1.NetworkInterfaceSource{
-				NetworkInterfaceRef: &corev1.LocalObjectReference{Name: ironcoreNic.Name},
-			},
-		}, &AggregateIronCoreNetworkInterface{
-			Network:          ironcoreNetwork,
-			NetworkInterface: ironcoreNic,
-		}, nil
+		Name: cfg.Name,
+		NetworkInterfaceSource: computev1alpha1.NetworkInterfaceSource{
+			NetworkInterfaceRef: &corev1.LocalObjectReference{Name: ironcoreNic.Name},
+		},
+	}, &AggregateIronCoreNetworkInterface{
+		Network:          ironcoreNetwork,
+		NetworkInterface: ironcoreNic,
+	}, nil
 }
 
 func (s *Server) attachIronCoreNetworkInterface(
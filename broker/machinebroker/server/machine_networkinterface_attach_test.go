@@ -38,9 +38,10 @@ var _ = Describe("AttachNetworkInterface", func() {
 		Expect(srv.AttachNetworkInterface(ctx, &iri.AttachNetworkInterfaceRequest{
 			MachineId: machineID,
 			NetworkInterface: &iri.NetworkInterface{
-				Name:      "my-nic",
-				NetworkId: "network-id",
-				Ips:       []string{"10.0.0.1"},
+				Name:              "my-nic",
+				NetworkId:         "network-id",
+				Ips:               []string{"10.0.0.1"},
+				DelegatedPrefixes: []string{"10.1.0.0/24"},
 			},
 		})).Error().NotTo(HaveOccurred())
 
@@ -69,6 +70,10 @@ var _ = Describe("AttachNetworkInterface", func() {
 		Expect(nic.Spec.IPs).To(Equal([]networkingv1alpha1.IPSource{
 			{Value: commonv1alpha1.MustParseNewIP("10.0.0.1")},
 		}))
+		Expect(nic.Spec.Prefixes).To(BeEmpty())
+		Expect(nic.Spec.DelegatedPrefixes).To(Equal([]commonv1alpha1.IPPrefix{
+			commonv1alpha1.MustParseIPPrefix("10.1.0.0/24"),
+		}))
 
 		By("getting the referenced ironcore network")
 		network := &networkingv1alpha1.Network{}
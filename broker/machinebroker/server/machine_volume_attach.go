@@ -14,7 +14,10 @@ import (
 	"github.com/ironcore-dev/ironcore/broker/common/cleaner"
 	machinebrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/machinebroker/api/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/errdetails"
 	metautils "github.com/ironcore-dev/ironcore/utils/meta"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -63,7 +66,7 @@ func (s *Server) getIronCoreVolumeConfig(volume *iri.Volume) (*IronCoreVolumeCon
 			EncryptionData: volume.Connection.EncryptionData,
 		}
 	default:
-		return nil, fmt.Errorf("unrecognized volume %#v", volume)
+		return nil, errdetails.InvalidSpec("volume", "unrecognized volume %#v", volume)
 	}
 
 	return &IronCoreVolumeConfig{
@@ -235,6 +238,11 @@ func (s *Server) AttachVolume(ctx context.Context, req *iri.AttachVolumeRequest)
 		return nil, err
 	}
 
+	idx := ironcoreMachineVolumeIndex(ironcoreMachine, volumeName)
+	if idx >= 0 {
+		return nil, grpcstatus.Errorf(codes.AlreadyExists, "machine %s volume %s already exists", machineID, volumeName)
+	}
+
 	log.V(1).Info("Getting ironcore volume config")
 	cfg, err := s.getIronCoreVolumeConfig(req.Volume)
 	if err != nil {
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	brokerwatch "github.com/ironcore-dev/ironcore/broker/common/watch"
+	machinebrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/machinebroker/api/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/watch"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (s *Server) newMachineHub(watchClient client.WithWatch) *watch.Hub[*iri.Machine] {
+	opts := []client.ListOption{
+		client.InNamespace(s.cluster.Namespace()),
+		client.MatchingLabels{
+			machinebrokerv1alpha1.ManagerLabel: machinebrokerv1alpha1.MachineBrokerManager,
+		},
+	}
+
+	// The iri machines also report the access of their volumes and the state of their network interfaces,
+	// hence changes of the broker-managed volumes, network interfaces and secrets have to be reported as well.
+	return watch.NewHub(s.listMachines, watch.HubOptions{
+		Notify: brokerwatch.NotifyAll(
+			brokerwatch.NotifyOnChange(watchClient, &computev1alpha1.MachineList{}, opts...),
+			brokerwatch.NotifyOnChange(watchClient, &storagev1alpha1.VolumeList{}, opts...),
+			brokerwatch.NotifyOnChange(watchClient, &networkingv1alpha1.NetworkInterfaceList{}, opts...),
+			brokerwatch.NotifyOnChange(watchClient, &corev1.SecretList{}, opts...),
+		),
+	})
+}
+
+func (s *Server) WatchMachines(req *iri.WatchMachinesRequest, stream iri.MachineRuntime_WatchMachinesServer) error {
+	return s.machineHub.Watch(stream.Context(), req.ResourceVersion, machineFilterFunc(req.Filter), func(event watch.Event[*iri.Machine]) error {
+		return stream.Send(&iri.WatchMachinesResponse{
+			Type:            event.Type,
+			Machine:         event.Object,
+			ResourceVersion: event.ResourceVersion,
+		})
+	})
+}
+
+func machineFilterFunc(filter *iri.MachineFilter) func(*iri.Machine) bool {
+	if filter == nil {
+		return nil
+	}
+
+	sel := labels.SelectorFromSet(filter.LabelSelector)
+	return func(machine *iri.Machine) bool {
+		if filter.Id != "" && filter.Id != machine.Metadata.Id {
+			return false
+		}
+		return sel.Matches(labels.Set(machine.Metadata.Labels))
+	}
+}
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+type watchMachinesStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *iri.WatchMachinesResponse
+}
+
+func (s *watchMachinesStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchMachinesStream) Send(res *iri.WatchMachinesResponse) error {
+	s.responses <- res
+	return nil
+}
+
+var _ = Describe("WatchMachines", func() {
+	ns, srv := SetupTest()
+	machineClass := SetupMachineClass()
+
+	watchMachines := func(ctx context.Context, req *iri.WatchMachinesRequest) chan *iri.WatchMachinesResponse {
+		ctx, cancel := context.WithCancel(ctx)
+		DeferCleanup(cancel)
+
+		stream := &watchMachinesStream{ctx: ctx, responses: make(chan *iri.WatchMachinesResponse, 100)}
+		go func() {
+			defer GinkgoRecover()
+			_ = srv.WatchMachines(req, stream)
+		}()
+		return stream.responses
+	}
+
+	createMachine := func(ctx context.Context) *iri.Machine {
+		res, err := srv.CreateMachine(ctx, &iri.CreateMachineRequest{
+			Machine: &iri.Machine{
+				Metadata: &irimeta.ObjectMetadata{
+					Labels: map[string]string{
+						machinepoolletv1alpha1.MachineUIDLabel: "foobar",
+					},
+				},
+				Spec: &iri.MachineSpec{
+					Power: iri.Power_POWER_ON,
+					Image: &iri.ImageSpec{
+						Image: "example.org/foo:latest",
+					},
+					Class: machineClass.Name,
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return res.Machine
+	}
+
+	It("should report the current machines and their changes", func(ctx SpecContext) {
+		By("creating a machine")
+		machine := createMachine(ctx)
+		machineID := machine.Metadata.Id
+
+		By("watching the machines")
+		responses := watchMachines(ctx, &iri.WatchMachinesRequest{})
+		Eventually(responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_ADDED),
+			HaveField("Machine.Metadata.Id", machineID),
+		)))
+		Eventually(responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_BOOKMARK),
+			HaveField("ResourceVersion", Not(BeEmpty())),
+		)))
+
+		By("updating the machine annotations")
+		Expect(srv.UpdateMachineAnnotations(ctx, &iri.UpdateMachineAnnotationsRequest{
+			MachineId:   machineID,
+			Annotations: map[string]string{"foo": "bar"},
+		})).Error().NotTo(HaveOccurred())
+
+		By("asserting the change is reported without waiting for a resync")
+		Eventually(responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_MODIFIED),
+			HaveField("Machine.Metadata.Id", machineID),
+			HaveField("Machine.Metadata.Annotations", HaveKeyWithValue("foo", "bar")),
+		)))
+
+		By("deleting the machine")
+		Expect(srv.DeleteMachine(ctx, &iri.DeleteMachineRequest{
+			MachineId: machineID,
+		})).Error().NotTo(HaveOccurred())
+
+		Eventually(responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_DELETED),
+			HaveField("Machine.Metadata.Id", machineID),
+		)))
+	})
+
+	It("should report changes of the volumes of a machine", func(ctx SpecContext) {
+		By("creating a machine with a volume")
+		machine := createMachine(ctx)
+		machineID := machine.Metadata.Id
+		Expect(srv.AttachVolume(ctx, &iri.AttachVolumeRequest{
+			MachineId: machineID,
+			Volume: &iri.Volume{
+				Name:   "my-volume",
+				Device: "oda",
+				Connection: &iri.VolumeConnection{
+					Driver: "ceph",
+					Handle: "mycephvolume",
+				},
+			},
+		})).Error().NotTo(HaveOccurred())
+
+		By("watching the machines")
+		responses := watchMachines(ctx, &iri.WatchMachinesRequest{})
+		Eventually(responses).Should(Receive(HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_ADDED)))
+		Eventually(responses).Should(Receive(HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_BOOKMARK)))
+
+		By("getting the ironcore volume of the machine")
+		ironcoreMachine := &computev1alpha1.Machine{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: ns.Name, Name: machineID}, ironcoreMachine)).To(Succeed())
+		Expect(ironcoreMachine.Spec.Volumes).To(HaveLen(1))
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      ironcoreMachine.Spec.Volumes[0].VolumeRef.Name,
+			},
+		}
+
+		By("changing the access of the ironcore volume")
+		Eventually(UpdateStatus(volume, func() {
+			volume.Status.Access = &storagev1alpha1.VolumeAccess{
+				Driver: "ceph",
+				Handle: "othercephvolume",
+			}
+		})).Should(Succeed())
+
+		By("asserting the change is reported without waiting for a resync")
+		Eventually(responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_MODIFIED),
+			HaveField("Machine.Metadata.Id", machineID),
+			HaveField("Machine.Spec.Volumes", ConsistOf(
+				HaveField("Connection.Handle", "othercephvolume"),
+			)),
+		)))
+	})
+
+	It("should only report machines matching the filter", func(ctx SpecContext) {
+		By("creating two machines")
+		machine := createMachine(ctx)
+		createMachine(ctx)
+
+		By("watching the first machine")
+		responses := watchMachines(ctx, &iri.WatchMachinesRequest{
+			Filter: &iri.MachineFilter{Id: machine.Metadata.Id},
+		})
+		Eventually(responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_ADDED),
+			HaveField("Machine.Metadata.Id", machine.Metadata.Id),
+		)))
+		Eventually(responses).Should(Receive(HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_BOOKMARK)))
+		Consistently(responses).ShouldNot(Receive())
+	})
+})
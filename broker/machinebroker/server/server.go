@@ -13,11 +13,16 @@ import (
 	"github.com/ironcore-dev/ironcore/broker/machinebroker/cluster"
 	"github.com/ironcore-dev/ironcore/broker/machinebroker/networks"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/watch"
+	"go.opentelemetry.io/otel"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ iri.MachineRuntimeServer = (*Server)(nil)
 
+var tracer = otel.Tracer("github.com/ironcore-dev/ironcore/broker/machinebroker/server")
+
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines/exec,verbs=get;create
@@ -49,6 +54,8 @@ type Server struct {
 	networks *networks.Manager
 
 	execRequestCache request.Cache[*iri.ExecRequest]
+
+	machineHub *watch.Hub[*iri.Machine]
 }
 
 type Options struct {
@@ -77,13 +84,20 @@ func New(cfg *rest.Config, namespace string, opts Options) (*Server, error) {
 		return nil, err
 	}
 
-	return &Server{
+	watchClient, err := client.NewWithWatch(cfg, client.Options{Scheme: c.Scheme()})
+	if err != nil {
+		return nil, fmt.Errorf("error creating watch client: %w", err)
+	}
+
+	s := &Server{
 		baseURL:                 baseURL,
 		brokerDownwardAPILabels: opts.BrokerDownwardAPILabels,
 		cluster:                 c,
 		networks:                networks.NewManager(c),
 		execRequestCache:        request.NewCache[*iri.ExecRequest](),
-	}, nil
+	}
+	s.machineHub = s.newMachineHub(watchClient)
+	return s, nil
 }
 
 func (s *Server) Start(ctx context.Context) error {
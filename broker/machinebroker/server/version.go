@@ -9,6 +9,7 @@ import (
 	"github.com/blang/semver/v4"
 	"github.com/ironcore-dev/ironcore/broker/machinebroker/version"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
 )
 
 func (s *Server) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
@@ -30,5 +31,9 @@ func (s *Server) Version(ctx context.Context, req *iri.VersionRequest) (*iri.Ver
 	return &iri.VersionResponse{
 		RuntimeName:    version.RuntimeName,
 		RuntimeVersion: runtimeVersion,
+		Capabilities: []string{
+			capabilities.MachineWatch,
+			capabilities.MachineExec,
+		},
 	}, nil
 }
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/apis/volume"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/conformance"
+	remotevolume "github.com/ironcore-dev/ironcore/iri/remote/volume"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Conformance", func() {
+	_, srv := SetupTest()
+	volumeClass := SetupVolumeClass()
+
+	var runtime volume.RuntimeService
+
+	BeforeEach(func(ctx SpecContext) {
+		By("creating a volume pool offering the volume class")
+		volumePool := &storagev1alpha1.VolumePool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volume-pool-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumePool)).To(Succeed())
+		DeferCleanup(func(ctx context.Context) error {
+			return client.IgnoreNotFound(k8sClient.Delete(ctx, volumePool))
+		})
+
+		base := volumePool.DeepCopy()
+		volumePool.Status.AvailableVolumeClasses = []corev1.LocalObjectReference{{Name: volumeClass.Name}}
+		volumePool.Status.Capacity = corev1alpha1.ResourceList{
+			corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeVolumeClass, volumeClass.Name): resource.MustParse("100Gi"),
+		}
+		Expect(k8sClient.Status().Patch(ctx, volumePool, client.MergeFrom(base))).To(Succeed())
+
+		By("serving the server on a unix socket")
+		dir, err := os.MkdirTemp("", "volumebroker")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(os.RemoveAll, dir)
+
+		socket := filepath.Join(dir, "volumebroker.sock")
+		lis, err := net.Listen("unix", socket)
+		Expect(err).NotTo(HaveOccurred())
+
+		grpcSrv := grpc.NewServer()
+		iri.RegisterVolumeRuntimeServer(grpcSrv, srv)
+		go func() {
+			defer GinkgoRecover()
+			Expect(grpcSrv.Serve(lis)).To(Succeed())
+		}()
+		DeferCleanup(grpcSrv.Stop)
+
+		runtime, err = remotevolume.NewRemoteRuntime("unix://" + socket)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(runtime.(io.Closer).Close)
+	})
+
+	conformance.DescribeVolumeRuntime(func() conformance.VolumeRuntimeConfig {
+		return conformance.VolumeRuntimeConfig{
+			Runtime:     runtime,
+			VolumeClass: volumeClass.Name,
+		}
+	})
+})
@@ -17,6 +17,7 @@ import (
 	volumebrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/volumebroker/api/v1alpha1"
 	"github.com/ironcore-dev/ironcore/broker/volumebroker/apiutils"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/watch"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -46,6 +47,8 @@ type Server struct {
 	namespace          string
 	volumePoolName     string
 	volumePoolSelector map[string]string
+
+	volumeHub *watch.Hub[*iri.Volume]
 }
 
 func (s *Server) loggerFrom(ctx context.Context, keysWithValues ...interface{}) logr.Logger {
@@ -101,13 +104,22 @@ func New(cfg *rest.Config, opts Options) (*Server, error) {
 		return nil, fmt.Errorf("error creating client: %w", err)
 	}
 
-	return &Server{
+	watchClient, err := client.NewWithWatch(cfg, client.Options{
+		Scheme: scheme,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating watch client: %w", err)
+	}
+
+	s := &Server{
 		client:             c,
 		idGen:              opts.IDGen,
 		namespace:          opts.Namespace,
 		volumePoolName:     opts.VolumePoolName,
 		volumePoolSelector: opts.VolumePoolSelector,
-	}, nil
+	}
+	s.volumeHub = s.newVolumeHub(watchClient)
+	return s, nil
 }
 
 func (s *Server) getManagedAndCreated(ctx context.Context, name string, obj client.Object) error {
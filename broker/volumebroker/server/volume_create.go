@@ -13,6 +13,7 @@ import (
 	volumebrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/volumebroker/api/v1alpha1"
 	"github.com/ironcore-dev/ironcore/broker/volumebroker/apiutils"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/errdetails"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,6 +27,13 @@ type AggregateIronCoreVolume struct {
 }
 
 func (s *Server) getIronCoreVolumeConfig(_ context.Context, volume *iri.Volume) (*AggregateIronCoreVolume, error) {
+	if volume.GetSpec().GetClass() == "" {
+		return nil, errdetails.InvalidSpec("volume.spec.class", "volume class must be specified")
+	}
+	if volume.Spec.Resources == nil {
+		return nil, errdetails.InvalidSpec("volume.spec.resources", "volume resources must be specified")
+	}
+
 	var volumePoolRef *corev1.LocalObjectReference
 	if s.volumePoolName != "" {
 		volumePoolRef = &corev1.LocalObjectReference{
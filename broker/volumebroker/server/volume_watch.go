@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	brokerwatch "github.com/ironcore-dev/ironcore/broker/common/watch"
+	volumebrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/volumebroker/api/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/watch"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (s *Server) newVolumeHub(watchClient client.WithWatch) *watch.Hub[*iri.Volume] {
+	return watch.NewHub(s.listVolumes, watch.HubOptions{
+		Notify: brokerwatch.NotifyOnChange(watchClient, &storagev1alpha1.VolumeList{},
+			client.InNamespace(s.namespace),
+			client.MatchingLabels{
+				volumebrokerv1alpha1.ManagerLabel: volumebrokerv1alpha1.VolumeBrokerManager,
+			},
+		),
+	})
+}
+
+func (s *Server) WatchVolumes(req *iri.WatchVolumesRequest, stream iri.VolumeRuntime_WatchVolumesServer) error {
+	return s.volumeHub.Watch(stream.Context(), req.ResourceVersion, volumeFilterFunc(req.Filter), func(event watch.Event[*iri.Volume]) error {
+		return stream.Send(&iri.WatchVolumesResponse{
+			Type:            event.Type,
+			Volume:          event.Object,
+			ResourceVersion: event.ResourceVersion,
+		})
+	})
+}
+
+func volumeFilterFunc(filter *iri.VolumeFilter) func(*iri.Volume) bool {
+	if filter == nil {
+		return nil
+	}
+
+	sel := labels.SelectorFromSet(filter.LabelSelector)
+	return func(volume *iri.Volume) bool {
+		if filter.Id != "" && filter.Id != volume.Metadata.Id {
+			return false
+		}
+		return sel.Matches(labels.Set(volume.Metadata.Labels))
+	}
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+)
+
+// IPRangeApplyConfiguration represents an declarative configuration of the IPRange type for use
+// with apply.
+type IPRangeApplyConfiguration struct {
+	From *v1alpha1.IP `json:"from,omitempty"`
+	To   *v1alpha1.IP `json:"to,omitempty"`
+}
+
+// IPRangeApplyConfiguration constructs an declarative configuration of the IPRange type for use with
+// apply.
+func IPRange() *IPRangeApplyConfiguration {
+	return &IPRangeApplyConfiguration{}
+}
+
+// WithFrom sets the From field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the From field is set to the value of the last call.
+func (b *IPRangeApplyConfiguration) WithFrom(value v1alpha1.IP) *IPRangeApplyConfiguration {
+	b.From = &value
+	return b
+}
+
+// WithTo sets the To field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the To field is set to the value of the last call.
+func (b *IPRangeApplyConfiguration) WithTo(value v1alpha1.IP) *IPRangeApplyConfiguration {
+	b.To = &value
+	return b
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+)
+
+// AllowedClassesApplyConfiguration represents an declarative configuration of the AllowedClasses type for use
+// with apply.
+type AllowedClassesApplyConfiguration struct {
+	ClassType *v1alpha1.ClassType `json:"classType,omitempty"`
+	Names     []string            `json:"names,omitempty"`
+}
+
+// AllowedClassesApplyConfiguration constructs an declarative configuration of the AllowedClasses type for use with
+// apply.
+func AllowedClasses() *AllowedClassesApplyConfiguration {
+	return &AllowedClassesApplyConfiguration{}
+}
+
+// WithClassType sets the ClassType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClassType field is set to the value of the last call.
+func (b *AllowedClassesApplyConfiguration) WithClassType(value v1alpha1.ClassType) *AllowedClassesApplyConfiguration {
+	b.ClassType = &value
+	return b
+}
+
+// WithNames adds the given value to the Names field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Names field.
+func (b *AllowedClassesApplyConfiguration) WithNames(values ...string) *AllowedClassesApplyConfiguration {
+	for i := range values {
+		b.Names = append(b.Names, values[i])
+	}
+	return b
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+)
+
+// ResourceLimitItemApplyConfiguration represents an declarative configuration of the ResourceLimitItem type for use
+// with apply.
+type ResourceLimitItemApplyConfiguration struct {
+	Type    *v1alpha1.ResourceLimitType `json:"type,omitempty"`
+	Min     *v1alpha1.ResourceList      `json:"min,omitempty"`
+	Max     *v1alpha1.ResourceList      `json:"max,omitempty"`
+	Default *v1alpha1.ResourceList      `json:"default,omitempty"`
+}
+
+// ResourceLimitItemApplyConfiguration constructs an declarative configuration of the ResourceLimitItem type for use with
+// apply.
+func ResourceLimitItem() *ResourceLimitItemApplyConfiguration {
+	return &ResourceLimitItemApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ResourceLimitItemApplyConfiguration) WithType(value v1alpha1.ResourceLimitType) *ResourceLimitItemApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithMin sets the Min field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Min field is set to the value of the last call.
+func (b *ResourceLimitItemApplyConfiguration) WithMin(value v1alpha1.ResourceList) *ResourceLimitItemApplyConfiguration {
+	b.Min = &value
+	return b
+}
+
+// WithMax sets the Max field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Max field is set to the value of the last call.
+func (b *ResourceLimitItemApplyConfiguration) WithMax(value v1alpha1.ResourceList) *ResourceLimitItemApplyConfiguration {
+	b.Max = &value
+	return b
+}
+
+// WithDefault sets the Default field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Default field is set to the value of the last call.
+func (b *ResourceLimitItemApplyConfiguration) WithDefault(value v1alpha1.ResourceList) *ResourceLimitItemApplyConfiguration {
+	b.Default = &value
+	return b
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ResourceLimitSpecApplyConfiguration represents an declarative configuration of the ResourceLimitSpec type for use
+// with apply.
+type ResourceLimitSpecApplyConfiguration struct {
+	Limits         []ResourceLimitItemApplyConfiguration `json:"limits,omitempty"`
+	AllowedClasses []AllowedClassesApplyConfiguration    `json:"allowedClasses,omitempty"`
+}
+
+// ResourceLimitSpecApplyConfiguration constructs an declarative configuration of the ResourceLimitSpec type for use with
+// apply.
+func ResourceLimitSpec() *ResourceLimitSpecApplyConfiguration {
+	return &ResourceLimitSpecApplyConfiguration{}
+}
+
+// WithLimits adds the given value to the Limits field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Limits field.
+func (b *ResourceLimitSpecApplyConfiguration) WithLimits(values ...*ResourceLimitItemApplyConfiguration) *ResourceLimitSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithLimits")
+		}
+		b.Limits = append(b.Limits, *values[i])
+	}
+	return b
+}
+
+// WithAllowedClasses adds the given value to the AllowedClasses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedClasses field.
+func (b *ResourceLimitSpecApplyConfiguration) WithAllowedClasses(values ...*AllowedClassesApplyConfiguration) *ResourceLimitSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAllowedClasses")
+		}
+		b.AllowedClasses = append(b.AllowedClasses, *values[i])
+	}
+	return b
+}
@@ -30,6 +30,15 @@ var schemaYAML = typed.YAMLObject(`types:
   scalar: untyped
 - name: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
   scalar: untyped
+- name: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPRange
+  map:
+    fields:
+    - name: from
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IP
+    - name: to
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IP
 - name: com.github.ironcore-dev.ironcore.api.common.v1alpha1.LocalUIDReference
   map:
     fields:
@@ -429,6 +438,19 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: state
       type:
         scalar: string
+- name: com.github.ironcore-dev.ironcore.api.core.v1alpha1.AllowedClasses
+  map:
+    fields:
+    - name: classType
+      type:
+        scalar: string
+      default: ""
+    - name: names
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
 - name: com.github.ironcore-dev.ironcore.api.core.v1alpha1.ObjectSelector
   map:
     fields:
@@ -447,6 +469,60 @@ var schemaYAML = typed.YAMLObject(`types:
         map:
           elementType:
             scalar: string
+- name: com.github.ironcore-dev.ironcore.api.core.v1alpha1.ResourceLimit
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.core.v1alpha1.ResourceLimitSpec
+      default: {}
+- name: com.github.ironcore-dev.ironcore.api.core.v1alpha1.ResourceLimitItem
+  map:
+    fields:
+    - name: default
+      type:
+        map:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+    - name: max
+      type:
+        map:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+    - name: min
+      type:
+        map:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+    - name: type
+      type:
+        scalar: string
+      default: ""
+- name: com.github.ironcore-dev.ironcore.api.core.v1alpha1.ResourceLimitSpec
+  map:
+    fields:
+    - name: allowedClasses
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.core.v1alpha1.AllowedClasses
+          elementRelationship: atomic
+    - name: limits
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.core.v1alpha1.ResourceLimitItem
+          elementRelationship: atomic
 - name: com.github.ironcore-dev.ironcore.api.core.v1alpha1.ResourceQuota
   map:
     fields:
@@ -563,6 +639,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixAllocationSpec
   map:
     fields:
+    - name: ipCount
+      type:
+        scalar: numeric
     - name: ipFamily
       type:
         scalar: string
@@ -572,15 +651,30 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: prefixLength
       type:
         scalar: numeric
+    - name: prefixNamespace
+      type:
+        scalar: string
     - name: prefixRef
       type:
         namedType: io.k8s.api.core.v1.LocalObjectReference
     - name: prefixSelector
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: range
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPRange
+    - name: rangeLength
+      type:
+        scalar: numeric
 - name: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixAllocationStatus
   map:
     fields:
+    - name: ips
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IP
+          elementRelationship: atomic
     - name: lastPhaseTransitionTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
@@ -590,12 +684,51 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: prefix
       type:
         namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
+    - name: range
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPRange
+- name: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixGrant
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixGrantSpec
+      default: {}
+- name: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixGrantSpec
+  map:
+    fields:
+    - name: namespaces
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: prefixRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+      default: {}
 - name: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixSpec
   map:
     fields:
+    - name: allocationStrategy
+      type:
+        scalar: string
     - name: ipFamily
       type:
         scalar: string
+    - name: parentNamespace
+      type:
+        scalar: string
     - name: parentRef
       type:
         namedType: io.k8s.api.core.v1.LocalObjectReference
@@ -608,6 +741,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: prefixLength
       type:
         scalar: numeric
+    - name: reservedRanges
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPRange
+          elementRelationship: atomic
 - name: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixStatus
   map:
     fields:
@@ -623,6 +762,9 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
           elementRelationship: atomic
+    - name: utilization
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixUtilization
 - name: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixTemplateSpec
   map:
     fields:
@@ -634,9 +776,30 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixSpec
       default: {}
+- name: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixUtilization
+  map:
+    fields:
+    - name: free
+      type:
+        scalar: string
+    - name: largestFreePrefixLength
+      type:
+        scalar: numeric
+    - name: reserved
+      type:
+        scalar: string
+    - name: total
+      type:
+        scalar: string
+    - name: used
+      type:
+        scalar: string
 - name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.EphemeralPrefixSource
   map:
     fields:
+    - name: delegatedPrefixLength
+      type:
+        scalar: numeric
     - name: prefixTemplate
       type:
         namedType: com.github.ironcore-dev.ironcore.api.ipam.v1alpha1.PrefixTemplateSpec
@@ -761,6 +924,12 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.LoadBalancerPort
           elementRelationship: atomic
+    - name: publicIPPoolRefs
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.LocalObjectReference
+          elementRelationship: atomic
     - name: type
       type:
         scalar: string
@@ -768,6 +937,12 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.LoadBalancerStatus
   map:
     fields:
+    - name: delegatedPrefixes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
+          elementRelationship: atomic
     - name: ips
       type:
         list:
@@ -824,6 +999,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: portsPerNetworkInterface
       type:
         scalar: numeric
+    - name: publicIPPoolRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
     - name: type
       type:
         scalar: string
@@ -887,6 +1065,12 @@ var schemaYAML = typed.YAMLObject(`types:
         map:
           elementType:
             scalar: string
+    - name: delegatedPrefixes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
+          elementRelationship: atomic
     - name: ipFamilies
       type:
         list:
@@ -915,12 +1099,21 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: providerID
       type:
         scalar: string
+    - name: subnetRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
     - name: virtualIP
       type:
         namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.VirtualIPSource
 - name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.NetworkInterfaceStatus
   map:
     fields:
+    - name: delegatedPrefixes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
+          elementRelationship: atomic
     - name: ips
       type:
         list:
@@ -964,6 +1157,14 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.NetworkPeeringNetworkRef
       default: {}
+    - name: prefixes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.PeeringPrefix
+          elementRelationship: associative
+          keys:
+          - name
 - name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.NetworkPeeringClaimRef
   map:
     fields:
@@ -994,6 +1195,12 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+    - name: prefixes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
+          elementRelationship: atomic
     - name: state
       type:
         scalar: string
@@ -1151,6 +1358,12 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - name
+    - name: prefixes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
+          elementRelationship: atomic
     - name: providerID
       type:
         scalar: string
@@ -1168,6 +1381,19 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: state
       type:
         scalar: string
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.PeeringPrefix
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: prefix
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
+    - name: subnetRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
 - name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.PrefixSource
   map:
     fields:
@@ -1177,6 +1403,187 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: value
       type:
         namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.PublicIPPool
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.PublicIPPoolSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.PublicIPPoolStatus
+      default: {}
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.PublicIPPoolSpec
+  map:
+    fields:
+    - name: ipFamily
+      type:
+        scalar: string
+      default: ""
+    - name: prefixes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
+          elementRelationship: atomic
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.PublicIPPoolStatus
+  map:
+    fields:
+    - name: lastStateTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: state
+      type:
+        scalar: string
+    - name: used
+      type:
+        scalar: numeric
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.Route
+  map:
+    fields:
+    - name: destination
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: nextHop
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.RouteNextHop
+      default: {}
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.RouteNextHop
+  map:
+    fields:
+    - name: natGatewayRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+    - name: networkInterfaceRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+    - name: networkPeeringRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+    - name: virtualIPRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.RouteStatus
+  map:
+    fields:
+    - name: lastStateTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: state
+      type:
+        scalar: string
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.RouteTable
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.RouteTableSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.RouteTableStatus
+      default: {}
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.RouteTableSpec
+  map:
+    fields:
+    - name: networkRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+      default: {}
+    - name: routes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.Route
+          elementRelationship: associative
+          keys:
+          - name
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.RouteTableStatus
+  map:
+    fields:
+    - name: routes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.RouteStatus
+          elementRelationship: associative
+          keys:
+          - name
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.Subnet
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.SubnetSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.SubnetStatus
+      default: {}
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.SubnetSpec
+  map:
+    fields:
+    - name: networkRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+      default: {}
+    - name: prefixes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.IPPrefix
+          elementRelationship: atomic
+- name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.SubnetStatus
+  map:
+    fields:
+    - name: lastStateTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: state
+      type:
+        scalar: string
 - name: com.github.ironcore-dev.ironcore.api.networking.v1alpha1.VirtualIP
   map:
     fields:
@@ -1214,6 +1621,9 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+    - name: publicIPPoolRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
     - name: targetRef
       type:
         namedType: com.github.ironcore-dev.ironcore.api.common.v1alpha1.LocalUIDReference
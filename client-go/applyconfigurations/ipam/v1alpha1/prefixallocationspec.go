@@ -7,6 +7,7 @@ package v1alpha1
 
 import (
 	v1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/common/v1alpha1"
 	metav1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/meta/v1"
 	v1 "k8s.io/api/core/v1"
 )
@@ -14,11 +15,15 @@ import (
 // PrefixAllocationSpecApplyConfiguration represents an declarative configuration of the PrefixAllocationSpec type for use
 // with apply.
 type PrefixAllocationSpecApplyConfiguration struct {
-	IPFamily       *v1.IPFamily                            `json:"ipFamily,omitempty"`
-	Prefix         *v1alpha1.IPPrefix                      `json:"prefix,omitempty"`
-	PrefixLength   *int32                                  `json:"prefixLength,omitempty"`
-	PrefixRef      *v1.LocalObjectReference                `json:"prefixRef,omitempty"`
-	PrefixSelector *metav1.LabelSelectorApplyConfiguration `json:"prefixSelector,omitempty"`
+	IPFamily        *v1.IPFamily                              `json:"ipFamily,omitempty"`
+	Prefix          *v1alpha1.IPPrefix                        `json:"prefix,omitempty"`
+	PrefixLength    *int32                                    `json:"prefixLength,omitempty"`
+	Range           *commonv1alpha1.IPRangeApplyConfiguration `json:"range,omitempty"`
+	RangeLength     *int32                                    `json:"rangeLength,omitempty"`
+	IPCount         *int32                                    `json:"ipCount,omitempty"`
+	PrefixRef       *v1.LocalObjectReference                  `json:"prefixRef,omitempty"`
+	PrefixNamespace *string                                   `json:"prefixNamespace,omitempty"`
+	PrefixSelector  *metav1.LabelSelectorApplyConfiguration   `json:"prefixSelector,omitempty"`
 }
 
 // PrefixAllocationSpecApplyConfiguration constructs an declarative configuration of the PrefixAllocationSpec type for use with
@@ -51,6 +56,30 @@ func (b *PrefixAllocationSpecApplyConfiguration) WithPrefixLength(value int32) *
 	return b
 }
 
+// WithRange sets the Range field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Range field is set to the value of the last call.
+func (b *PrefixAllocationSpecApplyConfiguration) WithRange(value *commonv1alpha1.IPRangeApplyConfiguration) *PrefixAllocationSpecApplyConfiguration {
+	b.Range = value
+	return b
+}
+
+// WithRangeLength sets the RangeLength field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RangeLength field is set to the value of the last call.
+func (b *PrefixAllocationSpecApplyConfiguration) WithRangeLength(value int32) *PrefixAllocationSpecApplyConfiguration {
+	b.RangeLength = &value
+	return b
+}
+
+// WithIPCount sets the IPCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPCount field is set to the value of the last call.
+func (b *PrefixAllocationSpecApplyConfiguration) WithIPCount(value int32) *PrefixAllocationSpecApplyConfiguration {
+	b.IPCount = &value
+	return b
+}
+
 // WithPrefixRef sets the PrefixRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PrefixRef field is set to the value of the last call.
@@ -59,6 +88,14 @@ func (b *PrefixAllocationSpecApplyConfiguration) WithPrefixRef(value v1.LocalObj
 	return b
 }
 
+// WithPrefixNamespace sets the PrefixNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrefixNamespace field is set to the value of the last call.
+func (b *PrefixAllocationSpecApplyConfiguration) WithPrefixNamespace(value string) *PrefixAllocationSpecApplyConfiguration {
+	b.PrefixNamespace = &value
+	return b
+}
+
 // WithPrefixSelector sets the PrefixSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PrefixSelector field is set to the value of the last call.
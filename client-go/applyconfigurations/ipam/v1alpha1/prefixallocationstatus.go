@@ -8,15 +8,18 @@ package v1alpha1
 import (
 	v1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/common/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // PrefixAllocationStatusApplyConfiguration represents an declarative configuration of the PrefixAllocationStatus type for use
 // with apply.
 type PrefixAllocationStatusApplyConfiguration struct {
-	Prefix                  *v1alpha1.IPPrefix                  `json:"prefix,omitempty"`
-	Phase                   *ipamv1alpha1.PrefixAllocationPhase `json:"phase,omitempty"`
-	LastPhaseTransitionTime *v1.Time                            `json:"lastPhaseTransitionTime,omitempty"`
+	Prefix                  *v1alpha1.IPPrefix                        `json:"prefix,omitempty"`
+	Range                   *commonv1alpha1.IPRangeApplyConfiguration `json:"range,omitempty"`
+	IPs                     []v1alpha1.IP                             `json:"ips,omitempty"`
+	Phase                   *ipamv1alpha1.PrefixAllocationPhase       `json:"phase,omitempty"`
+	LastPhaseTransitionTime *v1.Time                                  `json:"lastPhaseTransitionTime,omitempty"`
 }
 
 // PrefixAllocationStatusApplyConfiguration constructs an declarative configuration of the PrefixAllocationStatus type for use with
@@ -33,6 +36,24 @@ func (b *PrefixAllocationStatusApplyConfiguration) WithPrefix(value v1alpha1.IPP
 	return b
 }
 
+// WithRange sets the Range field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Range field is set to the value of the last call.
+func (b *PrefixAllocationStatusApplyConfiguration) WithRange(value *commonv1alpha1.IPRangeApplyConfiguration) *PrefixAllocationStatusApplyConfiguration {
+	b.Range = value
+	return b
+}
+
+// WithIPs adds the given value to the IPs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IPs field.
+func (b *PrefixAllocationStatusApplyConfiguration) WithIPs(values ...v1alpha1.IP) *PrefixAllocationStatusApplyConfiguration {
+	for i := range values {
+		b.IPs = append(b.IPs, values[i])
+	}
+	return b
+}
+
 // WithPhase sets the Phase field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Phase field is set to the value of the last call.
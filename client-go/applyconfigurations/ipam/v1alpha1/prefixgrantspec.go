@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// PrefixGrantSpecApplyConfiguration represents an declarative configuration of the PrefixGrantSpec type for use
+// with apply.
+type PrefixGrantSpecApplyConfiguration struct {
+	PrefixRef  *v1.LocalObjectReference `json:"prefixRef,omitempty"`
+	Namespaces []string                 `json:"namespaces,omitempty"`
+}
+
+// PrefixGrantSpecApplyConfiguration constructs an declarative configuration of the PrefixGrantSpec type for use with
+// apply.
+func PrefixGrantSpec() *PrefixGrantSpecApplyConfiguration {
+	return &PrefixGrantSpecApplyConfiguration{}
+}
+
+// WithPrefixRef sets the PrefixRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrefixRef field is set to the value of the last call.
+func (b *PrefixGrantSpecApplyConfiguration) WithPrefixRef(value v1.LocalObjectReference) *PrefixGrantSpecApplyConfiguration {
+	b.PrefixRef = &value
+	return b
+}
+
+// WithNamespaces adds the given value to the Namespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Namespaces field.
+func (b *PrefixGrantSpecApplyConfiguration) WithNamespaces(values ...string) *PrefixGrantSpecApplyConfiguration {
+	for i := range values {
+		b.Namespaces = append(b.Namespaces, values[i])
+	}
+	return b
+}
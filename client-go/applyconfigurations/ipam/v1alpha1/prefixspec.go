@@ -7,6 +7,8 @@ package v1alpha1
 
 import (
 	v1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/common/v1alpha1"
 	metav1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/meta/v1"
 	v1 "k8s.io/api/core/v1"
 )
@@ -14,11 +16,14 @@ import (
 // PrefixSpecApplyConfiguration represents an declarative configuration of the PrefixSpec type for use
 // with apply.
 type PrefixSpecApplyConfiguration struct {
-	IPFamily       *v1.IPFamily                            `json:"ipFamily,omitempty"`
-	Prefix         *v1alpha1.IPPrefix                      `json:"prefix,omitempty"`
-	PrefixLength   *int32                                  `json:"prefixLength,omitempty"`
-	ParentRef      *v1.LocalObjectReference                `json:"parentRef,omitempty"`
-	ParentSelector *metav1.LabelSelectorApplyConfiguration `json:"parentSelector,omitempty"`
+	IPFamily           *v1.IPFamily                               `json:"ipFamily,omitempty"`
+	Prefix             *v1alpha1.IPPrefix                         `json:"prefix,omitempty"`
+	PrefixLength       *int32                                     `json:"prefixLength,omitempty"`
+	ParentRef          *v1.LocalObjectReference                   `json:"parentRef,omitempty"`
+	ParentNamespace    *string                                    `json:"parentNamespace,omitempty"`
+	ParentSelector     *metav1.LabelSelectorApplyConfiguration    `json:"parentSelector,omitempty"`
+	ReservedRanges     []commonv1alpha1.IPRangeApplyConfiguration `json:"reservedRanges,omitempty"`
+	AllocationStrategy *ipamv1alpha1.PrefixAllocationStrategy     `json:"allocationStrategy,omitempty"`
 }
 
 // PrefixSpecApplyConfiguration constructs an declarative configuration of the PrefixSpec type for use with
@@ -59,6 +64,14 @@ func (b *PrefixSpecApplyConfiguration) WithParentRef(value v1.LocalObjectReferen
 	return b
 }
 
+// WithParentNamespace sets the ParentNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParentNamespace field is set to the value of the last call.
+func (b *PrefixSpecApplyConfiguration) WithParentNamespace(value string) *PrefixSpecApplyConfiguration {
+	b.ParentNamespace = &value
+	return b
+}
+
 // WithParentSelector sets the ParentSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ParentSelector field is set to the value of the last call.
@@ -66,3 +79,24 @@ func (b *PrefixSpecApplyConfiguration) WithParentSelector(value *metav1.LabelSel
 	b.ParentSelector = value
 	return b
 }
+
+// WithReservedRanges adds the given value to the ReservedRanges field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ReservedRanges field.
+func (b *PrefixSpecApplyConfiguration) WithReservedRanges(values ...*commonv1alpha1.IPRangeApplyConfiguration) *PrefixSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithReservedRanges")
+		}
+		b.ReservedRanges = append(b.ReservedRanges, *values[i])
+	}
+	return b
+}
+
+// WithAllocationStrategy sets the AllocationStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AllocationStrategy field is set to the value of the last call.
+func (b *PrefixSpecApplyConfiguration) WithAllocationStrategy(value ipamv1alpha1.PrefixAllocationStrategy) *PrefixSpecApplyConfiguration {
+	b.AllocationStrategy = &value
+	return b
+}
@@ -14,9 +14,10 @@ import (
 // PrefixStatusApplyConfiguration represents an declarative configuration of the PrefixStatus type for use
 // with apply.
 type PrefixStatusApplyConfiguration struct {
-	Phase                   *v1alpha1.PrefixPhase     `json:"phase,omitempty"`
-	LastPhaseTransitionTime *v1.Time                  `json:"lastPhaseTransitionTime,omitempty"`
-	Used                    []commonv1alpha1.IPPrefix `json:"used,omitempty"`
+	Phase                   *v1alpha1.PrefixPhase                `json:"phase,omitempty"`
+	LastPhaseTransitionTime *v1.Time                             `json:"lastPhaseTransitionTime,omitempty"`
+	Used                    []commonv1alpha1.IPPrefix            `json:"used,omitempty"`
+	Utilization             *PrefixUtilizationApplyConfiguration `json:"utilization,omitempty"`
 }
 
 // PrefixStatusApplyConfiguration constructs an declarative configuration of the PrefixStatus type for use with
@@ -50,3 +51,11 @@ func (b *PrefixStatusApplyConfiguration) WithUsed(values ...commonv1alpha1.IPPre
 	}
 	return b
 }
+
+// WithUtilization sets the Utilization field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Utilization field is set to the value of the last call.
+func (b *PrefixStatusApplyConfiguration) WithUtilization(value *PrefixUtilizationApplyConfiguration) *PrefixStatusApplyConfiguration {
+	b.Utilization = value
+	return b
+}
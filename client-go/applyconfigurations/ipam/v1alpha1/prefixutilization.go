@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// PrefixUtilizationApplyConfiguration represents an declarative configuration of the PrefixUtilization type for use
+// with apply.
+type PrefixUtilizationApplyConfiguration struct {
+	Total                   *string `json:"total,omitempty"`
+	Reserved                *string `json:"reserved,omitempty"`
+	Used                    *string `json:"used,omitempty"`
+	Free                    *string `json:"free,omitempty"`
+	LargestFreePrefixLength *int32  `json:"largestFreePrefixLength,omitempty"`
+}
+
+// PrefixUtilizationApplyConfiguration constructs an declarative configuration of the PrefixUtilization type for use with
+// apply.
+func PrefixUtilization() *PrefixUtilizationApplyConfiguration {
+	return &PrefixUtilizationApplyConfiguration{}
+}
+
+// WithTotal sets the Total field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Total field is set to the value of the last call.
+func (b *PrefixUtilizationApplyConfiguration) WithTotal(value string) *PrefixUtilizationApplyConfiguration {
+	b.Total = &value
+	return b
+}
+
+// WithReserved sets the Reserved field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reserved field is set to the value of the last call.
+func (b *PrefixUtilizationApplyConfiguration) WithReserved(value string) *PrefixUtilizationApplyConfiguration {
+	b.Reserved = &value
+	return b
+}
+
+// WithUsed sets the Used field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Used field is set to the value of the last call.
+func (b *PrefixUtilizationApplyConfiguration) WithUsed(value string) *PrefixUtilizationApplyConfiguration {
+	b.Used = &value
+	return b
+}
+
+// WithFree sets the Free field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Free field is set to the value of the last call.
+func (b *PrefixUtilizationApplyConfiguration) WithFree(value string) *PrefixUtilizationApplyConfiguration {
+	b.Free = &value
+	return b
+}
+
+// WithLargestFreePrefixLength sets the LargestFreePrefixLength field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LargestFreePrefixLength field is set to the value of the last call.
+func (b *PrefixUtilizationApplyConfiguration) WithLargestFreePrefixLength(value int32) *PrefixUtilizationApplyConfiguration {
+	b.LargestFreePrefixLength = &value
+	return b
+}
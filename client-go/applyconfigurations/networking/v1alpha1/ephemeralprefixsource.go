@@ -12,7 +12,8 @@ import (
 // EphemeralPrefixSourceApplyConfiguration represents an declarative configuration of the EphemeralPrefixSource type for use
 // with apply.
 type EphemeralPrefixSourceApplyConfiguration struct {
-	PrefixTemplate *v1alpha1.PrefixTemplateSpecApplyConfiguration `json:"prefixTemplate,omitempty"`
+	PrefixTemplate        *v1alpha1.PrefixTemplateSpecApplyConfiguration `json:"prefixTemplate,omitempty"`
+	DelegatedPrefixLength *int32                                         `json:"delegatedPrefixLength,omitempty"`
 }
 
 // EphemeralPrefixSourceApplyConfiguration constructs an declarative configuration of the EphemeralPrefixSource type for use with
@@ -28,3 +29,11 @@ func (b *EphemeralPrefixSourceApplyConfiguration) WithPrefixTemplate(value *v1al
 	b.PrefixTemplate = value
 	return b
 }
+
+// WithDelegatedPrefixLength sets the DelegatedPrefixLength field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DelegatedPrefixLength field is set to the value of the last call.
+func (b *EphemeralPrefixSourceApplyConfiguration) WithDelegatedPrefixLength(value int32) *EphemeralPrefixSourceApplyConfiguration {
+	b.DelegatedPrefixLength = &value
+	return b
+}
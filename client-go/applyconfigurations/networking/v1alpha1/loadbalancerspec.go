@@ -18,6 +18,7 @@ type LoadBalancerSpecApplyConfiguration struct {
 	IPFamilies               []v1.IPFamily                           `json:"ipFamilies,omitempty"`
 	IPs                      []IPSourceApplyConfiguration            `json:"ips,omitempty"`
 	NetworkRef               *v1.LocalObjectReference                `json:"networkRef,omitempty"`
+	PublicIPPoolRefs         []v1.LocalObjectReference               `json:"publicIPPoolRefs,omitempty"`
 	NetworkInterfaceSelector *metav1.LabelSelectorApplyConfiguration `json:"networkInterfaceSelector,omitempty"`
 	Ports                    []LoadBalancerPortApplyConfiguration    `json:"ports,omitempty"`
 }
@@ -67,6 +68,16 @@ func (b *LoadBalancerSpecApplyConfiguration) WithNetworkRef(value v1.LocalObject
 	return b
 }
 
+// WithPublicIPPoolRefs adds the given value to the PublicIPPoolRefs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PublicIPPoolRefs field.
+func (b *LoadBalancerSpecApplyConfiguration) WithPublicIPPoolRefs(values ...v1.LocalObjectReference) *LoadBalancerSpecApplyConfiguration {
+	for i := range values {
+		b.PublicIPPoolRefs = append(b.PublicIPPoolRefs, values[i])
+	}
+	return b
+}
+
 // WithNetworkInterfaceSelector sets the NetworkInterfaceSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the NetworkInterfaceSelector field is set to the value of the last call.
@@ -12,7 +12,8 @@ import (
 // LoadBalancerStatusApplyConfiguration represents an declarative configuration of the LoadBalancerStatus type for use
 // with apply.
 type LoadBalancerStatusApplyConfiguration struct {
-	IPs []v1alpha1.IP `json:"ips,omitempty"`
+	IPs               []v1alpha1.IP       `json:"ips,omitempty"`
+	DelegatedPrefixes []v1alpha1.IPPrefix `json:"delegatedPrefixes,omitempty"`
 }
 
 // LoadBalancerStatusApplyConfiguration constructs an declarative configuration of the LoadBalancerStatus type for use with
@@ -30,3 +31,13 @@ func (b *LoadBalancerStatusApplyConfiguration) WithIPs(values ...v1alpha1.IP) *L
 	}
 	return b
 }
+
+// WithDelegatedPrefixes adds the given value to the DelegatedPrefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DelegatedPrefixes field.
+func (b *LoadBalancerStatusApplyConfiguration) WithDelegatedPrefixes(values ...v1alpha1.IPPrefix) *LoadBalancerStatusApplyConfiguration {
+	for i := range values {
+		b.DelegatedPrefixes = append(b.DelegatedPrefixes, values[i])
+	}
+	return b
+}
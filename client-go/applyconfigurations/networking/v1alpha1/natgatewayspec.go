@@ -16,6 +16,7 @@ type NATGatewaySpecApplyConfiguration struct {
 	Type                     *v1alpha1.NATGatewayType `json:"type,omitempty"`
 	IPFamily                 *v1.IPFamily             `json:"ipFamily,omitempty"`
 	NetworkRef               *v1.LocalObjectReference `json:"networkRef,omitempty"`
+	PublicIPPoolRef          *v1.LocalObjectReference `json:"publicIPPoolRef,omitempty"`
 	PortsPerNetworkInterface *int32                   `json:"portsPerNetworkInterface,omitempty"`
 }
 
@@ -49,6 +50,14 @@ func (b *NATGatewaySpecApplyConfiguration) WithNetworkRef(value v1.LocalObjectRe
 	return b
 }
 
+// WithPublicIPPoolRef sets the PublicIPPoolRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PublicIPPoolRef field is set to the value of the last call.
+func (b *NATGatewaySpecApplyConfiguration) WithPublicIPPoolRef(value v1.LocalObjectReference) *NATGatewaySpecApplyConfiguration {
+	b.PublicIPPoolRef = &value
+	return b
+}
+
 // WithPortsPerNetworkInterface sets the PortsPerNetworkInterface field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PortsPerNetworkInterface field is set to the value of the last call.
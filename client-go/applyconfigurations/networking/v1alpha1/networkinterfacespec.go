@@ -6,6 +6,7 @@
 package v1alpha1
 
 import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	v1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/common/v1alpha1"
 	v1 "k8s.io/api/core/v1"
 )
@@ -13,14 +14,16 @@ import (
 // NetworkInterfaceSpecApplyConfiguration represents an declarative configuration of the NetworkInterfaceSpec type for use
 // with apply.
 type NetworkInterfaceSpecApplyConfiguration struct {
-	ProviderID *string                                       `json:"providerID,omitempty"`
-	NetworkRef *v1.LocalObjectReference                      `json:"networkRef,omitempty"`
-	MachineRef *v1alpha1.LocalUIDReferenceApplyConfiguration `json:"machineRef,omitempty"`
-	IPFamilies []v1.IPFamily                                 `json:"ipFamilies,omitempty"`
-	IPs        []IPSourceApplyConfiguration                  `json:"ips,omitempty"`
-	Prefixes   []PrefixSourceApplyConfiguration              `json:"prefixes,omitempty"`
-	VirtualIP  *VirtualIPSourceApplyConfiguration            `json:"virtualIP,omitempty"`
-	Attributes map[string]string                             `json:"attributes,omitempty"`
+	ProviderID        *string                                       `json:"providerID,omitempty"`
+	NetworkRef        *v1.LocalObjectReference                      `json:"networkRef,omitempty"`
+	SubnetRef         *v1.LocalObjectReference                      `json:"subnetRef,omitempty"`
+	MachineRef        *v1alpha1.LocalUIDReferenceApplyConfiguration `json:"machineRef,omitempty"`
+	IPFamilies        []v1.IPFamily                                 `json:"ipFamilies,omitempty"`
+	IPs               []IPSourceApplyConfiguration                  `json:"ips,omitempty"`
+	Prefixes          []PrefixSourceApplyConfiguration              `json:"prefixes,omitempty"`
+	DelegatedPrefixes []commonv1alpha1.IPPrefix                     `json:"delegatedPrefixes,omitempty"`
+	VirtualIP         *VirtualIPSourceApplyConfiguration            `json:"virtualIP,omitempty"`
+	Attributes        map[string]string                             `json:"attributes,omitempty"`
 }
 
 // NetworkInterfaceSpecApplyConfiguration constructs an declarative configuration of the NetworkInterfaceSpec type for use with
@@ -45,6 +48,14 @@ func (b *NetworkInterfaceSpecApplyConfiguration) WithNetworkRef(value v1.LocalOb
 	return b
 }
 
+// WithSubnetRef sets the SubnetRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SubnetRef field is set to the value of the last call.
+func (b *NetworkInterfaceSpecApplyConfiguration) WithSubnetRef(value v1.LocalObjectReference) *NetworkInterfaceSpecApplyConfiguration {
+	b.SubnetRef = &value
+	return b
+}
+
 // WithMachineRef sets the MachineRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MachineRef field is set to the value of the last call.
@@ -89,6 +100,16 @@ func (b *NetworkInterfaceSpecApplyConfiguration) WithPrefixes(values ...*PrefixS
 	return b
 }
 
+// WithDelegatedPrefixes adds the given value to the DelegatedPrefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DelegatedPrefixes field.
+func (b *NetworkInterfaceSpecApplyConfiguration) WithDelegatedPrefixes(values ...commonv1alpha1.IPPrefix) *NetworkInterfaceSpecApplyConfiguration {
+	for i := range values {
+		b.DelegatedPrefixes = append(b.DelegatedPrefixes, values[i])
+	}
+	return b
+}
+
 // WithVirtualIP sets the VirtualIP field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the VirtualIP field is set to the value of the last call.
@@ -18,6 +18,7 @@ type NetworkInterfaceStatusApplyConfiguration struct {
 	LastStateTransitionTime *v1.Time                        `json:"lastStateTransitionTime,omitempty"`
 	IPs                     []commonv1alpha1.IP             `json:"ips,omitempty"`
 	Prefixes                []commonv1alpha1.IPPrefix       `json:"prefixes,omitempty"`
+	DelegatedPrefixes       []commonv1alpha1.IPPrefix       `json:"delegatedPrefixes,omitempty"`
 	VirtualIP               *commonv1alpha1.IP              `json:"virtualIP,omitempty"`
 }
 
@@ -63,6 +64,16 @@ func (b *NetworkInterfaceStatusApplyConfiguration) WithPrefixes(values ...common
 	return b
 }
 
+// WithDelegatedPrefixes adds the given value to the DelegatedPrefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DelegatedPrefixes field.
+func (b *NetworkInterfaceStatusApplyConfiguration) WithDelegatedPrefixes(values ...commonv1alpha1.IPPrefix) *NetworkInterfaceStatusApplyConfiguration {
+	for i := range values {
+		b.DelegatedPrefixes = append(b.DelegatedPrefixes, values[i])
+	}
+	return b
+}
+
 // WithVirtualIP sets the VirtualIP field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the VirtualIP field is set to the value of the last call.
@@ -10,6 +10,7 @@ package v1alpha1
 type NetworkPeeringApplyConfiguration struct {
 	Name       *string                                     `json:"name,omitempty"`
 	NetworkRef *NetworkPeeringNetworkRefApplyConfiguration `json:"networkRef,omitempty"`
+	Prefixes   []PeeringPrefixApplyConfiguration           `json:"prefixes,omitempty"`
 }
 
 // NetworkPeeringApplyConfiguration constructs an declarative configuration of the NetworkPeering type for use with
@@ -33,3 +34,16 @@ func (b *NetworkPeeringApplyConfiguration) WithNetworkRef(value *NetworkPeeringN
 	b.NetworkRef = value
 	return b
 }
+
+// WithPrefixes adds the given value to the Prefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Prefixes field.
+func (b *NetworkPeeringApplyConfiguration) WithPrefixes(values ...*PeeringPrefixApplyConfiguration) *NetworkPeeringApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPrefixes")
+		}
+		b.Prefixes = append(b.Prefixes, *values[i])
+	}
+	return b
+}
@@ -6,14 +6,16 @@
 package v1alpha1
 
 import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	v1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 )
 
 // NetworkPeeringStatusApplyConfiguration represents an declarative configuration of the NetworkPeeringStatus type for use
 // with apply.
 type NetworkPeeringStatusApplyConfiguration struct {
-	Name  *string                       `json:"name,omitempty"`
-	State *v1alpha1.NetworkPeeringState `json:"state,omitempty"`
+	Name     *string                       `json:"name,omitempty"`
+	State    *v1alpha1.NetworkPeeringState `json:"state,omitempty"`
+	Prefixes []commonv1alpha1.IPPrefix     `json:"prefixes,omitempty"`
 }
 
 // NetworkPeeringStatusApplyConfiguration constructs an declarative configuration of the NetworkPeeringStatus type for use with
@@ -37,3 +39,13 @@ func (b *NetworkPeeringStatusApplyConfiguration) WithState(value v1alpha1.Networ
 	b.State = &value
 	return b
 }
+
+// WithPrefixes adds the given value to the Prefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Prefixes field.
+func (b *NetworkPeeringStatusApplyConfiguration) WithPrefixes(values ...commonv1alpha1.IPPrefix) *NetworkPeeringStatusApplyConfiguration {
+	for i := range values {
+		b.Prefixes = append(b.Prefixes, values[i])
+	}
+	return b
+}
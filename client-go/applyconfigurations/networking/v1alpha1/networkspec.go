@@ -5,10 +5,15 @@
 
 package v1alpha1
 
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+)
+
 // NetworkSpecApplyConfiguration represents an declarative configuration of the NetworkSpec type for use
 // with apply.
 type NetworkSpecApplyConfiguration struct {
 	ProviderID       *string                                    `json:"providerID,omitempty"`
+	Prefixes         []v1alpha1.IPPrefix                        `json:"prefixes,omitempty"`
 	Peerings         []NetworkPeeringApplyConfiguration         `json:"peerings,omitempty"`
 	PeeringClaimRefs []NetworkPeeringClaimRefApplyConfiguration `json:"incomingPeerings,omitempty"`
 }
@@ -27,6 +32,16 @@ func (b *NetworkSpecApplyConfiguration) WithProviderID(value string) *NetworkSpe
 	return b
 }
 
+// WithPrefixes adds the given value to the Prefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Prefixes field.
+func (b *NetworkSpecApplyConfiguration) WithPrefixes(values ...v1alpha1.IPPrefix) *NetworkSpecApplyConfiguration {
+	for i := range values {
+		b.Prefixes = append(b.Prefixes, values[i])
+	}
+	return b
+}
+
 // WithPeerings adds the given value to the Peerings field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Peerings field.
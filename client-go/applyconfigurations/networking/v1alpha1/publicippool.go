@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	internal "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/internal"
+	v1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
+)
+
+// PublicIPPoolApplyConfiguration represents an declarative configuration of the PublicIPPool type for use
+// with apply.
+type PublicIPPoolApplyConfiguration struct {
+	v1.TypeMetaApplyConfiguration    `json:",inline"`
+	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Spec                             *PublicIPPoolSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *PublicIPPoolStatusApplyConfiguration `json:"status,omitempty"`
+}
+
+// PublicIPPool constructs an declarative configuration of the PublicIPPool type for use with
+// apply.
+func PublicIPPool(name string) *PublicIPPoolApplyConfiguration {
+	b := &PublicIPPoolApplyConfiguration{}
+	b.WithName(name)
+	b.WithKind("PublicIPPool")
+	b.WithAPIVersion("networking.ironcore.dev/v1alpha1")
+	return b
+}
+
+// ExtractPublicIPPool extracts the applied configuration owned by fieldManager from
+// publicIPPool. If no managedFields are found in publicIPPool for fieldManager, a
+// PublicIPPoolApplyConfiguration is returned with only the Name, Namespace (if applicable),
+// APIVersion and Kind populated. It is possible that no managed fields were found for because other
+// field managers have taken ownership of all the fields previously owned by fieldManager, or because
+// the fieldManager never owned fields any fields.
+// publicIPPool must be a unmodified PublicIPPool API object that was retrieved from the Kubernetes API.
+// ExtractPublicIPPool provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+// Experimental!
+func ExtractPublicIPPool(publicIPPool *networkingv1alpha1.PublicIPPool, fieldManager string) (*PublicIPPoolApplyConfiguration, error) {
+	return extractPublicIPPool(publicIPPool, fieldManager, "")
+}
+
+// ExtractPublicIPPoolStatus is the same as ExtractPublicIPPool except
+// that it extracts the status subresource applied configuration.
+// Experimental!
+func ExtractPublicIPPoolStatus(publicIPPool *networkingv1alpha1.PublicIPPool, fieldManager string) (*PublicIPPoolApplyConfiguration, error) {
+	return extractPublicIPPool(publicIPPool, fieldManager, "status")
+}
+
+func extractPublicIPPool(publicIPPool *networkingv1alpha1.PublicIPPool, fieldManager string, subresource string) (*PublicIPPoolApplyConfiguration, error) {
+	b := &PublicIPPoolApplyConfiguration{}
+	err := managedfields.ExtractInto(publicIPPool, internal.Parser().Type("com.github.ironcore-dev.ironcore.api.networking.v1alpha1.PublicIPPool"), fieldManager, b, subresource)
+	if err != nil {
+		return nil, err
+	}
+	b.WithName(publicIPPool.Name)
+
+	b.WithKind("PublicIPPool")
+	b.WithAPIVersion("networking.ironcore.dev/v1alpha1")
+	return b, nil
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithKind(value string) *PublicIPPoolApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithAPIVersion(value string) *PublicIPPoolApplyConfiguration {
+	b.APIVersion = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithName(value string) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.Name = &value
+	return b
+}
+
+// WithGenerateName sets the GenerateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenerateName field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithGenerateName(value string) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.GenerateName = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithNamespace(value string) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.Namespace = &value
+	return b
+}
+
+// WithUID sets the UID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UID field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithUID(value types.UID) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.UID = &value
+	return b
+}
+
+// WithResourceVersion sets the ResourceVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceVersion field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithResourceVersion(value string) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ResourceVersion = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithGeneration(value int64) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.Generation = &value
+	return b
+}
+
+// WithCreationTimestamp sets the CreationTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CreationTimestamp field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithCreationTimestamp(value metav1.Time) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.CreationTimestamp = &value
+	return b
+}
+
+// WithDeletionTimestamp sets the DeletionTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionTimestamp field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithDeletionTimestamp(value metav1.Time) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.DeletionTimestamp = &value
+	return b
+}
+
+// WithDeletionGracePeriodSeconds sets the DeletionGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionGracePeriodSeconds field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithDeletionGracePeriodSeconds(value int64) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.DeletionGracePeriodSeconds = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *PublicIPPoolApplyConfiguration) WithLabels(entries map[string]string) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.Labels == nil && len(entries) > 0 {
+		b.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *PublicIPPoolApplyConfiguration) WithAnnotations(entries map[string]string) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.Annotations == nil && len(entries) > 0 {
+		b.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Annotations[k] = v
+	}
+	return b
+}
+
+// WithOwnerReferences adds the given value to the OwnerReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OwnerReferences field.
+func (b *PublicIPPoolApplyConfiguration) WithOwnerReferences(values ...*v1.OwnerReferenceApplyConfiguration) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOwnerReferences")
+		}
+		b.OwnerReferences = append(b.OwnerReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinalizers adds the given value to the Finalizers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Finalizers field.
+func (b *PublicIPPoolApplyConfiguration) WithFinalizers(values ...string) *PublicIPPoolApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		b.Finalizers = append(b.Finalizers, values[i])
+	}
+	return b
+}
+
+func (b *PublicIPPoolApplyConfiguration) ensureObjectMetaApplyConfigurationExists() {
+	if b.ObjectMetaApplyConfiguration == nil {
+		b.ObjectMetaApplyConfiguration = &v1.ObjectMetaApplyConfiguration{}
+	}
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithSpec(value *PublicIPPoolSpecApplyConfiguration) *PublicIPPoolApplyConfiguration {
+	b.Spec = value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *PublicIPPoolApplyConfiguration) WithStatus(value *PublicIPPoolStatusApplyConfiguration) *PublicIPPoolApplyConfiguration {
+	b.Status = value
+	return b
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// PublicIPPoolSpecApplyConfiguration represents an declarative configuration of the PublicIPPoolSpec type for use
+// with apply.
+type PublicIPPoolSpecApplyConfiguration struct {
+	IPFamily *v1.IPFamily        `json:"ipFamily,omitempty"`
+	Prefixes []v1alpha1.IPPrefix `json:"prefixes,omitempty"`
+}
+
+// PublicIPPoolSpecApplyConfiguration constructs an declarative configuration of the PublicIPPoolSpec type for use with
+// apply.
+func PublicIPPoolSpec() *PublicIPPoolSpecApplyConfiguration {
+	return &PublicIPPoolSpecApplyConfiguration{}
+}
+
+// WithIPFamily sets the IPFamily field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPFamily field is set to the value of the last call.
+func (b *PublicIPPoolSpecApplyConfiguration) WithIPFamily(value v1.IPFamily) *PublicIPPoolSpecApplyConfiguration {
+	b.IPFamily = &value
+	return b
+}
+
+// WithPrefixes adds the given value to the Prefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Prefixes field.
+func (b *PublicIPPoolSpecApplyConfiguration) WithPrefixes(values ...v1alpha1.IPPrefix) *PublicIPPoolSpecApplyConfiguration {
+	for i := range values {
+		b.Prefixes = append(b.Prefixes, values[i])
+	}
+	return b
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PublicIPPoolStatusApplyConfiguration represents an declarative configuration of the PublicIPPoolStatus type for use
+// with apply.
+type PublicIPPoolStatusApplyConfiguration struct {
+	State                   *v1alpha1.PublicIPPoolState `json:"state,omitempty"`
+	LastStateTransitionTime *v1.Time                    `json:"lastStateTransitionTime,omitempty"`
+	Used                    *int32                      `json:"used,omitempty"`
+}
+
+// PublicIPPoolStatusApplyConfiguration constructs an declarative configuration of the PublicIPPoolStatus type for use with
+// apply.
+func PublicIPPoolStatus() *PublicIPPoolStatusApplyConfiguration {
+	return &PublicIPPoolStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *PublicIPPoolStatusApplyConfiguration) WithState(value v1alpha1.PublicIPPoolState) *PublicIPPoolStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithLastStateTransitionTime sets the LastStateTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastStateTransitionTime field is set to the value of the last call.
+func (b *PublicIPPoolStatusApplyConfiguration) WithLastStateTransitionTime(value v1.Time) *PublicIPPoolStatusApplyConfiguration {
+	b.LastStateTransitionTime = &value
+	return b
+}
+
+// WithUsed sets the Used field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Used field is set to the value of the last call.
+func (b *PublicIPPoolStatusApplyConfiguration) WithUsed(value int32) *PublicIPPoolStatusApplyConfiguration {
+	b.Used = &value
+	return b
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+)
+
+// RouteApplyConfiguration represents an declarative configuration of the Route type for use
+// with apply.
+type RouteApplyConfiguration struct {
+	Name        *string                         `json:"name,omitempty"`
+	Destination *v1alpha1.IPPrefix              `json:"destination,omitempty"`
+	NextHop     *RouteNextHopApplyConfiguration `json:"nextHop,omitempty"`
+}
+
+// RouteApplyConfiguration constructs an declarative configuration of the Route type for use with
+// apply.
+func Route() *RouteApplyConfiguration {
+	return &RouteApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RouteApplyConfiguration) WithName(value string) *RouteApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithDestination sets the Destination field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Destination field is set to the value of the last call.
+func (b *RouteApplyConfiguration) WithDestination(value v1alpha1.IPPrefix) *RouteApplyConfiguration {
+	b.Destination = &value
+	return b
+}
+
+// WithNextHop sets the NextHop field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NextHop field is set to the value of the last call.
+func (b *RouteApplyConfiguration) WithNextHop(value *RouteNextHopApplyConfiguration) *RouteApplyConfiguration {
+	b.NextHop = value
+	return b
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// RouteNextHopApplyConfiguration represents an declarative configuration of the RouteNextHop type for use
+// with apply.
+type RouteNextHopApplyConfiguration struct {
+	NetworkInterfaceRef *v1.LocalObjectReference `json:"networkInterfaceRef,omitempty"`
+	VirtualIPRef        *v1.LocalObjectReference `json:"virtualIPRef,omitempty"`
+	NATGatewayRef       *v1.LocalObjectReference `json:"natGatewayRef,omitempty"`
+	NetworkPeeringRef   *v1.LocalObjectReference `json:"networkPeeringRef,omitempty"`
+}
+
+// RouteNextHopApplyConfiguration constructs an declarative configuration of the RouteNextHop type for use with
+// apply.
+func RouteNextHop() *RouteNextHopApplyConfiguration {
+	return &RouteNextHopApplyConfiguration{}
+}
+
+// WithNetworkInterfaceRef sets the NetworkInterfaceRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkInterfaceRef field is set to the value of the last call.
+func (b *RouteNextHopApplyConfiguration) WithNetworkInterfaceRef(value v1.LocalObjectReference) *RouteNextHopApplyConfiguration {
+	b.NetworkInterfaceRef = &value
+	return b
+}
+
+// WithVirtualIPRef sets the VirtualIPRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualIPRef field is set to the value of the last call.
+func (b *RouteNextHopApplyConfiguration) WithVirtualIPRef(value v1.LocalObjectReference) *RouteNextHopApplyConfiguration {
+	b.VirtualIPRef = &value
+	return b
+}
+
+// WithNATGatewayRef sets the NATGatewayRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NATGatewayRef field is set to the value of the last call.
+func (b *RouteNextHopApplyConfiguration) WithNATGatewayRef(value v1.LocalObjectReference) *RouteNextHopApplyConfiguration {
+	b.NATGatewayRef = &value
+	return b
+}
+
+// WithNetworkPeeringRef sets the NetworkPeeringRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkPeeringRef field is set to the value of the last call.
+func (b *RouteNextHopApplyConfiguration) WithNetworkPeeringRef(value v1.LocalObjectReference) *RouteNextHopApplyConfiguration {
+	b.NetworkPeeringRef = &value
+	return b
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RouteStatusApplyConfiguration represents an declarative configuration of the RouteStatus type for use
+// with apply.
+type RouteStatusApplyConfiguration struct {
+	Name                    *string              `json:"name,omitempty"`
+	State                   *v1alpha1.RouteState `json:"state,omitempty"`
+	Message                 *string              `json:"message,omitempty"`
+	LastStateTransitionTime *v1.Time             `json:"lastStateTransitionTime,omitempty"`
+}
+
+// RouteStatusApplyConfiguration constructs an declarative configuration of the RouteStatus type for use with
+// apply.
+func RouteStatus() *RouteStatusApplyConfiguration {
+	return &RouteStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RouteStatusApplyConfiguration) WithName(value string) *RouteStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *RouteStatusApplyConfiguration) WithState(value v1alpha1.RouteState) *RouteStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *RouteStatusApplyConfiguration) WithMessage(value string) *RouteStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithLastStateTransitionTime sets the LastStateTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastStateTransitionTime field is set to the value of the last call.
+func (b *RouteStatusApplyConfiguration) WithLastStateTransitionTime(value v1.Time) *RouteStatusApplyConfiguration {
+	b.LastStateTransitionTime = &value
+	return b
+}
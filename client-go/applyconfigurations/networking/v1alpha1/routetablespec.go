@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// RouteTableSpecApplyConfiguration represents an declarative configuration of the RouteTableSpec type for use
+// with apply.
+type RouteTableSpecApplyConfiguration struct {
+	NetworkRef *v1.LocalObjectReference  `json:"networkRef,omitempty"`
+	Routes     []RouteApplyConfiguration `json:"routes,omitempty"`
+}
+
+// RouteTableSpecApplyConfiguration constructs an declarative configuration of the RouteTableSpec type for use with
+// apply.
+func RouteTableSpec() *RouteTableSpecApplyConfiguration {
+	return &RouteTableSpecApplyConfiguration{}
+}
+
+// WithNetworkRef sets the NetworkRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkRef field is set to the value of the last call.
+func (b *RouteTableSpecApplyConfiguration) WithNetworkRef(value v1.LocalObjectReference) *RouteTableSpecApplyConfiguration {
+	b.NetworkRef = &value
+	return b
+}
+
+// WithRoutes adds the given value to the Routes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Routes field.
+func (b *RouteTableSpecApplyConfiguration) WithRoutes(values ...*RouteApplyConfiguration) *RouteTableSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRoutes")
+		}
+		b.Routes = append(b.Routes, *values[i])
+	}
+	return b
+}
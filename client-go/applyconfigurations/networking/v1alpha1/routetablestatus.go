@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// RouteTableStatusApplyConfiguration represents an declarative configuration of the RouteTableStatus type for use
+// with apply.
+type RouteTableStatusApplyConfiguration struct {
+	Routes []RouteStatusApplyConfiguration `json:"routes,omitempty"`
+}
+
+// RouteTableStatusApplyConfiguration constructs an declarative configuration of the RouteTableStatus type for use with
+// apply.
+func RouteTableStatus() *RouteTableStatusApplyConfiguration {
+	return &RouteTableStatusApplyConfiguration{}
+}
+
+// WithRoutes adds the given value to the Routes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Routes field.
+func (b *RouteTableStatusApplyConfiguration) WithRoutes(values ...*RouteStatusApplyConfiguration) *RouteTableStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRoutes")
+		}
+		b.Routes = append(b.Routes, *values[i])
+	}
+	return b
+}
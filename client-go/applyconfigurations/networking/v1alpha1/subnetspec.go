@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// SubnetSpecApplyConfiguration represents an declarative configuration of the SubnetSpec type for use
+// with apply.
+type SubnetSpecApplyConfiguration struct {
+	NetworkRef *v1.LocalObjectReference `json:"networkRef,omitempty"`
+	Prefixes   []v1alpha1.IPPrefix      `json:"prefixes,omitempty"`
+}
+
+// SubnetSpecApplyConfiguration constructs an declarative configuration of the SubnetSpec type for use with
+// apply.
+func SubnetSpec() *SubnetSpecApplyConfiguration {
+	return &SubnetSpecApplyConfiguration{}
+}
+
+// WithNetworkRef sets the NetworkRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkRef field is set to the value of the last call.
+func (b *SubnetSpecApplyConfiguration) WithNetworkRef(value v1.LocalObjectReference) *SubnetSpecApplyConfiguration {
+	b.NetworkRef = &value
+	return b
+}
+
+// WithPrefixes adds the given value to the Prefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Prefixes field.
+func (b *SubnetSpecApplyConfiguration) WithPrefixes(values ...v1alpha1.IPPrefix) *SubnetSpecApplyConfiguration {
+	for i := range values {
+		b.Prefixes = append(b.Prefixes, values[i])
+	}
+	return b
+}
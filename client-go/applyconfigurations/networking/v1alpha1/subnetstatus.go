@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubnetStatusApplyConfiguration represents an declarative configuration of the SubnetStatus type for use
+// with apply.
+type SubnetStatusApplyConfiguration struct {
+	State                   *v1alpha1.SubnetState `json:"state,omitempty"`
+	LastStateTransitionTime *v1.Time              `json:"lastStateTransitionTime,omitempty"`
+}
+
+// SubnetStatusApplyConfiguration constructs an declarative configuration of the SubnetStatus type for use with
+// apply.
+func SubnetStatus() *SubnetStatusApplyConfiguration {
+	return &SubnetStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *SubnetStatusApplyConfiguration) WithState(value v1alpha1.SubnetState) *SubnetStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithLastStateTransitionTime sets the LastStateTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastStateTransitionTime field is set to the value of the last call.
+func (b *SubnetStatusApplyConfiguration) WithLastStateTransitionTime(value v1.Time) *SubnetStatusApplyConfiguration {
+	b.LastStateTransitionTime = &value
+	return b
+}
@@ -14,9 +14,10 @@ import (
 // VirtualIPSpecApplyConfiguration represents an declarative configuration of the VirtualIPSpec type for use
 // with apply.
 type VirtualIPSpecApplyConfiguration struct {
-	Type      *v1alpha1.VirtualIPType                             `json:"type,omitempty"`
-	IPFamily  *v1.IPFamily                                        `json:"ipFamily,omitempty"`
-	TargetRef *commonv1alpha1.LocalUIDReferenceApplyConfiguration `json:"targetRef,omitempty"`
+	Type            *v1alpha1.VirtualIPType                             `json:"type,omitempty"`
+	IPFamily        *v1.IPFamily                                        `json:"ipFamily,omitempty"`
+	PublicIPPoolRef *v1.LocalObjectReference                            `json:"publicIPPoolRef,omitempty"`
+	TargetRef       *commonv1alpha1.LocalUIDReferenceApplyConfiguration `json:"targetRef,omitempty"`
 }
 
 // VirtualIPSpecApplyConfiguration constructs an declarative configuration of the VirtualIPSpec type for use with
@@ -41,6 +42,14 @@ func (b *VirtualIPSpecApplyConfiguration) WithIPFamily(value v1.IPFamily) *Virtu
 	return b
 }
 
+// WithPublicIPPoolRef sets the PublicIPPoolRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PublicIPPoolRef field is set to the value of the last call.
+func (b *VirtualIPSpecApplyConfiguration) WithPublicIPPoolRef(value v1.LocalObjectReference) *VirtualIPSpecApplyConfiguration {
+	b.PublicIPPoolRef = &value
+	return b
+}
+
 // WithTargetRef sets the TargetRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the TargetRef field is set to the value of the last call.
@@ -28,6 +28,8 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=common.ironcore.dev, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("IPRange"):
+		return &commonv1alpha1.IPRangeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LocalUIDReference"):
 		return &commonv1alpha1.LocalUIDReferenceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SecretKeySelector"):
@@ -82,8 +84,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationscomputev1alpha1.VolumeStatusApplyConfiguration{}
 
 		// Group=core.ironcore.dev, Version=v1alpha1
+	case corev1alpha1.SchemeGroupVersion.WithKind("AllowedClasses"):
+		return &applyconfigurationscorev1alpha1.AllowedClassesApplyConfiguration{}
 	case corev1alpha1.SchemeGroupVersion.WithKind("ObjectSelector"):
 		return &applyconfigurationscorev1alpha1.ObjectSelectorApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("ResourceLimit"):
+		return &applyconfigurationscorev1alpha1.ResourceLimitApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("ResourceLimitItem"):
+		return &applyconfigurationscorev1alpha1.ResourceLimitItemApplyConfiguration{}
+	case corev1alpha1.SchemeGroupVersion.WithKind("ResourceLimitSpec"):
+		return &applyconfigurationscorev1alpha1.ResourceLimitSpecApplyConfiguration{}
 	case corev1alpha1.SchemeGroupVersion.WithKind("ResourceQuota"):
 		return &applyconfigurationscorev1alpha1.ResourceQuotaApplyConfiguration{}
 	case corev1alpha1.SchemeGroupVersion.WithKind("ResourceQuotaSpec"):
@@ -104,12 +114,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationsipamv1alpha1.PrefixAllocationSpecApplyConfiguration{}
 	case ipamv1alpha1.SchemeGroupVersion.WithKind("PrefixAllocationStatus"):
 		return &applyconfigurationsipamv1alpha1.PrefixAllocationStatusApplyConfiguration{}
+	case ipamv1alpha1.SchemeGroupVersion.WithKind("PrefixGrant"):
+		return &applyconfigurationsipamv1alpha1.PrefixGrantApplyConfiguration{}
+	case ipamv1alpha1.SchemeGroupVersion.WithKind("PrefixGrantSpec"):
+		return &applyconfigurationsipamv1alpha1.PrefixGrantSpecApplyConfiguration{}
 	case ipamv1alpha1.SchemeGroupVersion.WithKind("PrefixSpec"):
 		return &applyconfigurationsipamv1alpha1.PrefixSpecApplyConfiguration{}
 	case ipamv1alpha1.SchemeGroupVersion.WithKind("PrefixStatus"):
 		return &applyconfigurationsipamv1alpha1.PrefixStatusApplyConfiguration{}
 	case ipamv1alpha1.SchemeGroupVersion.WithKind("PrefixTemplateSpec"):
 		return &applyconfigurationsipamv1alpha1.PrefixTemplateSpecApplyConfiguration{}
+	case ipamv1alpha1.SchemeGroupVersion.WithKind("PrefixUtilization"):
+		return &applyconfigurationsipamv1alpha1.PrefixUtilizationApplyConfiguration{}
 
 		// Group=meta.k8s.io, Version=v1
 	case v1.SchemeGroupVersion.WithKind("LabelSelector"):
@@ -192,8 +208,34 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationsnetworkingv1alpha1.NetworkSpecApplyConfiguration{}
 	case networkingv1alpha1.SchemeGroupVersion.WithKind("NetworkStatus"):
 		return &applyconfigurationsnetworkingv1alpha1.NetworkStatusApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("PeeringPrefix"):
+		return &applyconfigurationsnetworkingv1alpha1.PeeringPrefixApplyConfiguration{}
 	case networkingv1alpha1.SchemeGroupVersion.WithKind("PrefixSource"):
 		return &applyconfigurationsnetworkingv1alpha1.PrefixSourceApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("PublicIPPool"):
+		return &applyconfigurationsnetworkingv1alpha1.PublicIPPoolApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("PublicIPPoolSpec"):
+		return &applyconfigurationsnetworkingv1alpha1.PublicIPPoolSpecApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("PublicIPPoolStatus"):
+		return &applyconfigurationsnetworkingv1alpha1.PublicIPPoolStatusApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("Route"):
+		return &applyconfigurationsnetworkingv1alpha1.RouteApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("RouteNextHop"):
+		return &applyconfigurationsnetworkingv1alpha1.RouteNextHopApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("RouteStatus"):
+		return &applyconfigurationsnetworkingv1alpha1.RouteStatusApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("RouteTable"):
+		return &applyconfigurationsnetworkingv1alpha1.RouteTableApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("RouteTableSpec"):
+		return &applyconfigurationsnetworkingv1alpha1.RouteTableSpecApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("RouteTableStatus"):
+		return &applyconfigurationsnetworkingv1alpha1.RouteTableStatusApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("Subnet"):
+		return &applyconfigurationsnetworkingv1alpha1.SubnetApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("SubnetSpec"):
+		return &applyconfigurationsnetworkingv1alpha1.SubnetSpecApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("SubnetStatus"):
+		return &applyconfigurationsnetworkingv1alpha1.SubnetStatusApplyConfiguration{}
 	case networkingv1alpha1.SchemeGroupVersion.WithKind("VirtualIP"):
 		return &applyconfigurationsnetworkingv1alpha1.VirtualIPApplyConfiguration{}
 	case networkingv1alpha1.SchemeGroupVersion.WithKind("VirtualIPSource"):
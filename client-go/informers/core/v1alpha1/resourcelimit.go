@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	internalinterfaces "github.com/ironcore-dev/ironcore/client-go/informers/internalinterfaces"
+	ironcore "github.com/ironcore-dev/ironcore/client-go/ironcore"
+	v1alpha1 "github.com/ironcore-dev/ironcore/client-go/listers/core/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ResourceLimitInformer provides access to a shared informer and lister for
+// ResourceLimits.
+type ResourceLimitInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.ResourceLimitLister
+}
+
+type resourceLimitInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewResourceLimitInformer constructs a new informer for ResourceLimit type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewResourceLimitInformer(client ironcore.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredResourceLimitInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredResourceLimitInformer constructs a new informer for ResourceLimit type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredResourceLimitInformer(client ironcore.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CoreV1alpha1().ResourceLimits(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CoreV1alpha1().ResourceLimits(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&corev1alpha1.ResourceLimit{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *resourceLimitInformer) defaultInformer(client ironcore.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredResourceLimitInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *resourceLimitInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&corev1alpha1.ResourceLimit{}, f.defaultInformer)
+}
+
+func (f *resourceLimitInformer) Lister() v1alpha1.ResourceLimitLister {
+	return v1alpha1.NewResourceLimitLister(f.Informer().GetIndexer())
+}
@@ -52,6 +52,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Compute().V1alpha1().MachinePools().Informer()}, nil
 
 		// Group=core.ironcore.dev, Version=v1alpha1
+	case corev1alpha1.SchemeGroupVersion.WithResource("resourcelimits"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().ResourceLimits().Informer()}, nil
 	case corev1alpha1.SchemeGroupVersion.WithResource("resourcequotas"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().ResourceQuotas().Informer()}, nil
 
@@ -60,6 +62,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ipam().V1alpha1().Prefixes().Informer()}, nil
 	case ipamv1alpha1.SchemeGroupVersion.WithResource("prefixallocations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ipam().V1alpha1().PrefixAllocations().Informer()}, nil
+	case ipamv1alpha1.SchemeGroupVersion.WithResource("prefixgrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ipam().V1alpha1().PrefixGrants().Informer()}, nil
 
 		// Group=networking.ironcore.dev, Version=v1alpha1
 	case networkingv1alpha1.SchemeGroupVersion.WithResource("loadbalancers"):
@@ -74,6 +78,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().NetworkInterfaces().Informer()}, nil
 	case networkingv1alpha1.SchemeGroupVersion.WithResource("networkpolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().NetworkPolicies().Informer()}, nil
+	case networkingv1alpha1.SchemeGroupVersion.WithResource("publicippools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().PublicIPPools().Informer()}, nil
+	case networkingv1alpha1.SchemeGroupVersion.WithResource("routetables"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().RouteTables().Informer()}, nil
+	case networkingv1alpha1.SchemeGroupVersion.WithResource("subnets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().Subnets().Informer()}, nil
 	case networkingv1alpha1.SchemeGroupVersion.WithResource("virtualips"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha1().VirtualIPs().Informer()}, nil
 
@@ -15,6 +15,8 @@ type Interface interface {
 	Prefixes() PrefixInformer
 	// PrefixAllocations returns a PrefixAllocationInformer.
 	PrefixAllocations() PrefixAllocationInformer
+	// PrefixGrants returns a PrefixGrantInformer.
+	PrefixGrants() PrefixGrantInformer
 }
 
 type version struct {
@@ -37,3 +39,8 @@ func (v *version) Prefixes() PrefixInformer {
 func (v *version) PrefixAllocations() PrefixAllocationInformer {
 	return &prefixAllocationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// PrefixGrants returns a PrefixGrantInformer.
+func (v *version) PrefixGrants() PrefixGrantInformer {
+	return &prefixGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -23,6 +23,12 @@ type Interface interface {
 	NetworkInterfaces() NetworkInterfaceInformer
 	// NetworkPolicies returns a NetworkPolicyInformer.
 	NetworkPolicies() NetworkPolicyInformer
+	// PublicIPPools returns a PublicIPPoolInformer.
+	PublicIPPools() PublicIPPoolInformer
+	// RouteTables returns a RouteTableInformer.
+	RouteTables() RouteTableInformer
+	// Subnets returns a SubnetInformer.
+	Subnets() SubnetInformer
 	// VirtualIPs returns a VirtualIPInformer.
 	VirtualIPs() VirtualIPInformer
 }
@@ -68,6 +74,21 @@ func (v *version) NetworkPolicies() NetworkPolicyInformer {
 	return &networkPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// PublicIPPools returns a PublicIPPoolInformer.
+func (v *version) PublicIPPools() PublicIPPoolInformer {
+	return &publicIPPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// RouteTables returns a RouteTableInformer.
+func (v *version) RouteTables() RouteTableInformer {
+	return &routeTableInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Subnets returns a SubnetInformer.
+func (v *version) Subnets() SubnetInformer {
+	return &subnetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VirtualIPs returns a VirtualIPInformer.
 func (v *version) VirtualIPs() VirtualIPInformer {
 	return &virtualIPInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	internalinterfaces "github.com/ironcore-dev/ironcore/client-go/informers/internalinterfaces"
+	ironcore "github.com/ironcore-dev/ironcore/client-go/ironcore"
+	v1alpha1 "github.com/ironcore-dev/ironcore/client-go/listers/networking/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// PublicIPPoolInformer provides access to a shared informer and lister for
+// PublicIPPools.
+type PublicIPPoolInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.PublicIPPoolLister
+}
+
+type publicIPPoolInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewPublicIPPoolInformer constructs a new informer for PublicIPPool type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewPublicIPPoolInformer(client ironcore.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredPublicIPPoolInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredPublicIPPoolInformer constructs a new informer for PublicIPPool type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredPublicIPPoolInformer(client ironcore.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetworkingV1alpha1().PublicIPPools().List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetworkingV1alpha1().PublicIPPools().Watch(context.TODO(), options)
+			},
+		},
+		&networkingv1alpha1.PublicIPPool{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *publicIPPoolInformer) defaultInformer(client ironcore.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredPublicIPPoolInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *publicIPPoolInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&networkingv1alpha1.PublicIPPool{}, f.defaultInformer)
+}
+
+func (f *publicIPPoolInformer) Lister() v1alpha1.PublicIPPoolLister {
+	return v1alpha1.NewPublicIPPoolLister(f.Informer().GetIndexer())
+}
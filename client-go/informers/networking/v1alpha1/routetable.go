@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	internalinterfaces "github.com/ironcore-dev/ironcore/client-go/informers/internalinterfaces"
+	ironcore "github.com/ironcore-dev/ironcore/client-go/ironcore"
+	v1alpha1 "github.com/ironcore-dev/ironcore/client-go/listers/networking/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// RouteTableInformer provides access to a shared informer and lister for
+// RouteTables.
+type RouteTableInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.RouteTableLister
+}
+
+type routeTableInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewRouteTableInformer constructs a new informer for RouteTable type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewRouteTableInformer(client ironcore.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredRouteTableInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredRouteTableInformer constructs a new informer for RouteTable type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredRouteTableInformer(client ironcore.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetworkingV1alpha1().RouteTables(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetworkingV1alpha1().RouteTables(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&networkingv1alpha1.RouteTable{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *routeTableInformer) defaultInformer(client ironcore.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredRouteTableInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *routeTableInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&networkingv1alpha1.RouteTable{}, f.defaultInformer)
+}
+
+func (f *routeTableInformer) Lister() v1alpha1.RouteTableLister {
+	return v1alpha1.NewRouteTableLister(f.Informer().GetIndexer())
+}
@@ -15,6 +15,7 @@ import (
 
 type CoreV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	ResourceLimitsGetter
 	ResourceQuotasGetter
 }
 
@@ -23,6 +24,10 @@ type CoreV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *CoreV1alpha1Client) ResourceLimits(namespace string) ResourceLimitInterface {
+	return newResourceLimits(c, namespace)
+}
+
 func (c *CoreV1alpha1Client) ResourceQuotas(namespace string) ResourceQuotaInterface {
 	return newResourceQuotas(c, namespace)
 }
@@ -15,6 +15,10 @@ type FakeCoreV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeCoreV1alpha1) ResourceLimits(namespace string) v1alpha1.ResourceLimitInterface {
+	return &FakeResourceLimits{c, namespace}
+}
+
 func (c *FakeCoreV1alpha1) ResourceQuotas(namespace string) v1alpha1.ResourceQuotaInterface {
 	return &FakeResourceQuotas{c, namespace}
 }
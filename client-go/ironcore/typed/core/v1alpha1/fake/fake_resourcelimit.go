@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	corev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/core/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeResourceLimits implements ResourceLimitInterface
+type FakeResourceLimits struct {
+	Fake *FakeCoreV1alpha1
+	ns   string
+}
+
+var resourcelimitsResource = v1alpha1.SchemeGroupVersion.WithResource("resourcelimits")
+
+var resourcelimitsKind = v1alpha1.SchemeGroupVersion.WithKind("ResourceLimit")
+
+// Get takes name of the resourceLimit, and returns the corresponding resourceLimit object, and an error if there is any.
+func (c *FakeResourceLimits) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ResourceLimit, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(resourcelimitsResource, c.ns, name), &v1alpha1.ResourceLimit{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ResourceLimit), err
+}
+
+// List takes label and field selectors, and returns the list of ResourceLimits that match those selectors.
+func (c *FakeResourceLimits) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ResourceLimitList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(resourcelimitsResource, resourcelimitsKind, c.ns, opts), &v1alpha1.ResourceLimitList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ResourceLimitList{ListMeta: obj.(*v1alpha1.ResourceLimitList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ResourceLimitList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested resourceLimits.
+func (c *FakeResourceLimits) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(resourcelimitsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a resourceLimit and creates it.  Returns the server's representation of the resourceLimit, and an error, if there is any.
+func (c *FakeResourceLimits) Create(ctx context.Context, resourceLimit *v1alpha1.ResourceLimit, opts v1.CreateOptions) (result *v1alpha1.ResourceLimit, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(resourcelimitsResource, c.ns, resourceLimit), &v1alpha1.ResourceLimit{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ResourceLimit), err
+}
+
+// Update takes the representation of a resourceLimit and updates it. Returns the server's representation of the resourceLimit, and an error, if there is any.
+func (c *FakeResourceLimits) Update(ctx context.Context, resourceLimit *v1alpha1.ResourceLimit, opts v1.UpdateOptions) (result *v1alpha1.ResourceLimit, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(resourcelimitsResource, c.ns, resourceLimit), &v1alpha1.ResourceLimit{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ResourceLimit), err
+}
+
+// Delete takes name of the resourceLimit and deletes it. Returns an error if one occurs.
+func (c *FakeResourceLimits) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(resourcelimitsResource, c.ns, name, opts), &v1alpha1.ResourceLimit{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeResourceLimits) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(resourcelimitsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ResourceLimitList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched resourceLimit.
+func (c *FakeResourceLimits) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ResourceLimit, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(resourcelimitsResource, c.ns, name, pt, data, subresources...), &v1alpha1.ResourceLimit{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ResourceLimit), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied resourceLimit.
+func (c *FakeResourceLimits) Apply(ctx context.Context, resourceLimit *corev1alpha1.ResourceLimitApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ResourceLimit, err error) {
+	if resourceLimit == nil {
+		return nil, fmt.Errorf("resourceLimit provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(resourceLimit)
+	if err != nil {
+		return nil, err
+	}
+	name := resourceLimit.Name
+	if name == nil {
+		return nil, fmt.Errorf("resourceLimit.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(resourcelimitsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.ResourceLimit{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ResourceLimit), err
+}
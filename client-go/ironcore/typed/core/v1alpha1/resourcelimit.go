@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	corev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/core/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ResourceLimitsGetter has a method to return a ResourceLimitInterface.
+// A group's client should implement this interface.
+type ResourceLimitsGetter interface {
+	ResourceLimits(namespace string) ResourceLimitInterface
+}
+
+// ResourceLimitInterface has methods to work with ResourceLimit resources.
+type ResourceLimitInterface interface {
+	Create(ctx context.Context, resourceLimit *v1alpha1.ResourceLimit, opts v1.CreateOptions) (*v1alpha1.ResourceLimit, error)
+	Update(ctx context.Context, resourceLimit *v1alpha1.ResourceLimit, opts v1.UpdateOptions) (*v1alpha1.ResourceLimit, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ResourceLimit, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ResourceLimitList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ResourceLimit, err error)
+	Apply(ctx context.Context, resourceLimit *corev1alpha1.ResourceLimitApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ResourceLimit, err error)
+	ResourceLimitExpansion
+}
+
+// resourceLimits implements ResourceLimitInterface
+type resourceLimits struct {
+	client rest.Interface
+	ns     string
+}
+
+// newResourceLimits returns a ResourceLimits
+func newResourceLimits(c *CoreV1alpha1Client, namespace string) *resourceLimits {
+	return &resourceLimits{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the resourceLimit, and returns the corresponding resourceLimit object, and an error if there is any.
+func (c *resourceLimits) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ResourceLimit, err error) {
+	result = &v1alpha1.ResourceLimit{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("resourcelimits").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ResourceLimits that match those selectors.
+func (c *resourceLimits) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ResourceLimitList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ResourceLimitList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("resourcelimits").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested resourceLimits.
+func (c *resourceLimits) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("resourcelimits").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a resourceLimit and creates it.  Returns the server's representation of the resourceLimit, and an error, if there is any.
+func (c *resourceLimits) Create(ctx context.Context, resourceLimit *v1alpha1.ResourceLimit, opts v1.CreateOptions) (result *v1alpha1.ResourceLimit, err error) {
+	result = &v1alpha1.ResourceLimit{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("resourcelimits").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(resourceLimit).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a resourceLimit and updates it. Returns the server's representation of the resourceLimit, and an error, if there is any.
+func (c *resourceLimits) Update(ctx context.Context, resourceLimit *v1alpha1.ResourceLimit, opts v1.UpdateOptions) (result *v1alpha1.ResourceLimit, err error) {
+	result = &v1alpha1.ResourceLimit{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("resourcelimits").
+		Name(resourceLimit.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(resourceLimit).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the resourceLimit and deletes it. Returns an error if one occurs.
+func (c *resourceLimits) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("resourcelimits").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *resourceLimits) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("resourcelimits").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched resourceLimit.
+func (c *resourceLimits) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ResourceLimit, err error) {
+	result = &v1alpha1.ResourceLimit{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("resourcelimits").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied resourceLimit.
+func (c *resourceLimits) Apply(ctx context.Context, resourceLimit *corev1alpha1.ResourceLimitApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ResourceLimit, err error) {
+	if resourceLimit == nil {
+		return nil, fmt.Errorf("resourceLimit provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(resourceLimit)
+	if err != nil {
+		return nil, err
+	}
+	name := resourceLimit.Name
+	if name == nil {
+		return nil, fmt.Errorf("resourceLimit.Name must be provided to Apply")
+	}
+	result = &v1alpha1.ResourceLimit{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("resourcelimits").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
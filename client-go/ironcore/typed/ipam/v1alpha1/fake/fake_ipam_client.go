@@ -23,6 +23,10 @@ func (c *FakeIpamV1alpha1) PrefixAllocations(namespace string) v1alpha1.PrefixAl
 	return &FakePrefixAllocations{c, namespace}
 }
 
+func (c *FakeIpamV1alpha1) PrefixGrants(namespace string) v1alpha1.PrefixGrantInterface {
+	return &FakePrefixGrants{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeIpamV1alpha1) RESTClient() rest.Interface {
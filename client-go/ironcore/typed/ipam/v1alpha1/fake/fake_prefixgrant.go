@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/ipam/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakePrefixGrants implements PrefixGrantInterface
+type FakePrefixGrants struct {
+	Fake *FakeIpamV1alpha1
+	ns   string
+}
+
+var prefixgrantsResource = v1alpha1.SchemeGroupVersion.WithResource("prefixgrants")
+
+var prefixgrantsKind = v1alpha1.SchemeGroupVersion.WithKind("PrefixGrant")
+
+// Get takes name of the prefixGrant, and returns the corresponding prefixGrant object, and an error if there is any.
+func (c *FakePrefixGrants) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.PrefixGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(prefixgrantsResource, c.ns, name), &v1alpha1.PrefixGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PrefixGrant), err
+}
+
+// List takes label and field selectors, and returns the list of PrefixGrants that match those selectors.
+func (c *FakePrefixGrants) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.PrefixGrantList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(prefixgrantsResource, prefixgrantsKind, c.ns, opts), &v1alpha1.PrefixGrantList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.PrefixGrantList{ListMeta: obj.(*v1alpha1.PrefixGrantList).ListMeta}
+	for _, item := range obj.(*v1alpha1.PrefixGrantList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested prefixGrants.
+func (c *FakePrefixGrants) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(prefixgrantsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a prefixGrant and creates it.  Returns the server's representation of the prefixGrant, and an error, if there is any.
+func (c *FakePrefixGrants) Create(ctx context.Context, prefixGrant *v1alpha1.PrefixGrant, opts v1.CreateOptions) (result *v1alpha1.PrefixGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(prefixgrantsResource, c.ns, prefixGrant), &v1alpha1.PrefixGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PrefixGrant), err
+}
+
+// Update takes the representation of a prefixGrant and updates it. Returns the server's representation of the prefixGrant, and an error, if there is any.
+func (c *FakePrefixGrants) Update(ctx context.Context, prefixGrant *v1alpha1.PrefixGrant, opts v1.UpdateOptions) (result *v1alpha1.PrefixGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(prefixgrantsResource, c.ns, prefixGrant), &v1alpha1.PrefixGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PrefixGrant), err
+}
+
+// Delete takes name of the prefixGrant and deletes it. Returns an error if one occurs.
+func (c *FakePrefixGrants) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(prefixgrantsResource, c.ns, name, opts), &v1alpha1.PrefixGrant{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakePrefixGrants) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(prefixgrantsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.PrefixGrantList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched prefixGrant.
+func (c *FakePrefixGrants) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.PrefixGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(prefixgrantsResource, c.ns, name, pt, data, subresources...), &v1alpha1.PrefixGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PrefixGrant), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied prefixGrant.
+func (c *FakePrefixGrants) Apply(ctx context.Context, prefixGrant *ipamv1alpha1.PrefixGrantApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.PrefixGrant, err error) {
+	if prefixGrant == nil {
+		return nil, fmt.Errorf("prefixGrant provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(prefixGrant)
+	if err != nil {
+		return nil, err
+	}
+	name := prefixGrant.Name
+	if name == nil {
+		return nil, fmt.Errorf("prefixGrant.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(prefixgrantsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.PrefixGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PrefixGrant), err
+}
@@ -17,6 +17,7 @@ type IpamV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	PrefixesGetter
 	PrefixAllocationsGetter
+	PrefixGrantsGetter
 }
 
 // IpamV1alpha1Client is used to interact with features provided by the ipam.ironcore.dev group.
@@ -32,6 +33,10 @@ func (c *IpamV1alpha1Client) PrefixAllocations(namespace string) PrefixAllocatio
 	return newPrefixAllocations(c, namespace)
 }
 
+func (c *IpamV1alpha1Client) PrefixGrants(namespace string) PrefixGrantInterface {
+	return newPrefixGrants(c, namespace)
+}
+
 // NewForConfig creates a new IpamV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
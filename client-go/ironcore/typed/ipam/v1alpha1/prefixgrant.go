@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/ipam/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// PrefixGrantsGetter has a method to return a PrefixGrantInterface.
+// A group's client should implement this interface.
+type PrefixGrantsGetter interface {
+	PrefixGrants(namespace string) PrefixGrantInterface
+}
+
+// PrefixGrantInterface has methods to work with PrefixGrant resources.
+type PrefixGrantInterface interface {
+	Create(ctx context.Context, prefixGrant *v1alpha1.PrefixGrant, opts v1.CreateOptions) (*v1alpha1.PrefixGrant, error)
+	Update(ctx context.Context, prefixGrant *v1alpha1.PrefixGrant, opts v1.UpdateOptions) (*v1alpha1.PrefixGrant, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.PrefixGrant, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.PrefixGrantList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.PrefixGrant, err error)
+	Apply(ctx context.Context, prefixGrant *ipamv1alpha1.PrefixGrantApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.PrefixGrant, err error)
+	PrefixGrantExpansion
+}
+
+// prefixGrants implements PrefixGrantInterface
+type prefixGrants struct {
+	client rest.Interface
+	ns     string
+}
+
+// newPrefixGrants returns a PrefixGrants
+func newPrefixGrants(c *IpamV1alpha1Client, namespace string) *prefixGrants {
+	return &prefixGrants{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the prefixGrant, and returns the corresponding prefixGrant object, and an error if there is any.
+func (c *prefixGrants) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.PrefixGrant, err error) {
+	result = &v1alpha1.PrefixGrant{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("prefixgrants").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of PrefixGrants that match those selectors.
+func (c *prefixGrants) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.PrefixGrantList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.PrefixGrantList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("prefixgrants").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested prefixGrants.
+func (c *prefixGrants) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("prefixgrants").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a prefixGrant and creates it.  Returns the server's representation of the prefixGrant, and an error, if there is any.
+func (c *prefixGrants) Create(ctx context.Context, prefixGrant *v1alpha1.PrefixGrant, opts v1.CreateOptions) (result *v1alpha1.PrefixGrant, err error) {
+	result = &v1alpha1.PrefixGrant{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("prefixgrants").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(prefixGrant).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a prefixGrant and updates it. Returns the server's representation of the prefixGrant, and an error, if there is any.
+func (c *prefixGrants) Update(ctx context.Context, prefixGrant *v1alpha1.PrefixGrant, opts v1.UpdateOptions) (result *v1alpha1.PrefixGrant, err error) {
+	result = &v1alpha1.PrefixGrant{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("prefixgrants").
+		Name(prefixGrant.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(prefixGrant).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the prefixGrant and deletes it. Returns an error if one occurs.
+func (c *prefixGrants) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("prefixgrants").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *prefixGrants) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("prefixgrants").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched prefixGrant.
+func (c *prefixGrants) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.PrefixGrant, err error) {
+	result = &v1alpha1.PrefixGrant{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("prefixgrants").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied prefixGrant.
+func (c *prefixGrants) Apply(ctx context.Context, prefixGrant *ipamv1alpha1.PrefixGrantApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.PrefixGrant, err error) {
+	if prefixGrant == nil {
+		return nil, fmt.Errorf("prefixGrant provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(prefixGrant)
+	if err != nil {
+		return nil, err
+	}
+	name := prefixGrant.Name
+	if name == nil {
+		return nil, fmt.Errorf("prefixGrant.Name must be provided to Apply")
+	}
+	result = &v1alpha1.PrefixGrant{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("prefixgrants").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -39,6 +39,18 @@ func (c *FakeNetworkingV1alpha1) NetworkPolicies(namespace string) v1alpha1.Netw
 	return &FakeNetworkPolicies{c, namespace}
 }
 
+func (c *FakeNetworkingV1alpha1) PublicIPPools() v1alpha1.PublicIPPoolInterface {
+	return &FakePublicIPPools{c}
+}
+
+func (c *FakeNetworkingV1alpha1) RouteTables(namespace string) v1alpha1.RouteTableInterface {
+	return &FakeRouteTables{c, namespace}
+}
+
+func (c *FakeNetworkingV1alpha1) Subnets(namespace string) v1alpha1.SubnetInterface {
+	return &FakeSubnets{c, namespace}
+}
+
 func (c *FakeNetworkingV1alpha1) VirtualIPs(namespace string) v1alpha1.VirtualIPInterface {
 	return &FakeVirtualIPs{c, namespace}
 }
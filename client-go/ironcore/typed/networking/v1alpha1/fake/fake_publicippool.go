@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/networking/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakePublicIPPools implements PublicIPPoolInterface
+type FakePublicIPPools struct {
+	Fake *FakeNetworkingV1alpha1
+}
+
+var publicippoolsResource = v1alpha1.SchemeGroupVersion.WithResource("publicippools")
+
+var publicippoolsKind = v1alpha1.SchemeGroupVersion.WithKind("PublicIPPool")
+
+// Get takes name of the publicIPPool, and returns the corresponding publicIPPool object, and an error if there is any.
+func (c *FakePublicIPPools) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.PublicIPPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(publicippoolsResource, name), &v1alpha1.PublicIPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PublicIPPool), err
+}
+
+// List takes label and field selectors, and returns the list of PublicIPPools that match those selectors.
+func (c *FakePublicIPPools) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.PublicIPPoolList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(publicippoolsResource, publicippoolsKind, opts), &v1alpha1.PublicIPPoolList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.PublicIPPoolList{ListMeta: obj.(*v1alpha1.PublicIPPoolList).ListMeta}
+	for _, item := range obj.(*v1alpha1.PublicIPPoolList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested publicIPPools.
+func (c *FakePublicIPPools) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(publicippoolsResource, opts))
+}
+
+// Create takes the representation of a publicIPPool and creates it.  Returns the server's representation of the publicIPPool, and an error, if there is any.
+func (c *FakePublicIPPools) Create(ctx context.Context, publicIPPool *v1alpha1.PublicIPPool, opts v1.CreateOptions) (result *v1alpha1.PublicIPPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(publicippoolsResource, publicIPPool), &v1alpha1.PublicIPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PublicIPPool), err
+}
+
+// Update takes the representation of a publicIPPool and updates it. Returns the server's representation of the publicIPPool, and an error, if there is any.
+func (c *FakePublicIPPools) Update(ctx context.Context, publicIPPool *v1alpha1.PublicIPPool, opts v1.UpdateOptions) (result *v1alpha1.PublicIPPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(publicippoolsResource, publicIPPool), &v1alpha1.PublicIPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PublicIPPool), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakePublicIPPools) UpdateStatus(ctx context.Context, publicIPPool *v1alpha1.PublicIPPool, opts v1.UpdateOptions) (*v1alpha1.PublicIPPool, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(publicippoolsResource, "status", publicIPPool), &v1alpha1.PublicIPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PublicIPPool), err
+}
+
+// Delete takes name of the publicIPPool and deletes it. Returns an error if one occurs.
+func (c *FakePublicIPPools) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(publicippoolsResource, name, opts), &v1alpha1.PublicIPPool{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakePublicIPPools) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(publicippoolsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.PublicIPPoolList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched publicIPPool.
+func (c *FakePublicIPPools) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.PublicIPPool, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(publicippoolsResource, name, pt, data, subresources...), &v1alpha1.PublicIPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PublicIPPool), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied publicIPPool.
+func (c *FakePublicIPPools) Apply(ctx context.Context, publicIPPool *networkingv1alpha1.PublicIPPoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.PublicIPPool, err error) {
+	if publicIPPool == nil {
+		return nil, fmt.Errorf("publicIPPool provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(publicIPPool)
+	if err != nil {
+		return nil, err
+	}
+	name := publicIPPool.Name
+	if name == nil {
+		return nil, fmt.Errorf("publicIPPool.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(publicippoolsResource, *name, types.ApplyPatchType, data), &v1alpha1.PublicIPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PublicIPPool), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakePublicIPPools) ApplyStatus(ctx context.Context, publicIPPool *networkingv1alpha1.PublicIPPoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.PublicIPPool, err error) {
+	if publicIPPool == nil {
+		return nil, fmt.Errorf("publicIPPool provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(publicIPPool)
+	if err != nil {
+		return nil, err
+	}
+	name := publicIPPool.Name
+	if name == nil {
+		return nil, fmt.Errorf("publicIPPool.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(publicippoolsResource, *name, types.ApplyPatchType, data, "status"), &v1alpha1.PublicIPPool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PublicIPPool), err
+}
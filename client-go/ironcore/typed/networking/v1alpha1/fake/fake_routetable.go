@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/networking/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRouteTables implements RouteTableInterface
+type FakeRouteTables struct {
+	Fake *FakeNetworkingV1alpha1
+	ns   string
+}
+
+var routetablesResource = v1alpha1.SchemeGroupVersion.WithResource("routetables")
+
+var routetablesKind = v1alpha1.SchemeGroupVersion.WithKind("RouteTable")
+
+// Get takes name of the routeTable, and returns the corresponding routeTable object, and an error if there is any.
+func (c *FakeRouteTables) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RouteTable, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(routetablesResource, c.ns, name), &v1alpha1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RouteTable), err
+}
+
+// List takes label and field selectors, and returns the list of RouteTables that match those selectors.
+func (c *FakeRouteTables) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RouteTableList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(routetablesResource, routetablesKind, c.ns, opts), &v1alpha1.RouteTableList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.RouteTableList{ListMeta: obj.(*v1alpha1.RouteTableList).ListMeta}
+	for _, item := range obj.(*v1alpha1.RouteTableList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested routeTables.
+func (c *FakeRouteTables) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(routetablesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a routeTable and creates it.  Returns the server's representation of the routeTable, and an error, if there is any.
+func (c *FakeRouteTables) Create(ctx context.Context, routeTable *v1alpha1.RouteTable, opts v1.CreateOptions) (result *v1alpha1.RouteTable, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(routetablesResource, c.ns, routeTable), &v1alpha1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RouteTable), err
+}
+
+// Update takes the representation of a routeTable and updates it. Returns the server's representation of the routeTable, and an error, if there is any.
+func (c *FakeRouteTables) Update(ctx context.Context, routeTable *v1alpha1.RouteTable, opts v1.UpdateOptions) (result *v1alpha1.RouteTable, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(routetablesResource, c.ns, routeTable), &v1alpha1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RouteTable), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRouteTables) UpdateStatus(ctx context.Context, routeTable *v1alpha1.RouteTable, opts v1.UpdateOptions) (*v1alpha1.RouteTable, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(routetablesResource, "status", c.ns, routeTable), &v1alpha1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RouteTable), err
+}
+
+// Delete takes name of the routeTable and deletes it. Returns an error if one occurs.
+func (c *FakeRouteTables) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(routetablesResource, c.ns, name, opts), &v1alpha1.RouteTable{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRouteTables) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(routetablesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.RouteTableList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched routeTable.
+func (c *FakeRouteTables) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RouteTable, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(routetablesResource, c.ns, name, pt, data, subresources...), &v1alpha1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RouteTable), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied routeTable.
+func (c *FakeRouteTables) Apply(ctx context.Context, routeTable *networkingv1alpha1.RouteTableApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.RouteTable, err error) {
+	if routeTable == nil {
+		return nil, fmt.Errorf("routeTable provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(routeTable)
+	if err != nil {
+		return nil, err
+	}
+	name := routeTable.Name
+	if name == nil {
+		return nil, fmt.Errorf("routeTable.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(routetablesResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RouteTable), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeRouteTables) ApplyStatus(ctx context.Context, routeTable *networkingv1alpha1.RouteTableApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.RouteTable, err error) {
+	if routeTable == nil {
+		return nil, fmt.Errorf("routeTable provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(routeTable)
+	if err != nil {
+		return nil, err
+	}
+	name := routeTable.Name
+	if name == nil {
+		return nil, fmt.Errorf("routeTable.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(routetablesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RouteTable), err
+}
@@ -17,4 +17,10 @@ type NetworkInterfaceExpansion interface{}
 
 type NetworkPolicyExpansion interface{}
 
+type PublicIPPoolExpansion interface{}
+
+type RouteTableExpansion interface{}
+
+type SubnetExpansion interface{}
+
 type VirtualIPExpansion interface{}
@@ -21,6 +21,9 @@ type NetworkingV1alpha1Interface interface {
 	NetworksGetter
 	NetworkInterfacesGetter
 	NetworkPoliciesGetter
+	PublicIPPoolsGetter
+	RouteTablesGetter
+	SubnetsGetter
 	VirtualIPsGetter
 }
 
@@ -53,6 +56,18 @@ func (c *NetworkingV1alpha1Client) NetworkPolicies(namespace string) NetworkPoli
 	return newNetworkPolicies(c, namespace)
 }
 
+func (c *NetworkingV1alpha1Client) PublicIPPools() PublicIPPoolInterface {
+	return newPublicIPPools(c)
+}
+
+func (c *NetworkingV1alpha1Client) RouteTables(namespace string) RouteTableInterface {
+	return newRouteTables(c, namespace)
+}
+
+func (c *NetworkingV1alpha1Client) Subnets(namespace string) SubnetInterface {
+	return newSubnets(c, namespace)
+}
+
 func (c *NetworkingV1alpha1Client) VirtualIPs(namespace string) VirtualIPInterface {
 	return newVirtualIPs(c, namespace)
 }
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/networking/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// PublicIPPoolsGetter has a method to return a PublicIPPoolInterface.
+// A group's client should implement this interface.
+type PublicIPPoolsGetter interface {
+	PublicIPPools() PublicIPPoolInterface
+}
+
+// PublicIPPoolInterface has methods to work with PublicIPPool resources.
+type PublicIPPoolInterface interface {
+	Create(ctx context.Context, publicIPPool *v1alpha1.PublicIPPool, opts v1.CreateOptions) (*v1alpha1.PublicIPPool, error)
+	Update(ctx context.Context, publicIPPool *v1alpha1.PublicIPPool, opts v1.UpdateOptions) (*v1alpha1.PublicIPPool, error)
+	UpdateStatus(ctx context.Context, publicIPPool *v1alpha1.PublicIPPool, opts v1.UpdateOptions) (*v1alpha1.PublicIPPool, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.PublicIPPool, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.PublicIPPoolList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.PublicIPPool, err error)
+	Apply(ctx context.Context, publicIPPool *networkingv1alpha1.PublicIPPoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.PublicIPPool, err error)
+	ApplyStatus(ctx context.Context, publicIPPool *networkingv1alpha1.PublicIPPoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.PublicIPPool, err error)
+	PublicIPPoolExpansion
+}
+
+// publicIPPools implements PublicIPPoolInterface
+type publicIPPools struct {
+	client rest.Interface
+}
+
+// newPublicIPPools returns a PublicIPPools
+func newPublicIPPools(c *NetworkingV1alpha1Client) *publicIPPools {
+	return &publicIPPools{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the publicIPPool, and returns the corresponding publicIPPool object, and an error if there is any.
+func (c *publicIPPools) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.PublicIPPool, err error) {
+	result = &v1alpha1.PublicIPPool{}
+	err = c.client.Get().
+		Resource("publicippools").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of PublicIPPools that match those selectors.
+func (c *publicIPPools) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.PublicIPPoolList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.PublicIPPoolList{}
+	err = c.client.Get().
+		Resource("publicippools").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested publicIPPools.
+func (c *publicIPPools) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("publicippools").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a publicIPPool and creates it.  Returns the server's representation of the publicIPPool, and an error, if there is any.
+func (c *publicIPPools) Create(ctx context.Context, publicIPPool *v1alpha1.PublicIPPool, opts v1.CreateOptions) (result *v1alpha1.PublicIPPool, err error) {
+	result = &v1alpha1.PublicIPPool{}
+	err = c.client.Post().
+		Resource("publicippools").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(publicIPPool).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a publicIPPool and updates it. Returns the server's representation of the publicIPPool, and an error, if there is any.
+func (c *publicIPPools) Update(ctx context.Context, publicIPPool *v1alpha1.PublicIPPool, opts v1.UpdateOptions) (result *v1alpha1.PublicIPPool, err error) {
+	result = &v1alpha1.PublicIPPool{}
+	err = c.client.Put().
+		Resource("publicippools").
+		Name(publicIPPool.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(publicIPPool).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *publicIPPools) UpdateStatus(ctx context.Context, publicIPPool *v1alpha1.PublicIPPool, opts v1.UpdateOptions) (result *v1alpha1.PublicIPPool, err error) {
+	result = &v1alpha1.PublicIPPool{}
+	err = c.client.Put().
+		Resource("publicippools").
+		Name(publicIPPool.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(publicIPPool).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the publicIPPool and deletes it. Returns an error if one occurs.
+func (c *publicIPPools) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("publicippools").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *publicIPPools) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("publicippools").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched publicIPPool.
+func (c *publicIPPools) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.PublicIPPool, err error) {
+	result = &v1alpha1.PublicIPPool{}
+	err = c.client.Patch(pt).
+		Resource("publicippools").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied publicIPPool.
+func (c *publicIPPools) Apply(ctx context.Context, publicIPPool *networkingv1alpha1.PublicIPPoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.PublicIPPool, err error) {
+	if publicIPPool == nil {
+		return nil, fmt.Errorf("publicIPPool provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(publicIPPool)
+	if err != nil {
+		return nil, err
+	}
+	name := publicIPPool.Name
+	if name == nil {
+		return nil, fmt.Errorf("publicIPPool.Name must be provided to Apply")
+	}
+	result = &v1alpha1.PublicIPPool{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("publicippools").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *publicIPPools) ApplyStatus(ctx context.Context, publicIPPool *networkingv1alpha1.PublicIPPoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.PublicIPPool, err error) {
+	if publicIPPool == nil {
+		return nil, fmt.Errorf("publicIPPool provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(publicIPPool)
+	if err != nil {
+		return nil, err
+	}
+
+	name := publicIPPool.Name
+	if name == nil {
+		return nil, fmt.Errorf("publicIPPool.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.PublicIPPool{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("publicippools").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
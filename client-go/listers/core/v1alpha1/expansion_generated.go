@@ -5,6 +5,14 @@
 
 package v1alpha1
 
+// ResourceLimitListerExpansion allows custom methods to be added to
+// ResourceLimitLister.
+type ResourceLimitListerExpansion interface{}
+
+// ResourceLimitNamespaceListerExpansion allows custom methods to be added to
+// ResourceLimitNamespaceLister.
+type ResourceLimitNamespaceListerExpansion interface{}
+
 // ResourceQuotaListerExpansion allows custom methods to be added to
 // ResourceQuotaLister.
 type ResourceQuotaListerExpansion interface{}
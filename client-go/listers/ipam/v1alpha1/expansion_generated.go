@@ -20,3 +20,11 @@ type PrefixAllocationListerExpansion interface{}
 // PrefixAllocationNamespaceListerExpansion allows custom methods to be added to
 // PrefixAllocationNamespaceLister.
 type PrefixAllocationNamespaceListerExpansion interface{}
+
+// PrefixGrantListerExpansion allows custom methods to be added to
+// PrefixGrantLister.
+type PrefixGrantListerExpansion interface{}
+
+// PrefixGrantNamespaceListerExpansion allows custom methods to be added to
+// PrefixGrantNamespaceLister.
+type PrefixGrantNamespaceListerExpansion interface{}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// PrefixGrantLister helps list PrefixGrants.
+// All objects returned here must be treated as read-only.
+type PrefixGrantLister interface {
+	// List lists all PrefixGrants in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.PrefixGrant, err error)
+	// PrefixGrants returns an object that can list and get PrefixGrants.
+	PrefixGrants(namespace string) PrefixGrantNamespaceLister
+	PrefixGrantListerExpansion
+}
+
+// prefixGrantLister implements the PrefixGrantLister interface.
+type prefixGrantLister struct {
+	indexer cache.Indexer
+}
+
+// NewPrefixGrantLister returns a new PrefixGrantLister.
+func NewPrefixGrantLister(indexer cache.Indexer) PrefixGrantLister {
+	return &prefixGrantLister{indexer: indexer}
+}
+
+// List lists all PrefixGrants in the indexer.
+func (s *prefixGrantLister) List(selector labels.Selector) (ret []*v1alpha1.PrefixGrant, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.PrefixGrant))
+	})
+	return ret, err
+}
+
+// PrefixGrants returns an object that can list and get PrefixGrants.
+func (s *prefixGrantLister) PrefixGrants(namespace string) PrefixGrantNamespaceLister {
+	return prefixGrantNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// PrefixGrantNamespaceLister helps list and get PrefixGrants.
+// All objects returned here must be treated as read-only.
+type PrefixGrantNamespaceLister interface {
+	// List lists all PrefixGrants in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.PrefixGrant, err error)
+	// Get retrieves the PrefixGrant from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.PrefixGrant, error)
+	PrefixGrantNamespaceListerExpansion
+}
+
+// prefixGrantNamespaceLister implements the PrefixGrantNamespaceLister
+// interface.
+type prefixGrantNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all PrefixGrants in the indexer for a given namespace.
+func (s prefixGrantNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.PrefixGrant, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.PrefixGrant))
+	})
+	return ret, err
+}
+
+// Get retrieves the PrefixGrant from the indexer for a given namespace and name.
+func (s prefixGrantNamespaceLister) Get(name string) (*v1alpha1.PrefixGrant, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("prefixgrant"), name)
+	}
+	return obj.(*v1alpha1.PrefixGrant), nil
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// PublicIPPoolLister helps list PublicIPPools.
+// All objects returned here must be treated as read-only.
+type PublicIPPoolLister interface {
+	// List lists all PublicIPPools in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.PublicIPPool, err error)
+	// Get retrieves the PublicIPPool from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.PublicIPPool, error)
+	PublicIPPoolListerExpansion
+}
+
+// publicIPPoolLister implements the PublicIPPoolLister interface.
+type publicIPPoolLister struct {
+	indexer cache.Indexer
+}
+
+// NewPublicIPPoolLister returns a new PublicIPPoolLister.
+func NewPublicIPPoolLister(indexer cache.Indexer) PublicIPPoolLister {
+	return &publicIPPoolLister{indexer: indexer}
+}
+
+// List lists all PublicIPPools in the indexer.
+func (s *publicIPPoolLister) List(selector labels.Selector) (ret []*v1alpha1.PublicIPPool, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.PublicIPPool))
+	})
+	return ret, err
+}
+
+// Get retrieves the PublicIPPool from the index for a given name.
+func (s *publicIPPoolLister) Get(name string) (*v1alpha1.PublicIPPool, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("publicippool"), name)
+	}
+	return obj.(*v1alpha1.PublicIPPool), nil
+}
@@ -54,7 +54,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/ironcore-dev/ironcore/api/compute/v1alpha1.Volume":                          schema_ironcore_api_compute_v1alpha1_Volume(ref),
 		"github.com/ironcore-dev/ironcore/api/compute/v1alpha1.VolumeSource":                    schema_ironcore_api_compute_v1alpha1_VolumeSource(ref),
 		"github.com/ironcore-dev/ironcore/api/compute/v1alpha1.VolumeStatus":                    schema_ironcore_api_compute_v1alpha1_VolumeStatus(ref),
+		"github.com/ironcore-dev/ironcore/api/core/v1alpha1.AllowedClasses":                     schema_ironcore_api_core_v1alpha1_AllowedClasses(ref),
 		"github.com/ironcore-dev/ironcore/api/core/v1alpha1.ObjectSelector":                     schema_ironcore_api_core_v1alpha1_ObjectSelector(ref),
+		"github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceLimit":                      schema_ironcore_api_core_v1alpha1_ResourceLimit(ref),
+		"github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceLimitItem":                  schema_ironcore_api_core_v1alpha1_ResourceLimitItem(ref),
+		"github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceLimitList":                  schema_ironcore_api_core_v1alpha1_ResourceLimitList(ref),
+		"github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceLimitSpec":                  schema_ironcore_api_core_v1alpha1_ResourceLimitSpec(ref),
 		"github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceQuota":                      schema_ironcore_api_core_v1alpha1_ResourceQuota(ref),
 		"github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceQuotaList":                  schema_ironcore_api_core_v1alpha1_ResourceQuotaList(ref),
 		"github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceQuotaSpec":                  schema_ironcore_api_core_v1alpha1_ResourceQuotaSpec(ref),
@@ -66,10 +71,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixAllocationList":               schema_ironcore_api_ipam_v1alpha1_PrefixAllocationList(ref),
 		"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixAllocationSpec":               schema_ironcore_api_ipam_v1alpha1_PrefixAllocationSpec(ref),
 		"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixAllocationStatus":             schema_ironcore_api_ipam_v1alpha1_PrefixAllocationStatus(ref),
+		"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixGrant":                        schema_ironcore_api_ipam_v1alpha1_PrefixGrant(ref),
+		"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixGrantList":                    schema_ironcore_api_ipam_v1alpha1_PrefixGrantList(ref),
+		"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixGrantSpec":                    schema_ironcore_api_ipam_v1alpha1_PrefixGrantSpec(ref),
 		"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixList":                         schema_ironcore_api_ipam_v1alpha1_PrefixList(ref),
 		"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixSpec":                         schema_ironcore_api_ipam_v1alpha1_PrefixSpec(ref),
 		"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixStatus":                       schema_ironcore_api_ipam_v1alpha1_PrefixStatus(ref),
 		"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixTemplateSpec":                 schema_ironcore_api_ipam_v1alpha1_PrefixTemplateSpec(ref),
+		"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixUtilization":                  schema_ironcore_api_ipam_v1alpha1_PrefixUtilization(ref),
 		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.EphemeralPrefixSource":        schema_ironcore_api_networking_v1alpha1_EphemeralPrefixSource(ref),
 		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.EphemeralVirtualIPSource":     schema_ironcore_api_networking_v1alpha1_EphemeralVirtualIPSource(ref),
 		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.IPBlock":                      schema_ironcore_api_networking_v1alpha1_IPBlock(ref),
@@ -109,7 +118,23 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.NetworkPolicyStatus":          schema_ironcore_api_networking_v1alpha1_NetworkPolicyStatus(ref),
 		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.NetworkSpec":                  schema_ironcore_api_networking_v1alpha1_NetworkSpec(ref),
 		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.NetworkStatus":                schema_ironcore_api_networking_v1alpha1_NetworkStatus(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PeeringPrefix":                schema_ironcore_api_networking_v1alpha1_PeeringPrefix(ref),
 		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PrefixSource":                 schema_ironcore_api_networking_v1alpha1_PrefixSource(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PublicIPPool":                 schema_ironcore_api_networking_v1alpha1_PublicIPPool(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PublicIPPoolList":             schema_ironcore_api_networking_v1alpha1_PublicIPPoolList(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PublicIPPoolSpec":             schema_ironcore_api_networking_v1alpha1_PublicIPPoolSpec(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PublicIPPoolStatus":           schema_ironcore_api_networking_v1alpha1_PublicIPPoolStatus(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.Route":                        schema_ironcore_api_networking_v1alpha1_Route(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteNextHop":                 schema_ironcore_api_networking_v1alpha1_RouteNextHop(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteStatus":                  schema_ironcore_api_networking_v1alpha1_RouteStatus(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteTable":                   schema_ironcore_api_networking_v1alpha1_RouteTable(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteTableList":               schema_ironcore_api_networking_v1alpha1_RouteTableList(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteTableSpec":               schema_ironcore_api_networking_v1alpha1_RouteTableSpec(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteTableStatus":             schema_ironcore_api_networking_v1alpha1_RouteTableStatus(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.Subnet":                       schema_ironcore_api_networking_v1alpha1_Subnet(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.SubnetList":                   schema_ironcore_api_networking_v1alpha1_SubnetList(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.SubnetSpec":                   schema_ironcore_api_networking_v1alpha1_SubnetSpec(ref),
+		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.SubnetStatus":                 schema_ironcore_api_networking_v1alpha1_SubnetStatus(ref),
 		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIP":                    schema_ironcore_api_networking_v1alpha1_VirtualIP(ref),
 		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPList":                schema_ironcore_api_networking_v1alpha1_VirtualIPList(ref),
 		"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPSource":              schema_ironcore_api_networking_v1alpha1_VirtualIPSource(ref),
@@ -1840,6 +1865,43 @@ func schema_ironcore_api_compute_v1alpha1_VolumeStatus(ref common.ReferenceCallb
 	}
 }
 
+func schema_ironcore_api_core_v1alpha1_AllowedClasses(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AllowedClasses restricts the classes of a ClassType that may be used.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"classType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassType is the type of class the restriction applies to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"names": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Names are the names of the classes that may be used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"classType", "names"},
+			},
+		},
+	}
+}
+
 func schema_ironcore_api_core_v1alpha1_ObjectSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1894,6 +1956,205 @@ func schema_ironcore_api_core_v1alpha1_ObjectSelector(ref common.ReferenceCallba
 	}
 }
 
+func schema_ironcore_api_core_v1alpha1_ResourceLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceLimit is the Schema for the resourcelimits API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceLimitSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceLimitSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_ironcore_api_core_v1alpha1_ResourceLimitItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceLimitItem defines the min / max / default resources of a single object of a ResourceLimitType.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of object the limits apply to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"min": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Min is the minimum amount of resources an object has to request.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max is the maximum amount of resources an object may request.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Default is the amount of resources used for an object that does not request a resource.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_ironcore_api_core_v1alpha1_ResourceLimitList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceLimitList contains a list of ResourceLimit",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceLimit"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceLimit", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_ironcore_api_core_v1alpha1_ResourceLimitSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceLimitSpec defines the desired state of ResourceLimit",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"limits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limits are the ResourceLimitItems enforced for each object.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceLimitItem"),
+									},
+								},
+							},
+						},
+					},
+					"allowedClasses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedClasses are the classes that may be used, per ClassType. If a ClassType is not listed, all classes of that type may be used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/core/v1alpha1.AllowedClasses"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/core/v1alpha1.AllowedClasses", "github.com/ironcore-dev/ironcore/api/core/v1alpha1.ResourceLimitItem"},
+	}
+}
+
 func schema_ironcore_api_core_v1alpha1_ResourceQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2313,12 +2574,39 @@ func schema_ironcore_api_ipam_v1alpha1_PrefixAllocationSpec(ref common.Reference
 							Format:      "int32",
 						},
 					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range is the exact range of addresses to allocate.",
+							Ref:         ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPRange"),
+						},
+					},
+					"rangeLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RangeLength is the number of contiguous addresses to allocate as a range.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ipCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPCount is the number of single addresses to allocate. The lowest free addresses are allocated, they are not necessarily contiguous.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"prefixRef": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PrefixRef references the prefix to allocate from.",
 							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
+					"prefixNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefixNamespace is the namespace of the prefix referenced by PrefixRef. If unset, the prefix is looked up in the namespace of the PrefixAllocation. Allocating from a prefix in another namespace requires a PrefixGrant in the prefix's namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"prefixSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PrefixSelector selects the prefix to allocate from.",
@@ -2329,7 +2617,7 @@ func schema_ironcore_api_ipam_v1alpha1_PrefixAllocationSpec(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPRange", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -2346,6 +2634,25 @@ func schema_ironcore_api_ipam_v1alpha1_PrefixAllocationStatus(ref common.Referen
 							Ref:         ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"),
 						},
 					},
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Range is the allocated range, if any.",
+							Ref:         ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPRange"),
+						},
+					},
+					"ips": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPs are the allocated addresses, if any.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IP"),
+									},
+								},
+							},
+						},
+					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Phase is the phase of the PrefixAllocation.",
@@ -2363,15 +2670,15 @@ func schema_ironcore_api_ipam_v1alpha1_PrefixAllocationStatus(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IP", "github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPRange", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_ironcore_api_ipam_v1alpha1_PrefixList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_api_ipam_v1alpha1_PrefixGrant(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrefixList contains a list of Prefix",
+				Description: "PrefixGrant authorizes other namespaces to allocate from a Prefix.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2391,18 +2698,146 @@ func schema_ironcore_api_ipam_v1alpha1_PrefixList(ref common.ReferenceCallback)
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.Prefix"),
-									},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixGrantSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixGrantSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_ironcore_api_ipam_v1alpha1_PrefixGrantList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrefixGrantList contains a list of PrefixGrant",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixGrant"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixGrant", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_ironcore_api_ipam_v1alpha1_PrefixGrantSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrefixGrantSpec defines the desired state of PrefixGrant",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prefixRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefixRef references the prefix in the namespace of the PrefixGrant that may be allocated from.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces are the namespaces that are allowed to allocate from the referenced prefix.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"prefixRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_ironcore_api_ipam_v1alpha1_PrefixList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrefixList contains a list of Prefix",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.Prefix"),
+									},
 								},
 							},
 						},
@@ -2450,17 +2885,45 @@ func schema_ironcore_api_ipam_v1alpha1_PrefixSpec(ref common.ReferenceCallback)
 							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
+					"parentNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParentNamespace is the namespace of the parent referenced by ParentRef. If unset, the parent is looked up in the namespace of the Prefix. Allocating from a parent in another namespace requires a PrefixGrant in the parent's namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"parentSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ParentSelector is the LabelSelector to use for determining the parent for this Prefix.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"reservedRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReservedRanges are ranges of the prefix that are never allocated to sub-prefixes, for example gateway, broadcast or DHCP addresses.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPRange"),
+									},
+								},
+							},
+						},
+					},
+					"allocationStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllocationStrategy is the strategy used to allocate sub-prefixes by length. If unset, PrefixAllocationStrategyBestFit is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPRange", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -2497,11 +2960,17 @@ func schema_ironcore_api_ipam_v1alpha1_PrefixStatus(ref common.ReferenceCallback
 							},
 						},
 					},
+					"utilization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Utilization reports the address utilization of the prefix.",
+							Ref:         ref("github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixUtilization"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixUtilization", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -2531,6 +3000,54 @@ func schema_ironcore_api_ipam_v1alpha1_PrefixTemplateSpec(ref common.ReferenceCa
 	}
 }
 
+func schema_ironcore_api_ipam_v1alpha1_PrefixUtilization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrefixUtilization reports the address utilization of a Prefix. Address counts are decimal strings since they may exceed 64 bits for IPv6 prefixes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the total number of addresses of the prefix.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reserved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reserved is the number of addresses covered by reserved ranges.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the number of addresses allocated to sub-prefixes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"free": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Free is the number of addresses that are neither reserved nor used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"largestFreePrefixLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LargestFreePrefixLength is the length of the largest free block. It is unset if there are no free addresses.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_ironcore_api_networking_v1alpha1_EphemeralPrefixSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2544,6 +3061,13 @@ func schema_ironcore_api_networking_v1alpha1_EphemeralPrefixSource(ref common.Re
 							Ref:         ref("github.com/ironcore-dev/ironcore/api/ipam/v1alpha1.PrefixTemplateSpec"),
 						},
 					},
+					"delegatedPrefixLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DelegatedPrefixLength is the length of a prefix that is delegated in addition to the ephemeral IP. The delegated prefix is allocated from the same parent as the ephemeral IP and has its IP family. Only applicable to ephemeral IP sources.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -2952,6 +3476,20 @@ func schema_ironcore_api_networking_v1alpha1_LoadBalancerSpec(ref common.Referen
 							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
+					"publicIPPoolRefs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicIPPoolRefs reference the PublicIPPools to allocate the IPs from, one PublicIPPool per ip family. Can only be used when Type is LoadBalancerTypePublic. If unset, the IPs are allocated by the provider.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
 					"networkInterfaceSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "NetworkInterfaceSelector defines the NetworkInterfaces for which this LoadBalancer should be applied",
@@ -3001,11 +3539,24 @@ func schema_ironcore_api_networking_v1alpha1_LoadBalancerStatus(ref common.Refer
 							},
 						},
 					},
+					"delegatedPrefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DelegatedPrefixes are the prefixes delegated to the load balancer via its ephemeral IP sources.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IP"},
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IP", "github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"},
 	}
 }
 
@@ -3174,6 +3725,12 @@ func schema_ironcore_api_networking_v1alpha1_NATGatewaySpec(ref common.Reference
 							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
+					"publicIPPoolRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicIPPoolRef references the PublicIPPool to allocate the IPs from. If unset, the IPs are allocated by the provider.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
 					"portsPerNetworkInterface": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PortsPerNetworkInterface defines the number of concurrent connections per target network interface. Has to be a power of 2. If empty, 2048 (DefaultPortsPerNetworkInterface) is the default.",
@@ -3382,6 +3939,12 @@ func schema_ironcore_api_networking_v1alpha1_NetworkInterfaceSpec(ref common.Ref
 							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
+					"subnetRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubnetRef is the Subnet of the Network this NetworkInterface belongs to. If set, ephemeral IPs without an explicit parent are allocated from the Subnet prefixes.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
 					"machineRef": {
 						SchemaProps: spec.SchemaProps{
 							Description: "MachineRef is the Machine this NetworkInterface is used by",
@@ -3431,6 +3994,19 @@ func schema_ironcore_api_networking_v1alpha1_NetworkInterfaceSpec(ref common.Ref
 							},
 						},
 					},
+					"delegatedPrefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DelegatedPrefixes are static prefixes delegated to this NetworkInterface, in addition to the ones delegated via its ephemeral IP sources.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"),
+									},
+								},
+							},
+						},
+					},
 					"virtualIP": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VirtualIP specifies the virtual ip that should be assigned to this NetworkInterface.",
@@ -3458,7 +4034,7 @@ func schema_ironcore_api_networking_v1alpha1_NetworkInterfaceSpec(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.LocalUIDReference", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.IPSource", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PrefixSource", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPSource", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "github.com/ironcore-dev/ironcore/api/common/v1alpha1.LocalUIDReference", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.IPSource", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PrefixSource", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPSource", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -3508,6 +4084,19 @@ func schema_ironcore_api_networking_v1alpha1_NetworkInterfaceStatus(ref common.R
 							},
 						},
 					},
+					"delegatedPrefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DelegatedPrefixes are the static prefixes delegated to the NetworkInterface followed by the prefixes delegated via its ephemeral IP sources.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"),
+									},
+								},
+							},
+						},
+					},
 					"virtualIP": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VirtualIP is any virtual ip assigned to the NetworkInterface.",
@@ -3620,19 +4209,39 @@ func schema_ironcore_api_networking_v1alpha1_NetworkPeering(ref common.Reference
 							Ref:         ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.NetworkPeeringNetworkRef"),
 						},
 					},
-				},
-				Required: []string{"name", "networkRef"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.NetworkPeeringNetworkRef"},
-	}
-}
-
-func schema_ironcore_api_networking_v1alpha1_NetworkPeeringClaimRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
+					"prefixes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge,retainKeys",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefixes restricts the prefixes of this network that are advertised to the peered network. If empty, all prefixes of this network are advertised.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PeeringPrefix"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "networkRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.NetworkPeeringNetworkRef", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PeeringPrefix"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_NetworkPeeringClaimRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"namespace": {
@@ -3715,10 +4324,25 @@ func schema_ironcore_api_networking_v1alpha1_NetworkPeeringStatus(ref common.Ref
 							Format:      "",
 						},
 					},
+					"prefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefixes are the effective prefixes of the peered network that are reachable via the network peering. They are negotiated from the prefixes advertised by the peering of the peered network.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"},
 	}
 }
 
@@ -4143,6 +4767,19 @@ func schema_ironcore_api_networking_v1alpha1_NetworkSpec(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"prefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefixes are the address ranges of the network. If set, all Subnets of the network have to be contained in these prefixes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"),
+									},
+								},
+							},
+						},
+					},
 					"peerings": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -4187,7 +4824,7 @@ func schema_ironcore_api_networking_v1alpha1_NetworkSpec(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.NetworkPeering", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.NetworkPeeringClaimRef"},
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.NetworkPeering", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.NetworkPeeringClaimRef"},
 	}
 }
 
@@ -4234,6 +4871,42 @@ func schema_ironcore_api_networking_v1alpha1_NetworkStatus(ref common.ReferenceC
 	}
 }
 
+func schema_ironcore_api_networking_v1alpha1_PeeringPrefix(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PeeringPrefix is a prefix advertised over a network peering. Exactly one of Prefix and SubnetRef has to be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the semantical name of the peering prefix.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is the prefix to advertise. If the network specifies prefixes, it has to be contained in one of them.",
+							Ref:         ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"),
+						},
+					},
+					"subnetRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubnetRef references a Subnet of this network whose prefixes are advertised.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
 func schema_ironcore_api_networking_v1alpha1_PrefixSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4260,11 +4933,11 @@ func schema_ironcore_api_networking_v1alpha1_PrefixSource(ref common.ReferenceCa
 	}
 }
 
-func schema_ironcore_api_networking_v1alpha1_VirtualIP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_api_networking_v1alpha1_PublicIPPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualIP is the Schema for the virtualips API",
+				Description: "PublicIPPool is the Schema for the publicippools API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4290,28 +4963,28 @@ func schema_ironcore_api_networking_v1alpha1_VirtualIP(ref common.ReferenceCallb
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPSpec"),
+							Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PublicIPPoolSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPStatus"),
+							Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PublicIPPoolStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPSpec", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PublicIPPoolSpec", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PublicIPPoolStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_ironcore_api_networking_v1alpha1_VirtualIPList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_api_networking_v1alpha1_PublicIPPoolList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualIPList contains a list of VirtualIP",
+				Description: "PublicIPPoolList contains a list of PublicIPPool",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4341,7 +5014,7 @@ func schema_ironcore_api_networking_v1alpha1_VirtualIPList(ref common.ReferenceC
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIP"),
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PublicIPPool"),
 									},
 								},
 							},
@@ -4352,59 +5025,691 @@ func schema_ironcore_api_networking_v1alpha1_VirtualIPList(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIP", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.PublicIPPool", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_ironcore_api_networking_v1alpha1_VirtualIPSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_api_networking_v1alpha1_PublicIPPoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualIPSource is the definition of how to obtain a VirtualIP.",
+				Description: "PublicIPPoolSpec defines the desired state of PublicIPPool",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamily is the ip family of the PublicIPPool.\n\nPossible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"", "IPv4", "IPv6"},
+						},
+					},
+					"prefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefixes are the public prefixes IPs are allocated from. Each prefix is backed by an ipam Prefix all allocations of the pool are made from.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ipFamily", "prefixes"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_PublicIPPoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PublicIPPoolStatus defines the observed state of PublicIPPool",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the PublicIPPool.\n\nPossible enum values:\n - `\"Available\"` means all PublicIPPool prefixes are allocated and IPs can be allocated from it.\n - `\"Pending\"` means the PublicIPPool prefixes are not yet allocated.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Available", "Pending"},
+						},
+					},
+					"lastStateTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastStateTransitionTime is the last time the State transitioned from one value to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the number of IPs allocated from the PublicIPPool.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_Route(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Route is a static route.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the semantical name of the route.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"destination": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination is the destination prefix of the route.",
+							Ref:         ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"),
+						},
+					},
+					"nextHop": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextHop is the next hop traffic to the destination is sent to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteNextHop"),
+						},
+					},
+				},
+				Required: []string{"name", "destination", "nextHop"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteNextHop"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_RouteNextHop(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RouteNextHop is the next hop of a route. Exactly one of the fields has to be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"networkInterfaceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkInterfaceRef references a NetworkInterface of the Network acting as the next hop, e.g. a NetworkInterface of an appliance machine.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
 					"virtualIPRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VirtualIPRef references a VirtualIP to use.",
+							Description: "VirtualIPRef references a VirtualIP acting as the next hop.",
 							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"ephemeral": {
+					"natGatewayRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ephemeral instructs to create an ephemeral (i.e. coupled to the lifetime of the surrounding object) VirtualIP.",
-							Ref:         ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.EphemeralVirtualIPSource"),
+							Description: "NATGatewayRef references a NATGateway of the Network acting as the next hop.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"networkPeeringRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkPeeringRef references a peering of the Network by name. Traffic is routed to the peered Network.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.EphemeralVirtualIPSource", "k8s.io/api/core/v1.LocalObjectReference"},
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_ironcore_api_networking_v1alpha1_VirtualIPSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_ironcore_api_networking_v1alpha1_RouteStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualIPSpec defines the desired state of VirtualIP",
+				Description: "RouteStatus is the status of a route.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of VirtualIP.",
+							Description: "Name is the name of the route.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ipFamily": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPFamily is the ip family of the VirtualIP.\n\nPossible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
-							Default:     "",
+							Description: "State is the state of the route.\n\nPossible enum values:\n - `\"Applied\"` signals that the route is programmed by the provider.\n - `\"Error\"` signals that the provider failed to program the route.\n - `\"Pending\"` signals that the route is not yet programmed by the provider.",
 							Type:        []string{"string"},
 							Format:      "",
-							Enum:        []interface{}{"", "IPv4", "IPv6"},
+							Enum:        []interface{}{"Applied", "Error", "Pending"},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable message indicating details about the state of the route.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastStateTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastStateTransitionTime is the last time the State transitioned from one value to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_RouteTable(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RouteTable is the Schema for the routetables API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteTableSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteTableStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteTableSpec", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteTableStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_RouteTableList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RouteTableList contains a list of RouteTable",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteTable"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteTable", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_RouteTableSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RouteTableSpec defines the desired state of RouteTable",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"networkRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkRef is the Network this RouteTable applies to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"routes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge,retainKeys",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes are the static routes of the RouteTable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.Route"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"networkRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.Route", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_RouteTableStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RouteTableStatus defines the observed state of RouteTable",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"routes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge,retainKeys",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes contains the status of the routes of the RouteTable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.RouteStatus"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_Subnet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Subnet is the Schema for the subnets API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.SubnetSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.SubnetStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.SubnetSpec", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.SubnetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_SubnetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubnetList contains a list of Subnet",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.Subnet"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.Subnet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_SubnetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubnetSpec defines the desired state of Subnet",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"networkRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkRef is the Network this Subnet belongs to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"prefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefixes are the prefixes of the Subnet. At most one prefix per IP family may be specified. If the referenced Network specifies prefixes, the Subnet prefixes have to be contained in them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"networkRef", "prefixes"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.IPPrefix", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_SubnetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubnetStatus defines the observed state of Subnet",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the Subnet.\n\nPossible enum values:\n - `\"Available\"` means all Subnet prefixes are allocated and the Subnet is ready to use.\n - `\"Error\"` means the Subnet prefixes could not be allocated, e.g. because they are not contained in the Network prefixes or overlap with another Subnet.\n - `\"Pending\"` means the Subnet prefixes are not yet allocated.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Available", "Error", "Pending"},
+						},
+					},
+					"lastStateTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastStateTransitionTime is the last time the State transitioned from one value to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_VirtualIP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualIP is the Schema for the virtualips API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPSpec", "github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIPStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_VirtualIPList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualIPList contains a list of VirtualIP",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIP"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.VirtualIP", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_VirtualIPSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualIPSource is the definition of how to obtain a VirtualIP.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualIPRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualIPRef references a VirtualIP to use.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"ephemeral": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ephemeral instructs to create an ephemeral (i.e. coupled to the lifetime of the surrounding object) VirtualIP.",
+							Ref:         ref("github.com/ironcore-dev/ironcore/api/networking/v1alpha1.EphemeralVirtualIPSource"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/ironcore/api/networking/v1alpha1.EphemeralVirtualIPSource", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_ironcore_api_networking_v1alpha1_VirtualIPSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualIPSpec defines the desired state of VirtualIP",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of VirtualIP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPFamily is the ip family of the VirtualIP.\n\nPossible enum values:\n - `\"\"` indicates that this IP is unknown protocol\n - `\"IPv4\"` indicates that this IP is IPv4 protocol\n - `\"IPv6\"` indicates that this IP is IPv6 protocol",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"", "IPv4", "IPv6"},
+						},
+					},
+					"publicIPPoolRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicIPPoolRef references the PublicIPPool to allocate the IP from. If unset, the IP is allocated by the provider.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
 					"targetRef": {
@@ -4418,7 +5723,7 @@ func schema_ironcore_api_networking_v1alpha1_VirtualIPSpec(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.LocalUIDReference"},
+			"github.com/ironcore-dev/ironcore/api/common/v1alpha1.LocalUIDReference", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -4,9 +4,11 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
+	"slices"
 	"time"
 
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
@@ -18,6 +20,7 @@ import (
 	computecontrollers "github.com/ironcore-dev/ironcore/internal/controllers/compute"
 	computescheduler "github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler"
 	corecontrollers "github.com/ironcore-dev/ironcore/internal/controllers/core"
+	certificateconfig "github.com/ironcore-dev/ironcore/internal/controllers/core/certificate/config"
 	certificateironcore "github.com/ironcore-dev/ironcore/internal/controllers/core/certificate/ironcore"
 	quotacontrollergeneric "github.com/ironcore-dev/ironcore/internal/controllers/core/quota/generic"
 	quotacontrollerironcore "github.com/ironcore-dev/ironcore/internal/controllers/core/quota/ironcore"
@@ -27,6 +30,8 @@ import (
 	storagescheduler "github.com/ironcore-dev/ironcore/internal/controllers/storage/scheduler"
 	quotaevaluatorironcore "github.com/ironcore-dev/ironcore/internal/quota/evaluator/ironcore"
 	"github.com/ironcore-dev/ironcore/utils/quota"
+	"github.com/ironcore-dev/ironcore/utils/tracing"
+	"github.com/spf13/pflag"
 	"k8s.io/utils/lru"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
@@ -75,12 +80,18 @@ const (
 	// networking controllers
 	loadBalancerController                       = "loadbalancer"
 	loadBalancerEphemeralPrefixController        = "loadbalancerephemeralprefix"
+	networkEphemeralPrefixController             = "networkephemeralprefix"
 	networkProtectionController                  = "networkprotection"
 	networkPeeringController                     = "networkpeering"
 	networkReleaseController                     = "networkrelease"
 	networkInterfaceEphemeralPrefixController    = "networkinterfaceephemeralprefix"
 	networkInterfaceEphemeralVirtualIPController = "networkinterfaceephemeralvirtualip"
 	networkInterfaceReleaseController            = "networkinterfacerelease"
+	subnetController                             = "subnet"
+	publicIPPoolController                       = "publicippool"
+	virtualIPPublicIPPoolController              = "virtualippublicippool"
+	loadBalancerPublicIPPoolController           = "loadbalancerpublicippool"
+	natGatewayPublicIPPoolController             = "natgatewaypublicippool"
 	virtualIPReleaseController                   = "virtualiprelease"
 
 	// core controllers
@@ -106,6 +117,9 @@ func main() {
 	var volumeBindTimeout time.Duration
 	var virtualIPBindTimeout time.Duration
 	var networkInterfaceBindTimeout time.Duration
+	var publicIPPoolNamespace string
+	var certificateApprovalConfigFile string
+	var tracingOpts tracing.Options
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
@@ -115,6 +129,14 @@ func main() {
 	flag.DurationVar(&volumeBindTimeout, "volume-bind-timeout", 10*time.Second, "Time to wait until considering a volume bind to be failed.")
 	flag.DurationVar(&virtualIPBindTimeout, "virtual-ip-bind-timeout", 10*time.Second, "Time to wait until considering a virtual ip bind to be failed.")
 	flag.DurationVar(&networkInterfaceBindTimeout, "network-interface-bind-timeout", 10*time.Second, "Time to wait until considering a network interface bind to be failed.")
+	flag.StringVar(&publicIPPoolNamespace, "public-ip-pool-namespace", "ironcore-system", "Namespace to manage the ipam prefixes of public ip pools in.")
+	flag.StringVar(&certificateApprovalConfigFile, "certificate-approval-config-file", "",
+		"Path to a file declaring additional certificate signing request recognizers to auto-approve client certificates with.")
+	tracingFlags := pflag.NewFlagSet("tracing", pflag.ContinueOnError)
+	tracingOpts.BindFlags(tracingFlags)
+	tracingFlags.VisitAll(func(f *pflag.Flag) {
+		flag.Var(f.Value, f.Name, f.Usage)
+	})
 
 	controllers := switches.New(
 		// compute controllers
@@ -137,11 +159,17 @@ func main() {
 		// networking controllers
 		loadBalancerController,
 		loadBalancerEphemeralPrefixController,
+		networkEphemeralPrefixController,
 		networkProtectionController,
 		networkReleaseController,
 		networkInterfaceEphemeralPrefixController,
 		networkInterfaceEphemeralVirtualIPController,
 		networkInterfaceReleaseController,
+		subnetController,
+		publicIPPoolController,
+		virtualIPPublicIPPoolController,
+		loadBalancerPublicIPPoolController,
+		natGatewayPublicIPPoolController,
 		virtualIPReleaseController,
 
 		// core controllers
@@ -165,7 +193,21 @@ func main() {
 	ctrl.SetLogger(logger)
 	ctx := ctrl.SetupSignalHandler()
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	shutdownTracing, err := tracing.Setup(ctx, "ironcore-controller-manager", tracingOpts)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "unable to shut down tracing")
+		}
+	}()
+
+	cfg := ctrl.GetConfigOrDie()
+	tracing.WrapConfig(cfg)
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Logger:                 logger,
 		Scheme:                 scheme,
 		Metrics:                metricsserver.Options{BindAddress: metricsAddr},
@@ -291,6 +333,7 @@ func main() {
 
 	if controllers.Enabled(prefixController) {
 		if err := (&ipamcontrollers.PrefixReconciler{
+			EventRecorder:           mgr.GetEventRecorderFor("prefixes"),
 			Client:                  mgr.GetClient(),
 			APIReader:               mgr.GetAPIReader(),
 			Scheme:                  mgr.GetScheme(),
@@ -342,6 +385,15 @@ func main() {
 		}
 	}
 
+	if controllers.Enabled(networkEphemeralPrefixController) {
+		if err := (&networkingcontrollers.NetworkEphemeralPrefixReconciler{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "NetworkEphemeralPrefix")
+			os.Exit(1)
+		}
+	}
+
 	if controllers.Enabled(networkPeeringController) {
 		if err := (&networkingcontrollers.NetworkPeeringReconciler{
 			Client: mgr.GetClient(),
@@ -364,13 +416,23 @@ func main() {
 
 	if controllers.Enabled(networkInterfaceEphemeralPrefixController) {
 		if err := (&networkingcontrollers.NetworkInterfaceEphemeralPrefixReconciler{
-			Client: mgr.GetClient(),
+			EventRecorder: mgr.GetEventRecorderFor("networkinterface-ephemeral-prefix"),
+			Client:        mgr.GetClient(),
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "NetworkInterfaceEphemeralPrefix")
 			os.Exit(1)
 		}
 	}
 
+	if controllers.Enabled(subnetController) {
+		if err := (&networkingcontrollers.SubnetReconciler{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Subnet")
+			os.Exit(1)
+		}
+	}
+
 	if controllers.Enabled(networkInterfaceEphemeralVirtualIPController) {
 		if err := (&networkingcontrollers.NetworkInterfaceEphemeralVirtualIPReconciler{
 			Client: mgr.GetClient(),
@@ -391,11 +453,52 @@ func main() {
 		}
 	}
 
+	if controllers.Enabled(publicIPPoolController) {
+		if err := (&networkingcontrollers.PublicIPPoolReconciler{
+			Client:        mgr.GetClient(),
+			PoolNamespace: publicIPPoolNamespace,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "PublicIPPool")
+			os.Exit(1)
+		}
+	}
+
+	if controllers.Enabled(virtualIPPublicIPPoolController) {
+		if err := (&networkingcontrollers.VirtualIPPublicIPPoolReconciler{
+			Client:        mgr.GetClient(),
+			PoolNamespace: publicIPPoolNamespace,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "VirtualIPPublicIPPool")
+			os.Exit(1)
+		}
+	}
+
+	if controllers.Enabled(loadBalancerPublicIPPoolController) {
+		if err := (&networkingcontrollers.LoadBalancerPublicIPPoolReconciler{
+			Client:        mgr.GetClient(),
+			PoolNamespace: publicIPPoolNamespace,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "LoadBalancerPublicIPPool")
+			os.Exit(1)
+		}
+	}
+
+	if controllers.Enabled(natGatewayPublicIPPoolController) {
+		if err := (&networkingcontrollers.NATGatewayPublicIPPoolReconciler{
+			Client:        mgr.GetClient(),
+			PoolNamespace: publicIPPoolNamespace,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "NATGatewayPublicIPPool")
+			os.Exit(1)
+		}
+	}
+
 	if controllers.Enabled(virtualIPReleaseController) {
 		if err := (&networkingcontrollers.VirtualIPReleaseReconciler{
-			Client:       mgr.GetClient(),
-			APIReader:    mgr.GetAPIReader(),
-			AbsenceCache: lru.New(500),
+			Client:        mgr.GetClient(),
+			APIReader:     mgr.GetAPIReader(),
+			AbsenceCache:  lru.New(500),
+			PoolNamespace: publicIPPoolNamespace,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "VirtualIPRelease")
 			os.Exit(1)
@@ -434,9 +537,19 @@ func main() {
 	}
 
 	if controllers.Enabled(certificateApprovalController) {
+		recognizers := certificateironcore.Recognizers
+		if certificateApprovalConfigFile != "" {
+			certificateApprovalConfig, err := certificateconfig.LoadFromFile(certificateApprovalConfigFile)
+			if err != nil {
+				setupLog.Error(err, "unable to load certificate approval config")
+				os.Exit(1)
+			}
+			recognizers = append(slices.Clip(recognizers), certificateconfig.Recognizers(certificateApprovalConfig)...)
+		}
+
 		if err := (&corecontrollers.CertificateApprovalReconciler{
 			Client:      mgr.GetClient(),
-			Recognizers: certificateironcore.Recognizers,
+			Recognizers: recognizers,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "CertificateApproval")
 			os.Exit(1)
@@ -490,8 +603,8 @@ func main() {
 	}
 
 	if controllers.AnyEnabled(prefixController) {
-		if err := ipamclient.SetupPrefixSpecParentRefFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
-			setupLog.Error(err, "unable to setup field indexer", "field", ipamclient.PrefixSpecParentRefNameField)
+		if err := ipamclient.SetupPrefixSpecParentRefKeyFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
+			setupLog.Error(err, "unable to setup field indexer", "field", ipamclient.PrefixSpecParentRefKeyField)
 			os.Exit(1)
 		}
 	}
@@ -504,8 +617,8 @@ func main() {
 	}
 
 	if controllers.AnyEnabled(prefixController) {
-		if err := ipamclient.SetupPrefixAllocationSpecPrefixRefNameField(ctx, mgr.GetFieldIndexer()); err != nil {
-			setupLog.Error(err, "unable to setup field indexer", "field", ipamclient.PrefixAllocationSpecPrefixRefNameField)
+		if err := ipamclient.SetupPrefixAllocationSpecPrefixRefKeyField(ctx, mgr.GetFieldIndexer()); err != nil {
+			setupLog.Error(err, "unable to setup field indexer", "field", ipamclient.PrefixAllocationSpecPrefixRefKeyField)
 			os.Exit(1)
 		}
 	}
@@ -561,6 +674,41 @@ func main() {
 		}
 	}
 
+	if controllers.AnyEnabled(networkInterfaceEphemeralPrefixController) {
+		if err := networkingclient.SetupNetworkInterfaceSubnetNameFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
+			setupLog.Error(err, "unable to setup field indexer", "field", networkingclient.NetworkInterfaceSpecSubnetRefNameField)
+			os.Exit(1)
+		}
+	}
+
+	if controllers.AnyEnabled(subnetController) {
+		if err := networkingclient.SetupSubnetNetworkNameFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
+			setupLog.Error(err, "unable to setup field indexer", "field", networkingclient.SubnetNetworkNameField)
+			os.Exit(1)
+		}
+	}
+
+	if controllers.AnyEnabled(virtualIPPublicIPPoolController) {
+		if err := networkingclient.SetupVirtualIPPublicIPPoolNameFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
+			setupLog.Error(err, "unable to setup field indexer", "field", networkingclient.VirtualIPPublicIPPoolNameField)
+			os.Exit(1)
+		}
+	}
+
+	if controllers.AnyEnabled(loadBalancerPublicIPPoolController) {
+		if err := networkingclient.SetupLoadBalancerPublicIPPoolNameFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
+			setupLog.Error(err, "unable to setup field indexer", "field", networkingclient.LoadBalancerPublicIPPoolNameField)
+			os.Exit(1)
+		}
+	}
+
+	if controllers.AnyEnabled(natGatewayPublicIPPoolController) {
+		if err := networkingclient.SetupNATGatewayPublicIPPoolNameFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
+			setupLog.Error(err, "unable to setup field indexer", "field", networkingclient.NATGatewayPublicIPPoolNameField)
+			os.Exit(1)
+		}
+	}
+
 	// storage indexers
 
 	if controllers.AnyEnabled(bucketClassController) {
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package defaultclass
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	"github.com/ironcore-dev/ironcore/client-go/informers"
+	computev1alpha1listers "github.com/ironcore-dev/ironcore/client-go/listers/compute/v1alpha1"
+	storagev1alpha1listers "github.com/ironcore-dev/ironcore/client-go/listers/storage/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+// PluginName indicates name of admission plugin.
+const PluginName = "DefaultClass"
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return NewDefaultClass(), nil
+	})
+}
+
+// DefaultClass sets the class of newly created Machines, Volumes and Buckets that do not specify a class
+// to the class annotated with commonv1alpha1.DefaultClassAnnotation.
+//
+// Volumes without a class and without any resources are unclassed volumes and are left untouched.
+// Marking a class as default is rejected if another class of the same kind already is the default.
+type DefaultClass struct {
+	*admission.Handler
+
+	machineClassLister computev1alpha1listers.MachineClassLister
+	volumeClassLister  storagev1alpha1listers.VolumeClassLister
+	bucketClassLister  storagev1alpha1listers.BucketClassLister
+}
+
+func NewDefaultClass() *DefaultClass {
+	return &DefaultClass{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}
+}
+
+func (d *DefaultClass) SetExternalIronCoreInformerFactory(f informers.SharedInformerFactory) {
+	machineClassInformer := f.Compute().V1alpha1().MachineClasses()
+	volumeClassInformer := f.Storage().V1alpha1().VolumeClasses()
+	bucketClassInformer := f.Storage().V1alpha1().BucketClasses()
+
+	d.machineClassLister = machineClassInformer.Lister()
+	d.volumeClassLister = volumeClassInformer.Lister()
+	d.bucketClassLister = bucketClassInformer.Lister()
+
+	d.SetReadyFunc(func() bool {
+		return machineClassInformer.Informer().HasSynced() &&
+			volumeClassInformer.Informer().HasSynced() &&
+			bucketClassInformer.Informer().HasSynced()
+	})
+}
+
+func (d *DefaultClass) ValidateInitialization() error {
+	if d.machineClassLister == nil {
+		return fmt.Errorf("missing machine class lister")
+	}
+	if d.volumeClassLister == nil {
+		return fmt.Errorf("missing volume class lister")
+	}
+	if d.bucketClassLister == nil {
+		return fmt.Errorf("missing bucket class lister")
+	}
+	return nil
+}
+
+func (d *DefaultClass) Admit(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetSubresource() != "" || a.GetOperation() != admission.Create {
+		return nil
+	}
+
+	switch obj := a.GetObject().(type) {
+	case *compute.Machine:
+		if obj.Spec.MachineClassRef.Name != "" {
+			return nil
+		}
+		name, err := d.defaultClassName(a, "MachineClass", listAsObjects(d.machineClassLister.List))
+		if err != nil || name == "" {
+			return err
+		}
+		obj.Spec.MachineClassRef = corev1.LocalObjectReference{Name: name}
+	case *storage.Volume:
+		if obj.Spec.VolumeClassRef != nil || obj.Spec.Resources == nil {
+			return nil
+		}
+		name, err := d.defaultClassName(a, "VolumeClass", listAsObjects(d.volumeClassLister.List))
+		if err != nil || name == "" {
+			return err
+		}
+		obj.Spec.VolumeClassRef = &corev1.LocalObjectReference{Name: name}
+	case *storage.Bucket:
+		if obj.Spec.BucketClassRef != nil {
+			return nil
+		}
+		name, err := d.defaultClassName(a, "BucketClass", listAsObjects(d.bucketClassLister.List))
+		if err != nil || name == "" {
+			return err
+		}
+		obj.Spec.BucketClassRef = &corev1.LocalObjectReference{Name: name}
+	}
+	return nil
+}
+
+func (d *DefaultClass) Validate(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	var (
+		kind string
+		list func() ([]metav1.Object, error)
+	)
+	switch a.GetObject().(type) {
+	case *compute.MachineClass:
+		kind, list = "MachineClass", listAsObjects(d.machineClassLister.List)
+	case *storage.VolumeClass:
+		kind, list = "VolumeClass", listAsObjects(d.volumeClassLister.List)
+	case *storage.BucketClass:
+		kind, list = "BucketClass", listAsObjects(d.bucketClassLister.List)
+	default:
+		return nil
+	}
+
+	class := a.GetObject().(metav1.Object)
+	if !IsDefaultClass(class) {
+		return nil
+	}
+	if oldClass, ok := a.GetOldObject().(metav1.Object); ok && IsDefaultClass(oldClass) {
+		return nil
+	}
+
+	name, err := d.defaultClassName(a, kind, list)
+	if err != nil {
+		return err
+	}
+	if name != "" && name != class.GetName() {
+		return admission.NewForbidden(a, fmt.Errorf("%s %s is already the default", kind, name))
+	}
+	return nil
+}
+
+// defaultClassName returns the name of the default class or an empty string if there is none.
+// It errors if more than one default class exists.
+func (d *DefaultClass) defaultClassName(a admission.Attributes, kind string, list func() ([]metav1.Object, error)) (string, error) {
+	if !d.WaitForReady() {
+		return "", admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	classes, err := list()
+	if err != nil {
+		return "", apierrors.NewInternalError(fmt.Errorf("error listing %s objects: %w", kind, err))
+	}
+
+	var defaultNames []string
+	for _, class := range classes {
+		if IsDefaultClass(class) {
+			defaultNames = append(defaultNames, class.GetName())
+		}
+	}
+
+	switch len(defaultNames) {
+	case 0:
+		return "", nil
+	case 1:
+		return defaultNames[0], nil
+	default:
+		return "", admission.NewForbidden(a, fmt.Errorf("%d default %s objects were found: %v", len(defaultNames), kind, defaultNames))
+	}
+}
+
+// IsDefaultClass reports whether the given class is annotated as default class.
+func IsDefaultClass(class metav1.Object) bool {
+	return class.GetAnnotations()[commonv1alpha1.DefaultClassAnnotation] == "true"
+}
+
+func listAsObjects[T metav1.Object](list func(selector labels.Selector) ([]T, error)) func() ([]metav1.Object, error) {
+	return func() ([]metav1.Object, error) {
+		items, err := list(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+
+		res := make([]metav1.Object, 0, len(items))
+		for _, item := range items {
+			res = append(res, item)
+		}
+		return res, nil
+	}
+}
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package defaultclass_test
+
+import (
+	"context"
+
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	"github.com/ironcore-dev/ironcore/client-go/informers"
+	"github.com/ironcore-dev/ironcore/client-go/ironcore/fake"
+	. "github.com/ironcore-dev/ironcore/internal/admission/plugin/defaultclass"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/core"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+var _ = Describe("Admission", func() {
+	defaultAnnotations := map[string]string{commonv1alpha1.DefaultClassAnnotation: "true"}
+
+	newPlugin := func(objs ...runtime.Object) *DefaultClass {
+		client := fake.NewSimpleClientset(objs...)
+		factory := informers.NewSharedInformerFactory(client, 0)
+
+		plugin := NewDefaultClass()
+		plugin.SetExternalIronCoreInformerFactory(factory)
+		Expect(plugin.ValidateInitialization()).To(Succeed())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+		factory.Start(ctx.Done())
+		factory.WaitForCacheSync(ctx.Done())
+		return plugin
+	}
+
+	attributes := func(obj, oldObj runtime.Object, kind schema.GroupKind, resource schema.GroupResource, op admission.Operation) admission.Attributes {
+		return admission.NewAttributesRecord(
+			obj,
+			oldObj,
+			kind.WithVersion("version"),
+			"foo",
+			"bar",
+			resource.WithVersion("version"),
+			"",
+			op,
+			nil,
+			false,
+			&user.DefaultInfo{},
+		)
+	}
+
+	machineAttributes := func(machine *compute.Machine) admission.Attributes {
+		return attributes(machine, nil, compute.Kind("Machine"), compute.Resource("machines"), admission.Create)
+	}
+
+	volumeAttributes := func(volume *storage.Volume) admission.Attributes {
+		return attributes(volume, nil, storage.Kind("Volume"), storage.Resource("volumes"), admission.Create)
+	}
+
+	It("should default the machine class if a default class exists", func(ctx SpecContext) {
+		plugin := newPlugin(
+			&computev1alpha1.MachineClass{ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: defaultAnnotations}},
+			&computev1alpha1.MachineClass{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+		)
+
+		machine := &compute.Machine{}
+		Expect(plugin.Admit(ctx, machineAttributes(machine), nil)).To(Succeed())
+		Expect(machine.Spec.MachineClassRef).To(Equal(corev1.LocalObjectReference{Name: "default"}))
+
+		machine = &compute.Machine{Spec: compute.MachineSpec{MachineClassRef: corev1.LocalObjectReference{Name: "other"}}}
+		Expect(plugin.Admit(ctx, machineAttributes(machine), nil)).To(Succeed())
+		Expect(machine.Spec.MachineClassRef).To(Equal(corev1.LocalObjectReference{Name: "other"}))
+	})
+
+	It("should not default the machine class if no default class exists", func(ctx SpecContext) {
+		plugin := newPlugin(&computev1alpha1.MachineClass{ObjectMeta: metav1.ObjectMeta{Name: "other"}})
+
+		machine := &compute.Machine{}
+		Expect(plugin.Admit(ctx, machineAttributes(machine), nil)).To(Succeed())
+		Expect(machine.Spec.MachineClassRef.Name).To(BeEmpty())
+	})
+
+	It("should reject defaulting if multiple default classes exist", func(ctx SpecContext) {
+		plugin := newPlugin(
+			&computev1alpha1.MachineClass{ObjectMeta: metav1.ObjectMeta{Name: "default-1", Annotations: defaultAnnotations}},
+			&computev1alpha1.MachineClass{ObjectMeta: metav1.ObjectMeta{Name: "default-2", Annotations: defaultAnnotations}},
+		)
+
+		err := plugin.Admit(ctx, machineAttributes(&compute.Machine{}), nil)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+	})
+
+	It("should default the volume class only for volumes requesting resources", func(ctx SpecContext) {
+		plugin := newPlugin(
+			&storagev1alpha1.VolumeClass{ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: defaultAnnotations}},
+		)
+
+		volume := &storage.Volume{Spec: storage.VolumeSpec{
+			Resources: core.ResourceList{core.ResourceStorage: resource.MustParse("1Gi")},
+		}}
+		Expect(plugin.Admit(ctx, volumeAttributes(volume), nil)).To(Succeed())
+		Expect(volume.Spec.VolumeClassRef).To(Equal(&corev1.LocalObjectReference{Name: "default"}))
+
+		unclassedVolume := &storage.Volume{}
+		Expect(plugin.Admit(ctx, volumeAttributes(unclassedVolume), nil)).To(Succeed())
+		Expect(unclassedVolume.Spec.VolumeClassRef).To(BeNil())
+	})
+
+	It("should default the bucket class", func(ctx SpecContext) {
+		plugin := newPlugin(
+			&storagev1alpha1.BucketClass{ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: defaultAnnotations}},
+		)
+
+		bucket := &storage.Bucket{}
+		Expect(plugin.Admit(ctx, attributes(bucket, nil, storage.Kind("Bucket"), storage.Resource("buckets"), admission.Create), nil)).To(Succeed())
+		Expect(bucket.Spec.BucketClassRef).To(Equal(&corev1.LocalObjectReference{Name: "default"}))
+	})
+
+	It("should reject marking a second class as default", func(ctx SpecContext) {
+		plugin := newPlugin(
+			&storagev1alpha1.VolumeClass{ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: defaultAnnotations}},
+		)
+
+		volumeClass := &storage.VolumeClass{ObjectMeta: metav1.ObjectMeta{Name: "other", Annotations: defaultAnnotations}}
+		err := plugin.Validate(ctx, attributes(volumeClass, nil, storage.Kind("VolumeClass"), storage.Resource("volumeclasses"), admission.Create), nil)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+
+		oldVolumeClass := &storage.VolumeClass{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		newVolumeClass := &storage.VolumeClass{ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: defaultAnnotations}}
+		Expect(plugin.Validate(ctx, attributes(newVolumeClass, oldVolumeClass, storage.Kind("VolumeClass"), storage.Resource("volumeclasses"), admission.Update), nil)).To(Succeed())
+	})
+})
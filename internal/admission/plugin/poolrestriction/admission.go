@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package poolrestriction
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+// PluginName indicates name of admission plugin.
+const PluginName = "PoolRestriction"
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return NewPoolRestriction(), nil
+	})
+}
+
+// poolType describes a kind of pool, the identity of its poollets and the objects bound to it.
+type poolType struct {
+	// group is the rbac group all poollets of the pool type are in.
+	group string
+	// userNamePrefix is the prefix of the user name of a poollet, followed by the pool name.
+	userNamePrefix string
+	// poolResource is the resource of the pool object.
+	poolResource schema.GroupResource
+	// boundResource is the resource of the objects bound to a pool.
+	boundResource schema.GroupResource
+	// boundPoolRef returns the pool reference of a bound object.
+	boundPoolRef func(obj runtime.Object) (*corev1.LocalObjectReference, bool)
+}
+
+var poolTypes = []poolType{
+	{
+		group:          computev1alpha1.MachinePoolsGroup,
+		userNamePrefix: computev1alpha1.MachinePoolUserNamePrefix,
+		poolResource:   compute.Resource("machinepools"),
+		boundResource:  compute.Resource("machines"),
+		boundPoolRef: func(obj runtime.Object) (*corev1.LocalObjectReference, bool) {
+			machine, ok := obj.(*compute.Machine)
+			if !ok {
+				return nil, false
+			}
+			return machine.Spec.MachinePoolRef, true
+		},
+	},
+	{
+		group:          storagev1alpha1.VolumePoolsGroup,
+		userNamePrefix: storagev1alpha1.VolumePoolUserNamePrefix,
+		poolResource:   storage.Resource("volumepools"),
+		boundResource:  storage.Resource("volumes"),
+		boundPoolRef: func(obj runtime.Object) (*corev1.LocalObjectReference, bool) {
+			volume, ok := obj.(*storage.Volume)
+			if !ok {
+				return nil, false
+			}
+			return volume.Spec.VolumePoolRef, true
+		},
+	},
+	{
+		group:          storagev1alpha1.BucketPoolsGroup,
+		userNamePrefix: storagev1alpha1.BucketPoolUserNamePrefix,
+		poolResource:   storage.Resource("bucketpools"),
+		boundResource:  storage.Resource("buckets"),
+		boundPoolRef: func(obj runtime.Object) (*corev1.LocalObjectReference, bool) {
+			bucket, ok := obj.(*storage.Bucket)
+			if !ok {
+				return nil, false
+			}
+			return bucket.Spec.BucketPoolRef, true
+		},
+	},
+}
+
+// PoolRestriction limits the pool objects and the objects bound to a pool a poollet can modify
+// to the ones of its own pool.
+type PoolRestriction struct {
+	*admission.Handler
+}
+
+var _ admission.ValidationInterface = &PoolRestriction{}
+
+func NewPoolRestriction() *PoolRestriction {
+	return &PoolRestriction{
+		Handler: admission.NewHandler(admission.Create, admission.Update, admission.Delete),
+	}
+}
+
+func (p *PoolRestriction) Validate(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	userInfo := a.GetUserInfo()
+	if userInfo == nil {
+		return nil
+	}
+
+	for _, typ := range poolTypes {
+		if !slices.Contains(userInfo.GetGroups(), typ.group) {
+			continue
+		}
+
+		return p.validate(a, typ, userInfo)
+	}
+	return nil
+}
+
+func (p *PoolRestriction) validate(a admission.Attributes, typ poolType, userInfo user.Info) error {
+	poolName, ok := strings.CutPrefix(userInfo.GetName(), typ.userNamePrefix)
+	if !ok || poolName == "" {
+		return admission.NewForbidden(a, fmt.Errorf("could not determine %s from user %q", typ.poolResource.Resource, userInfo.GetName()))
+	}
+
+	switch a.GetResource().GroupResource() {
+	case typ.poolResource:
+		if a.GetName() != poolName {
+			return admission.NewForbidden(a, fmt.Errorf("%s %q may only modify its own %s", typ.poolResource.Resource, poolName, typ.poolResource.Resource))
+		}
+		return nil
+	case typ.boundResource:
+		return p.validateBoundObject(a, typ, poolName)
+	default:
+		return nil
+	}
+}
+
+func (p *PoolRestriction) validateBoundObject(a admission.Attributes, typ poolType, poolName string) error {
+	var objs []runtime.Object
+	switch a.GetOperation() {
+	case admission.Create:
+		objs = append(objs, a.GetObject())
+	case admission.Update:
+		objs = append(objs, a.GetObject(), a.GetOldObject())
+	case admission.Delete:
+		objs = append(objs, a.GetOldObject())
+	}
+
+	for _, obj := range objs {
+		if obj == nil {
+			return admission.NewForbidden(a, fmt.Errorf("could not determine %s of %s %q", typ.poolResource.Resource, typ.boundResource.Resource, a.GetName()))
+		}
+
+		poolRef, ok := typ.boundPoolRef(obj)
+		if !ok {
+			return apierrors.NewBadRequest(fmt.Sprintf("Resource was marked with resource %s but was unable to be converted", typ.boundResource.Resource))
+		}
+
+		if poolRef == nil || poolRef.Name != poolName {
+			return admission.NewForbidden(a, fmt.Errorf("%s %q may only modify %s bound to it", typ.poolResource.Resource, poolName, typ.boundResource.Resource))
+		}
+	}
+	return nil
+}
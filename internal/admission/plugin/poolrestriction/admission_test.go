@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package poolrestriction_test
+
+import (
+	"context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/internal/admission/plugin/poolrestriction"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+var _ = Describe("Admission", func() {
+	var (
+		plugin *PoolRestriction
+
+		machinePoolUser = &user.DefaultInfo{
+			Name:   computev1alpha1.MachinePoolCommonName("my-pool"),
+			Groups: []string{computev1alpha1.MachinePoolsGroup},
+		}
+		volumePoolUser = &user.DefaultInfo{
+			Name:   storagev1alpha1.VolumePoolCommonName("my-pool"),
+			Groups: []string{storagev1alpha1.VolumePoolsGroup},
+		}
+	)
+	BeforeEach(func() {
+		plugin = NewPoolRestriction()
+	})
+
+	newMachine := func(poolName string) *compute.Machine {
+		machine := &compute.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "bar",
+			},
+		}
+		if poolName != "" {
+			machine.Spec.MachinePoolRef = &corev1.LocalObjectReference{Name: poolName}
+		}
+		return machine
+	}
+
+	machineAttributes := func(newMachine, oldMachine *compute.Machine, op admission.Operation, userInfo user.Info) admission.Attributes {
+		var obj, oldObj runtime.Object
+		if newMachine != nil {
+			obj = newMachine
+		}
+		if oldMachine != nil {
+			oldObj = oldMachine
+		}
+		return admission.NewAttributesRecord(
+			obj,
+			oldObj,
+			compute.Kind("Machine").WithVersion("version"),
+			"foo",
+			"bar",
+			compute.Resource("machines").WithVersion("version"),
+			"status",
+			op,
+			nil,
+			false,
+			userInfo,
+		)
+	}
+
+	machinePoolAttributes := func(name string, userInfo user.Info) admission.Attributes {
+		machinePool := &compute.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		return admission.NewAttributesRecord(
+			machinePool,
+			machinePool,
+			compute.Kind("MachinePool").WithVersion("version"),
+			"",
+			name,
+			compute.Resource("machinepools").WithVersion("version"),
+			"status",
+			admission.Update,
+			&metav1.UpdateOptions{},
+			false,
+			userInfo,
+		)
+	}
+
+	It("should ignore non-poollet users", func() {
+		Expect(plugin.Validate(
+			context.TODO(),
+			machineAttributes(newMachine("other-pool"), newMachine("other-pool"), admission.Update, &user.DefaultInfo{Name: "admin"}),
+			nil,
+		)).To(Succeed())
+	})
+
+	It("should allow a machine pool to update machines bound to it", func() {
+		Expect(plugin.Validate(
+			context.TODO(),
+			machineAttributes(newMachine("my-pool"), newMachine("my-pool"), admission.Update, machinePoolUser),
+			nil,
+		)).To(Succeed())
+	})
+
+	It("should forbid a machine pool to update machines bound to another pool", func() {
+		Expect(plugin.Validate(
+			context.TODO(),
+			machineAttributes(newMachine("other-pool"), newMachine("other-pool"), admission.Update, machinePoolUser),
+			nil,
+		)).To(Satisfy(apierrors.IsForbidden))
+	})
+
+	It("should forbid a machine pool to rebind a machine to itself", func() {
+		Expect(plugin.Validate(
+			context.TODO(),
+			machineAttributes(newMachine("my-pool"), newMachine(""), admission.Update, machinePoolUser),
+			nil,
+		)).To(Satisfy(apierrors.IsForbidden))
+	})
+
+	It("should forbid a machine pool to delete machines bound to another pool", func() {
+		Expect(plugin.Validate(
+			context.TODO(),
+			machineAttributes(nil, newMachine("other-pool"), admission.Delete, machinePoolUser),
+			nil,
+		)).To(Satisfy(apierrors.IsForbidden))
+	})
+
+	It("should allow a machine pool to update its own pool", func() {
+		Expect(plugin.Validate(context.TODO(), machinePoolAttributes("my-pool", machinePoolUser), nil)).To(Succeed())
+	})
+
+	It("should forbid a machine pool to update another pool", func() {
+		Expect(plugin.Validate(context.TODO(), machinePoolAttributes("other-pool", machinePoolUser), nil)).To(Satisfy(apierrors.IsForbidden))
+	})
+
+	It("should not restrict a volume pool on machine pools", func() {
+		Expect(plugin.Validate(context.TODO(), machinePoolAttributes("other-pool", volumePoolUser), nil)).To(Succeed())
+	})
+
+	It("should forbid a volume pool to update volumes bound to another pool", func() {
+		volume := &storage.Volume{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+			Spec: storage.VolumeSpec{
+				VolumePoolRef: &corev1.LocalObjectReference{Name: "other-pool"},
+			},
+		}
+		Expect(plugin.Validate(
+			context.TODO(),
+			admission.NewAttributesRecord(
+				volume,
+				volume,
+				storage.Kind("Volume").WithVersion("version"),
+				volume.Namespace,
+				volume.Name,
+				storage.Resource("volumes").WithVersion("version"),
+				"",
+				admission.Update,
+				&metav1.UpdateOptions{},
+				false,
+				volumePoolUser,
+			),
+			nil,
+		)).To(Satisfy(apierrors.IsForbidden))
+	})
+
+	It("should forbid poollet users without pool name", func() {
+		Expect(plugin.Validate(
+			context.TODO(),
+			machinePoolAttributes("my-pool", &user.DefaultInfo{
+				Name:   "foo",
+				Groups: []string{computev1alpha1.MachinePoolsGroup},
+			}),
+			nil,
+		)).To(Satisfy(apierrors.IsForbidden))
+	})
+})
@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package referencevalidation
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	"github.com/ironcore-dev/ironcore/client-go/informers"
+	"github.com/ironcore-dev/ironcore/client-go/ironcore"
+	computev1alpha1listers "github.com/ironcore-dev/ironcore/client-go/listers/compute/v1alpha1"
+	networkingv1alpha1listers "github.com/ironcore-dev/ironcore/client-go/listers/networking/v1alpha1"
+	storagev1alpha1listers "github.com/ironcore-dev/ironcore/client-go/listers/storage/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/client-go/tools/cache"
+)
+
+// PluginName indicates name of admission plugin.
+const PluginName = "ReferenceValidation"
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return NewReferenceValidation(), nil
+	})
+}
+
+// ReferenceValidation rejects newly created objects that reference classes that do not exist or
+// networks / pools that do not exist or are terminating.
+//
+// If an object is annotated with commonv1alpha1.SoftReferenceValidationAnnotation, violations are only
+// reported as warnings.
+//
+// References missing from the informer caches are looked up live, so objects may be created right after
+// the objects they reference.
+type ReferenceValidation struct {
+	*admission.Handler
+
+	client ironcore.Interface
+
+	machineClassLister computev1alpha1listers.MachineClassLister
+	machinePoolLister  computev1alpha1listers.MachinePoolLister
+	volumeClassLister  storagev1alpha1listers.VolumeClassLister
+	volumePoolLister   storagev1alpha1listers.VolumePoolLister
+	bucketClassLister  storagev1alpha1listers.BucketClassLister
+	bucketPoolLister   storagev1alpha1listers.BucketPoolLister
+	networkLister      networkingv1alpha1listers.NetworkLister
+	publicIPPoolLister networkingv1alpha1listers.PublicIPPoolLister
+}
+
+func NewReferenceValidation() *ReferenceValidation {
+	return &ReferenceValidation{
+		Handler: admission.NewHandler(admission.Create),
+	}
+}
+
+func (r *ReferenceValidation) SetExternalIronCoreClientSet(client ironcore.Interface) {
+	r.client = client
+}
+
+func (r *ReferenceValidation) SetExternalIronCoreInformerFactory(f informers.SharedInformerFactory) {
+	machineClassInformer := f.Compute().V1alpha1().MachineClasses()
+	machinePoolInformer := f.Compute().V1alpha1().MachinePools()
+	volumeClassInformer := f.Storage().V1alpha1().VolumeClasses()
+	volumePoolInformer := f.Storage().V1alpha1().VolumePools()
+	bucketClassInformer := f.Storage().V1alpha1().BucketClasses()
+	bucketPoolInformer := f.Storage().V1alpha1().BucketPools()
+	networkInformer := f.Networking().V1alpha1().Networks()
+	publicIPPoolInformer := f.Networking().V1alpha1().PublicIPPools()
+
+	r.machineClassLister = machineClassInformer.Lister()
+	r.machinePoolLister = machinePoolInformer.Lister()
+	r.volumeClassLister = volumeClassInformer.Lister()
+	r.volumePoolLister = volumePoolInformer.Lister()
+	r.bucketClassLister = bucketClassInformer.Lister()
+	r.bucketPoolLister = bucketPoolInformer.Lister()
+	r.networkLister = networkInformer.Lister()
+	r.publicIPPoolLister = publicIPPoolInformer.Lister()
+
+	r.SetReadyFunc(func() bool {
+		for _, synced := range []cache.InformerSynced{
+			machineClassInformer.Informer().HasSynced,
+			machinePoolInformer.Informer().HasSynced,
+			volumeClassInformer.Informer().HasSynced,
+			volumePoolInformer.Informer().HasSynced,
+			bucketClassInformer.Informer().HasSynced,
+			bucketPoolInformer.Informer().HasSynced,
+			networkInformer.Informer().HasSynced,
+			publicIPPoolInformer.Informer().HasSynced,
+		} {
+			if !synced() {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func (r *ReferenceValidation) ValidateInitialization() error {
+	if r.client == nil {
+		return fmt.Errorf("missing client")
+	}
+	if r.machineClassLister == nil {
+		return fmt.Errorf("missing machine class lister")
+	}
+	if r.machinePoolLister == nil {
+		return fmt.Errorf("missing machine pool lister")
+	}
+	if r.volumeClassLister == nil {
+		return fmt.Errorf("missing volume class lister")
+	}
+	if r.volumePoolLister == nil {
+		return fmt.Errorf("missing volume pool lister")
+	}
+	if r.bucketClassLister == nil {
+		return fmt.Errorf("missing bucket class lister")
+	}
+	if r.bucketPoolLister == nil {
+		return fmt.Errorf("missing bucket pool lister")
+	}
+	if r.networkLister == nil {
+		return fmt.Errorf("missing network lister")
+	}
+	if r.publicIPPoolLister == nil {
+		return fmt.Errorf("missing public ip pool lister")
+	}
+	return nil
+}
+
+// referenceKind describes how a reference has to be validated.
+type referenceKind int
+
+const (
+	// referenceMustExist requires the referenced object to exist.
+	referenceMustExist referenceKind = iota
+	// referenceMustExistAndNotBeTerminating requires the referenced object to exist and not to be terminating.
+	referenceMustExistAndNotBeTerminating
+	// referenceMustNotBeTerminating requires the referenced object not to be terminating, if it exists.
+	referenceMustNotBeTerminating
+)
+
+type reference struct {
+	path *field.Path
+	name string
+	kind referenceKind
+	get  func(ctx context.Context, name string) (metav1.Object, error)
+}
+
+func (r *ReferenceValidation) Validate(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	obj, ok := a.GetObject().(metav1.Object)
+	if !ok {
+		return nil
+	}
+
+	refs := r.references(a.GetObject(), a.GetNamespace())
+	if len(refs) == 0 {
+		return nil
+	}
+
+	if !r.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	var allErrs field.ErrorList
+	for _, ref := range refs {
+		if err := validateReference(ctx, ref); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	if obj.GetAnnotations()[commonv1alpha1.SoftReferenceValidationAnnotation] == "true" {
+		for _, err := range allErrs {
+			warning.AddWarning(ctx, "", err.Error())
+		}
+		return nil
+	}
+	return apierrors.NewInvalid(a.GetKind().GroupKind(), a.GetName(), allErrs)
+}
+
+func validateReference(ctx context.Context, ref reference) *field.Error {
+	obj, err := ref.get(ctx, ref.name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return field.InternalError(ref.path, err)
+		}
+		if ref.kind == referenceMustNotBeTerminating {
+			return nil
+		}
+		return field.NotFound(ref.path, ref.name)
+	}
+
+	if ref.kind != referenceMustExist && !obj.GetDeletionTimestamp().IsZero() {
+		return field.Invalid(ref.path, ref.name, "referenced object is terminating")
+	}
+	return nil
+}
+
+func (r *ReferenceValidation) references(obj any, namespace string) []reference {
+	var (
+		computeClient    = r.client.ComputeV1alpha1()
+		storageClient    = r.client.StorageV1alpha1()
+		networkingClient = r.client.NetworkingV1alpha1()
+	)
+
+	specPath := field.NewPath("spec")
+	switch obj := obj.(type) {
+	case *compute.Machine:
+		refs := []reference{
+			{specPath.Child("machineClassRef"), obj.Spec.MachineClassRef.Name, referenceMustExist, getter(r.machineClassLister.Get, computeClient.MachineClasses().Get)},
+		}
+		refs = appendOptionalReference(refs, specPath.Child("machinePoolRef"), obj.Spec.MachinePoolRef, referenceMustNotBeTerminating, getter(r.machinePoolLister.Get, computeClient.MachinePools().Get))
+		return refs
+	case *storage.Volume:
+		var refs []reference
+		refs = appendOptionalReference(refs, specPath.Child("volumeClassRef"), obj.Spec.VolumeClassRef, referenceMustExist, getter(r.volumeClassLister.Get, storageClient.VolumeClasses().Get))
+		refs = appendOptionalReference(refs, specPath.Child("volumePoolRef"), obj.Spec.VolumePoolRef, referenceMustNotBeTerminating, getter(r.volumePoolLister.Get, storageClient.VolumePools().Get))
+		return refs
+	case *storage.Bucket:
+		var refs []reference
+		refs = appendOptionalReference(refs, specPath.Child("bucketClassRef"), obj.Spec.BucketClassRef, referenceMustExist, getter(r.bucketClassLister.Get, storageClient.BucketClasses().Get))
+		refs = appendOptionalReference(refs, specPath.Child("bucketPoolRef"), obj.Spec.BucketPoolRef, referenceMustNotBeTerminating, getter(r.bucketPoolLister.Get, storageClient.BucketPools().Get))
+		return refs
+	case *networking.NetworkInterface:
+		return []reference{
+			{specPath.Child("networkRef"), obj.Spec.NetworkRef.Name, referenceMustExistAndNotBeTerminating, getter(r.networkLister.Networks(namespace).Get, networkingClient.Networks(namespace).Get)},
+		}
+	case *networking.LoadBalancer:
+		refs := []reference{
+			{specPath.Child("networkRef"), obj.Spec.NetworkRef.Name, referenceMustExistAndNotBeTerminating, getter(r.networkLister.Networks(namespace).Get, networkingClient.Networks(namespace).Get)},
+		}
+		for i, publicIPPoolRef := range obj.Spec.PublicIPPoolRefs {
+			refs = appendOptionalReference(refs, specPath.Child("publicIPPoolRefs").Index(i), &publicIPPoolRef, referenceMustExistAndNotBeTerminating, getter(r.publicIPPoolLister.Get, networkingClient.PublicIPPools().Get))
+		}
+		return refs
+	case *networking.NATGateway:
+		refs := []reference{
+			{specPath.Child("networkRef"), obj.Spec.NetworkRef.Name, referenceMustExistAndNotBeTerminating, getter(r.networkLister.Networks(namespace).Get, networkingClient.Networks(namespace).Get)},
+		}
+		refs = appendOptionalReference(refs, specPath.Child("publicIPPoolRef"), obj.Spec.PublicIPPoolRef, referenceMustExistAndNotBeTerminating, getter(r.publicIPPoolLister.Get, networkingClient.PublicIPPools().Get))
+		return refs
+	case *networking.VirtualIP:
+		var refs []reference
+		refs = appendOptionalReference(refs, specPath.Child("publicIPPoolRef"), obj.Spec.PublicIPPoolRef, referenceMustExistAndNotBeTerminating, getter(r.publicIPPoolLister.Get, networkingClient.PublicIPPools().Get))
+		return refs
+	default:
+		return nil
+	}
+}
+
+func appendOptionalReference(
+	refs []reference,
+	path *field.Path,
+	ref *corev1.LocalObjectReference,
+	kind referenceKind,
+	get func(ctx context.Context, name string) (metav1.Object, error),
+) []reference {
+	if ref == nil || ref.Name == "" {
+		return refs
+	}
+	return append(refs, reference{path, ref.Name, kind, get})
+}
+
+func getter[T metav1.Object](
+	getCached func(name string) (T, error),
+	getLive func(ctx context.Context, name string, opts metav1.GetOptions) (T, error),
+) func(ctx context.Context, name string) (metav1.Object, error) {
+	return func(ctx context.Context, name string) (metav1.Object, error) {
+		obj, err := getCached(name)
+		if apierrors.IsNotFound(err) {
+			// The cache may not have observed a recently created object yet.
+			obj, err = getLive(ctx, name, metav1.GetOptions{})
+		}
+		if err != nil {
+			return nil, err
+		}
+		return obj, nil
+	}
+}
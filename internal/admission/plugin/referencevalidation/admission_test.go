@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package referencevalidation_test
+
+import (
+	"context"
+
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/client-go/informers"
+	"github.com/ironcore-dev/ironcore/client-go/ironcore/fake"
+	. "github.com/ironcore-dev/ironcore/internal/admission/plugin/referencevalidation"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+var _ = Describe("Admission", func() {
+	var (
+		plugin *ReferenceValidation
+	)
+	BeforeEach(func() {
+		now := metav1.Now()
+		objects := []runtime.Object{
+			&computev1alpha1.MachineClass{ObjectMeta: metav1.ObjectMeta{Name: "my-class"}},
+			&computev1alpha1.MachinePool{ObjectMeta: metav1.ObjectMeta{
+				Name:              "terminating-pool",
+				DeletionTimestamp: &now,
+				Finalizers:        []string{"foo"},
+			}},
+			&networkingv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "my-network"}},
+			&networkingv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "foo",
+				Name:              "terminating-network",
+				DeletionTimestamp: &now,
+				Finalizers:        []string{"foo"},
+			}},
+		}
+		factory := informers.NewSharedInformerFactory(fake.NewSimpleClientset(objects...), 0)
+
+		// The live client knows about a class the informer caches have not observed yet.
+		liveClient := fake.NewSimpleClientset(append(objects,
+			&computev1alpha1.MachineClass{ObjectMeta: metav1.ObjectMeta{Name: "new-class"}},
+		)...)
+
+		plugin = NewReferenceValidation()
+		plugin.SetExternalIronCoreClientSet(liveClient)
+		plugin.SetExternalIronCoreInformerFactory(factory)
+		Expect(plugin.ValidateInitialization()).To(Succeed())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+		factory.Start(ctx.Done())
+		factory.WaitForCacheSync(ctx.Done())
+	})
+
+	attributes := func(obj runtime.Object, kind schema.GroupKind, resource schema.GroupResource) admission.Attributes {
+		return admission.NewAttributesRecord(
+			obj,
+			nil,
+			kind.WithVersion("version"),
+			"foo",
+			"bar",
+			resource.WithVersion("version"),
+			"",
+			admission.Create,
+			nil,
+			false,
+			&user.DefaultInfo{},
+		)
+	}
+
+	machineAttributes := func(machine *compute.Machine) admission.Attributes {
+		return attributes(machine, compute.Kind("Machine"), compute.Resource("machines"))
+	}
+
+	nicAttributes := func(nic *networking.NetworkInterface) admission.Attributes {
+		return attributes(nic, schema.GroupKind{Group: networking.SchemeGroupVersion.Group, Kind: "NetworkInterface"}, networking.Resource("networkinterfaces"))
+	}
+
+	newMachine := func(className string, poolName string) *compute.Machine {
+		machine := &compute.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+			Spec: compute.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: className},
+			},
+		}
+		if poolName != "" {
+			machine.Spec.MachinePoolRef = &corev1.LocalObjectReference{Name: poolName}
+		}
+		return machine
+	}
+
+	newNetworkInterface := func(networkName string) *networking.NetworkInterface {
+		return &networking.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+			Spec: networking.NetworkInterfaceSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: networkName},
+			},
+		}
+	}
+
+	It("should allow a machine referencing an existing class and a non-existing pool", func(ctx SpecContext) {
+		Expect(plugin.Validate(ctx, machineAttributes(newMachine("my-class", "some-pool")), nil)).To(Succeed())
+	})
+
+	It("should reject a machine referencing a non-existing class", func(ctx SpecContext) {
+		err := plugin.Validate(ctx, machineAttributes(newMachine("other-class", "")), nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue(), "unexpected error %v", err)
+	})
+
+	It("should allow a machine referencing a class not yet observed by the cache", func(ctx SpecContext) {
+		Expect(plugin.Validate(ctx, machineAttributes(newMachine("new-class", "")), nil)).To(Succeed())
+	})
+
+	It("should reject a machine referencing a terminating pool", func(ctx SpecContext) {
+		err := plugin.Validate(ctx, machineAttributes(newMachine("my-class", "terminating-pool")), nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue(), "unexpected error %v", err)
+	})
+
+	It("should allow a network interface referencing an existing network", func(ctx SpecContext) {
+		Expect(plugin.Validate(ctx, nicAttributes(newNetworkInterface("my-network")), nil)).To(Succeed())
+	})
+
+	It("should reject a network interface referencing a non-existing or terminating network", func(ctx SpecContext) {
+		err := plugin.Validate(ctx, nicAttributes(newNetworkInterface("other-network")), nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue(), "unexpected error %v", err)
+
+		err = plugin.Validate(ctx, nicAttributes(newNetworkInterface("terminating-network")), nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue(), "unexpected error %v", err)
+	})
+
+	It("should allow objects with dangling references if soft validation is requested", func(ctx SpecContext) {
+		nic := newNetworkInterface("other-network")
+		nic.Annotations = map[string]string{commonv1alpha1.SoftReferenceValidationAnnotation: "true"}
+		Expect(plugin.Validate(ctx, nicAttributes(nic), nil)).To(Succeed())
+	})
+
+	It("should allow volumes without a volume class", func(ctx SpecContext) {
+		volume := &storage.Volume{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"}}
+		Expect(plugin.Validate(ctx, attributes(volume, storage.Kind("Volume"), storage.Resource("volumes")), nil)).To(Succeed())
+	})
+})
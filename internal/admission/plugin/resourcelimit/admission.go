@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcelimit
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore/client-go/informers"
+	corev1alpha1listers "github.com/ironcore-dev/ironcore/client-go/listers/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/core"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+// PluginName indicates name of admission plugin.
+const PluginName = "ResourceLimit"
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return NewResourceLimit(), nil
+	})
+}
+
+// ResourceLimit enforces the ResourceLimit objects of a namespace:
+// It defaults the storage of classed Volumes, rejects Volumes whose storage is not within the
+// limits and rejects Machines and Volumes using classes that are not allowed.
+type ResourceLimit struct {
+	*admission.Handler
+
+	lister corev1alpha1listers.ResourceLimitLister
+}
+
+func NewResourceLimit() *ResourceLimit {
+	return &ResourceLimit{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}
+}
+
+func (r *ResourceLimit) SetExternalIronCoreInformerFactory(f informers.SharedInformerFactory) {
+	informer := f.Core().V1alpha1().ResourceLimits()
+	r.lister = informer.Lister()
+	r.SetReadyFunc(informer.Informer().HasSynced)
+}
+
+func (r *ResourceLimit) ValidateInitialization() error {
+	if r.lister == nil {
+		return fmt.Errorf("missing lister")
+	}
+	return nil
+}
+
+func shouldHandle(a admission.Attributes) bool {
+	if a.GetSubresource() != "" {
+		return false
+	}
+
+	switch a.GetObject().(type) {
+	case *compute.Machine, *storage.Volume:
+		return true
+	default:
+		return false
+	}
+}
+
+// resourceLimits returns the ResourceLimits of the namespace, sorted by name.
+func (r *ResourceLimit) resourceLimits(a admission.Attributes) ([]*corev1alpha1.ResourceLimit, error) {
+	if !r.WaitForReady() {
+		return nil, admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	resourceLimits, err := r.lister.ResourceLimits(a.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error listing resource limits: %w", err))
+	}
+
+	slices.SortFunc(resourceLimits, func(a, b *corev1alpha1.ResourceLimit) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return resourceLimits, nil
+}
+
+func (r *ResourceLimit) Admit(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetOperation() != admission.Create || !shouldHandle(a) {
+		return nil
+	}
+
+	volume, ok := a.GetObject().(*storage.Volume)
+	if !ok || volume.Spec.VolumeClassRef == nil {
+		return nil
+	}
+	if _, ok := volume.Spec.Resources[core.ResourceStorage]; ok {
+		return nil
+	}
+
+	resourceLimits, err := r.resourceLimits(a)
+	if err != nil {
+		return err
+	}
+
+	for _, resourceLimit := range resourceLimits {
+		for _, item := range resourceLimit.Spec.Limits {
+			if item.Type != corev1alpha1.ResourceLimitTypeVolume {
+				continue
+			}
+
+			defaultStorage, ok := item.Default[corev1alpha1.ResourceStorage]
+			if !ok {
+				continue
+			}
+
+			if volume.Spec.Resources == nil {
+				volume.Spec.Resources = core.ResourceList{}
+			}
+			volume.Spec.Resources[core.ResourceStorage] = defaultStorage
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *ResourceLimit) Validate(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if !shouldHandle(a) {
+		return nil
+	}
+
+	resourceLimits, err := r.resourceLimits(a)
+	if err != nil {
+		return err
+	}
+
+	for _, resourceLimit := range resourceLimits {
+		if err := validate(a, resourceLimit); err != nil {
+			return admission.NewForbidden(a, fmt.Errorf("resource limit %s: %w", resourceLimit.Name, err))
+		}
+	}
+	return nil
+}
+
+func validate(a admission.Attributes, resourceLimit *corev1alpha1.ResourceLimit) error {
+	switch obj := a.GetObject().(type) {
+	case *compute.Machine:
+		if a.GetOperation() != admission.Create {
+			return nil
+		}
+		return validateClass(resourceLimit, corev1alpha1.ClassTypeMachineClass, obj.Spec.MachineClassRef.Name)
+	case *storage.Volume:
+		if a.GetOperation() == admission.Create && obj.Spec.VolumeClassRef != nil {
+			if err := validateClass(resourceLimit, corev1alpha1.ClassTypeVolumeClass, obj.Spec.VolumeClassRef.Name); err != nil {
+				return err
+			}
+		}
+
+		storageQuantity, ok := obj.Spec.Resources[core.ResourceStorage]
+		if !ok {
+			return nil
+		}
+		if oldVolume, ok := a.GetOldObject().(*storage.Volume); ok {
+			if oldStorageQuantity, ok := oldVolume.Spec.Resources[core.ResourceStorage]; ok && oldStorageQuantity.Equal(storageQuantity) {
+				return nil
+			}
+		}
+
+		for _, item := range resourceLimit.Spec.Limits {
+			if item.Type != corev1alpha1.ResourceLimitTypeVolume {
+				continue
+			}
+
+			if minQuantity, ok := item.Min[corev1alpha1.ResourceStorage]; ok && storageQuantity.Cmp(minQuantity) < 0 {
+				return fmt.Errorf("volume storage %s is less than the minimum %s", storageQuantity.String(), minQuantity.String())
+			}
+			if maxQuantity, ok := item.Max[corev1alpha1.ResourceStorage]; ok && storageQuantity.Cmp(maxQuantity) > 0 {
+				return fmt.Errorf("volume storage %s is greater than the maximum %s", storageQuantity.String(), maxQuantity.String())
+			}
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+func validateClass(resourceLimit *corev1alpha1.ResourceLimit, classType corev1alpha1.ClassType, className string) error {
+	for _, allowedClasses := range resourceLimit.Spec.AllowedClasses {
+		if allowedClasses.ClassType != classType {
+			continue
+		}
+
+		if !slices.Contains(allowedClasses.Names, className) {
+			return fmt.Errorf("%s class %q is not allowed, allowed classes are %v", classType, className, allowedClasses.Names)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcelimit_test
+
+import (
+	"context"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore/client-go/informers"
+	"github.com/ironcore-dev/ironcore/client-go/ironcore/fake"
+	. "github.com/ironcore-dev/ironcore/internal/admission/plugin/resourcelimit"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/core"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+var _ = Describe("Admission", func() {
+	var (
+		plugin *ResourceLimit
+	)
+	BeforeEach(func() {
+		client := fake.NewSimpleClientset(&corev1alpha1.ResourceLimit{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "limits"},
+			Spec: corev1alpha1.ResourceLimitSpec{
+				Limits: []corev1alpha1.ResourceLimitItem{
+					{
+						Type:    corev1alpha1.ResourceLimitTypeVolume,
+						Min:     corev1alpha1.ResourceList{corev1alpha1.ResourceStorage: resource.MustParse("10Gi")},
+						Max:     corev1alpha1.ResourceList{corev1alpha1.ResourceStorage: resource.MustParse("2Ti")},
+						Default: corev1alpha1.ResourceList{corev1alpha1.ResourceStorage: resource.MustParse("100Gi")},
+					},
+				},
+				AllowedClasses: []corev1alpha1.AllowedClasses{
+					{ClassType: corev1alpha1.ClassTypeMachineClass, Names: []string{"allowed"}},
+				},
+			},
+		})
+		factory := informers.NewSharedInformerFactory(client, 0)
+
+		plugin = NewResourceLimit()
+		plugin.SetExternalIronCoreInformerFactory(factory)
+		Expect(plugin.ValidateInitialization()).To(Succeed())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+		factory.Start(ctx.Done())
+		factory.WaitForCacheSync(ctx.Done())
+	})
+
+	volumeAttributes := func(namespace string, volume, oldVolume *storage.Volume, op admission.Operation) admission.Attributes {
+		var oldObj runtime.Object
+		if oldVolume != nil {
+			oldObj = oldVolume
+		}
+		return admission.NewAttributesRecord(
+			volume,
+			oldObj,
+			storage.Kind("Volume").WithVersion("version"),
+			namespace,
+			"bar",
+			storage.Resource("volumes").WithVersion("version"),
+			"",
+			op,
+			nil,
+			false,
+			&user.DefaultInfo{},
+		)
+	}
+
+	machineAttributes := func(namespace string, machine *compute.Machine) admission.Attributes {
+		return admission.NewAttributesRecord(
+			machine,
+			nil,
+			compute.Kind("Machine").WithVersion("version"),
+			namespace,
+			"bar",
+			compute.Resource("machines").WithVersion("version"),
+			"",
+			admission.Create,
+			nil,
+			false,
+			&user.DefaultInfo{},
+		)
+	}
+
+	newVolume := func(storageQuantity string) *storage.Volume {
+		volume := &storage.Volume{
+			Spec: storage.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: "my-class"},
+			},
+		}
+		if storageQuantity != "" {
+			volume.Spec.Resources = core.ResourceList{core.ResourceStorage: resource.MustParse(storageQuantity)}
+		}
+		return volume
+	}
+
+	It("should default the volume storage", func(ctx SpecContext) {
+		volume := newVolume("")
+		Expect(plugin.Admit(ctx, volumeAttributes("foo", volume, nil, admission.Create), nil)).To(Succeed())
+		Expect(volume.Spec.Resources).To(HaveKeyWithValue(core.ResourceStorage, resource.MustParse("100Gi")))
+
+		unlimitedVolume := newVolume("")
+		Expect(plugin.Admit(ctx, volumeAttributes("bar", unlimitedVolume, nil, admission.Create), nil)).To(Succeed())
+		Expect(unlimitedVolume.Spec.Resources).To(BeNil())
+	})
+
+	It("should reject volumes outside of the limits", func(ctx SpecContext) {
+		Expect(plugin.Validate(ctx, volumeAttributes("foo", newVolume("20Gi"), nil, admission.Create), nil)).To(Succeed())
+
+		err := plugin.Validate(ctx, volumeAttributes("foo", newVolume("1Gi"), nil, admission.Create), nil)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+
+		err = plugin.Validate(ctx, volumeAttributes("foo", newVolume("3Ti"), newVolume("20Gi"), admission.Update), nil)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+
+		Expect(plugin.Validate(ctx, volumeAttributes("bar", newVolume("3Ti"), nil, admission.Create), nil)).To(Succeed())
+	})
+
+	It("should reject machines using a class that is not allowed", func(ctx SpecContext) {
+		newMachine := func(className string) *compute.Machine {
+			return &compute.Machine{Spec: compute.MachineSpec{MachineClassRef: corev1.LocalObjectReference{Name: className}}}
+		}
+
+		Expect(plugin.Validate(ctx, machineAttributes("foo", newMachine("allowed")), nil)).To(Succeed())
+
+		err := plugin.Validate(ctx, machineAttributes("foo", newMachine("other")), nil)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue(), "unexpected error %v", err)
+
+		Expect(plugin.Validate(ctx, machineAttributes("bar", newMachine("other")), nil)).To(Succeed())
+	})
+})
@@ -5,6 +5,7 @@ package resourcequota
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -15,6 +16,8 @@ import (
 	"github.com/ironcore-dev/ironcore/utils/quota"
 	ironcoreutilruntime "github.com/ironcore-dev/ironcore/utils/runtime"
 	utilslices "github.com/ironcore-dev/ironcore/utils/slices"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/admission"
@@ -337,26 +340,20 @@ func (e *EvaluatorController) checkRequest(ctx context.Context, quotas []corev1a
 		indexes = append(indexes, i)
 	}
 
-	deltaUsage, err := evaluator.Usage(ctx, obj)
+	usage, err := evaluator.Usage(ctx, obj)
 	if err != nil {
 		return nil, fmt.Errorf("error determining usage: %w", err)
 	}
-	if negativeUsage := quota.IsNegative(deltaUsage); negativeUsage.Len() > 0 {
+	if negativeUsage := quota.IsNegative(usage); negativeUsage.Len() > 0 {
 		return nil, admission.NewForbidden(a, fmt.Errorf("quota usage is negative for resource(s): %v", sets.List(negativeUsage)))
 	}
 
+	var prevUsage corev1alpha1.ResourceList
 	if oldObj != nil {
-		prevUsage, err := evaluator.Usage(ctx, oldObj)
+		prevUsage, err = evaluator.Usage(ctx, oldObj)
 		if err != nil {
 			return nil, fmt.Errorf("error determining old usage: %w", err)
 		}
-
-		deltaUsage = quota.SubtractWithNonNegativeResult(deltaUsage, prevUsage)
-	}
-
-	deltaUsage = quota.RemoveZeros(deltaUsage)
-	if len(deltaUsage) == 0 {
-		return quotas, nil
 	}
 
 	if len(indexes) == 0 {
@@ -367,6 +364,24 @@ func (e *EvaluatorController) checkRequest(ctx context.Context, quotas []corev1a
 	for _, i := range indexes {
 		resourceQuota := outQuotas[i]
 
+		// The old object only accounts for the usage of a quota if it matched its scopes as well,
+		// e.g. a machine that is powered on does not yet account for quotas scoped to powered-on machines.
+		deltaUsage := usage
+		if oldObj != nil {
+			oldMatch, err := quota.EvaluatorMatchesResourceScopeSelector(evaluator, oldObj, resourceQuota.Spec.ScopeSelector)
+			if err != nil {
+				return nil, fmt.Errorf("error matching scopes of quota %s for old object: %w", resourceQuota.Name, err)
+			}
+			if oldMatch {
+				deltaUsage = quota.SubtractWithNonNegativeResult(usage, prevUsage)
+			}
+		}
+
+		deltaUsage = quota.RemoveZeros(deltaUsage)
+		if len(deltaUsage) == 0 {
+			continue
+		}
+
 		hardResourceNames := quota.ResourceNames(resourceQuota.Status.Hard)
 		maskedDeltaUsage := quota.Mask(deltaUsage, hardResourceNames)
 		newUsage := quota.Add(resourceQuota.Status.Used, maskedDeltaUsage)
@@ -376,7 +391,7 @@ func (e *EvaluatorController) checkRequest(ctx context.Context, quotas []corev1a
 			failedRequestedUsage := quota.Mask(maskedDeltaUsage, exceeded)
 			failedUsed := quota.Mask(resourceQuota.Status.Used, exceeded)
 			failedHard := quota.Mask(resourceQuota.Status.Hard, exceeded)
-			return nil, admission.NewForbidden(a,
+			return nil, quotaExceededError(a,
 				fmt.Errorf("exceeded quota: %s, requested: %s, used: %s, limited: %s",
 					resourceQuota.Name,
 					prettyPrint(failedRequestedUsage),
@@ -389,6 +404,24 @@ func (e *EvaluatorController) checkRequest(ctx context.Context, quotas []corev1a
 	return outQuotas, nil
 }
 
+// quotaExceededError returns a forbidden error for the given attributes that carries a
+// corev1alpha1.CauseTypeQuotaExceeded cause, allowing clients to detect exhausted quota.
+func quotaExceededError(a admission.Attributes, err error) error {
+	forbiddenErr := admission.NewForbidden(a, err)
+
+	var statusErr *apierrors.StatusError
+	if errors.As(forbiddenErr, &statusErr) {
+		if statusErr.ErrStatus.Details == nil {
+			statusErr.ErrStatus.Details = &metav1.StatusDetails{}
+		}
+		statusErr.ErrStatus.Details.Causes = append(statusErr.ErrStatus.Details.Causes, metav1.StatusCause{
+			Type:    corev1alpha1.CauseTypeQuotaExceeded,
+			Message: err.Error(),
+		})
+	}
+	return forbiddenErr
+}
+
 // prettyPrint formats a resource list for usage in errors
 // it outputs resources sorted in increasing order
 func prettyPrint(item corev1alpha1.ResourceList) string {
@@ -66,3 +66,19 @@ func ValidatePrefixLength(ipFamily corev1.IPFamily, prefixLength int32, fldPath
 
 	return allErrs
 }
+
+func ValidateIPRange(ipFamily corev1.IPFamily, ipRange commonv1alpha1.IPRange, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !ipRange.IsValid() {
+		allErrs = append(allErrs, field.Invalid(fldPath, ipRange, "must specify a valid range"))
+	} else {
+		if !ironcorevalidation.IsSupportedIPFamily(ipFamily) {
+			allErrs = append(allErrs, field.Invalid(fldPath, ipRange, "cannot determine ip family for range"))
+		} else if ipFamily != ipRange.From.Family() {
+			allErrs = append(allErrs, field.Invalid(fldPath, ipRange, fmt.Sprintf("expected ip family %s but got %s", ipFamily, ipRange.From.Family())))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceLimitType is the type of object a ResourceLimitItem applies to.
+type ResourceLimitType string
+
+const (
+	// ResourceLimitTypeVolume applies a ResourceLimitItem to volumes.
+	ResourceLimitTypeVolume ResourceLimitType = "Volume"
+)
+
+// ResourceLimitItem defines the min / max / default resources of a single object of a ResourceLimitType.
+type ResourceLimitItem struct {
+	// Type is the type of object the limits apply to.
+	Type ResourceLimitType
+	// Min is the minimum amount of resources an object has to request.
+	Min ResourceList
+	// Max is the maximum amount of resources an object may request.
+	Max ResourceList
+	// Default is the amount of resources used for an object that does not request a resource.
+	Default ResourceList
+}
+
+// AllowedClasses restricts the classes of a ClassType that may be used.
+type AllowedClasses struct {
+	// ClassType is the type of class the restriction applies to.
+	ClassType ClassType
+	// Names are the names of the classes that may be used.
+	Names []string
+}
+
+// ResourceLimitSpec defines the desired state of ResourceLimit
+type ResourceLimitSpec struct {
+	// Limits are the ResourceLimitItems enforced for each object.
+	Limits []ResourceLimitItem
+	// AllowedClasses are the classes that may be used, per ClassType.
+	// If a ClassType is not listed, all classes of that type may be used.
+	AllowedClasses []AllowedClasses
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceLimit is the Schema for the resourcelimits API
+type ResourceLimit struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec ResourceLimitSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceLimitList contains a list of ResourceLimit
+type ResourceLimitList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []ResourceLimit
+}
@@ -32,6 +32,17 @@ const (
 	ResourceScopeVolumeClass ResourceScope = "VolumeClass"
 	// ResourceScopeBucketClass refers to the bucket class of a resource.
 	ResourceScopeBucketClass ResourceScope = "BucketClass"
+	// ResourceScopePublicIPPool refers to the public IP pool of a resource.
+	ResourceScopePublicIPPool ResourceScope = "PublicIPPool"
+	// ResourceScopeMachinePower refers to the desired power state of a machine (On / Off).
+	ResourceScopeMachinePower ResourceScope = "MachinePower"
+	// ResourceScopeMachinePool refers to the machine pool a machine is scheduled to.
+	ResourceScopeMachinePool ResourceScope = "MachinePool"
+	// ResourceScopeVolumePool refers to the volume pool a volume is scheduled to.
+	ResourceScopeVolumePool ResourceScope = "VolumePool"
+	// ResourceScopeVolumeEncryption refers to whether a volume is encrypted.
+	// Only the Exists / DoesNotExist operators are supported.
+	ResourceScopeVolumeEncryption ResourceScope = "VolumeEncryption"
 )
 
 // ResourceScopeSelectorOperator is an operator to compare a ResourceScope with values.
@@ -24,6 +24,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AllowedClasses)(nil), (*core.AllowedClasses)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AllowedClasses_To_core_AllowedClasses(a.(*v1alpha1.AllowedClasses), b.(*core.AllowedClasses), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AllowedClasses)(nil), (*v1alpha1.AllowedClasses)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AllowedClasses_To_v1alpha1_AllowedClasses(a.(*core.AllowedClasses), b.(*v1alpha1.AllowedClasses), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.ObjectSelector)(nil), (*core.ObjectSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ObjectSelector_To_core_ObjectSelector(a.(*v1alpha1.ObjectSelector), b.(*core.ObjectSelector), scope)
 	}); err != nil {
@@ -34,6 +44,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResourceLimit)(nil), (*core.ResourceLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceLimit_To_core_ResourceLimit(a.(*v1alpha1.ResourceLimit), b.(*core.ResourceLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ResourceLimit)(nil), (*v1alpha1.ResourceLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ResourceLimit_To_v1alpha1_ResourceLimit(a.(*core.ResourceLimit), b.(*v1alpha1.ResourceLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResourceLimitItem)(nil), (*core.ResourceLimitItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceLimitItem_To_core_ResourceLimitItem(a.(*v1alpha1.ResourceLimitItem), b.(*core.ResourceLimitItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ResourceLimitItem)(nil), (*v1alpha1.ResourceLimitItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ResourceLimitItem_To_v1alpha1_ResourceLimitItem(a.(*core.ResourceLimitItem), b.(*v1alpha1.ResourceLimitItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResourceLimitList)(nil), (*core.ResourceLimitList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceLimitList_To_core_ResourceLimitList(a.(*v1alpha1.ResourceLimitList), b.(*core.ResourceLimitList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ResourceLimitList)(nil), (*v1alpha1.ResourceLimitList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ResourceLimitList_To_v1alpha1_ResourceLimitList(a.(*core.ResourceLimitList), b.(*v1alpha1.ResourceLimitList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResourceLimitSpec)(nil), (*core.ResourceLimitSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceLimitSpec_To_core_ResourceLimitSpec(a.(*v1alpha1.ResourceLimitSpec), b.(*core.ResourceLimitSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ResourceLimitSpec)(nil), (*v1alpha1.ResourceLimitSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ResourceLimitSpec_To_v1alpha1_ResourceLimitSpec(a.(*core.ResourceLimitSpec), b.(*v1alpha1.ResourceLimitSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResourceQuota)(nil), (*core.ResourceQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResourceQuota_To_core_ResourceQuota(a.(*v1alpha1.ResourceQuota), b.(*core.ResourceQuota), scope)
 	}); err != nil {
@@ -97,6 +147,28 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_AllowedClasses_To_core_AllowedClasses(in *v1alpha1.AllowedClasses, out *core.AllowedClasses, s conversion.Scope) error {
+	out.ClassType = core.ClassType(in.ClassType)
+	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
+	return nil
+}
+
+// Convert_v1alpha1_AllowedClasses_To_core_AllowedClasses is an autogenerated conversion function.
+func Convert_v1alpha1_AllowedClasses_To_core_AllowedClasses(in *v1alpha1.AllowedClasses, out *core.AllowedClasses, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AllowedClasses_To_core_AllowedClasses(in, out, s)
+}
+
+func autoConvert_core_AllowedClasses_To_v1alpha1_AllowedClasses(in *core.AllowedClasses, out *v1alpha1.AllowedClasses, s conversion.Scope) error {
+	out.ClassType = v1alpha1.ClassType(in.ClassType)
+	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
+	return nil
+}
+
+// Convert_core_AllowedClasses_To_v1alpha1_AllowedClasses is an autogenerated conversion function.
+func Convert_core_AllowedClasses_To_v1alpha1_AllowedClasses(in *core.AllowedClasses, out *v1alpha1.AllowedClasses, s conversion.Scope) error {
+	return autoConvert_core_AllowedClasses_To_v1alpha1_AllowedClasses(in, out, s)
+}
+
 func autoConvert_v1alpha1_ObjectSelector_To_core_ObjectSelector(in *v1alpha1.ObjectSelector, out *core.ObjectSelector, s conversion.Scope) error {
 	out.Kind = in.Kind
 	out.LabelSelector = in.LabelSelector
@@ -119,6 +191,102 @@ func Convert_core_ObjectSelector_To_v1alpha1_ObjectSelector(in *core.ObjectSelec
 	return autoConvert_core_ObjectSelector_To_v1alpha1_ObjectSelector(in, out, s)
 }
 
+func autoConvert_v1alpha1_ResourceLimit_To_core_ResourceLimit(in *v1alpha1.ResourceLimit, out *core.ResourceLimit, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ResourceLimitSpec_To_core_ResourceLimitSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ResourceLimit_To_core_ResourceLimit is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceLimit_To_core_ResourceLimit(in *v1alpha1.ResourceLimit, out *core.ResourceLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceLimit_To_core_ResourceLimit(in, out, s)
+}
+
+func autoConvert_core_ResourceLimit_To_v1alpha1_ResourceLimit(in *core.ResourceLimit, out *v1alpha1.ResourceLimit, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ResourceLimitSpec_To_v1alpha1_ResourceLimitSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ResourceLimit_To_v1alpha1_ResourceLimit is an autogenerated conversion function.
+func Convert_core_ResourceLimit_To_v1alpha1_ResourceLimit(in *core.ResourceLimit, out *v1alpha1.ResourceLimit, s conversion.Scope) error {
+	return autoConvert_core_ResourceLimit_To_v1alpha1_ResourceLimit(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceLimitItem_To_core_ResourceLimitItem(in *v1alpha1.ResourceLimitItem, out *core.ResourceLimitItem, s conversion.Scope) error {
+	out.Type = core.ResourceLimitType(in.Type)
+	out.Min = *(*core.ResourceList)(unsafe.Pointer(&in.Min))
+	out.Max = *(*core.ResourceList)(unsafe.Pointer(&in.Max))
+	out.Default = *(*core.ResourceList)(unsafe.Pointer(&in.Default))
+	return nil
+}
+
+// Convert_v1alpha1_ResourceLimitItem_To_core_ResourceLimitItem is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceLimitItem_To_core_ResourceLimitItem(in *v1alpha1.ResourceLimitItem, out *core.ResourceLimitItem, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceLimitItem_To_core_ResourceLimitItem(in, out, s)
+}
+
+func autoConvert_core_ResourceLimitItem_To_v1alpha1_ResourceLimitItem(in *core.ResourceLimitItem, out *v1alpha1.ResourceLimitItem, s conversion.Scope) error {
+	out.Type = v1alpha1.ResourceLimitType(in.Type)
+	out.Min = *(*v1alpha1.ResourceList)(unsafe.Pointer(&in.Min))
+	out.Max = *(*v1alpha1.ResourceList)(unsafe.Pointer(&in.Max))
+	out.Default = *(*v1alpha1.ResourceList)(unsafe.Pointer(&in.Default))
+	return nil
+}
+
+// Convert_core_ResourceLimitItem_To_v1alpha1_ResourceLimitItem is an autogenerated conversion function.
+func Convert_core_ResourceLimitItem_To_v1alpha1_ResourceLimitItem(in *core.ResourceLimitItem, out *v1alpha1.ResourceLimitItem, s conversion.Scope) error {
+	return autoConvert_core_ResourceLimitItem_To_v1alpha1_ResourceLimitItem(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceLimitList_To_core_ResourceLimitList(in *v1alpha1.ResourceLimitList, out *core.ResourceLimitList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.ResourceLimit)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ResourceLimitList_To_core_ResourceLimitList is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceLimitList_To_core_ResourceLimitList(in *v1alpha1.ResourceLimitList, out *core.ResourceLimitList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceLimitList_To_core_ResourceLimitList(in, out, s)
+}
+
+func autoConvert_core_ResourceLimitList_To_v1alpha1_ResourceLimitList(in *core.ResourceLimitList, out *v1alpha1.ResourceLimitList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.ResourceLimit)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_ResourceLimitList_To_v1alpha1_ResourceLimitList is an autogenerated conversion function.
+func Convert_core_ResourceLimitList_To_v1alpha1_ResourceLimitList(in *core.ResourceLimitList, out *v1alpha1.ResourceLimitList, s conversion.Scope) error {
+	return autoConvert_core_ResourceLimitList_To_v1alpha1_ResourceLimitList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceLimitSpec_To_core_ResourceLimitSpec(in *v1alpha1.ResourceLimitSpec, out *core.ResourceLimitSpec, s conversion.Scope) error {
+	out.Limits = *(*[]core.ResourceLimitItem)(unsafe.Pointer(&in.Limits))
+	out.AllowedClasses = *(*[]core.AllowedClasses)(unsafe.Pointer(&in.AllowedClasses))
+	return nil
+}
+
+// Convert_v1alpha1_ResourceLimitSpec_To_core_ResourceLimitSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceLimitSpec_To_core_ResourceLimitSpec(in *v1alpha1.ResourceLimitSpec, out *core.ResourceLimitSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceLimitSpec_To_core_ResourceLimitSpec(in, out, s)
+}
+
+func autoConvert_core_ResourceLimitSpec_To_v1alpha1_ResourceLimitSpec(in *core.ResourceLimitSpec, out *v1alpha1.ResourceLimitSpec, s conversion.Scope) error {
+	out.Limits = *(*[]v1alpha1.ResourceLimitItem)(unsafe.Pointer(&in.Limits))
+	out.AllowedClasses = *(*[]v1alpha1.AllowedClasses)(unsafe.Pointer(&in.AllowedClasses))
+	return nil
+}
+
+// Convert_core_ResourceLimitSpec_To_v1alpha1_ResourceLimitSpec is an autogenerated conversion function.
+func Convert_core_ResourceLimitSpec_To_v1alpha1_ResourceLimitSpec(in *core.ResourceLimitSpec, out *v1alpha1.ResourceLimitSpec, s conversion.Scope) error {
+	return autoConvert_core_ResourceLimitSpec_To_v1alpha1_ResourceLimitSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_ResourceQuota_To_core_ResourceQuota(in *v1alpha1.ResourceQuota, out *core.ResourceQuota, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ResourceQuotaSpec_To_core_ResourceQuotaSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
+	"github.com/ironcore-dev/ironcore/internal/apis/core"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	supportedResourceLimitTypes = sets.New(
+		core.ResourceLimitTypeVolume,
+	)
+
+	supportedResourceLimitResourceNames = map[core.ResourceLimitType]sets.Set[core.ResourceName]{
+		core.ResourceLimitTypeVolume: sets.New(core.ResourceStorage),
+	}
+
+	supportedClassTypes = sets.New(
+		core.ClassTypeMachineClass,
+		core.ClassTypeVolumeClass,
+	)
+)
+
+func ValidateResourceLimit(resourceLimit *core.ResourceLimit) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(resourceLimit, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateResourceLimitSpec(&resourceLimit.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateResourceLimitSpec(spec *core.ResourceLimitSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seenTypes := sets.New[core.ResourceLimitType]()
+	for i := range spec.Limits {
+		item := &spec.Limits[i]
+		itemPath := fldPath.Child("limits").Index(i)
+
+		if seenTypes.Has(item.Type) {
+			allErrs = append(allErrs, field.Duplicate(itemPath.Child("type"), item.Type))
+		}
+		seenTypes.Insert(item.Type)
+
+		allErrs = append(allErrs, validateResourceLimitItem(item, itemPath)...)
+	}
+
+	seenClassTypes := sets.New[core.ClassType]()
+	for i := range spec.AllowedClasses {
+		allowedClasses := &spec.AllowedClasses[i]
+		allowedClassesPath := fldPath.Child("allowedClasses").Index(i)
+
+		if seenClassTypes.Has(allowedClasses.ClassType) {
+			allErrs = append(allErrs, field.Duplicate(allowedClassesPath.Child("classType"), allowedClasses.ClassType))
+		}
+		seenClassTypes.Insert(allowedClasses.ClassType)
+
+		allErrs = append(allErrs, validateAllowedClasses(allowedClasses, allowedClassesPath)...)
+	}
+
+	return allErrs
+}
+
+func validateResourceLimitItem(item *core.ResourceLimitItem, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !supportedResourceLimitTypes.Has(item.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), item.Type, sets.List(supportedResourceLimitTypes)))
+		return allErrs
+	}
+
+	supportedNames := supportedResourceLimitResourceNames[item.Type]
+	for fieldName, resources := range map[string]core.ResourceList{
+		"min":     item.Min,
+		"max":     item.Max,
+		"default": item.Default,
+	} {
+		for name, quantity := range resources {
+			namePath := fldPath.Child(fieldName).Key(string(name))
+			if !supportedNames.Has(name) {
+				allErrs = append(allErrs, field.NotSupported(namePath, name, sets.List(supportedNames)))
+				continue
+			}
+			allErrs = append(allErrs, ironcorevalidation.ValidateNonNegativeQuantity(quantity, namePath)...)
+		}
+	}
+
+	for name, minQuantity := range item.Min {
+		if maxQuantity, ok := item.Max[name]; ok && minQuantity.Cmp(maxQuantity) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("min").Key(string(name)), minQuantity.String(), fmt.Sprintf("must be less than or equal to max %s", maxQuantity.String())))
+		}
+		if defaultQuantity, ok := item.Default[name]; ok && minQuantity.Cmp(defaultQuantity) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("default").Key(string(name)), defaultQuantity.String(), fmt.Sprintf("must be greater than or equal to min %s", minQuantity.String())))
+		}
+	}
+	for name, maxQuantity := range item.Max {
+		if defaultQuantity, ok := item.Default[name]; ok && defaultQuantity.Cmp(maxQuantity) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("default").Key(string(name)), defaultQuantity.String(), fmt.Sprintf("must be less than or equal to max %s", maxQuantity.String())))
+		}
+	}
+
+	return allErrs
+}
+
+func validateAllowedClasses(allowedClasses *core.AllowedClasses, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !supportedClassTypes.Has(allowedClasses.ClassType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("classType"), allowedClasses.ClassType, sets.List(supportedClassTypes)))
+	}
+
+	for i, name := range allowedClasses.Names {
+		for _, msg := range apivalidation.NameIsDNSLabel(name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("names").Index(i), name, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateResourceLimitUpdate(newResourceLimit, oldResourceLimit *core.ResourceLimit) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newResourceLimit, oldResourceLimit, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateResourceLimitSpec(&newResourceLimit.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/core"
+	. "github.com/ironcore-dev/ironcore/internal/apis/core/validation"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ResourceLimit", func() {
+	DescribeTable("ValidateResourceLimit",
+		func(resourceLimit *core.ResourceLimit, match types.GomegaMatcher) {
+			errList := ValidateResourceLimit(resourceLimit)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&core.ResourceLimit{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("missing namespace",
+			&core.ResourceLimit{ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+			ContainElement(RequiredField("metadata.namespace")),
+		),
+		Entry("unsupported limit type",
+			&core.ResourceLimit{
+				Spec: core.ResourceLimitSpec{
+					Limits: []core.ResourceLimitItem{{Type: "Bucket"}},
+				},
+			},
+			ContainElement(NotSupportedField("spec.limits[0].type")),
+		),
+		Entry("duplicate limit type",
+			&core.ResourceLimit{
+				Spec: core.ResourceLimitSpec{
+					Limits: []core.ResourceLimitItem{
+						{Type: core.ResourceLimitTypeVolume},
+						{Type: core.ResourceLimitTypeVolume},
+					},
+				},
+			},
+			ContainElement(DuplicateField("spec.limits[1].type")),
+		),
+		Entry("unsupported resource name",
+			&core.ResourceLimit{
+				Spec: core.ResourceLimitSpec{
+					Limits: []core.ResourceLimitItem{
+						{
+							Type: core.ResourceLimitTypeVolume,
+							Max:  core.ResourceList{core.ResourceCPU: resource.MustParse("1")},
+						},
+					},
+				},
+			},
+			ContainElement(NotSupportedField("spec.limits[0].max[cpu]")),
+		),
+		Entry("negative quantity",
+			&core.ResourceLimit{
+				Spec: core.ResourceLimitSpec{
+					Limits: []core.ResourceLimitItem{
+						{
+							Type: core.ResourceLimitTypeVolume,
+							Min:  core.ResourceList{core.ResourceStorage: resource.MustParse("-1Gi")},
+						},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.limits[0].min[storage]")),
+		),
+		Entry("min greater than max",
+			&core.ResourceLimit{
+				Spec: core.ResourceLimitSpec{
+					Limits: []core.ResourceLimitItem{
+						{
+							Type: core.ResourceLimitTypeVolume,
+							Min:  core.ResourceList{core.ResourceStorage: resource.MustParse("2Ti")},
+							Max:  core.ResourceList{core.ResourceStorage: resource.MustParse("10Gi")},
+						},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.limits[0].min[storage]")),
+		),
+		Entry("default out of range",
+			&core.ResourceLimit{
+				Spec: core.ResourceLimitSpec{
+					Limits: []core.ResourceLimitItem{
+						{
+							Type:    core.ResourceLimitTypeVolume,
+							Min:     core.ResourceList{core.ResourceStorage: resource.MustParse("10Gi")},
+							Max:     core.ResourceList{core.ResourceStorage: resource.MustParse("2Ti")},
+							Default: core.ResourceList{core.ResourceStorage: resource.MustParse("1Gi")},
+						},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.limits[0].default[storage]")),
+		),
+		Entry("valid limits",
+			&core.ResourceLimit{
+				Spec: core.ResourceLimitSpec{
+					Limits: []core.ResourceLimitItem{
+						{
+							Type:    core.ResourceLimitTypeVolume,
+							Min:     core.ResourceList{core.ResourceStorage: resource.MustParse("10Gi")},
+							Max:     core.ResourceList{core.ResourceStorage: resource.MustParse("2Ti")},
+							Default: core.ResourceList{core.ResourceStorage: resource.MustParse("100Gi")},
+						},
+					},
+				},
+			},
+			Not(ContainElement(HaveField("Field", HavePrefix("spec.limits")))),
+		),
+		Entry("unsupported class type",
+			&core.ResourceLimit{
+				Spec: core.ResourceLimitSpec{
+					AllowedClasses: []core.AllowedClasses{{ClassType: "bucket"}},
+				},
+			},
+			ContainElement(NotSupportedField("spec.allowedClasses[0].classType")),
+		),
+		Entry("duplicate class type",
+			&core.ResourceLimit{
+				Spec: core.ResourceLimitSpec{
+					AllowedClasses: []core.AllowedClasses{
+						{ClassType: core.ClassTypeMachineClass},
+						{ClassType: core.ClassTypeMachineClass},
+					},
+				},
+			},
+			ContainElement(DuplicateField("spec.allowedClasses[1].classType")),
+		),
+		Entry("invalid class name",
+			&core.ResourceLimit{
+				Spec: core.ResourceLimitSpec{
+					AllowedClasses: []core.AllowedClasses{
+						{ClassType: core.ClassTypeVolumeClass, Names: []string{"foo*"}},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.allowedClasses[0].names[0]")),
+		),
+	)
+
+	DescribeTable("ValidateResourceLimitUpdate",
+		func(newResourceLimit, oldResourceLimit *core.ResourceLimit, match types.GomegaMatcher) {
+			errList := ValidateResourceLimitUpdate(newResourceLimit, oldResourceLimit)
+			Expect(errList).To(match)
+		},
+		Entry("resource version missing",
+			&core.ResourceLimit{},
+			&core.ResourceLimit{},
+			ContainElement(InvalidField("metadata.resourceVersion")),
+		),
+	)
+})
@@ -23,10 +23,33 @@ type PrefixSpec struct {
 	// If ParentRef and ParentSelector is empty, the Prefix is considered a root prefix and thus
 	// allocated by itself.
 	ParentRef *corev1.LocalObjectReference
+	// ParentNamespace is the namespace of the parent referenced by ParentRef.
+	// If unset, the parent is looked up in the namespace of the Prefix.
+	// Allocating from a parent in another namespace requires a PrefixGrant in the parent's namespace.
+	ParentNamespace string
 	// ParentSelector is the LabelSelector to use for determining the parent for this Prefix.
 	ParentSelector *metav1.LabelSelector
+
+	// ReservedRanges are ranges of the prefix that are never allocated to sub-prefixes,
+	// for example gateway, broadcast or DHCP addresses.
+	ReservedRanges []commonv1alpha1.IPRange
+	// AllocationStrategy is the strategy used to allocate sub-prefixes by length.
+	// If unset, PrefixAllocationStrategyBestFit is used.
+	AllocationStrategy PrefixAllocationStrategy
 }
 
+// PrefixAllocationStrategy is a strategy to allocate sub-prefixes by length.
+type PrefixAllocationStrategy string
+
+const (
+	// PrefixAllocationStrategyBestFit allocates from the smallest free block that fits the requested length.
+	PrefixAllocationStrategyBestFit PrefixAllocationStrategy = "BestFit"
+	// PrefixAllocationStrategyFirstFit allocates the free block with the lowest address.
+	PrefixAllocationStrategyFirstFit PrefixAllocationStrategy = "FirstFit"
+	// PrefixAllocationStrategyRandom allocates a random free block, spreading allocations over the prefix.
+	PrefixAllocationStrategyRandom PrefixAllocationStrategy = "Random"
+)
+
 func (s *PrefixSpec) IsRoot() bool {
 	return s.ParentRef == nil && s.ParentSelector == nil
 }
@@ -40,6 +63,25 @@ type PrefixStatus struct {
 
 	// Used is a list of used prefixes.
 	Used []commonv1alpha1.IPPrefix
+
+	// Utilization reports the address utilization of the prefix.
+	Utilization *PrefixUtilization
+}
+
+// PrefixUtilization reports the address utilization of a Prefix.
+// Address counts are decimal strings since they may exceed 64 bits for IPv6 prefixes.
+type PrefixUtilization struct {
+	// Total is the total number of addresses of the prefix.
+	Total string
+	// Reserved is the number of addresses covered by reserved ranges.
+	Reserved string
+	// Used is the number of addresses allocated to sub-prefixes.
+	Used string
+	// Free is the number of addresses that are neither reserved nor used.
+	Free string
+	// LargestFreePrefixLength is the length of the largest free block.
+	// It is unset if there are no free addresses.
+	LargestFreePrefixLength *int32
 }
 
 // PrefixPhase is a phase a Prefix can be in.
@@ -18,9 +18,20 @@ type PrefixAllocationSpec struct {
 	Prefix *commonv1alpha1.IPPrefix
 	// PrefixLength is the length of prefix to allocate for this Prefix.
 	PrefixLength int32
+	// Range is the exact range of addresses to allocate.
+	Range *commonv1alpha1.IPRange
+	// RangeLength is the number of contiguous addresses to allocate as a range.
+	RangeLength int32
+	// IPCount is the number of single addresses to allocate.
+	// The lowest free addresses are allocated, they are not necessarily contiguous.
+	IPCount int32
 
 	// PrefixRef references the prefix to allocate from.
 	PrefixRef *corev1.LocalObjectReference
+	// PrefixNamespace is the namespace of the prefix referenced by PrefixRef.
+	// If unset, the prefix is looked up in the namespace of the PrefixAllocation.
+	// Allocating from a prefix in another namespace requires a PrefixGrant in the prefix's namespace.
+	PrefixNamespace string
 	// PrefixSelector selects the prefix to allocate from.
 	PrefixSelector *metav1.LabelSelector
 }
@@ -41,6 +52,10 @@ const (
 type PrefixAllocationStatus struct {
 	// Prefix is the allocated prefix, if any
 	Prefix *commonv1alpha1.IPPrefix
+	// Range is the allocated range, if any.
+	Range *commonv1alpha1.IPRange
+	// IPs are the allocated addresses, if any.
+	IPs []commonv1alpha1.IP
 	// LastPhaseTransitionTime is the last time the Phase changed values.
 	LastPhaseTransitionTime *metav1.Time
 
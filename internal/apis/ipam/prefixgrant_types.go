@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PrefixGrantSpec defines the desired state of PrefixGrant
+type PrefixGrantSpec struct {
+	// PrefixRef references the prefix in the namespace of the PrefixGrant that may be allocated from.
+	PrefixRef corev1.LocalObjectReference
+	// Namespaces are the namespaces that are allowed to allocate from the referenced prefix.
+	Namespaces []string
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PrefixGrant authorizes other namespaces to allocate from a Prefix.
+type PrefixGrant struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec PrefixGrantSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PrefixGrantList contains a list of PrefixGrant
+type PrefixGrantList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []PrefixGrant
+}
@@ -34,6 +34,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&PrefixList{},
 		&PrefixAllocation{},
 		&PrefixAllocationList{},
+		&PrefixGrant{},
+		&PrefixGrantList{},
 	)
 	return nil
 }
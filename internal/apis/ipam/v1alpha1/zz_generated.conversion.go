@@ -77,6 +77,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PrefixGrant)(nil), (*ipam.PrefixGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrefixGrant_To_ipam_PrefixGrant(a.(*v1alpha1.PrefixGrant), b.(*ipam.PrefixGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ipam.PrefixGrant)(nil), (*v1alpha1.PrefixGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ipam_PrefixGrant_To_v1alpha1_PrefixGrant(a.(*ipam.PrefixGrant), b.(*v1alpha1.PrefixGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PrefixGrantList)(nil), (*ipam.PrefixGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrefixGrantList_To_ipam_PrefixGrantList(a.(*v1alpha1.PrefixGrantList), b.(*ipam.PrefixGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ipam.PrefixGrantList)(nil), (*v1alpha1.PrefixGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ipam_PrefixGrantList_To_v1alpha1_PrefixGrantList(a.(*ipam.PrefixGrantList), b.(*v1alpha1.PrefixGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PrefixGrantSpec)(nil), (*ipam.PrefixGrantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrefixGrantSpec_To_ipam_PrefixGrantSpec(a.(*v1alpha1.PrefixGrantSpec), b.(*ipam.PrefixGrantSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ipam.PrefixGrantSpec)(nil), (*v1alpha1.PrefixGrantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ipam_PrefixGrantSpec_To_v1alpha1_PrefixGrantSpec(a.(*ipam.PrefixGrantSpec), b.(*v1alpha1.PrefixGrantSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.PrefixList)(nil), (*ipam.PrefixList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PrefixList_To_ipam_PrefixList(a.(*v1alpha1.PrefixList), b.(*ipam.PrefixList), scope)
 	}); err != nil {
@@ -117,6 +147,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PrefixUtilization)(nil), (*ipam.PrefixUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrefixUtilization_To_ipam_PrefixUtilization(a.(*v1alpha1.PrefixUtilization), b.(*ipam.PrefixUtilization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ipam.PrefixUtilization)(nil), (*v1alpha1.PrefixUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ipam_PrefixUtilization_To_v1alpha1_PrefixUtilization(a.(*ipam.PrefixUtilization), b.(*v1alpha1.PrefixUtilization), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -230,7 +270,11 @@ func autoConvert_v1alpha1_PrefixAllocationSpec_To_ipam_PrefixAllocationSpec(in *
 	out.IPFamily = v1.IPFamily(in.IPFamily)
 	out.Prefix = (*commonv1alpha1.IPPrefix)(unsafe.Pointer(in.Prefix))
 	out.PrefixLength = in.PrefixLength
+	out.Range = (*commonv1alpha1.IPRange)(unsafe.Pointer(in.Range))
+	out.RangeLength = in.RangeLength
+	out.IPCount = in.IPCount
 	out.PrefixRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PrefixRef))
+	out.PrefixNamespace = in.PrefixNamespace
 	out.PrefixSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.PrefixSelector))
 	return nil
 }
@@ -244,7 +288,11 @@ func autoConvert_ipam_PrefixAllocationSpec_To_v1alpha1_PrefixAllocationSpec(in *
 	out.IPFamily = v1.IPFamily(in.IPFamily)
 	out.Prefix = (*commonv1alpha1.IPPrefix)(unsafe.Pointer(in.Prefix))
 	out.PrefixLength = in.PrefixLength
+	out.Range = (*commonv1alpha1.IPRange)(unsafe.Pointer(in.Range))
+	out.RangeLength = in.RangeLength
+	out.IPCount = in.IPCount
 	out.PrefixRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PrefixRef))
+	out.PrefixNamespace = in.PrefixNamespace
 	out.PrefixSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.PrefixSelector))
 	return nil
 }
@@ -256,6 +304,8 @@ func Convert_ipam_PrefixAllocationSpec_To_v1alpha1_PrefixAllocationSpec(in *ipam
 
 func autoConvert_v1alpha1_PrefixAllocationStatus_To_ipam_PrefixAllocationStatus(in *v1alpha1.PrefixAllocationStatus, out *ipam.PrefixAllocationStatus, s conversion.Scope) error {
 	out.Prefix = (*commonv1alpha1.IPPrefix)(unsafe.Pointer(in.Prefix))
+	out.Range = (*commonv1alpha1.IPRange)(unsafe.Pointer(in.Range))
+	out.IPs = *(*[]commonv1alpha1.IP)(unsafe.Pointer(&in.IPs))
 	out.Phase = ipam.PrefixAllocationPhase(in.Phase)
 	out.LastPhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastPhaseTransitionTime))
 	return nil
@@ -268,6 +318,8 @@ func Convert_v1alpha1_PrefixAllocationStatus_To_ipam_PrefixAllocationStatus(in *
 
 func autoConvert_ipam_PrefixAllocationStatus_To_v1alpha1_PrefixAllocationStatus(in *ipam.PrefixAllocationStatus, out *v1alpha1.PrefixAllocationStatus, s conversion.Scope) error {
 	out.Prefix = (*commonv1alpha1.IPPrefix)(unsafe.Pointer(in.Prefix))
+	out.Range = (*commonv1alpha1.IPRange)(unsafe.Pointer(in.Range))
+	out.IPs = *(*[]commonv1alpha1.IP)(unsafe.Pointer(&in.IPs))
 	out.LastPhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastPhaseTransitionTime))
 	out.Phase = v1alpha1.PrefixAllocationPhase(in.Phase)
 	return nil
@@ -278,6 +330,76 @@ func Convert_ipam_PrefixAllocationStatus_To_v1alpha1_PrefixAllocationStatus(in *
 	return autoConvert_ipam_PrefixAllocationStatus_To_v1alpha1_PrefixAllocationStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_PrefixGrant_To_ipam_PrefixGrant(in *v1alpha1.PrefixGrant, out *ipam.PrefixGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_PrefixGrantSpec_To_ipam_PrefixGrantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PrefixGrant_To_ipam_PrefixGrant is an autogenerated conversion function.
+func Convert_v1alpha1_PrefixGrant_To_ipam_PrefixGrant(in *v1alpha1.PrefixGrant, out *ipam.PrefixGrant, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrefixGrant_To_ipam_PrefixGrant(in, out, s)
+}
+
+func autoConvert_ipam_PrefixGrant_To_v1alpha1_PrefixGrant(in *ipam.PrefixGrant, out *v1alpha1.PrefixGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_ipam_PrefixGrantSpec_To_v1alpha1_PrefixGrantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_ipam_PrefixGrant_To_v1alpha1_PrefixGrant is an autogenerated conversion function.
+func Convert_ipam_PrefixGrant_To_v1alpha1_PrefixGrant(in *ipam.PrefixGrant, out *v1alpha1.PrefixGrant, s conversion.Scope) error {
+	return autoConvert_ipam_PrefixGrant_To_v1alpha1_PrefixGrant(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrefixGrantList_To_ipam_PrefixGrantList(in *v1alpha1.PrefixGrantList, out *ipam.PrefixGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ipam.PrefixGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_PrefixGrantList_To_ipam_PrefixGrantList is an autogenerated conversion function.
+func Convert_v1alpha1_PrefixGrantList_To_ipam_PrefixGrantList(in *v1alpha1.PrefixGrantList, out *ipam.PrefixGrantList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrefixGrantList_To_ipam_PrefixGrantList(in, out, s)
+}
+
+func autoConvert_ipam_PrefixGrantList_To_v1alpha1_PrefixGrantList(in *ipam.PrefixGrantList, out *v1alpha1.PrefixGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.PrefixGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_ipam_PrefixGrantList_To_v1alpha1_PrefixGrantList is an autogenerated conversion function.
+func Convert_ipam_PrefixGrantList_To_v1alpha1_PrefixGrantList(in *ipam.PrefixGrantList, out *v1alpha1.PrefixGrantList, s conversion.Scope) error {
+	return autoConvert_ipam_PrefixGrantList_To_v1alpha1_PrefixGrantList(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrefixGrantSpec_To_ipam_PrefixGrantSpec(in *v1alpha1.PrefixGrantSpec, out *ipam.PrefixGrantSpec, s conversion.Scope) error {
+	out.PrefixRef = in.PrefixRef
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_v1alpha1_PrefixGrantSpec_To_ipam_PrefixGrantSpec is an autogenerated conversion function.
+func Convert_v1alpha1_PrefixGrantSpec_To_ipam_PrefixGrantSpec(in *v1alpha1.PrefixGrantSpec, out *ipam.PrefixGrantSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrefixGrantSpec_To_ipam_PrefixGrantSpec(in, out, s)
+}
+
+func autoConvert_ipam_PrefixGrantSpec_To_v1alpha1_PrefixGrantSpec(in *ipam.PrefixGrantSpec, out *v1alpha1.PrefixGrantSpec, s conversion.Scope) error {
+	out.PrefixRef = in.PrefixRef
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_ipam_PrefixGrantSpec_To_v1alpha1_PrefixGrantSpec is an autogenerated conversion function.
+func Convert_ipam_PrefixGrantSpec_To_v1alpha1_PrefixGrantSpec(in *ipam.PrefixGrantSpec, out *v1alpha1.PrefixGrantSpec, s conversion.Scope) error {
+	return autoConvert_ipam_PrefixGrantSpec_To_v1alpha1_PrefixGrantSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_PrefixList_To_ipam_PrefixList(in *v1alpha1.PrefixList, out *ipam.PrefixList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]ipam.Prefix)(unsafe.Pointer(&in.Items))
@@ -305,7 +427,10 @@ func autoConvert_v1alpha1_PrefixSpec_To_ipam_PrefixSpec(in *v1alpha1.PrefixSpec,
 	out.Prefix = (*commonv1alpha1.IPPrefix)(unsafe.Pointer(in.Prefix))
 	out.PrefixLength = in.PrefixLength
 	out.ParentRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.ParentRef))
+	out.ParentNamespace = in.ParentNamespace
 	out.ParentSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ParentSelector))
+	out.ReservedRanges = *(*[]commonv1alpha1.IPRange)(unsafe.Pointer(&in.ReservedRanges))
+	out.AllocationStrategy = ipam.PrefixAllocationStrategy(in.AllocationStrategy)
 	return nil
 }
 
@@ -319,7 +444,10 @@ func autoConvert_ipam_PrefixSpec_To_v1alpha1_PrefixSpec(in *ipam.PrefixSpec, out
 	out.Prefix = (*commonv1alpha1.IPPrefix)(unsafe.Pointer(in.Prefix))
 	out.PrefixLength = in.PrefixLength
 	out.ParentRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.ParentRef))
+	out.ParentNamespace = in.ParentNamespace
 	out.ParentSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ParentSelector))
+	out.ReservedRanges = *(*[]commonv1alpha1.IPRange)(unsafe.Pointer(&in.ReservedRanges))
+	out.AllocationStrategy = v1alpha1.PrefixAllocationStrategy(in.AllocationStrategy)
 	return nil
 }
 
@@ -332,6 +460,7 @@ func autoConvert_v1alpha1_PrefixStatus_To_ipam_PrefixStatus(in *v1alpha1.PrefixS
 	out.Phase = ipam.PrefixPhase(in.Phase)
 	out.LastPhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastPhaseTransitionTime))
 	out.Used = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Used))
+	out.Utilization = (*ipam.PrefixUtilization)(unsafe.Pointer(in.Utilization))
 	return nil
 }
 
@@ -344,6 +473,7 @@ func autoConvert_ipam_PrefixStatus_To_v1alpha1_PrefixStatus(in *ipam.PrefixStatu
 	out.Phase = v1alpha1.PrefixPhase(in.Phase)
 	out.LastPhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastPhaseTransitionTime))
 	out.Used = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Used))
+	out.Utilization = (*v1alpha1.PrefixUtilization)(unsafe.Pointer(in.Utilization))
 	return nil
 }
 
@@ -377,3 +507,31 @@ func autoConvert_ipam_PrefixTemplateSpec_To_v1alpha1_PrefixTemplateSpec(in *ipam
 func Convert_ipam_PrefixTemplateSpec_To_v1alpha1_PrefixTemplateSpec(in *ipam.PrefixTemplateSpec, out *v1alpha1.PrefixTemplateSpec, s conversion.Scope) error {
 	return autoConvert_ipam_PrefixTemplateSpec_To_v1alpha1_PrefixTemplateSpec(in, out, s)
 }
+
+func autoConvert_v1alpha1_PrefixUtilization_To_ipam_PrefixUtilization(in *v1alpha1.PrefixUtilization, out *ipam.PrefixUtilization, s conversion.Scope) error {
+	out.Total = in.Total
+	out.Reserved = in.Reserved
+	out.Used = in.Used
+	out.Free = in.Free
+	out.LargestFreePrefixLength = (*int32)(unsafe.Pointer(in.LargestFreePrefixLength))
+	return nil
+}
+
+// Convert_v1alpha1_PrefixUtilization_To_ipam_PrefixUtilization is an autogenerated conversion function.
+func Convert_v1alpha1_PrefixUtilization_To_ipam_PrefixUtilization(in *v1alpha1.PrefixUtilization, out *ipam.PrefixUtilization, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrefixUtilization_To_ipam_PrefixUtilization(in, out, s)
+}
+
+func autoConvert_ipam_PrefixUtilization_To_v1alpha1_PrefixUtilization(in *ipam.PrefixUtilization, out *v1alpha1.PrefixUtilization, s conversion.Scope) error {
+	out.Total = in.Total
+	out.Reserved = in.Reserved
+	out.Used = in.Used
+	out.Free = in.Free
+	out.LargestFreePrefixLength = (*int32)(unsafe.Pointer(in.LargestFreePrefixLength))
+	return nil
+}
+
+// Convert_ipam_PrefixUtilization_To_v1alpha1_PrefixUtilization is an autogenerated conversion function.
+func Convert_ipam_PrefixUtilization_To_v1alpha1_PrefixUtilization(in *ipam.PrefixUtilization, out *v1alpha1.PrefixUtilization, s conversion.Scope) error {
+	return autoConvert_ipam_PrefixUtilization_To_v1alpha1_PrefixUtilization(in, out, s)
+}
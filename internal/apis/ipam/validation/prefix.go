@@ -68,6 +68,9 @@ func ValidatePrefixSpec(spec *ipam.PrefixSpec, fldPath *field.Path) field.ErrorL
 	allErrs = append(allErrs, validateIPFamilyAndOptionalPrefixAndLength(spec.IPFamily, spec.Prefix, spec.PrefixLength, fldPath)...)
 
 	if spec.IsRoot() {
+		if spec.ParentNamespace != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("parentNamespace"), "must not specify parentNamespace without parentRef"))
+		}
 		if spec.PrefixLength != 0 {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("prefixLength"), spec.PrefixLength, "cannot specify prefixLength for a root prefix"))
 		}
@@ -90,9 +93,47 @@ func ValidatePrefixSpec(spec *ipam.PrefixSpec, fldPath *field.Path) field.ErrorL
 		}
 
 		allErrs = append(allErrs, validateOptionalRef(spec.ParentRef, fldPath.Child("parentRef"))...)
+		if spec.ParentNamespace != "" {
+			allErrs = append(allErrs, validateNamespaceName(spec.ParentNamespace, fldPath.Child("parentNamespace"))...)
+			if spec.ParentRef == nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("parentNamespace"), "must not specify parentNamespace without parentRef"))
+			}
+		}
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ParentSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("parentSelector"))...)
 	}
 
+	allErrs = append(allErrs, validateReservedRanges(spec.IPFamily, spec.Prefix, spec.ReservedRanges, fldPath.Child("reservedRanges"))...)
+
+	if spec.AllocationStrategy != "" && !supportedPrefixAllocationStrategies.Has(spec.AllocationStrategy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("allocationStrategy"), spec.AllocationStrategy, sets.List(supportedPrefixAllocationStrategies)))
+	}
+
+	return allErrs
+}
+
+var supportedPrefixAllocationStrategies = sets.New(
+	ipam.PrefixAllocationStrategyBestFit,
+	ipam.PrefixAllocationStrategyFirstFit,
+	ipam.PrefixAllocationStrategyRandom,
+)
+
+func validateReservedRanges(ipFamily corev1.IPFamily, prefix *commonv1alpha1.IPPrefix, reservedRanges []commonv1alpha1.IPRange, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, reservedRange := range reservedRanges {
+		fldPath := fldPath.Index(i)
+		errs := commonvalidation.ValidateIPRange(ipFamily, reservedRange, fldPath)
+		allErrs = append(allErrs, errs...)
+		if len(errs) > 0 || !prefix.IsValid() {
+			continue
+		}
+
+		rng := reservedRange.Range()
+		if !prefix.Contains(rng.From()) || !prefix.Contains(rng.To()) {
+			allErrs = append(allErrs, field.Invalid(fldPath, reservedRange, fmt.Sprintf("not contained in prefix %s", prefix)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -126,6 +167,10 @@ func validatePrefixSpecUpdate(newSpec, oldSpec *ipam.PrefixSpec, fldPath *field.
 		oldSpecCopy.ParentRef = newSpecCopy.ParentRef
 	}
 
+	// Reserved ranges and the allocation strategy only affect future allocations and may be changed anytime.
+	oldSpecCopy.ReservedRanges = newSpecCopy.ReservedRanges
+	oldSpecCopy.AllocationStrategy = newSpecCopy.AllocationStrategy
+
 	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableFieldWithDiff(newSpecCopy, oldSpecCopy, fldPath)...)
 
 	return allErrs
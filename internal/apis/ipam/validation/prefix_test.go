@@ -145,6 +145,85 @@ var _ = Describe("Prefix", func() {
 			},
 			ContainElement(InvalidField("spec")),
 		),
+		Entry("invalid reserved range",
+			&ipam.Prefix{
+				Spec: ipam.PrefixSpec{
+					IPFamily:       corev1.IPv4Protocol,
+					Prefix:         commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/24"),
+					ReservedRanges: []commonv1alpha1.IPRange{{}},
+				},
+			},
+			ContainElement(InvalidField("spec.reservedRanges[0]")),
+		),
+		Entry("reserved range ip family mismatch",
+			&ipam.Prefix{
+				Spec: ipam.PrefixSpec{
+					IPFamily:       corev1.IPv4Protocol,
+					Prefix:         commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/24"),
+					ReservedRanges: []commonv1alpha1.IPRange{commonv1alpha1.MustParseIPRange("beef::1-beef::2")},
+				},
+			},
+			ContainElement(InvalidField("spec.reservedRanges[0]")),
+		),
+		Entry("reserved range not contained in prefix",
+			&ipam.Prefix{
+				Spec: ipam.PrefixSpec{
+					IPFamily:       corev1.IPv4Protocol,
+					Prefix:         commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/24"),
+					ReservedRanges: []commonv1alpha1.IPRange{commonv1alpha1.MustParseIPRange("10.0.0.250-10.0.1.5")},
+				},
+			},
+			ContainElement(InvalidField("spec.reservedRanges[0]")),
+		),
+		Entry("unsupported allocation strategy",
+			&ipam.Prefix{
+				Spec: ipam.PrefixSpec{
+					AllocationStrategy: "invalid",
+				},
+			},
+			ContainElement(NotSupportedField("spec.allocationStrategy")),
+		),
+		Entry("valid root prefix with reserved ranges and allocation strategy",
+			&ipam.Prefix{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+				Spec: ipam.PrefixSpec{
+					IPFamily: corev1.IPv4Protocol,
+					Prefix:   commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/24"),
+					ReservedRanges: []commonv1alpha1.IPRange{
+						commonv1alpha1.MustParseIPRange("10.0.0.0-10.0.0.1"),
+						commonv1alpha1.MustParseIPRange("10.0.0.255-10.0.0.255"),
+					},
+					AllocationStrategy: ipam.PrefixAllocationStrategyRandom,
+				},
+			},
+			BeEmpty(),
+		),
+		Entry("parent namespace on root prefix",
+			&ipam.Prefix{
+				Spec: ipam.PrefixSpec{
+					ParentNamespace: "foo",
+				},
+			},
+			ContainElement(ForbiddenField("spec.parentNamespace")),
+		),
+		Entry("parent namespace with parent selector",
+			&ipam.Prefix{
+				Spec: ipam.PrefixSpec{
+					ParentNamespace: "foo",
+					ParentSelector:  &metav1.LabelSelector{},
+				},
+			},
+			ContainElement(ForbiddenField("spec.parentNamespace")),
+		),
+		Entry("invalid parent namespace",
+			&ipam.Prefix{
+				Spec: ipam.PrefixSpec{
+					ParentRef:       &corev1.LocalObjectReference{Name: "parent"},
+					ParentNamespace: "foo*",
+				},
+			},
+			ContainElement(InvalidField("spec.parentNamespace")),
+		),
 		Entry("valid root prefix",
 			&ipam.Prefix{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
@@ -241,6 +320,21 @@ var _ = Describe("Prefix", func() {
 			},
 			Not(ContainElement(ImmutableField("spec"))),
 		),
+		Entry("mutable reserved ranges and allocation strategy",
+			&ipam.Prefix{
+				Spec: ipam.PrefixSpec{
+					Prefix:             commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/8"),
+					ReservedRanges:     []commonv1alpha1.IPRange{commonv1alpha1.MustParseIPRange("10.0.0.0-10.0.0.1")},
+					AllocationStrategy: ipam.PrefixAllocationStrategyFirstFit,
+				},
+			},
+			&ipam.Prefix{
+				Spec: ipam.PrefixSpec{
+					Prefix: commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/8"),
+				},
+			},
+			Not(ContainElement(ImmutableField("spec"))),
+		),
 		Entry("mutable prefix if unset",
 			&ipam.Prefix{
 				Spec: ipam.PrefixSpec{
@@ -5,16 +5,23 @@ package validation
 
 import (
 	"fmt"
+	"math/big"
 
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
 	commonvalidation "github.com/ironcore-dev/ironcore/internal/apis/common/validation"
 	"github.com/ironcore-dev/ironcore/internal/apis/ipam"
 	"github.com/ironcore-dev/ironcore/utils/equality"
+	utilsipam "github.com/ironcore-dev/ironcore/utils/ipam"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// maxPrefixAllocationIPCount is the maximum number of single addresses a PrefixAllocation may request.
+const maxPrefixAllocationIPCount = 1024
+
 func ValidatePrefixAllocation(prefixAllocation *ipam.PrefixAllocation) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -29,15 +36,40 @@ func validatePrefixAllocationSpec(spec *ipam.PrefixAllocationSpec, fldPath *fiel
 
 	allErrs = append(allErrs, validateIPFamilyAndOptionalPrefixAndLength(spec.IPFamily, spec.Prefix, spec.PrefixLength, fldPath)...)
 	allErrs = append(allErrs, validateOptionalRef(spec.PrefixRef, fldPath.Child("prefixRef"))...)
+	if spec.PrefixNamespace != "" {
+		allErrs = append(allErrs, validateNamespaceName(spec.PrefixNamespace, fldPath.Child("prefixNamespace"))...)
+		if spec.PrefixRef == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("prefixNamespace"), "must not specify prefixNamespace without prefixRef"))
+		}
+	}
 	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.PrefixSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("prefixSelector"))...)
 
-	var numRequests int
-	if spec.Prefix != nil {
-		numRequests++
+	if spec.Range != nil {
+		allErrs = append(allErrs, commonvalidation.ValidateIPRange(spec.IPFamily, *spec.Range, fldPath.Child("range"))...)
+	}
+	if spec.RangeLength < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rangeLength"), spec.RangeLength, "must be > 0"))
 	}
-	if spec.PrefixLength > 0 {
+	if spec.IPCount < 0 || spec.IPCount > maxPrefixAllocationIPCount {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipCount"), spec.IPCount, fmt.Sprintf("must be > 0 and <= %d", maxPrefixAllocationIPCount)))
+	}
+
+	var numRequests int
+	for _, request := range []struct {
+		name string
+		set  bool
+	}{
+		{"prefix", spec.Prefix != nil},
+		{"prefixLength", spec.PrefixLength > 0},
+		{"range", spec.Range != nil},
+		{"rangeLength", spec.RangeLength > 0},
+		{"ipCount", spec.IPCount > 0},
+	} {
+		if !request.set {
+			continue
+		}
 		if numRequests > 0 {
-			allErrs = append(allErrs, field.Forbidden(fldPath.Child("prefixLength"), "must not specify more than 1 request"))
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(request.name), "must not specify more than 1 request"))
 		} else {
 			numRequests++
 		}
@@ -91,16 +123,43 @@ func ValidatePrefixAllocationStatus(status *ipam.PrefixAllocationStatus, fldPath
 	if status.Prefix != nil {
 		allErrs = append(allErrs, commonvalidation.ValidateIPPrefix(status.Prefix.IP().Family(), *status.Prefix, fldPath.Child("prefix"))...)
 	}
+	if status.Range != nil {
+		allErrs = append(allErrs, commonvalidation.ValidateIPRange(status.Range.From.Family(), *status.Range, fldPath.Child("range"))...)
+	}
+	seenIPs := sets.New[commonv1alpha1.IP]()
+	for i, ip := range status.IPs {
+		allErrs = append(allErrs, commonvalidation.ValidateIP(ip.Family(), ip, fldPath.Child("ips").Index(i))...)
+		if seenIPs.Has(ip) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("ips").Index(i), ip))
+		}
+		seenIPs.Insert(ip)
+	}
+
+	var numResults int
+	for _, set := range []bool{status.Prefix != nil, status.Range != nil, len(status.IPs) > 0} {
+		if set {
+			numResults++
+		}
+	}
 
 	switch status.Phase {
 	case ipam.PrefixAllocationPhaseAllocated:
-		if status.Prefix == nil {
-			allErrs = append(allErrs, field.Required(fldPath.Child("prefix"), "must specify prefix when allocated"))
+		if numResults == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("prefix"), "must specify prefix, range or ips when allocated"))
+		}
+		if numResults > 1 {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "must not specify more than 1 of prefix, range and ips"))
 		}
 	default:
 		if status.Prefix != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("prefix"), "must not specify a prefix when not allocated"))
 		}
+		if status.Range != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("range"), "must not specify a range when not allocated"))
+		}
+		if len(status.IPs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("ips"), "must not specify ips when not allocated"))
+		}
 	}
 
 	return allErrs
@@ -141,5 +200,39 @@ func ValidatePrefixAllocationStatusUpdate(newPrefixAllocation, oldPrefixAllocati
 		}
 	}
 
+	statusRangeField := statusField.Child("range")
+	if newStatusRange := newPrefixAllocation.Status.Range; newStatusRange != nil {
+		allErrs = append(allErrs, commonvalidation.ValidateIPRange(newPrefixAllocation.Spec.IPFamily, *newStatusRange, statusRangeField)...)
+
+		if newSpecRange := newPrefixAllocation.Spec.Range; newSpecRange != nil && *newSpecRange != *newStatusRange {
+			allErrs = append(allErrs, field.Forbidden(statusRangeField, fmt.Sprintf("does not match spec range %s", newSpecRange)))
+		}
+
+		if newSpecRangeLength := newPrefixAllocation.Spec.RangeLength; newSpecRangeLength > 0 {
+			if rng := newStatusRange.Range(); rng.IsValid() && utilsipam.IPRangeSize(rng).Cmp(big.NewInt(int64(newSpecRangeLength))) != 0 {
+				allErrs = append(allErrs, field.Forbidden(statusRangeField, fmt.Sprintf("does not match spec range length %d", newSpecRangeLength)))
+			}
+		}
+
+		if newPrefixAllocation.Spec.PrefixRef == nil {
+			allErrs = append(allErrs, field.Forbidden(statusRangeField, "spec.prefixRef needs to be set first"))
+		}
+	}
+
+	statusIPsField := statusField.Child("ips")
+	if newStatusIPs := newPrefixAllocation.Status.IPs; len(newStatusIPs) > 0 {
+		for i, ip := range newStatusIPs {
+			allErrs = append(allErrs, commonvalidation.ValidateIP(newPrefixAllocation.Spec.IPFamily, ip, statusIPsField.Index(i))...)
+		}
+
+		if newSpecIPCount := newPrefixAllocation.Spec.IPCount; newSpecIPCount > 0 && int32(len(newStatusIPs)) != newSpecIPCount {
+			allErrs = append(allErrs, field.Forbidden(statusIPsField, fmt.Sprintf("does not match spec ip count %d", newSpecIPCount)))
+		}
+
+		if newPrefixAllocation.Spec.PrefixRef == nil {
+			allErrs = append(allErrs, field.Forbidden(statusIPsField, "spec.prefixRef needs to be set first"))
+		}
+	}
+
 	return allErrs
 }
@@ -106,6 +106,57 @@ var _ = Describe("PrefixAllocation", func() {
 			},
 			ContainElement(InvalidField("spec")),
 		),
+		Entry("invalid range",
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					IPFamily: corev1.IPv4Protocol,
+					Range:    &commonv1alpha1.IPRange{},
+				},
+			},
+			ContainElement(InvalidField("spec.range")),
+		),
+		Entry("range ip family mismatch",
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					IPFamily: corev1.IPv4Protocol,
+					Range:    commonv1alpha1.MustParseNewIPRange("beef::1-beef::5"),
+				},
+			},
+			ContainElement(InvalidField("spec.range")),
+		),
+		Entry("negative rangeLength",
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					RangeLength: -1,
+				},
+			},
+			ContainElement(InvalidField("spec.rangeLength")),
+		),
+		Entry("too large ipCount",
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					IPCount: 100000,
+				},
+			},
+			ContainElement(InvalidField("spec.ipCount")),
+		),
+		Entry("multiple range requests",
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					RangeLength: 37,
+					IPCount:     3,
+				},
+			},
+			ContainElement(ForbiddenField("spec.ipCount")),
+		),
+		Entry("prefix namespace without prefix ref",
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					PrefixNamespace: "foo",
+				},
+			},
+			ContainElement(ForbiddenField("spec.prefixNamespace")),
+		),
 		Entry("empty prefix ref",
 			&ipam.PrefixAllocation{
 				Spec: ipam.PrefixAllocationSpec{
@@ -122,6 +173,19 @@ var _ = Describe("PrefixAllocation", func() {
 			},
 			ContainElement(InvalidField("spec.prefixRef.name")),
 		),
+		Entry("valid range length allocation",
+			&ipam.PrefixAllocation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+				Spec: ipam.PrefixAllocationSpec{
+					IPFamily:    corev1.IPv4Protocol,
+					RangeLength: 37,
+					PrefixRef: &corev1.LocalObjectReference{
+						Name: "foo",
+					},
+				},
+			},
+			BeEmpty(),
+		),
 		Entry("valid prefix allocation",
 			&ipam.PrefixAllocation{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
@@ -189,6 +253,40 @@ var _ = Describe("PrefixAllocation", func() {
 			},
 			ContainElement(ForbiddenField("status.prefix")),
 		),
+		Entry("not allocated but range",
+			&ipam.PrefixAllocationStatus{
+				Range: commonv1alpha1.MustParseNewIPRange("10.0.0.1-10.0.0.5"),
+			},
+			ContainElement(ForbiddenField("status.range")),
+		),
+		Entry("allocated with multiple results",
+			&ipam.PrefixAllocationStatus{
+				Phase:  ipam.PrefixAllocationPhaseAllocated,
+				Prefix: commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/8"),
+				IPs:    []commonv1alpha1.IP{commonv1alpha1.MustParseIP("10.0.0.1")},
+			},
+			ContainElement(ForbiddenField("status")),
+		),
+		Entry("allocated with duplicate ips",
+			&ipam.PrefixAllocationStatus{
+				Phase: ipam.PrefixAllocationPhaseAllocated,
+				IPs: []commonv1alpha1.IP{
+					commonv1alpha1.MustParseIP("10.0.0.1"),
+					commonv1alpha1.MustParseIP("10.0.0.1"),
+				},
+			},
+			ContainElement(DuplicateField("status.ips[1]")),
+		),
+		Entry("allocated with ips",
+			&ipam.PrefixAllocationStatus{
+				Phase: ipam.PrefixAllocationPhaseAllocated,
+				IPs: []commonv1alpha1.IP{
+					commonv1alpha1.MustParseIP("10.0.0.1"),
+					commonv1alpha1.MustParseIP("10.0.0.3"),
+				},
+			},
+			BeEmpty(),
+		),
 		Entry("allocated with result",
 			&ipam.PrefixAllocationStatus{
 				Prefix: commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/8"),
@@ -286,6 +384,69 @@ var _ = Describe("PrefixAllocation", func() {
 			},
 			ContainElement(InvalidField("status.prefix")),
 		),
+		Entry("range mismatch with spec.rangeLength",
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					IPFamily:    corev1.IPv4Protocol,
+					RangeLength: 37,
+					PrefixRef:   &corev1.LocalObjectReference{Name: "foo"},
+				},
+				Status: ipam.PrefixAllocationStatus{
+					Phase: ipam.PrefixAllocationPhaseAllocated,
+					Range: commonv1alpha1.MustParseNewIPRange("10.0.0.0-10.0.0.35"),
+				},
+			},
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					IPFamily:    corev1.IPv4Protocol,
+					RangeLength: 37,
+					PrefixRef:   &corev1.LocalObjectReference{Name: "foo"},
+				},
+			},
+			ContainElement(ForbiddenField("status.range")),
+		),
+		Entry("range match with spec.rangeLength",
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					IPFamily:    corev1.IPv4Protocol,
+					RangeLength: 37,
+					PrefixRef:   &corev1.LocalObjectReference{Name: "foo"},
+				},
+				Status: ipam.PrefixAllocationStatus{
+					Phase: ipam.PrefixAllocationPhaseAllocated,
+					Range: commonv1alpha1.MustParseNewIPRange("10.0.0.0-10.0.0.36"),
+				},
+			},
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					IPFamily:    corev1.IPv4Protocol,
+					RangeLength: 37,
+					PrefixRef:   &corev1.LocalObjectReference{Name: "foo"},
+				},
+			},
+			Not(ContainElement(ForbiddenField("status.range"))),
+		),
+		Entry("ips mismatch with spec.ipCount",
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					IPFamily:  corev1.IPv4Protocol,
+					IPCount:   2,
+					PrefixRef: &corev1.LocalObjectReference{Name: "foo"},
+				},
+				Status: ipam.PrefixAllocationStatus{
+					Phase: ipam.PrefixAllocationPhaseAllocated,
+					IPs:   []commonv1alpha1.IP{commonv1alpha1.MustParseIP("10.0.0.1")},
+				},
+			},
+			&ipam.PrefixAllocation{
+				Spec: ipam.PrefixAllocationSpec{
+					IPFamily:  corev1.IPv4Protocol,
+					IPCount:   2,
+					PrefixRef: &corev1.LocalObjectReference{Name: "foo"},
+				},
+			},
+			ContainElement(ForbiddenField("status.ips")),
+		),
 		Entry("prefix but no spec.prefixRef",
 			&ipam.PrefixAllocation{
 				Spec: ipam.PrefixAllocationSpec{
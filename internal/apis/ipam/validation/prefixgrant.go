@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/ipam"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidatePrefixGrant(prefixGrant *ipam.PrefixGrant) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(prefixGrant, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validatePrefixGrantSpec(&prefixGrant.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validatePrefixGrantSpec(spec *ipam.PrefixGrantSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateOptionalRef(&spec.PrefixRef, fldPath.Child("prefixRef"))...)
+
+	seenNamespaces := sets.New[string]()
+	for i, namespace := range spec.Namespaces {
+		allErrs = append(allErrs, validateNamespaceName(namespace, fldPath.Child("namespaces").Index(i))...)
+		if seenNamespaces.Has(namespace) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("namespaces").Index(i), namespace))
+		}
+		seenNamespaces.Insert(namespace)
+	}
+
+	return allErrs
+}
+
+func validateNamespaceName(namespace string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, msg := range apivalidation.ValidateNamespaceName(namespace, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath, namespace, msg))
+	}
+
+	return allErrs
+}
+
+func ValidatePrefixGrantUpdate(newPrefixGrant, oldPrefixGrant *ipam.PrefixGrant) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newPrefixGrant, oldPrefixGrant, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidatePrefixGrant(newPrefixGrant)...)
+
+	return allErrs
+}
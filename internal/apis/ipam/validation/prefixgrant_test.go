@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/ipam"
+	. "github.com/ironcore-dev/ironcore/internal/apis/ipam/validation"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("PrefixGrant", func() {
+	DescribeTable("ValidatePrefixGrant",
+		func(prefixGrant *ipam.PrefixGrant, match types.GomegaMatcher) {
+			errList := ValidatePrefixGrant(prefixGrant)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&ipam.PrefixGrant{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("missing namespace",
+			&ipam.PrefixGrant{ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+			ContainElement(RequiredField("metadata.namespace")),
+		),
+		Entry("missing prefix ref name",
+			&ipam.PrefixGrant{},
+			ContainElement(RequiredField("spec.prefixRef.name")),
+		),
+		Entry("invalid namespace",
+			&ipam.PrefixGrant{
+				Spec: ipam.PrefixGrantSpec{
+					Namespaces: []string{"foo*"},
+				},
+			},
+			ContainElement(InvalidField("spec.namespaces[0]")),
+		),
+		Entry("duplicate namespace",
+			&ipam.PrefixGrant{
+				Spec: ipam.PrefixGrantSpec{
+					Namespaces: []string{"foo", "foo"},
+				},
+			},
+			ContainElement(DuplicateField("spec.namespaces[1]")),
+		),
+		Entry("valid prefix grant",
+			&ipam.PrefixGrant{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+				Spec: ipam.PrefixGrantSpec{
+					PrefixRef:  corev1.LocalObjectReference{Name: "foo"},
+					Namespaces: []string{"tenant-a", "tenant-b"},
+				},
+			},
+			BeEmpty(),
+		),
+	)
+})
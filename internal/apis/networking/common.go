@@ -25,6 +25,10 @@ func NetworkPluginCommonName(name string) string {
 type EphemeralPrefixSource struct {
 	// PrefixTemplate is the template for the Prefix.
 	PrefixTemplate *ipam.PrefixTemplateSpec
+	// DelegatedPrefixLength is the length of a prefix that is delegated in addition to the ephemeral IP.
+	// The delegated prefix is allocated from the same parent as the ephemeral IP and has its IP family.
+	// Only applicable to ephemeral IP sources.
+	DelegatedPrefixLength *int32
 }
 
 // EphemeralVirtualIPSource contains the definition to create an ephemeral (i.e. coupled to the lifetime of the
@@ -30,6 +30,9 @@ type NATGatewaySpec struct {
 	IPFamily corev1.IPFamily
 	// NetworkRef is the Network this NATGateway should belong to.
 	NetworkRef corev1.LocalObjectReference
+	// PublicIPPoolRef references the PublicIPPool to allocate the IPs from.
+	// If unset, the IPs are allocated by the provider.
+	PublicIPPoolRef *corev1.LocalObjectReference
 	// PortsPerNetworkInterface defines the number of concurrent connections per target network interface.
 	// Has to be a power of 2. If empty, 2048 (DefaultPortsPerNetworkInterface) is the default.
 	PortsPerNetworkInterface *int32
@@ -4,6 +4,8 @@
 package networking
 
 import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -12,6 +14,10 @@ import (
 type NetworkSpec struct {
 	// ProviderID is the provider-internal ID of the network.
 	ProviderID string
+	// Prefixes are the address ranges of the network.
+	// If set, all Subnets of the network have to be contained in these prefixes.
+	// +optional
+	Prefixes []commonv1alpha1.IPPrefix
 	// Peerings are the network peerings with this network.
 	// +optional
 	// +patchMergeKey=name
@@ -51,6 +57,24 @@ type NetworkPeering struct {
 	// NetworkRef is the reference to the network to peer with.
 	// An empty namespace indicates that the target network resides in the same namespace as the source network.
 	NetworkRef NetworkPeeringNetworkRef
+	// Prefixes restricts the prefixes of this network that are advertised to the peered network.
+	// If empty, all prefixes of this network are advertised.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	Prefixes []PeeringPrefix
+}
+
+// PeeringPrefix is a prefix advertised over a network peering.
+// Exactly one of Prefix and SubnetRef has to be set.
+type PeeringPrefix struct {
+	// Name is the semantical name of the peering prefix.
+	Name string
+	// Prefix is the prefix to advertise.
+	// If the network specifies prefixes, it has to be contained in one of them.
+	Prefix *commonv1alpha1.IPPrefix
+	// SubnetRef references a Subnet of this network whose prefixes are advertised.
+	SubnetRef *corev1.LocalObjectReference
 }
 
 // NetworkStatus defines the observed state of Network
@@ -76,6 +100,9 @@ const (
 	NetworkPeeringStatePending NetworkPeeringState = "Pending"
 	// NetworkPeeringStateApplied signals that the network peering is applied.
 	NetworkPeeringStateApplied NetworkPeeringState = "Applied"
+	// NetworkPeeringStateError signals that the network peering cannot be applied, e.g. because the
+	// prefixes of the peered networks overlap.
+	NetworkPeeringStateError NetworkPeeringState = "Error"
 )
 
 // NetworkPeeringStatus is the status of a network peering.
@@ -84,6 +111,9 @@ type NetworkPeeringStatus struct {
 	Name string
 	// State represents the network peering state
 	State NetworkPeeringState
+	// Prefixes are the effective prefixes of the peered network that are reachable via the network peering.
+	// They are negotiated from the prefixes advertised by the peering of the peered network.
+	Prefixes []commonv1alpha1.IPPrefix
 }
 
 const (
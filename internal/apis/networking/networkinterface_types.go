@@ -15,6 +15,10 @@ type NetworkInterfaceSpec struct {
 	ProviderID string
 	// NetworkRef is the Network this NetworkInterface is connected to
 	NetworkRef corev1.LocalObjectReference
+	// SubnetRef is the Subnet of the Network this NetworkInterface belongs to.
+	// If set, ephemeral IPs without an explicit parent are allocated from the Subnet prefixes.
+	// +optional
+	SubnetRef *corev1.LocalObjectReference
 	// MachineRef is the Machine this NetworkInterface is used by
 	MachineRef *commonv1alpha1.LocalUIDReference
 	// IPFamilies defines which IPFamilies this NetworkInterface is supporting
@@ -25,6 +29,9 @@ type NetworkInterfaceSpec struct {
 	// Prefixes is the list of provided prefixes or ephemeral prefixes which should be assigned to
 	// this NetworkInterface.
 	Prefixes []PrefixSource
+	// DelegatedPrefixes are static prefixes delegated to this NetworkInterface, in addition to the ones
+	// delegated via its ephemeral IP sources.
+	DelegatedPrefixes []commonv1alpha1.IPPrefix
 	// VirtualIP specifies the virtual ip that should be assigned to this NetworkInterface.
 	VirtualIP *VirtualIPSource
 	// Attributes are provider-specific attributes for the network interface.
@@ -66,6 +73,9 @@ type NetworkInterfaceStatus struct {
 	IPs []commonv1alpha1.IP
 	// Prefixes represent the prefixes routed to the NetworkInterface.
 	Prefixes []commonv1alpha1.IPPrefix
+	// DelegatedPrefixes are the static prefixes delegated to the NetworkInterface followed by the prefixes
+	// delegated via its ephemeral IP sources.
+	DelegatedPrefixes []commonv1alpha1.IPPrefix
 	// VirtualIP is any virtual ip assigned to the NetworkInterface.
 	VirtualIP *commonv1alpha1.IP
 }
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PublicIPPoolSpec defines the desired state of PublicIPPool
+type PublicIPPoolSpec struct {
+	// IPFamily is the ip family of the PublicIPPool.
+	IPFamily corev1.IPFamily
+	// Prefixes are the public prefixes IPs are allocated from.
+	// Each prefix is backed by an ipam Prefix all allocations of the pool are made from.
+	Prefixes []commonv1alpha1.IPPrefix
+}
+
+// PublicIPPoolStatus defines the observed state of PublicIPPool
+type PublicIPPoolStatus struct {
+	// State is the state of the PublicIPPool.
+	State PublicIPPoolState
+	// LastStateTransitionTime is the last time the State transitioned from one value to another.
+	LastStateTransitionTime *metav1.Time
+	// Used is the number of IPs allocated from the PublicIPPool.
+	Used int32
+}
+
+// PublicIPPoolState is the state of a PublicIPPool.
+// +enum
+type PublicIPPoolState string
+
+const (
+	// PublicIPPoolStatePending means the PublicIPPool prefixes are not yet allocated.
+	PublicIPPoolStatePending PublicIPPoolState = "Pending"
+	// PublicIPPoolStateAvailable means all PublicIPPool prefixes are allocated and IPs can be allocated from it.
+	PublicIPPoolStateAvailable PublicIPPoolState = "Available"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PublicIPPool is the Schema for the publicippools API
+type PublicIPPool struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   PublicIPPoolSpec
+	Status PublicIPPoolStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PublicIPPoolList contains a list of PublicIPPool
+type PublicIPPoolList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []PublicIPPool
+}
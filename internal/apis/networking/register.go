@@ -44,6 +44,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LoadBalancerRoutingList{},
 		&NATGateway{},
 		&NATGatewayList{},
+		&Subnet{},
+		&SubnetList{},
+		&PublicIPPool{},
+		&PublicIPPoolList{},
+		&RouteTable{},
+		&RouteTableList{},
 	)
 	return nil
 }
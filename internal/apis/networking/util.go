@@ -26,3 +26,18 @@ func NetworkInterfaceIPIPAMPrefixName(nicName string, idx int) string {
 func NetworkInterfacePrefixIPAMPrefixName(nicName string, idx int) string {
 	return fmt.Sprintf("%s-pf-%d", nicName, idx)
 }
+
+// NetworkPrefixIPAMPrefixName returns the name of a Prefix for a network prefix.
+func NetworkPrefixIPAMPrefixName(networkName string, idx int) string {
+	return fmt.Sprintf("%s-nw-%d", networkName, idx)
+}
+
+// SubnetPrefixIPAMPrefixName returns the name of a Prefix for a subnet prefix.
+func SubnetPrefixIPAMPrefixName(subnetName string, idx int) string {
+	return fmt.Sprintf("%s-sn-%d", subnetName, idx)
+}
+
+// PublicIPPoolPrefixIPAMPrefixName returns the name of a Prefix backing a public ip pool prefix.
+func PublicIPPoolPrefixIPAMPrefixName(poolName string, idx int) string {
+	return fmt.Sprintf("%s-%d", poolName, idx)
+}
@@ -422,6 +422,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PeeringPrefix)(nil), (*networking.PeeringPrefix)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PeeringPrefix_To_networking_PeeringPrefix(a.(*v1alpha1.PeeringPrefix), b.(*networking.PeeringPrefix), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.PeeringPrefix)(nil), (*v1alpha1.PeeringPrefix)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_PeeringPrefix_To_v1alpha1_PeeringPrefix(a.(*networking.PeeringPrefix), b.(*v1alpha1.PeeringPrefix), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.PrefixSource)(nil), (*networking.PrefixSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PrefixSource_To_networking_PrefixSource(a.(*v1alpha1.PrefixSource), b.(*networking.PrefixSource), scope)
 	}); err != nil {
@@ -432,6 +442,156 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PublicIPPool)(nil), (*networking.PublicIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPPool_To_networking_PublicIPPool(a.(*v1alpha1.PublicIPPool), b.(*networking.PublicIPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.PublicIPPool)(nil), (*v1alpha1.PublicIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_PublicIPPool_To_v1alpha1_PublicIPPool(a.(*networking.PublicIPPool), b.(*v1alpha1.PublicIPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PublicIPPoolList)(nil), (*networking.PublicIPPoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPPoolList_To_networking_PublicIPPoolList(a.(*v1alpha1.PublicIPPoolList), b.(*networking.PublicIPPoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.PublicIPPoolList)(nil), (*v1alpha1.PublicIPPoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_PublicIPPoolList_To_v1alpha1_PublicIPPoolList(a.(*networking.PublicIPPoolList), b.(*v1alpha1.PublicIPPoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PublicIPPoolSpec)(nil), (*networking.PublicIPPoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPPoolSpec_To_networking_PublicIPPoolSpec(a.(*v1alpha1.PublicIPPoolSpec), b.(*networking.PublicIPPoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.PublicIPPoolSpec)(nil), (*v1alpha1.PublicIPPoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_PublicIPPoolSpec_To_v1alpha1_PublicIPPoolSpec(a.(*networking.PublicIPPoolSpec), b.(*v1alpha1.PublicIPPoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PublicIPPoolStatus)(nil), (*networking.PublicIPPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPPoolStatus_To_networking_PublicIPPoolStatus(a.(*v1alpha1.PublicIPPoolStatus), b.(*networking.PublicIPPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.PublicIPPoolStatus)(nil), (*v1alpha1.PublicIPPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_PublicIPPoolStatus_To_v1alpha1_PublicIPPoolStatus(a.(*networking.PublicIPPoolStatus), b.(*v1alpha1.PublicIPPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Route)(nil), (*networking.Route)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Route_To_networking_Route(a.(*v1alpha1.Route), b.(*networking.Route), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.Route)(nil), (*v1alpha1.Route)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_Route_To_v1alpha1_Route(a.(*networking.Route), b.(*v1alpha1.Route), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RouteNextHop)(nil), (*networking.RouteNextHop)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RouteNextHop_To_networking_RouteNextHop(a.(*v1alpha1.RouteNextHop), b.(*networking.RouteNextHop), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.RouteNextHop)(nil), (*v1alpha1.RouteNextHop)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_RouteNextHop_To_v1alpha1_RouteNextHop(a.(*networking.RouteNextHop), b.(*v1alpha1.RouteNextHop), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RouteStatus)(nil), (*networking.RouteStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RouteStatus_To_networking_RouteStatus(a.(*v1alpha1.RouteStatus), b.(*networking.RouteStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.RouteStatus)(nil), (*v1alpha1.RouteStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_RouteStatus_To_v1alpha1_RouteStatus(a.(*networking.RouteStatus), b.(*v1alpha1.RouteStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RouteTable)(nil), (*networking.RouteTable)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RouteTable_To_networking_RouteTable(a.(*v1alpha1.RouteTable), b.(*networking.RouteTable), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.RouteTable)(nil), (*v1alpha1.RouteTable)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_RouteTable_To_v1alpha1_RouteTable(a.(*networking.RouteTable), b.(*v1alpha1.RouteTable), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RouteTableList)(nil), (*networking.RouteTableList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RouteTableList_To_networking_RouteTableList(a.(*v1alpha1.RouteTableList), b.(*networking.RouteTableList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.RouteTableList)(nil), (*v1alpha1.RouteTableList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_RouteTableList_To_v1alpha1_RouteTableList(a.(*networking.RouteTableList), b.(*v1alpha1.RouteTableList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RouteTableSpec)(nil), (*networking.RouteTableSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RouteTableSpec_To_networking_RouteTableSpec(a.(*v1alpha1.RouteTableSpec), b.(*networking.RouteTableSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.RouteTableSpec)(nil), (*v1alpha1.RouteTableSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_RouteTableSpec_To_v1alpha1_RouteTableSpec(a.(*networking.RouteTableSpec), b.(*v1alpha1.RouteTableSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RouteTableStatus)(nil), (*networking.RouteTableStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RouteTableStatus_To_networking_RouteTableStatus(a.(*v1alpha1.RouteTableStatus), b.(*networking.RouteTableStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.RouteTableStatus)(nil), (*v1alpha1.RouteTableStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_RouteTableStatus_To_v1alpha1_RouteTableStatus(a.(*networking.RouteTableStatus), b.(*v1alpha1.RouteTableStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Subnet)(nil), (*networking.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Subnet_To_networking_Subnet(a.(*v1alpha1.Subnet), b.(*networking.Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.Subnet)(nil), (*v1alpha1.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_Subnet_To_v1alpha1_Subnet(a.(*networking.Subnet), b.(*v1alpha1.Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SubnetList)(nil), (*networking.SubnetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SubnetList_To_networking_SubnetList(a.(*v1alpha1.SubnetList), b.(*networking.SubnetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.SubnetList)(nil), (*v1alpha1.SubnetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_SubnetList_To_v1alpha1_SubnetList(a.(*networking.SubnetList), b.(*v1alpha1.SubnetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SubnetSpec)(nil), (*networking.SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SubnetSpec_To_networking_SubnetSpec(a.(*v1alpha1.SubnetSpec), b.(*networking.SubnetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.SubnetSpec)(nil), (*v1alpha1.SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_SubnetSpec_To_v1alpha1_SubnetSpec(a.(*networking.SubnetSpec), b.(*v1alpha1.SubnetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SubnetStatus)(nil), (*networking.SubnetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SubnetStatus_To_networking_SubnetStatus(a.(*v1alpha1.SubnetStatus), b.(*networking.SubnetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*networking.SubnetStatus)(nil), (*v1alpha1.SubnetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_networking_SubnetStatus_To_v1alpha1_SubnetStatus(a.(*networking.SubnetStatus), b.(*v1alpha1.SubnetStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.VirtualIP)(nil), (*networking.VirtualIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VirtualIP_To_networking_VirtualIP(a.(*v1alpha1.VirtualIP), b.(*networking.VirtualIP), scope)
 	}); err != nil {
@@ -497,6 +657,7 @@ func RegisterConversions(s *runtime.Scheme) error {
 
 func autoConvert_v1alpha1_EphemeralPrefixSource_To_networking_EphemeralPrefixSource(in *v1alpha1.EphemeralPrefixSource, out *networking.EphemeralPrefixSource, s conversion.Scope) error {
 	out.PrefixTemplate = (*ipam.PrefixTemplateSpec)(unsafe.Pointer(in.PrefixTemplate))
+	out.DelegatedPrefixLength = (*int32)(unsafe.Pointer(in.DelegatedPrefixLength))
 	return nil
 }
 
@@ -507,6 +668,7 @@ func Convert_v1alpha1_EphemeralPrefixSource_To_networking_EphemeralPrefixSource(
 
 func autoConvert_networking_EphemeralPrefixSource_To_v1alpha1_EphemeralPrefixSource(in *networking.EphemeralPrefixSource, out *v1alpha1.EphemeralPrefixSource, s conversion.Scope) error {
 	out.PrefixTemplate = (*ipamv1alpha1.PrefixTemplateSpec)(unsafe.Pointer(in.PrefixTemplate))
+	out.DelegatedPrefixLength = (*int32)(unsafe.Pointer(in.DelegatedPrefixLength))
 	return nil
 }
 
@@ -730,6 +892,7 @@ func autoConvert_v1alpha1_LoadBalancerSpec_To_networking_LoadBalancerSpec(in *v1
 	out.IPFamilies = *(*[]v1.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	out.IPs = *(*[]networking.IPSource)(unsafe.Pointer(&in.IPs))
 	out.NetworkRef = in.NetworkRef
+	out.PublicIPPoolRefs = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.PublicIPPoolRefs))
 	out.NetworkInterfaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NetworkInterfaceSelector))
 	out.Ports = *(*[]networking.LoadBalancerPort)(unsafe.Pointer(&in.Ports))
 	return nil
@@ -745,6 +908,7 @@ func autoConvert_networking_LoadBalancerSpec_To_v1alpha1_LoadBalancerSpec(in *ne
 	out.IPFamilies = *(*[]v1.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	out.IPs = *(*[]v1alpha1.IPSource)(unsafe.Pointer(&in.IPs))
 	out.NetworkRef = in.NetworkRef
+	out.PublicIPPoolRefs = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.PublicIPPoolRefs))
 	out.NetworkInterfaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NetworkInterfaceSelector))
 	out.Ports = *(*[]v1alpha1.LoadBalancerPort)(unsafe.Pointer(&in.Ports))
 	return nil
@@ -757,6 +921,7 @@ func Convert_networking_LoadBalancerSpec_To_v1alpha1_LoadBalancerSpec(in *networ
 
 func autoConvert_v1alpha1_LoadBalancerStatus_To_networking_LoadBalancerStatus(in *v1alpha1.LoadBalancerStatus, out *networking.LoadBalancerStatus, s conversion.Scope) error {
 	out.IPs = *(*[]commonv1alpha1.IP)(unsafe.Pointer(&in.IPs))
+	out.DelegatedPrefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.DelegatedPrefixes))
 	return nil
 }
 
@@ -767,6 +932,7 @@ func Convert_v1alpha1_LoadBalancerStatus_To_networking_LoadBalancerStatus(in *v1
 
 func autoConvert_networking_LoadBalancerStatus_To_v1alpha1_LoadBalancerStatus(in *networking.LoadBalancerStatus, out *v1alpha1.LoadBalancerStatus, s conversion.Scope) error {
 	out.IPs = *(*[]commonv1alpha1.IP)(unsafe.Pointer(&in.IPs))
+	out.DelegatedPrefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.DelegatedPrefixes))
 	return nil
 }
 
@@ -857,6 +1023,7 @@ func autoConvert_v1alpha1_NATGatewaySpec_To_networking_NATGatewaySpec(in *v1alph
 	out.Type = networking.NATGatewayType(in.Type)
 	out.IPFamily = v1.IPFamily(in.IPFamily)
 	out.NetworkRef = in.NetworkRef
+	out.PublicIPPoolRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PublicIPPoolRef))
 	out.PortsPerNetworkInterface = (*int32)(unsafe.Pointer(in.PortsPerNetworkInterface))
 	return nil
 }
@@ -870,6 +1037,7 @@ func autoConvert_networking_NATGatewaySpec_To_v1alpha1_NATGatewaySpec(in *networ
 	out.Type = v1alpha1.NATGatewayType(in.Type)
 	out.IPFamily = v1.IPFamily(in.IPFamily)
 	out.NetworkRef = in.NetworkRef
+	out.PublicIPPoolRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PublicIPPoolRef))
 	out.PortsPerNetworkInterface = (*int32)(unsafe.Pointer(in.PortsPerNetworkInterface))
 	return nil
 }
@@ -988,10 +1156,12 @@ func Convert_networking_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList(in
 func autoConvert_v1alpha1_NetworkInterfaceSpec_To_networking_NetworkInterfaceSpec(in *v1alpha1.NetworkInterfaceSpec, out *networking.NetworkInterfaceSpec, s conversion.Scope) error {
 	out.ProviderID = in.ProviderID
 	out.NetworkRef = in.NetworkRef
+	out.SubnetRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.SubnetRef))
 	out.MachineRef = (*commonv1alpha1.LocalUIDReference)(unsafe.Pointer(in.MachineRef))
 	out.IPFamilies = *(*[]v1.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	out.IPs = *(*[]networking.IPSource)(unsafe.Pointer(&in.IPs))
 	out.Prefixes = *(*[]networking.PrefixSource)(unsafe.Pointer(&in.Prefixes))
+	out.DelegatedPrefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.DelegatedPrefixes))
 	out.VirtualIP = (*networking.VirtualIPSource)(unsafe.Pointer(in.VirtualIP))
 	out.Attributes = *(*map[string]string)(unsafe.Pointer(&in.Attributes))
 	return nil
@@ -1005,10 +1175,12 @@ func Convert_v1alpha1_NetworkInterfaceSpec_To_networking_NetworkInterfaceSpec(in
 func autoConvert_networking_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(in *networking.NetworkInterfaceSpec, out *v1alpha1.NetworkInterfaceSpec, s conversion.Scope) error {
 	out.ProviderID = in.ProviderID
 	out.NetworkRef = in.NetworkRef
+	out.SubnetRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.SubnetRef))
 	out.MachineRef = (*commonv1alpha1.LocalUIDReference)(unsafe.Pointer(in.MachineRef))
 	out.IPFamilies = *(*[]v1.IPFamily)(unsafe.Pointer(&in.IPFamilies))
 	out.IPs = *(*[]v1alpha1.IPSource)(unsafe.Pointer(&in.IPs))
 	out.Prefixes = *(*[]v1alpha1.PrefixSource)(unsafe.Pointer(&in.Prefixes))
+	out.DelegatedPrefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.DelegatedPrefixes))
 	out.VirtualIP = (*v1alpha1.VirtualIPSource)(unsafe.Pointer(in.VirtualIP))
 	out.Attributes = *(*map[string]string)(unsafe.Pointer(&in.Attributes))
 	return nil
@@ -1024,6 +1196,7 @@ func autoConvert_v1alpha1_NetworkInterfaceStatus_To_networking_NetworkInterfaceS
 	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
 	out.IPs = *(*[]commonv1alpha1.IP)(unsafe.Pointer(&in.IPs))
 	out.Prefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Prefixes))
+	out.DelegatedPrefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.DelegatedPrefixes))
 	out.VirtualIP = (*commonv1alpha1.IP)(unsafe.Pointer(in.VirtualIP))
 	return nil
 }
@@ -1038,6 +1211,7 @@ func autoConvert_networking_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceS
 	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
 	out.IPs = *(*[]commonv1alpha1.IP)(unsafe.Pointer(&in.IPs))
 	out.Prefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Prefixes))
+	out.DelegatedPrefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.DelegatedPrefixes))
 	out.VirtualIP = (*commonv1alpha1.IP)(unsafe.Pointer(in.VirtualIP))
 	return nil
 }
@@ -1100,6 +1274,7 @@ func autoConvert_v1alpha1_NetworkPeering_To_networking_NetworkPeering(in *v1alph
 	if err := Convert_v1alpha1_NetworkPeeringNetworkRef_To_networking_NetworkPeeringNetworkRef(&in.NetworkRef, &out.NetworkRef, s); err != nil {
 		return err
 	}
+	out.Prefixes = *(*[]networking.PeeringPrefix)(unsafe.Pointer(&in.Prefixes))
 	return nil
 }
 
@@ -1113,6 +1288,7 @@ func autoConvert_networking_NetworkPeering_To_v1alpha1_NetworkPeering(in *networ
 	if err := Convert_networking_NetworkPeeringNetworkRef_To_v1alpha1_NetworkPeeringNetworkRef(&in.NetworkRef, &out.NetworkRef, s); err != nil {
 		return err
 	}
+	out.Prefixes = *(*[]v1alpha1.PeeringPrefix)(unsafe.Pointer(&in.Prefixes))
 	return nil
 }
 
@@ -1170,6 +1346,7 @@ func Convert_networking_NetworkPeeringNetworkRef_To_v1alpha1_NetworkPeeringNetwo
 func autoConvert_v1alpha1_NetworkPeeringStatus_To_networking_NetworkPeeringStatus(in *v1alpha1.NetworkPeeringStatus, out *networking.NetworkPeeringStatus, s conversion.Scope) error {
 	out.Name = in.Name
 	out.State = networking.NetworkPeeringState(in.State)
+	out.Prefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Prefixes))
 	return nil
 }
 
@@ -1181,6 +1358,7 @@ func Convert_v1alpha1_NetworkPeeringStatus_To_networking_NetworkPeeringStatus(in
 func autoConvert_networking_NetworkPeeringStatus_To_v1alpha1_NetworkPeeringStatus(in *networking.NetworkPeeringStatus, out *v1alpha1.NetworkPeeringStatus, s conversion.Scope) error {
 	out.Name = in.Name
 	out.State = v1alpha1.NetworkPeeringState(in.State)
+	out.Prefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Prefixes))
 	return nil
 }
 
@@ -1413,6 +1591,7 @@ func Convert_networking_NetworkPolicyStatus_To_v1alpha1_NetworkPolicyStatus(in *
 
 func autoConvert_v1alpha1_NetworkSpec_To_networking_NetworkSpec(in *v1alpha1.NetworkSpec, out *networking.NetworkSpec, s conversion.Scope) error {
 	out.ProviderID = in.ProviderID
+	out.Prefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Prefixes))
 	out.Peerings = *(*[]networking.NetworkPeering)(unsafe.Pointer(&in.Peerings))
 	out.PeeringClaimRefs = *(*[]networking.NetworkPeeringClaimRef)(unsafe.Pointer(&in.PeeringClaimRefs))
 	return nil
@@ -1425,6 +1604,7 @@ func Convert_v1alpha1_NetworkSpec_To_networking_NetworkSpec(in *v1alpha1.Network
 
 func autoConvert_networking_NetworkSpec_To_v1alpha1_NetworkSpec(in *networking.NetworkSpec, out *v1alpha1.NetworkSpec, s conversion.Scope) error {
 	out.ProviderID = in.ProviderID
+	out.Prefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Prefixes))
 	out.Peerings = *(*[]v1alpha1.NetworkPeering)(unsafe.Pointer(&in.Peerings))
 	out.PeeringClaimRefs = *(*[]v1alpha1.NetworkPeeringClaimRef)(unsafe.Pointer(&in.PeeringClaimRefs))
 	return nil
@@ -1457,6 +1637,30 @@ func Convert_networking_NetworkStatus_To_v1alpha1_NetworkStatus(in *networking.N
 	return autoConvert_networking_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_PeeringPrefix_To_networking_PeeringPrefix(in *v1alpha1.PeeringPrefix, out *networking.PeeringPrefix, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Prefix = (*commonv1alpha1.IPPrefix)(unsafe.Pointer(in.Prefix))
+	out.SubnetRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.SubnetRef))
+	return nil
+}
+
+// Convert_v1alpha1_PeeringPrefix_To_networking_PeeringPrefix is an autogenerated conversion function.
+func Convert_v1alpha1_PeeringPrefix_To_networking_PeeringPrefix(in *v1alpha1.PeeringPrefix, out *networking.PeeringPrefix, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PeeringPrefix_To_networking_PeeringPrefix(in, out, s)
+}
+
+func autoConvert_networking_PeeringPrefix_To_v1alpha1_PeeringPrefix(in *networking.PeeringPrefix, out *v1alpha1.PeeringPrefix, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Prefix = (*commonv1alpha1.IPPrefix)(unsafe.Pointer(in.Prefix))
+	out.SubnetRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.SubnetRef))
+	return nil
+}
+
+// Convert_networking_PeeringPrefix_To_v1alpha1_PeeringPrefix is an autogenerated conversion function.
+func Convert_networking_PeeringPrefix_To_v1alpha1_PeeringPrefix(in *networking.PeeringPrefix, out *v1alpha1.PeeringPrefix, s conversion.Scope) error {
+	return autoConvert_networking_PeeringPrefix_To_v1alpha1_PeeringPrefix(in, out, s)
+}
+
 func autoConvert_v1alpha1_PrefixSource_To_networking_PrefixSource(in *v1alpha1.PrefixSource, out *networking.PrefixSource, s conversion.Scope) error {
 	out.Value = (*commonv1alpha1.IPPrefix)(unsafe.Pointer(in.Value))
 	out.Ephemeral = (*networking.EphemeralPrefixSource)(unsafe.Pointer(in.Ephemeral))
@@ -1479,6 +1683,380 @@ func Convert_networking_PrefixSource_To_v1alpha1_PrefixSource(in *networking.Pre
 	return autoConvert_networking_PrefixSource_To_v1alpha1_PrefixSource(in, out, s)
 }
 
+func autoConvert_v1alpha1_PublicIPPool_To_networking_PublicIPPool(in *v1alpha1.PublicIPPool, out *networking.PublicIPPool, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_PublicIPPoolSpec_To_networking_PublicIPPoolSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_PublicIPPoolStatus_To_networking_PublicIPPoolStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPPool_To_networking_PublicIPPool is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPPool_To_networking_PublicIPPool(in *v1alpha1.PublicIPPool, out *networking.PublicIPPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPPool_To_networking_PublicIPPool(in, out, s)
+}
+
+func autoConvert_networking_PublicIPPool_To_v1alpha1_PublicIPPool(in *networking.PublicIPPool, out *v1alpha1.PublicIPPool, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_networking_PublicIPPoolSpec_To_v1alpha1_PublicIPPoolSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_networking_PublicIPPoolStatus_To_v1alpha1_PublicIPPoolStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_networking_PublicIPPool_To_v1alpha1_PublicIPPool is an autogenerated conversion function.
+func Convert_networking_PublicIPPool_To_v1alpha1_PublicIPPool(in *networking.PublicIPPool, out *v1alpha1.PublicIPPool, s conversion.Scope) error {
+	return autoConvert_networking_PublicIPPool_To_v1alpha1_PublicIPPool(in, out, s)
+}
+
+func autoConvert_v1alpha1_PublicIPPoolList_To_networking_PublicIPPoolList(in *v1alpha1.PublicIPPoolList, out *networking.PublicIPPoolList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]networking.PublicIPPool)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPPoolList_To_networking_PublicIPPoolList is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPPoolList_To_networking_PublicIPPoolList(in *v1alpha1.PublicIPPoolList, out *networking.PublicIPPoolList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPPoolList_To_networking_PublicIPPoolList(in, out, s)
+}
+
+func autoConvert_networking_PublicIPPoolList_To_v1alpha1_PublicIPPoolList(in *networking.PublicIPPoolList, out *v1alpha1.PublicIPPoolList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.PublicIPPool)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_networking_PublicIPPoolList_To_v1alpha1_PublicIPPoolList is an autogenerated conversion function.
+func Convert_networking_PublicIPPoolList_To_v1alpha1_PublicIPPoolList(in *networking.PublicIPPoolList, out *v1alpha1.PublicIPPoolList, s conversion.Scope) error {
+	return autoConvert_networking_PublicIPPoolList_To_v1alpha1_PublicIPPoolList(in, out, s)
+}
+
+func autoConvert_v1alpha1_PublicIPPoolSpec_To_networking_PublicIPPoolSpec(in *v1alpha1.PublicIPPoolSpec, out *networking.PublicIPPoolSpec, s conversion.Scope) error {
+	out.IPFamily = v1.IPFamily(in.IPFamily)
+	out.Prefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Prefixes))
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPPoolSpec_To_networking_PublicIPPoolSpec is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPPoolSpec_To_networking_PublicIPPoolSpec(in *v1alpha1.PublicIPPoolSpec, out *networking.PublicIPPoolSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPPoolSpec_To_networking_PublicIPPoolSpec(in, out, s)
+}
+
+func autoConvert_networking_PublicIPPoolSpec_To_v1alpha1_PublicIPPoolSpec(in *networking.PublicIPPoolSpec, out *v1alpha1.PublicIPPoolSpec, s conversion.Scope) error {
+	out.IPFamily = v1.IPFamily(in.IPFamily)
+	out.Prefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Prefixes))
+	return nil
+}
+
+// Convert_networking_PublicIPPoolSpec_To_v1alpha1_PublicIPPoolSpec is an autogenerated conversion function.
+func Convert_networking_PublicIPPoolSpec_To_v1alpha1_PublicIPPoolSpec(in *networking.PublicIPPoolSpec, out *v1alpha1.PublicIPPoolSpec, s conversion.Scope) error {
+	return autoConvert_networking_PublicIPPoolSpec_To_v1alpha1_PublicIPPoolSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_PublicIPPoolStatus_To_networking_PublicIPPoolStatus(in *v1alpha1.PublicIPPoolStatus, out *networking.PublicIPPoolStatus, s conversion.Scope) error {
+	out.State = networking.PublicIPPoolState(in.State)
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.Used = in.Used
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPPoolStatus_To_networking_PublicIPPoolStatus is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPPoolStatus_To_networking_PublicIPPoolStatus(in *v1alpha1.PublicIPPoolStatus, out *networking.PublicIPPoolStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPPoolStatus_To_networking_PublicIPPoolStatus(in, out, s)
+}
+
+func autoConvert_networking_PublicIPPoolStatus_To_v1alpha1_PublicIPPoolStatus(in *networking.PublicIPPoolStatus, out *v1alpha1.PublicIPPoolStatus, s conversion.Scope) error {
+	out.State = v1alpha1.PublicIPPoolState(in.State)
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.Used = in.Used
+	return nil
+}
+
+// Convert_networking_PublicIPPoolStatus_To_v1alpha1_PublicIPPoolStatus is an autogenerated conversion function.
+func Convert_networking_PublicIPPoolStatus_To_v1alpha1_PublicIPPoolStatus(in *networking.PublicIPPoolStatus, out *v1alpha1.PublicIPPoolStatus, s conversion.Scope) error {
+	return autoConvert_networking_PublicIPPoolStatus_To_v1alpha1_PublicIPPoolStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_Route_To_networking_Route(in *v1alpha1.Route, out *networking.Route, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Destination = in.Destination
+	if err := Convert_v1alpha1_RouteNextHop_To_networking_RouteNextHop(&in.NextHop, &out.NextHop, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Route_To_networking_Route is an autogenerated conversion function.
+func Convert_v1alpha1_Route_To_networking_Route(in *v1alpha1.Route, out *networking.Route, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Route_To_networking_Route(in, out, s)
+}
+
+func autoConvert_networking_Route_To_v1alpha1_Route(in *networking.Route, out *v1alpha1.Route, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Destination = in.Destination
+	if err := Convert_networking_RouteNextHop_To_v1alpha1_RouteNextHop(&in.NextHop, &out.NextHop, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_networking_Route_To_v1alpha1_Route is an autogenerated conversion function.
+func Convert_networking_Route_To_v1alpha1_Route(in *networking.Route, out *v1alpha1.Route, s conversion.Scope) error {
+	return autoConvert_networking_Route_To_v1alpha1_Route(in, out, s)
+}
+
+func autoConvert_v1alpha1_RouteNextHop_To_networking_RouteNextHop(in *v1alpha1.RouteNextHop, out *networking.RouteNextHop, s conversion.Scope) error {
+	out.NetworkInterfaceRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.NetworkInterfaceRef))
+	out.VirtualIPRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VirtualIPRef))
+	out.NATGatewayRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.NATGatewayRef))
+	out.NetworkPeeringRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.NetworkPeeringRef))
+	return nil
+}
+
+// Convert_v1alpha1_RouteNextHop_To_networking_RouteNextHop is an autogenerated conversion function.
+func Convert_v1alpha1_RouteNextHop_To_networking_RouteNextHop(in *v1alpha1.RouteNextHop, out *networking.RouteNextHop, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RouteNextHop_To_networking_RouteNextHop(in, out, s)
+}
+
+func autoConvert_networking_RouteNextHop_To_v1alpha1_RouteNextHop(in *networking.RouteNextHop, out *v1alpha1.RouteNextHop, s conversion.Scope) error {
+	out.NetworkInterfaceRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.NetworkInterfaceRef))
+	out.VirtualIPRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VirtualIPRef))
+	out.NATGatewayRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.NATGatewayRef))
+	out.NetworkPeeringRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.NetworkPeeringRef))
+	return nil
+}
+
+// Convert_networking_RouteNextHop_To_v1alpha1_RouteNextHop is an autogenerated conversion function.
+func Convert_networking_RouteNextHop_To_v1alpha1_RouteNextHop(in *networking.RouteNextHop, out *v1alpha1.RouteNextHop, s conversion.Scope) error {
+	return autoConvert_networking_RouteNextHop_To_v1alpha1_RouteNextHop(in, out, s)
+}
+
+func autoConvert_v1alpha1_RouteStatus_To_networking_RouteStatus(in *v1alpha1.RouteStatus, out *networking.RouteStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = networking.RouteState(in.State)
+	out.Message = in.Message
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	return nil
+}
+
+// Convert_v1alpha1_RouteStatus_To_networking_RouteStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RouteStatus_To_networking_RouteStatus(in *v1alpha1.RouteStatus, out *networking.RouteStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RouteStatus_To_networking_RouteStatus(in, out, s)
+}
+
+func autoConvert_networking_RouteStatus_To_v1alpha1_RouteStatus(in *networking.RouteStatus, out *v1alpha1.RouteStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = v1alpha1.RouteState(in.State)
+	out.Message = in.Message
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	return nil
+}
+
+// Convert_networking_RouteStatus_To_v1alpha1_RouteStatus is an autogenerated conversion function.
+func Convert_networking_RouteStatus_To_v1alpha1_RouteStatus(in *networking.RouteStatus, out *v1alpha1.RouteStatus, s conversion.Scope) error {
+	return autoConvert_networking_RouteStatus_To_v1alpha1_RouteStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RouteTable_To_networking_RouteTable(in *v1alpha1.RouteTable, out *networking.RouteTable, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_RouteTableSpec_To_networking_RouteTableSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_RouteTableStatus_To_networking_RouteTableStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RouteTable_To_networking_RouteTable is an autogenerated conversion function.
+func Convert_v1alpha1_RouteTable_To_networking_RouteTable(in *v1alpha1.RouteTable, out *networking.RouteTable, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RouteTable_To_networking_RouteTable(in, out, s)
+}
+
+func autoConvert_networking_RouteTable_To_v1alpha1_RouteTable(in *networking.RouteTable, out *v1alpha1.RouteTable, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_networking_RouteTableSpec_To_v1alpha1_RouteTableSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_networking_RouteTableStatus_To_v1alpha1_RouteTableStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_networking_RouteTable_To_v1alpha1_RouteTable is an autogenerated conversion function.
+func Convert_networking_RouteTable_To_v1alpha1_RouteTable(in *networking.RouteTable, out *v1alpha1.RouteTable, s conversion.Scope) error {
+	return autoConvert_networking_RouteTable_To_v1alpha1_RouteTable(in, out, s)
+}
+
+func autoConvert_v1alpha1_RouteTableList_To_networking_RouteTableList(in *v1alpha1.RouteTableList, out *networking.RouteTableList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]networking.RouteTable)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_RouteTableList_To_networking_RouteTableList is an autogenerated conversion function.
+func Convert_v1alpha1_RouteTableList_To_networking_RouteTableList(in *v1alpha1.RouteTableList, out *networking.RouteTableList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RouteTableList_To_networking_RouteTableList(in, out, s)
+}
+
+func autoConvert_networking_RouteTableList_To_v1alpha1_RouteTableList(in *networking.RouteTableList, out *v1alpha1.RouteTableList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.RouteTable)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_networking_RouteTableList_To_v1alpha1_RouteTableList is an autogenerated conversion function.
+func Convert_networking_RouteTableList_To_v1alpha1_RouteTableList(in *networking.RouteTableList, out *v1alpha1.RouteTableList, s conversion.Scope) error {
+	return autoConvert_networking_RouteTableList_To_v1alpha1_RouteTableList(in, out, s)
+}
+
+func autoConvert_v1alpha1_RouteTableSpec_To_networking_RouteTableSpec(in *v1alpha1.RouteTableSpec, out *networking.RouteTableSpec, s conversion.Scope) error {
+	out.NetworkRef = in.NetworkRef
+	out.Routes = *(*[]networking.Route)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1alpha1_RouteTableSpec_To_networking_RouteTableSpec is an autogenerated conversion function.
+func Convert_v1alpha1_RouteTableSpec_To_networking_RouteTableSpec(in *v1alpha1.RouteTableSpec, out *networking.RouteTableSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RouteTableSpec_To_networking_RouteTableSpec(in, out, s)
+}
+
+func autoConvert_networking_RouteTableSpec_To_v1alpha1_RouteTableSpec(in *networking.RouteTableSpec, out *v1alpha1.RouteTableSpec, s conversion.Scope) error {
+	out.NetworkRef = in.NetworkRef
+	out.Routes = *(*[]v1alpha1.Route)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_networking_RouteTableSpec_To_v1alpha1_RouteTableSpec is an autogenerated conversion function.
+func Convert_networking_RouteTableSpec_To_v1alpha1_RouteTableSpec(in *networking.RouteTableSpec, out *v1alpha1.RouteTableSpec, s conversion.Scope) error {
+	return autoConvert_networking_RouteTableSpec_To_v1alpha1_RouteTableSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_RouteTableStatus_To_networking_RouteTableStatus(in *v1alpha1.RouteTableStatus, out *networking.RouteTableStatus, s conversion.Scope) error {
+	out.Routes = *(*[]networking.RouteStatus)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1alpha1_RouteTableStatus_To_networking_RouteTableStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RouteTableStatus_To_networking_RouteTableStatus(in *v1alpha1.RouteTableStatus, out *networking.RouteTableStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RouteTableStatus_To_networking_RouteTableStatus(in, out, s)
+}
+
+func autoConvert_networking_RouteTableStatus_To_v1alpha1_RouteTableStatus(in *networking.RouteTableStatus, out *v1alpha1.RouteTableStatus, s conversion.Scope) error {
+	out.Routes = *(*[]v1alpha1.RouteStatus)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_networking_RouteTableStatus_To_v1alpha1_RouteTableStatus is an autogenerated conversion function.
+func Convert_networking_RouteTableStatus_To_v1alpha1_RouteTableStatus(in *networking.RouteTableStatus, out *v1alpha1.RouteTableStatus, s conversion.Scope) error {
+	return autoConvert_networking_RouteTableStatus_To_v1alpha1_RouteTableStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_Subnet_To_networking_Subnet(in *v1alpha1.Subnet, out *networking.Subnet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_SubnetSpec_To_networking_SubnetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SubnetStatus_To_networking_SubnetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Subnet_To_networking_Subnet is an autogenerated conversion function.
+func Convert_v1alpha1_Subnet_To_networking_Subnet(in *v1alpha1.Subnet, out *networking.Subnet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Subnet_To_networking_Subnet(in, out, s)
+}
+
+func autoConvert_networking_Subnet_To_v1alpha1_Subnet(in *networking.Subnet, out *v1alpha1.Subnet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_networking_SubnetSpec_To_v1alpha1_SubnetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_networking_SubnetStatus_To_v1alpha1_SubnetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_networking_Subnet_To_v1alpha1_Subnet is an autogenerated conversion function.
+func Convert_networking_Subnet_To_v1alpha1_Subnet(in *networking.Subnet, out *v1alpha1.Subnet, s conversion.Scope) error {
+	return autoConvert_networking_Subnet_To_v1alpha1_Subnet(in, out, s)
+}
+
+func autoConvert_v1alpha1_SubnetList_To_networking_SubnetList(in *v1alpha1.SubnetList, out *networking.SubnetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]networking.Subnet)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_SubnetList_To_networking_SubnetList is an autogenerated conversion function.
+func Convert_v1alpha1_SubnetList_To_networking_SubnetList(in *v1alpha1.SubnetList, out *networking.SubnetList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SubnetList_To_networking_SubnetList(in, out, s)
+}
+
+func autoConvert_networking_SubnetList_To_v1alpha1_SubnetList(in *networking.SubnetList, out *v1alpha1.SubnetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.Subnet)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_networking_SubnetList_To_v1alpha1_SubnetList is an autogenerated conversion function.
+func Convert_networking_SubnetList_To_v1alpha1_SubnetList(in *networking.SubnetList, out *v1alpha1.SubnetList, s conversion.Scope) error {
+	return autoConvert_networking_SubnetList_To_v1alpha1_SubnetList(in, out, s)
+}
+
+func autoConvert_v1alpha1_SubnetSpec_To_networking_SubnetSpec(in *v1alpha1.SubnetSpec, out *networking.SubnetSpec, s conversion.Scope) error {
+	out.NetworkRef = in.NetworkRef
+	out.Prefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Prefixes))
+	return nil
+}
+
+// Convert_v1alpha1_SubnetSpec_To_networking_SubnetSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SubnetSpec_To_networking_SubnetSpec(in *v1alpha1.SubnetSpec, out *networking.SubnetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SubnetSpec_To_networking_SubnetSpec(in, out, s)
+}
+
+func autoConvert_networking_SubnetSpec_To_v1alpha1_SubnetSpec(in *networking.SubnetSpec, out *v1alpha1.SubnetSpec, s conversion.Scope) error {
+	out.NetworkRef = in.NetworkRef
+	out.Prefixes = *(*[]commonv1alpha1.IPPrefix)(unsafe.Pointer(&in.Prefixes))
+	return nil
+}
+
+// Convert_networking_SubnetSpec_To_v1alpha1_SubnetSpec is an autogenerated conversion function.
+func Convert_networking_SubnetSpec_To_v1alpha1_SubnetSpec(in *networking.SubnetSpec, out *v1alpha1.SubnetSpec, s conversion.Scope) error {
+	return autoConvert_networking_SubnetSpec_To_v1alpha1_SubnetSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_SubnetStatus_To_networking_SubnetStatus(in *v1alpha1.SubnetStatus, out *networking.SubnetStatus, s conversion.Scope) error {
+	out.State = networking.SubnetState(in.State)
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	return nil
+}
+
+// Convert_v1alpha1_SubnetStatus_To_networking_SubnetStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SubnetStatus_To_networking_SubnetStatus(in *v1alpha1.SubnetStatus, out *networking.SubnetStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SubnetStatus_To_networking_SubnetStatus(in, out, s)
+}
+
+func autoConvert_networking_SubnetStatus_To_v1alpha1_SubnetStatus(in *networking.SubnetStatus, out *v1alpha1.SubnetStatus, s conversion.Scope) error {
+	out.State = v1alpha1.SubnetState(in.State)
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	return nil
+}
+
+// Convert_networking_SubnetStatus_To_v1alpha1_SubnetStatus is an autogenerated conversion function.
+func Convert_networking_SubnetStatus_To_v1alpha1_SubnetStatus(in *networking.SubnetStatus, out *v1alpha1.SubnetStatus, s conversion.Scope) error {
+	return autoConvert_networking_SubnetStatus_To_v1alpha1_SubnetStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_VirtualIP_To_networking_VirtualIP(in *v1alpha1.VirtualIP, out *networking.VirtualIP, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_VirtualIPSpec_To_networking_VirtualIPSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1558,6 +2136,7 @@ func Convert_networking_VirtualIPSource_To_v1alpha1_VirtualIPSource(in *networki
 func autoConvert_v1alpha1_VirtualIPSpec_To_networking_VirtualIPSpec(in *v1alpha1.VirtualIPSpec, out *networking.VirtualIPSpec, s conversion.Scope) error {
 	out.Type = networking.VirtualIPType(in.Type)
 	out.IPFamily = v1.IPFamily(in.IPFamily)
+	out.PublicIPPoolRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PublicIPPoolRef))
 	out.TargetRef = (*commonv1alpha1.LocalUIDReference)(unsafe.Pointer(in.TargetRef))
 	return nil
 }
@@ -1570,6 +2149,7 @@ func Convert_v1alpha1_VirtualIPSpec_To_networking_VirtualIPSpec(in *v1alpha1.Vir
 func autoConvert_networking_VirtualIPSpec_To_v1alpha1_VirtualIPSpec(in *networking.VirtualIPSpec, out *v1alpha1.VirtualIPSpec, s conversion.Scope) error {
 	out.Type = v1alpha1.VirtualIPType(in.Type)
 	out.IPFamily = v1.IPFamily(in.IPFamily)
+	out.PublicIPPoolRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PublicIPPoolRef))
 	out.TargetRef = (*commonv1alpha1.LocalUIDReference)(unsafe.Pointer(in.TargetRef))
 	return nil
 }
@@ -60,6 +60,9 @@ func validatePrefixSource(src networking.PrefixSource, idx int, objectMeta *meta
 		} else {
 			numSources++
 			allErrs = append(allErrs, ValidatePrefixPrefixTemplate(ephemeral.PrefixTemplate, fldPath.Child("ephemeral"))...)
+			if ephemeral.DelegatedPrefixLength != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("ephemeral", "delegatedPrefixLength"), "prefix delegation is only supported for ip sources"))
+			}
 			if objectMeta != nil && objectMeta.Name != "" {
 				prefixName := networking.NetworkInterfacePrefixIPAMPrefixName(objectMeta.Name, idx)
 				for _, msg := range apivalidation.NameIsDNSLabel(prefixName, false) {
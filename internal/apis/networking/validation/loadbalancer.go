@@ -40,6 +40,22 @@ func validateLoadBalancerSpec(spec *networking.LoadBalancerSpec, lbMeta *metav1.
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("networkRef").Child("name"), spec.NetworkRef.Name, msg))
 	}
 
+	if len(spec.PublicIPPoolRefs) > 0 && spec.Type != networking.LoadBalancerTypePublic {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("publicIPPoolRefs"), fmt.Sprintf("can only be used with type %s", networking.LoadBalancerTypePublic)))
+	}
+	if len(spec.PublicIPPoolRefs) > 0 && len(spec.PublicIPPoolRefs) != len(spec.IPFamilies) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("publicIPPoolRefs"), len(spec.PublicIPPoolRefs), "must reference one public ip pool per ip family"))
+	}
+	seenPublicIPPoolNames := sets.New[string]()
+	for i, publicIPPoolRef := range spec.PublicIPPoolRefs {
+		fldPath := fldPath.Child("publicIPPoolRefs").Index(i)
+		if seenPublicIPPoolNames.Has(publicIPPoolRef.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), publicIPPoolRef.Name))
+		}
+		seenPublicIPPoolNames.Insert(publicIPPoolRef.Name)
+		allErrs = append(allErrs, validatePublicIPPoolRef(&publicIPPoolRef, fldPath)...)
+	}
+
 	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.NetworkInterfaceSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("networkInterfaceSelector"))...)
 
 	var (
@@ -130,6 +146,7 @@ func validateLoadBalancerSpecUpdate(newSpec, oldSpec *networking.LoadBalancerSpe
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.NetworkRef, oldSpec.NetworkRef, fldPath.Child("networkRef"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.PublicIPPoolRefs, oldSpec.PublicIPPoolRefs, fldPath.Child("publicIPPoolRefs"))...)
 
 	return allErrs
 }
@@ -56,6 +56,56 @@ var _ = Describe("LoadBalancer", func() {
 			&networking.LoadBalancer{Spec: networking.LoadBalancerSpec{Type: networking.LoadBalancerTypeInternal}},
 			Not(ContainElement(RequiredField("spec.type"))),
 		),
+		Entry("public ip pool ref on internal load balancer",
+			&networking.LoadBalancer{
+				Spec: networking.LoadBalancerSpec{
+					Type:             networking.LoadBalancerTypeInternal,
+					IPFamilies:       []corev1.IPFamily{corev1.IPv4Protocol},
+					PublicIPPoolRefs: []corev1.LocalObjectReference{{Name: "pool"}},
+				},
+			},
+			ContainElement(ForbiddenField("spec.publicIPPoolRefs")),
+		),
+		Entry("public ip pool ref on public load balancer",
+			&networking.LoadBalancer{
+				Spec: networking.LoadBalancerSpec{
+					Type:             networking.LoadBalancerTypePublic,
+					IPFamilies:       []corev1.IPFamily{corev1.IPv4Protocol},
+					PublicIPPoolRefs: []corev1.LocalObjectReference{{Name: "pool"}},
+				},
+			},
+			Not(ContainElement(ForbiddenField("spec.publicIPPoolRefs"))),
+		),
+		Entry("public ip pool refs not matching ip families",
+			&networking.LoadBalancer{
+				Spec: networking.LoadBalancerSpec{
+					Type:             networking.LoadBalancerTypePublic,
+					IPFamilies:       []corev1.IPFamily{corev1.IPv4Protocol},
+					PublicIPPoolRefs: []corev1.LocalObjectReference{{Name: "pool-v4"}, {Name: "pool-v6"}},
+				},
+			},
+			ContainElement(InvalidField("spec.publicIPPoolRefs")),
+		),
+		Entry("duplicate public ip pool ref",
+			&networking.LoadBalancer{
+				Spec: networking.LoadBalancerSpec{
+					Type:             networking.LoadBalancerTypePublic,
+					IPFamilies:       []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+					PublicIPPoolRefs: []corev1.LocalObjectReference{{Name: "pool"}, {Name: "pool"}},
+				},
+			},
+			ContainElement(DuplicateField("spec.publicIPPoolRefs[1].name")),
+		),
+		Entry("public ip pool ref per ip family",
+			&networking.LoadBalancer{
+				Spec: networking.LoadBalancerSpec{
+					Type:             networking.LoadBalancerTypePublic,
+					IPFamilies:       []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+					PublicIPPoolRefs: []corev1.LocalObjectReference{{Name: "pool-v4"}, {Name: "pool-v6"}},
+				},
+			},
+			Not(ContainElement(HaveField("Field", HavePrefix("spec.publicIPPoolRefs")))),
+		),
 		Entry("duplicate ip family",
 			&networking.LoadBalancer{
 				Spec: networking.LoadBalancerSpec{
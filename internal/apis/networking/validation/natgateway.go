@@ -32,6 +32,8 @@ func validateNATGatewaySpec(spec *networking.NATGatewaySpec, fldPath *field.Path
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("networkRef").Child("name"), spec.NetworkRef.Name, msg))
 	}
 
+	allErrs = append(allErrs, validatePublicIPPoolRef(spec.PublicIPPoolRef, fldPath.Child("publicIPPoolRef"))...)
+
 	if spec.PortsPerNetworkInterface != nil {
 		allErrs = append(allErrs, ironcorevalidation.ValidatePowerOfTwo(int64(*spec.PortsPerNetworkInterface), fldPath.Child("portsPerNetworkInterface"))...)
 	}
@@ -63,6 +65,7 @@ func validateNATGatewaySpecPrefixUpdate(newSpec, oldSpec *networking.NATGatewayS
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.NetworkRef, oldSpec.NetworkRef, fldPath.Child("networkRef"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.PublicIPPoolRef, oldSpec.PublicIPPoolRef, fldPath.Child("publicIPPoolRef"))...)
 
 	return allErrs
 }
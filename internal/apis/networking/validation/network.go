@@ -4,6 +4,10 @@
 package validation
 
 import (
+	"fmt"
+	"slices"
+
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
 	"github.com/ironcore-dev/ironcore/internal/apis/networking"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -22,9 +26,43 @@ func ValidateNetwork(network *networking.Network) field.ErrorList {
 	return allErrs
 }
 
+func validateNetworkPrefixes(name string, prefixes []commonv1alpha1.IPPrefix, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, prefix := range prefixes {
+		fldPath := fldPath.Index(i)
+		if !prefix.IsValid() {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must specify a valid prefix"))
+			continue
+		}
+
+		if prefix.Prefix != prefix.Masked() {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, fmt.Sprintf("must be the masked prefix %s", prefix.Masked())))
+		}
+
+		for _, other := range prefixes[:i] {
+			if other.IsValid() && other.Overlaps(prefix.Prefix) {
+				allErrs = append(allErrs, field.Invalid(fldPath, prefix, fmt.Sprintf("overlaps with prefix %s", other)))
+				break
+			}
+		}
+
+		if name != "" {
+			prefixName := networking.NetworkPrefixIPAMPrefixName(name, i)
+			for _, msg := range apivalidation.NameIsDNSLabel(prefixName, false) {
+				allErrs = append(allErrs, field.Invalid(fldPath, prefixName, fmt.Sprintf("resulting prefix name %q is invalid: %s", prefixName, msg)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateNetworkSpec(namespace, name string, spec *networking.NetworkSpec, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
+	allErrs = append(allErrs, validateNetworkPrefixes(name, spec.Prefixes, fldPath.Child("prefixes"))...)
+
 	seenNames := sets.New[string]()
 	seenPeeringNetworkKeys := sets.New[client.ObjectKey]()
 
@@ -51,13 +89,13 @@ func validateNetworkSpec(namespace, name string, spec *networking.NetworkSpec, f
 			seenPeeringNetworkKeys.Insert(peeringNetworkKey)
 		}
 
-		allErrs = append(allErrs, validateNetworkPeering(peering, fldPath)...)
+		allErrs = append(allErrs, validateNetworkPeering(peering, spec.Prefixes, fldPath)...)
 	}
 
 	return allErrs
 }
 
-func validateNetworkPeering(peering networking.NetworkPeering, fldPath *field.Path) field.ErrorList {
+func validateNetworkPeering(peering networking.NetworkPeering, networkPrefixes []commonv1alpha1.IPPrefix, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
 	for _, msg := range apivalidation.NameIsDNSLabel(peering.Name, false) {
@@ -74,6 +112,68 @@ func validateNetworkPeering(peering networking.NetworkPeering, fldPath *field.Pa
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("networkRef", "name"), networkRef.Name, msg))
 	}
 
+	seenNames := sets.New[string]()
+	for i, peeringPrefix := range peering.Prefixes {
+		fldPath := fldPath.Child("prefixes").Index(i)
+		if seenNames.Has(peeringPrefix.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), peeringPrefix.Name))
+		} else {
+			seenNames.Insert(peeringPrefix.Name)
+		}
+
+		allErrs = append(allErrs, validatePeeringPrefix(peeringPrefix, networkPrefixes, fldPath)...)
+	}
+
+	return allErrs
+}
+
+func validatePeeringPrefix(peeringPrefix networking.PeeringPrefix, networkPrefixes []commonv1alpha1.IPPrefix, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, msg := range apivalidation.NameIsDNSLabel(peeringPrefix.Name, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), peeringPrefix.Name, msg))
+	}
+
+	var numSources int
+	if prefix := peeringPrefix.Prefix; prefix != nil {
+		numSources++
+		allErrs = append(allErrs, validatePeeringPrefixPrefix(*prefix, networkPrefixes, fldPath.Child("prefix"))...)
+	}
+	if subnetRef := peeringPrefix.SubnetRef; subnetRef != nil {
+		if numSources > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("subnetRef"), "cannot specify multiple sources"))
+		} else {
+			numSources++
+			for _, msg := range apivalidation.NameIsDNSLabel(subnetRef.Name, false) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("subnetRef", "name"), subnetRef.Name, msg))
+			}
+		}
+	}
+	if numSources == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify a prefix or a subnet ref"))
+	}
+
+	return allErrs
+}
+
+func validatePeeringPrefixPrefix(prefix commonv1alpha1.IPPrefix, networkPrefixes []commonv1alpha1.IPPrefix, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !prefix.IsValid() {
+		allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must specify a valid prefix"))
+		return allErrs
+	}
+
+	if prefix.Prefix != prefix.Masked() {
+		allErrs = append(allErrs, field.Invalid(fldPath, prefix, fmt.Sprintf("must be the masked prefix %s", prefix.Masked())))
+	}
+
+	if len(networkPrefixes) > 0 && !slices.ContainsFunc(networkPrefixes, func(networkPrefix commonv1alpha1.IPPrefix) bool {
+		return networkPrefix.IsValid() && networkPrefix.Bits() <= prefix.Bits() && networkPrefix.Contains(prefix.Addr())
+	}) {
+		allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must be contained in the network prefixes"))
+	}
+
 	return allErrs
 }
 
@@ -95,5 +195,9 @@ func validateNetworkSpecUpdate(newSpec, oldSpec *networking.NetworkSpec, fldPath
 		allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.ProviderID, oldSpec.ProviderID, fldPath.Child("providerID"))...)
 	}
 
+	if len(oldSpec.Prefixes) > 0 {
+		allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.Prefixes, oldSpec.Prefixes, fldPath.Child("prefixes"))...)
+	}
+
 	return allErrs
 }
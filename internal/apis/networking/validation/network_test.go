@@ -4,11 +4,13 @@
 package validation
 
 import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	"github.com/ironcore-dev/ironcore/internal/apis/networking"
 	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -68,6 +70,129 @@ var _ = Describe("Network", func() {
 			},
 			ContainElement(DuplicateField("spec.peerings[1].networkRef")),
 		),
+		Entry("invalid prefix",
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{{}},
+				},
+			},
+			ContainElement(InvalidField("spec.prefixes[0]")),
+		),
+		Entry("non-masked prefix",
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.1/16")},
+				},
+			},
+			ContainElement(InvalidField("spec.prefixes[0]")),
+		),
+		Entry("overlapping prefixes",
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{
+						commonv1alpha1.MustParseIPPrefix("10.0.0.0/16"),
+						commonv1alpha1.MustParseIPPrefix("10.0.1.0/24"),
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.prefixes[1]")),
+		),
+		Entry("valid dual stack prefixes",
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{
+						commonv1alpha1.MustParseIPPrefix("10.0.0.0/16"),
+						commonv1alpha1.MustParseIPPrefix("fd00::/48"),
+					},
+				},
+			},
+			Not(ContainElement(InvalidField("spec.prefixes[1]"))),
+		),
+		Entry("peering prefix without source",
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Peerings: []networking.NetworkPeering{
+						{
+							Name:       "peering",
+							NetworkRef: networking.NetworkPeeringNetworkRef{Name: "bar"},
+							Prefixes:   []networking.PeeringPrefix{{Name: "foo"}},
+						},
+					},
+				},
+			},
+			ContainElement(RequiredField("spec.peerings[0].prefixes[0]")),
+		),
+		Entry("peering prefix with multiple sources",
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Peerings: []networking.NetworkPeering{
+						{
+							Name:       "peering",
+							NetworkRef: networking.NetworkPeeringNetworkRef{Name: "bar"},
+							Prefixes: []networking.PeeringPrefix{
+								{
+									Name:      "foo",
+									Prefix:    commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/24"),
+									SubnetRef: &corev1.LocalObjectReference{Name: "subnet"},
+								},
+							},
+						},
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.peerings[0].prefixes[0].subnetRef")),
+		),
+		Entry("duplicate peering prefix name",
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Peerings: []networking.NetworkPeering{
+						{
+							Name:       "peering",
+							NetworkRef: networking.NetworkPeeringNetworkRef{Name: "bar"},
+							Prefixes: []networking.PeeringPrefix{
+								{Name: "foo", SubnetRef: &corev1.LocalObjectReference{Name: "subnet-1"}},
+								{Name: "foo", SubnetRef: &corev1.LocalObjectReference{Name: "subnet-2"}},
+							},
+						},
+					},
+				},
+			},
+			ContainElement(DuplicateField("spec.peerings[0].prefixes[1].name")),
+		),
+		Entry("peering prefix not contained in network prefixes",
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+					Peerings: []networking.NetworkPeering{
+						{
+							Name:       "peering",
+							NetworkRef: networking.NetworkPeeringNetworkRef{Name: "bar"},
+							Prefixes: []networking.PeeringPrefix{
+								{Name: "foo", Prefix: commonv1alpha1.MustParseNewIPPrefix("192.168.0.0/24")},
+							},
+						},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.peerings[0].prefixes[0].prefix")),
+		),
+		Entry("valid peering prefix",
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+					Peerings: []networking.NetworkPeering{
+						{
+							Name:       "peering",
+							NetworkRef: networking.NetworkPeeringNetworkRef{Name: "bar"},
+							Prefixes: []networking.PeeringPrefix{
+								{Name: "foo", Prefix: commonv1alpha1.MustParseNewIPPrefix("10.0.1.0/24")},
+							},
+						},
+					},
+				},
+			},
+			Not(ContainElement(InvalidField("spec.peerings[0].prefixes[0].prefix"))),
+		),
 	)
 
 	DescribeTable("ValidateNetworkUpdate",
@@ -97,5 +222,27 @@ var _ = Describe("Network", func() {
 			&networking.Network{},
 			Not(ContainElement(ImmutableField("spec.providerID"))),
 		),
+		Entry("immutable prefixes if set",
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+				},
+			},
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.1.0.0/16")},
+				},
+			},
+			ContainElement(ImmutableField("spec.prefixes")),
+		),
+		Entry("mutable prefixes if not set",
+			&networking.Network{
+				Spec: networking.NetworkSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+				},
+			},
+			&networking.Network{},
+			Not(ContainElement(ImmutableField("spec.prefixes"))),
+		),
 	)
 })
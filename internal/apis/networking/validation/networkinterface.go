@@ -6,6 +6,7 @@ package validation
 import (
 	"fmt"
 
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
 	"github.com/ironcore-dev/ironcore/internal/apis/ipam"
 	ipamvalidation "github.com/ironcore-dev/ironcore/internal/apis/ipam/validation"
@@ -48,6 +49,12 @@ func validateNetworkInterfaceSpec(spec *networking.NetworkInterfaceSpec, nicMeta
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("networkRef").Child("name"), spec.NetworkRef.Name, msg))
 	}
 
+	if spec.SubnetRef != nil {
+		for _, msg := range apivalidation.NameIsDNSLabel(spec.SubnetRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("subnetRef").Child("name"), spec.SubnetRef.Name, msg))
+		}
+	}
+
 	if spec.MachineRef != nil {
 		for _, msg := range apivalidation.NameIsDNSLabel(spec.MachineRef.Name, false) {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("machineRef").Child("name"), spec.MachineRef.Name, msg))
@@ -62,6 +69,7 @@ func validateNetworkInterfaceSpec(spec *networking.NetworkInterfaceSpec, nicMeta
 
 	allErrs = append(allErrs, validateNetworkInterfaceIPSources(spec.IPs, spec.IPFamilies, nicMeta, fldPath.Child("ips"))...)
 	allErrs = append(allErrs, validateNetworkInterfacePrefixSources(spec.Prefixes, nicMeta, fldPath.Child("prefixes"))...)
+	allErrs = append(allErrs, validateNetworkInterfaceDelegatedPrefixes(spec.DelegatedPrefixes, fldPath.Child("delegatedPrefixes"))...)
 
 	if virtualIP := spec.VirtualIP; virtualIP != nil {
 		allErrs = append(allErrs, validateVirtualIPSource(virtualIP, fldPath.Child("virtualIP"))...)
@@ -95,6 +103,24 @@ func validateNetworkInterfacePrefixSources(prefixSources []networking.PrefixSour
 	return allErrs
 }
 
+func validateNetworkInterfaceDelegatedPrefixes(prefixes []commonv1alpha1.IPPrefix, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, prefix := range prefixes {
+		fldPath := fldPath.Index(i)
+		if !prefix.IsValid() {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must specify a valid prefix"))
+			continue
+		}
+
+		if prefix.Prefix != prefix.Masked() {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, fmt.Sprintf("must be the masked prefix %s", prefix.Masked())))
+		}
+	}
+
+	return allErrs
+}
+
 func validateVirtualIPSource(vipSource *networking.VirtualIPSource, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -171,6 +197,12 @@ func validateEphemeralPrefixSource(ipFamily corev1.IPFamily, source *networking.
 
 	allErrs = append(allErrs, ValidateIPPrefixTemplate(source.PrefixTemplate, ipFamily, fldPath.Child("prefixTemplate"))...)
 
+	if delegatedPrefixLength := source.DelegatedPrefixLength; delegatedPrefixLength != nil {
+		if bits := ipFamilyToBits[ipFamily]; *delegatedPrefixLength <= 0 || *delegatedPrefixLength > bits {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("delegatedPrefixLength"), *delegatedPrefixLength, fmt.Sprintf("must be between 1 and %d", bits)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -204,6 +236,7 @@ func validateNetworkInterfaceSpecUpdate(newSpec, oldSpec *networking.NetworkInte
 	oldSpecCopy.ProviderID = newSpec.ProviderID
 	oldSpecCopy.IPs = newSpec.IPs
 	oldSpecCopy.Prefixes = newSpec.Prefixes
+	oldSpecCopy.DelegatedPrefixes = newSpec.DelegatedPrefixes
 	oldSpecCopy.MachineRef = newSpec.MachineRef
 	oldSpecCopy.VirtualIP = newSpec.VirtualIP
 	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableFieldWithDiff(newSpecCopy, oldSpecCopy, fldPath)...)
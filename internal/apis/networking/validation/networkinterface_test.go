@@ -13,6 +13,7 @@ import (
 	"github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 var _ = Describe("NetworkInterface", func() {
@@ -45,6 +46,14 @@ var _ = Describe("NetworkInterface", func() {
 			},
 			ContainElement(InvalidField("spec.networkRef.name")),
 		),
+		Entry("invalid subnet ref name",
+			&networking.NetworkInterface{
+				Spec: networking.NetworkInterfaceSpec{
+					SubnetRef: &corev1.LocalObjectReference{Name: "foo*"},
+				},
+			},
+			ContainElement(InvalidField("spec.subnetRef.name")),
+		),
 		Entry("invalid machine ref name",
 			&networking.NetworkInterface{
 				Spec: networking.NetworkInterfaceSpec{
@@ -127,6 +136,70 @@ var _ = Describe("NetworkInterface", func() {
 			},
 			ContainElement(ForbiddenField("spec.ips[0].ephemeral.prefixTemplate.spec.prefixLength")),
 		),
+		Entry("delegated prefix length exceeds ip family bits",
+			&networking.NetworkInterface{
+				Spec: networking.NetworkInterfaceSpec{
+					IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+					IPs: []networking.IPSource{{Ephemeral: &networking.EphemeralPrefixSource{
+						PrefixTemplate: &ipam.PrefixTemplateSpec{
+							Spec: ipam.PrefixSpec{
+								IPFamily: corev1.IPv4Protocol,
+							},
+						},
+						DelegatedPrefixLength: ptr.To[int32](64),
+					}}},
+				},
+			},
+			ContainElement(InvalidField("spec.ips[0].ephemeral.delegatedPrefixLength")),
+		),
+		Entry("valid ipv6 delegated prefix length",
+			&networking.NetworkInterface{
+				Spec: networking.NetworkInterfaceSpec{
+					IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol},
+					IPs: []networking.IPSource{{Ephemeral: &networking.EphemeralPrefixSource{
+						PrefixTemplate: &ipam.PrefixTemplateSpec{
+							Spec: ipam.PrefixSpec{
+								IPFamily: corev1.IPv6Protocol,
+							},
+						},
+						DelegatedPrefixLength: ptr.To[int32](64),
+					}}},
+				},
+			},
+			Not(ContainElement(InvalidField("spec.ips[0].ephemeral.delegatedPrefixLength"))),
+		),
+		Entry("delegated prefix length on prefix source",
+			&networking.NetworkInterface{
+				Spec: networking.NetworkInterfaceSpec{
+					Prefixes: []networking.PrefixSource{{Ephemeral: &networking.EphemeralPrefixSource{
+						PrefixTemplate: &ipam.PrefixTemplateSpec{
+							Spec: ipam.PrefixSpec{
+								IPFamily:     corev1.IPv6Protocol,
+								PrefixLength: 64,
+							},
+						},
+						DelegatedPrefixLength: ptr.To[int32](64),
+					}}},
+				},
+			},
+			ContainElement(ForbiddenField("spec.prefixes[0].ephemeral.delegatedPrefixLength")),
+		),
+		Entry("unmasked delegated prefix",
+			&networking.NetworkInterface{
+				Spec: networking.NetworkInterfaceSpec{
+					DelegatedPrefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("2001:db8::1/64")},
+				},
+			},
+			ContainElement(InvalidField("spec.delegatedPrefixes[0]")),
+		),
+		Entry("valid delegated prefix",
+			&networking.NetworkInterface{
+				Spec: networking.NetworkInterfaceSpec{
+					DelegatedPrefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("2001:db8::/64")},
+				},
+			},
+			Not(ContainElement(InvalidField("spec.delegatedPrefixes[0]"))),
+		),
 		Entry("invalid virtual ip reference",
 			&networking.NetworkInterface{
 				Spec: networking.NetworkInterfaceSpec{
@@ -225,6 +298,15 @@ var _ = Describe("NetworkInterface", func() {
 			},
 			Not(ContainElement(ForbiddenField("spec"))),
 		),
+		Entry("mutable delegated prefixes",
+			&networking.NetworkInterface{
+				Spec: networking.NetworkInterfaceSpec{
+					DelegatedPrefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("2001:db8::/64")},
+				},
+			},
+			&networking.NetworkInterface{},
+			Not(ContainElement(ForbiddenField("spec"))),
+		),
 		Entry("mutable virtual ip",
 			&networking.NetworkInterface{
 				Spec: networking.NetworkInterfaceSpec{
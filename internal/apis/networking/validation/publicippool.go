@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidatePublicIPPool validates a PublicIPPool object.
+func ValidatePublicIPPool(publicIPPool *networking.PublicIPPool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(publicIPPool, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validatePublicIPPoolSpec(publicIPPool.Name, &publicIPPool.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validatePublicIPPoolSpec(name string, spec *networking.PublicIPPoolSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ironcorevalidation.ValidateIPFamily(spec.IPFamily, fldPath.Child("ipFamily"))...)
+
+	if len(spec.Prefixes) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("prefixes"), "must specify at least one prefix"))
+	}
+
+	for i, prefix := range spec.Prefixes {
+		fldPath := fldPath.Child("prefixes").Index(i)
+		if !prefix.IsValid() {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must specify a valid prefix"))
+			continue
+		}
+
+		if prefix.Prefix != prefix.Masked() {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, fmt.Sprintf("must be the masked prefix %s", prefix.Masked())))
+		}
+
+		if ipFamily := prefix.IP().Family(); spec.IPFamily != "" && ipFamily != spec.IPFamily {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, fmt.Sprintf("must be of ip family %s", spec.IPFamily)))
+		}
+
+		if slices.ContainsFunc(spec.Prefixes[:i], func(other commonv1alpha1.IPPrefix) bool {
+			return other.IsValid() && other.Overlaps(prefix.Prefix)
+		}) {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must not overlap with other prefixes"))
+		}
+
+		if name != "" {
+			prefixName := networking.PublicIPPoolPrefixIPAMPrefixName(name, i)
+			for _, msg := range apivalidation.NameIsDNSLabel(prefixName, false) {
+				allErrs = append(allErrs, field.Invalid(fldPath, prefixName, fmt.Sprintf("resulting prefix name %q is invalid: %s", prefixName, msg)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidatePublicIPPoolUpdate validates a PublicIPPool object before an update.
+func ValidatePublicIPPoolUpdate(newPublicIPPool, oldPublicIPPool *networking.PublicIPPool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newPublicIPPool, oldPublicIPPool, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validatePublicIPPoolSpecUpdate(&newPublicIPPool.Spec, &oldPublicIPPool.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidatePublicIPPool(newPublicIPPool)...)
+
+	return allErrs
+}
+
+// validatePublicIPPoolSpecUpdate validates the spec of a PublicIPPool object before an update.
+func validatePublicIPPoolSpecUpdate(newSpec, oldSpec *networking.PublicIPPoolSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.IPFamily, oldSpec.IPFamily, fldPath.Child("ipFamily"))...)
+
+	// Prefixes may only be added to a pool, as removing or changing them would invalidate existing allocations.
+	if len(newSpec.Prefixes) < len(oldSpec.Prefixes) || !slices.Equal(newSpec.Prefixes[:len(oldSpec.Prefixes)], oldSpec.Prefixes) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("prefixes"), "existing prefixes must not be removed or changed"))
+	}
+
+	return allErrs
+}
+
+func validatePublicIPPoolRef(publicIPPoolRef *corev1.LocalObjectReference, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if publicIPPoolRef != nil {
+		for _, msg := range apivalidation.NameIsDNSLabel(publicIPPoolRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), publicIPPoolRef.Name, msg))
+		}
+	}
+
+	return allErrs
+}
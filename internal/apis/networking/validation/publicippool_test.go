@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("PublicIPPool", func() {
+	DescribeTable("ValidatePublicIPPool",
+		func(publicIPPool *networking.PublicIPPool, match types.GomegaMatcher) {
+			errList := ValidatePublicIPPool(publicIPPool)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&networking.PublicIPPool{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("forbidden namespace",
+			&networking.PublicIPPool{ObjectMeta: metav1.ObjectMeta{Namespace: "foo"}},
+			ContainElement(ForbiddenField("metadata.namespace")),
+		),
+		Entry("missing ip family",
+			&networking.PublicIPPool{},
+			ContainElement(RequiredField("spec.ipFamily")),
+		),
+		Entry("missing prefixes",
+			&networking.PublicIPPool{},
+			ContainElement(RequiredField("spec.prefixes")),
+		),
+		Entry("non-masked prefix",
+			&networking.PublicIPPool{
+				Spec: networking.PublicIPPoolSpec{
+					IPFamily: corev1.IPv4Protocol,
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("192.0.2.1/24")},
+				},
+			},
+			ContainElement(InvalidField("spec.prefixes[0]")),
+		),
+		Entry("prefix of different ip family",
+			&networking.PublicIPPool{
+				Spec: networking.PublicIPPoolSpec{
+					IPFamily: corev1.IPv4Protocol,
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("2001:db8::/64")},
+				},
+			},
+			ContainElement(InvalidField("spec.prefixes[0]")),
+		),
+		Entry("overlapping prefixes",
+			&networking.PublicIPPool{
+				Spec: networking.PublicIPPoolSpec{
+					IPFamily: corev1.IPv4Protocol,
+					Prefixes: []commonv1alpha1.IPPrefix{
+						commonv1alpha1.MustParseIPPrefix("192.0.2.0/24"),
+						commonv1alpha1.MustParseIPPrefix("192.0.2.128/25"),
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.prefixes[1]")),
+		),
+		Entry("valid public ip pool",
+			&networking.PublicIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: networking.PublicIPPoolSpec{
+					IPFamily: corev1.IPv4Protocol,
+					Prefixes: []commonv1alpha1.IPPrefix{
+						commonv1alpha1.MustParseIPPrefix("192.0.2.0/25"),
+						commonv1alpha1.MustParseIPPrefix("192.0.2.128/25"),
+					},
+				},
+			},
+			BeEmpty(),
+		),
+	)
+
+	DescribeTable("ValidatePublicIPPoolUpdate",
+		func(newPublicIPPool, oldPublicIPPool *networking.PublicIPPool, match types.GomegaMatcher) {
+			errList := ValidatePublicIPPoolUpdate(newPublicIPPool, oldPublicIPPool)
+			Expect(errList).To(match)
+		},
+		Entry("immutable ip family",
+			&networking.PublicIPPool{Spec: networking.PublicIPPoolSpec{IPFamily: corev1.IPv4Protocol}},
+			&networking.PublicIPPool{Spec: networking.PublicIPPoolSpec{IPFamily: corev1.IPv6Protocol}},
+			ContainElement(ImmutableField("spec.ipFamily")),
+		),
+		Entry("removed prefix",
+			&networking.PublicIPPool{
+				Spec: networking.PublicIPPoolSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("192.0.2.0/25")},
+				},
+			},
+			&networking.PublicIPPool{
+				Spec: networking.PublicIPPoolSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{
+						commonv1alpha1.MustParseIPPrefix("192.0.2.0/25"),
+						commonv1alpha1.MustParseIPPrefix("192.0.2.128/25"),
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.prefixes")),
+		),
+		Entry("added prefix",
+			&networking.PublicIPPool{
+				Spec: networking.PublicIPPoolSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{
+						commonv1alpha1.MustParseIPPrefix("192.0.2.0/25"),
+						commonv1alpha1.MustParseIPPrefix("192.0.2.128/25"),
+					},
+				},
+			},
+			&networking.PublicIPPool{
+				Spec: networking.PublicIPPoolSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("192.0.2.0/25")},
+				},
+			},
+			Not(ContainElement(ForbiddenField("spec.prefixes"))),
+		),
+	)
+})
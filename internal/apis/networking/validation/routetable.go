@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateRouteTable validates a RouteTable object.
+func ValidateRouteTable(routeTable *networking.RouteTable) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(routeTable, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateRouteTableSpec(&routeTable.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateRouteTableSpec(spec *networking.RouteTableSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.NetworkRef == (corev1.LocalObjectReference{}) {
+		allErrs = append(allErrs, field.Required(fldPath.Child("networkRef"), "must specify a network ref"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSLabel(spec.NetworkRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("networkRef").Child("name"), spec.NetworkRef.Name, msg))
+		}
+	}
+
+	seenNames := sets.New[string]()
+	seenDestinations := sets.New[commonv1alpha1.IPPrefix]()
+	for i, route := range spec.Routes {
+		fldPath := fldPath.Child("routes").Index(i)
+		if seenNames.Has(route.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), route.Name))
+		} else {
+			seenNames.Insert(route.Name)
+		}
+
+		if route.Destination.IsValid() {
+			if seenDestinations.Has(route.Destination) {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Child("destination"), route.Destination))
+			} else {
+				seenDestinations.Insert(route.Destination)
+			}
+		}
+
+		allErrs = append(allErrs, validateRoute(&route, fldPath)...)
+	}
+
+	return allErrs
+}
+
+func validateRoute(route *networking.Route, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, msg := range apivalidation.NameIsDNSLabel(route.Name, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), route.Name, msg))
+	}
+
+	if !route.Destination.IsValid() {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("destination"), route.Destination, "must specify a valid prefix"))
+	} else if route.Destination.Prefix != route.Destination.Masked() {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("destination"), route.Destination, fmt.Sprintf("must be the masked prefix %s", route.Destination.Masked())))
+	}
+
+	allErrs = append(allErrs, validateRouteNextHop(&route.NextHop, fldPath.Child("nextHop"))...)
+
+	return allErrs
+}
+
+func validateRouteNextHop(nextHop *networking.RouteNextHop, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var numDefs int
+	for _, ref := range []struct {
+		name string
+		ref  *corev1.LocalObjectReference
+	}{
+		{"networkInterfaceRef", nextHop.NetworkInterfaceRef},
+		{"virtualIPRef", nextHop.VirtualIPRef},
+		{"natGatewayRef", nextHop.NATGatewayRef},
+		{"networkPeeringRef", nextHop.NetworkPeeringRef},
+	} {
+		if ref.ref == nil {
+			continue
+		}
+
+		if numDefs > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(ref.name), "must only specify one next hop"))
+			continue
+		}
+		numDefs++
+
+		for _, msg := range apivalidation.NameIsDNSLabel(ref.ref.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(ref.name, "name"), ref.ref.Name, msg))
+		}
+	}
+	if numDefs == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify a next hop"))
+	}
+
+	return allErrs
+}
+
+// ValidateRouteTableUpdate validates a RouteTable object before an update.
+func ValidateRouteTableUpdate(newRouteTable, oldRouteTable *networking.RouteTable) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newRouteTable, oldRouteTable, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateRouteTableSpecUpdate(&newRouteTable.Spec, &oldRouteTable.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateRouteTable(newRouteTable)...)
+
+	return allErrs
+}
+
+// validateRouteTableSpecUpdate validates the spec of a RouteTable object before an update.
+func validateRouteTableSpecUpdate(newSpec, oldSpec *networking.RouteTableSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.NetworkRef, oldSpec.NetworkRef, fldPath.Child("networkRef"))...)
+
+	return allErrs
+}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("RouteTable", func() {
+	DescribeTable("ValidateRouteTable",
+		func(routeTable *networking.RouteTable, match types.GomegaMatcher) {
+			errList := ValidateRouteTable(routeTable)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&networking.RouteTable{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("missing namespace",
+			&networking.RouteTable{ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+			ContainElement(RequiredField("metadata.namespace")),
+		),
+		Entry("bad name",
+			&networking.RouteTable{ObjectMeta: metav1.ObjectMeta{Name: "foo*"}},
+			ContainElement(InvalidField("metadata.name")),
+		),
+		Entry("no network ref",
+			&networking.RouteTable{},
+			ContainElement(RequiredField("spec.networkRef")),
+		),
+		Entry("invalid network ref name",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					NetworkRef: corev1.LocalObjectReference{Name: "foo*"},
+				},
+			},
+			ContainElement(InvalidField("spec.networkRef.name")),
+		),
+		Entry("invalid route name",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					Routes: []networking.Route{{Name: "foo*"}},
+				},
+			},
+			ContainElement(InvalidField("spec.routes[0].name")),
+		),
+		Entry("duplicate route name",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					Routes: []networking.Route{{Name: "foo"}, {Name: "foo"}},
+				},
+			},
+			ContainElement(DuplicateField("spec.routes[1].name")),
+		),
+		Entry("invalid destination",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					Routes: []networking.Route{{Name: "foo"}},
+				},
+			},
+			ContainElement(InvalidField("spec.routes[0].destination")),
+		),
+		Entry("non-masked destination",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					Routes: []networking.Route{
+						{Name: "foo", Destination: commonv1alpha1.MustParseIPPrefix("10.50.0.1/16")},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.routes[0].destination")),
+		),
+		Entry("duplicate destination",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					Routes: []networking.Route{
+						{Name: "foo", Destination: commonv1alpha1.MustParseIPPrefix("10.50.0.0/16")},
+						{Name: "bar", Destination: commonv1alpha1.MustParseIPPrefix("10.50.0.0/16")},
+					},
+				},
+			},
+			ContainElement(DuplicateField("spec.routes[1].destination")),
+		),
+		Entry("no next hop",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					Routes: []networking.Route{{Name: "foo"}},
+				},
+			},
+			ContainElement(RequiredField("spec.routes[0].nextHop")),
+		),
+		Entry("multiple next hops",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					Routes: []networking.Route{
+						{
+							Name: "foo",
+							NextHop: networking.RouteNextHop{
+								NetworkInterfaceRef: &corev1.LocalObjectReference{Name: "foo"},
+								VirtualIPRef:        &corev1.LocalObjectReference{Name: "bar"},
+							},
+						},
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.routes[0].nextHop.virtualIPRef")),
+		),
+		Entry("invalid next hop name",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					Routes: []networking.Route{
+						{
+							Name: "foo",
+							NextHop: networking.RouteNextHop{
+								NATGatewayRef: &corev1.LocalObjectReference{Name: "foo*"},
+							},
+						},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.routes[0].nextHop.natGatewayRef.name")),
+		),
+		Entry("valid route",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					Routes: []networking.Route{
+						{
+							Name:        "foo",
+							Destination: commonv1alpha1.MustParseIPPrefix("10.50.0.0/16"),
+							NextHop: networking.RouteNextHop{
+								NetworkInterfaceRef: &corev1.LocalObjectReference{Name: "appliance"},
+							},
+						},
+					},
+				},
+			},
+			Not(ContainElement(InvalidField("spec.routes[0]"))),
+		),
+	)
+
+	DescribeTable("ValidateRouteTableUpdate",
+		func(newRouteTable, oldRouteTable *networking.RouteTable, match types.GomegaMatcher) {
+			errList := ValidateRouteTableUpdate(newRouteTable, oldRouteTable)
+			Expect(errList).To(match)
+		},
+		Entry("immutable network ref",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					NetworkRef: corev1.LocalObjectReference{Name: "foo"},
+				},
+			},
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					NetworkRef: corev1.LocalObjectReference{Name: "bar"},
+				},
+			},
+			ContainElement(ImmutableField("spec.networkRef")),
+		),
+		Entry("mutable routes",
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					NetworkRef: corev1.LocalObjectReference{Name: "foo"},
+					Routes: []networking.Route{
+						{
+							Name:        "foo",
+							Destination: commonv1alpha1.MustParseIPPrefix("10.50.0.0/16"),
+							NextHop: networking.RouteNextHop{
+								NetworkPeeringRef: &corev1.LocalObjectReference{Name: "peering"},
+							},
+						},
+					},
+				},
+			},
+			&networking.RouteTable{
+				Spec: networking.RouteTableSpec{
+					NetworkRef: corev1.LocalObjectReference{Name: "foo"},
+				},
+			},
+			Not(ContainElement(ImmutableField("spec.routes"))),
+		),
+	)
+})
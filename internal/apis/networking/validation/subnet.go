@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateSubnet validates a Subnet object.
+func ValidateSubnet(subnet *networking.Subnet) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(subnet, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateSubnetSpec(subnet.Name, &subnet.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateSubnetSpec(name string, spec *networking.SubnetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.NetworkRef == (corev1.LocalObjectReference{}) {
+		allErrs = append(allErrs, field.Required(fldPath.Child("networkRef"), "must specify a network ref"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSLabel(spec.NetworkRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("networkRef").Child("name"), spec.NetworkRef.Name, msg))
+		}
+	}
+
+	if len(spec.Prefixes) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("prefixes"), "must specify at least one prefix"))
+	}
+
+	seenIPFamilies := sets.New[corev1.IPFamily]()
+	for i, prefix := range spec.Prefixes {
+		fldPath := fldPath.Child("prefixes").Index(i)
+		if !prefix.IsValid() {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, "must specify a valid prefix"))
+			continue
+		}
+
+		if prefix.Prefix != prefix.Masked() {
+			allErrs = append(allErrs, field.Invalid(fldPath, prefix, fmt.Sprintf("must be the masked prefix %s", prefix.Masked())))
+		}
+
+		ipFamily := prefix.IP().Family()
+		if seenIPFamilies.Has(ipFamily) {
+			allErrs = append(allErrs, field.Duplicate(fldPath, prefix))
+		} else {
+			seenIPFamilies.Insert(ipFamily)
+		}
+
+		if name != "" {
+			prefixName := networking.SubnetPrefixIPAMPrefixName(name, i)
+			for _, msg := range apivalidation.NameIsDNSLabel(prefixName, false) {
+				allErrs = append(allErrs, field.Invalid(fldPath, prefixName, fmt.Sprintf("resulting prefix name %q is invalid: %s", prefixName, msg)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateSubnetUpdate validates a Subnet object before an update.
+func ValidateSubnetUpdate(newSubnet, oldSubnet *networking.Subnet) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newSubnet, oldSubnet, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateSubnetSpecUpdate(&newSubnet.Spec, &oldSubnet.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateSubnet(newSubnet)...)
+
+	return allErrs
+}
+
+// validateSubnetSpecUpdate validates the spec of a Subnet object before an update.
+func validateSubnetSpecUpdate(newSpec, oldSpec *networking.SubnetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableFieldWithDiff(newSpec, oldSpec, fldPath)...)
+
+	return allErrs
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Subnet", func() {
+	DescribeTable("ValidateSubnet",
+		func(subnet *networking.Subnet, match types.GomegaMatcher) {
+			errList := ValidateSubnet(subnet)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&networking.Subnet{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("missing namespace",
+			&networking.Subnet{ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+			ContainElement(RequiredField("metadata.namespace")),
+		),
+		Entry("bad name",
+			&networking.Subnet{ObjectMeta: metav1.ObjectMeta{Name: "foo*"}},
+			ContainElement(InvalidField("metadata.name")),
+		),
+		Entry("no network ref",
+			&networking.Subnet{},
+			ContainElement(RequiredField("spec.networkRef")),
+		),
+		Entry("invalid network ref name",
+			&networking.Subnet{
+				Spec: networking.SubnetSpec{
+					NetworkRef: corev1.LocalObjectReference{Name: "foo*"},
+				},
+			},
+			ContainElement(InvalidField("spec.networkRef.name")),
+		),
+		Entry("no prefixes",
+			&networking.Subnet{},
+			ContainElement(RequiredField("spec.prefixes")),
+		),
+		Entry("invalid prefix",
+			&networking.Subnet{
+				Spec: networking.SubnetSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{{}},
+				},
+			},
+			ContainElement(InvalidField("spec.prefixes[0]")),
+		),
+		Entry("non-masked prefix",
+			&networking.Subnet{
+				Spec: networking.SubnetSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.1/24")},
+				},
+			},
+			ContainElement(InvalidField("spec.prefixes[0]")),
+		),
+		Entry("multiple prefixes of the same ip family",
+			&networking.Subnet{
+				Spec: networking.SubnetSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{
+						commonv1alpha1.MustParseIPPrefix("10.0.0.0/24"),
+						commonv1alpha1.MustParseIPPrefix("10.0.1.0/24"),
+					},
+				},
+			},
+			ContainElement(DuplicateField("spec.prefixes[1]")),
+		),
+		Entry("dual stack prefixes",
+			&networking.Subnet{
+				Spec: networking.SubnetSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{
+						commonv1alpha1.MustParseIPPrefix("10.0.0.0/24"),
+						commonv1alpha1.MustParseIPPrefix("fd00::/64"),
+					},
+				},
+			},
+			Not(ContainElement(DuplicateField("spec.prefixes[1]"))),
+		),
+	)
+
+	DescribeTable("ValidateSubnetUpdate",
+		func(newSubnet, oldSubnet *networking.Subnet, match types.GomegaMatcher) {
+			errList := ValidateSubnetUpdate(newSubnet, oldSubnet)
+			Expect(errList).To(match)
+		},
+		Entry("immutable networkRef",
+			&networking.Subnet{
+				Spec: networking.SubnetSpec{
+					NetworkRef: corev1.LocalObjectReference{Name: "foo"},
+				},
+			},
+			&networking.Subnet{
+				Spec: networking.SubnetSpec{
+					NetworkRef: corev1.LocalObjectReference{Name: "bar"},
+				},
+			},
+			ContainElement(ForbiddenField("spec")),
+		),
+		Entry("immutable prefixes",
+			&networking.Subnet{
+				Spec: networking.SubnetSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/24")},
+				},
+			},
+			&networking.Subnet{
+				Spec: networking.SubnetSpec{
+					Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.1.0/24")},
+				},
+			},
+			ContainElement(ForbiddenField("spec")),
+		),
+	)
+})
@@ -46,6 +46,8 @@ func validateVirtualIPSpec(spec *networking.VirtualIPSpec, fldPath *field.Path)
 	allErrs = append(allErrs, validateVirtualIPType(spec.Type, fldPath.Child("type"))...)
 	allErrs = append(allErrs, ironcorevalidation.ValidateIPFamily(spec.IPFamily, fldPath.Child("ipFamily"))...)
 
+	allErrs = append(allErrs, validatePublicIPPoolRef(spec.PublicIPPoolRef, fldPath.Child("publicIPPoolRef"))...)
+
 	if targetRef := spec.TargetRef; targetRef != nil {
 		for _, msg := range apivalidation.NameIsDNSLabel(targetRef.Name, false) {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("targetRef", "name"), targetRef.Name, msg))
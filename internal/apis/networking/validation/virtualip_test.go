@@ -66,6 +66,14 @@ var _ = Describe("VirtualIP", func() {
 			},
 			ContainElement(InvalidField("spec.targetRef.name")),
 		),
+		Entry("invalid public ip pool ref name",
+			&networking.VirtualIP{
+				Spec: networking.VirtualIPSpec{
+					PublicIPPoolRef: &corev1.LocalObjectReference{Name: "foo*"},
+				},
+			},
+			ContainElement(InvalidField("spec.publicIPPoolRef.name")),
+		),
 		Entry("valid virtual ip",
 			&networking.VirtualIP{
 				ObjectMeta: metav1.ObjectMeta{
@@ -18,7 +18,11 @@ import (
 	clientset "github.com/ironcore-dev/ironcore/client-go/ironcore"
 	ironcoreopenapi "github.com/ironcore-dev/ironcore/client-go/openapi"
 	ironcoreinitializer "github.com/ironcore-dev/ironcore/internal/admission/initializer"
+	"github.com/ironcore-dev/ironcore/internal/admission/plugin/defaultclass"
 	"github.com/ironcore-dev/ironcore/internal/admission/plugin/machinevolumedevices"
+	"github.com/ironcore-dev/ironcore/internal/admission/plugin/poolrestriction"
+	"github.com/ironcore-dev/ironcore/internal/admission/plugin/referencevalidation"
+	"github.com/ironcore-dev/ironcore/internal/admission/plugin/resourcelimit"
 	"github.com/ironcore-dev/ironcore/internal/admission/plugin/resourcequota"
 	"github.com/ironcore-dev/ironcore/internal/admission/plugin/volumeresizepolicy"
 	"github.com/ironcore-dev/ironcore/internal/api"
@@ -36,6 +40,7 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/union"
 	"k8s.io/apiserver/pkg/endpoints/openapi"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	genericoptions "k8s.io/apiserver/pkg/server/options"
@@ -66,6 +71,9 @@ type IronCoreAPIServerOptions struct {
 	RecommendedOptions   *genericoptions.RecommendedOptions
 	MachinePoolletConfig client.MachinePoolletClientConfig
 
+	// EnableMachinePoolAuthorizer enables the MachinePoolAuthorizer in front of the delegated authorization.
+	EnableMachinePoolAuthorizer bool
+
 	SharedInformerFactory informers.SharedInformerFactory
 }
 
@@ -87,6 +95,9 @@ func (o *IronCoreAPIServerOptions) AddFlags(fs *pflag.FlagSet) {
 
 	fs.StringVar(&o.MachinePoolletConfig.CAFile, "machinepoollet-certificate-authority", o.MachinePoolletConfig.CAFile,
 		"Path to a cert file for the certificate authority.")
+
+	fs.BoolVar(&o.EnableMachinePoolAuthorizer, "enable-machinepool-authorizer", o.EnableMachinePoolAuthorizer,
+		"Restrict reads of individual volumes and network interfaces by machine pool identities to the ones reachable from machines scheduled onto their machine pool.")
 }
 
 func NewIronCoreAPIServerOptions() *IronCoreAPIServerOptions {
@@ -117,6 +128,7 @@ func NewIronCoreAPIServerOptions() *IronCoreAPIServerOptions {
 			},
 			HTTPTimeout: time.Duration(5) * time.Second,
 		},
+		EnableMachinePoolAuthorizer: true,
 	}
 	o.RecommendedOptions.Etcd.StorageConfig.EncodeVersioner = runtime.NewMultiGroupVersioner(
 		computev1alpha1.SchemeGroupVersion,
@@ -161,13 +173,21 @@ func (o *IronCoreAPIServerOptions) Validate(args []string) error {
 }
 
 func (o *IronCoreAPIServerOptions) Complete() error {
+	defaultclass.Register(o.RecommendedOptions.Admission.Plugins)
 	machinevolumedevices.Register(o.RecommendedOptions.Admission.Plugins)
+	poolrestriction.Register(o.RecommendedOptions.Admission.Plugins)
+	referencevalidation.Register(o.RecommendedOptions.Admission.Plugins)
+	resourcelimit.Register(o.RecommendedOptions.Admission.Plugins)
 	resourcequota.Register(o.RecommendedOptions.Admission.Plugins)
 	volumeresizepolicy.Register(o.RecommendedOptions.Admission.Plugins)
 
 	o.RecommendedOptions.Admission.RecommendedPluginOrder = append(
 		o.RecommendedOptions.Admission.RecommendedPluginOrder,
+		defaultclass.PluginName,
 		machinevolumedevices.PluginName,
+		poolrestriction.PluginName,
+		referencevalidation.PluginName,
+		resourcelimit.PluginName,
 		resourcequota.PluginName,
 		volumeresizepolicy.PluginName,
 	)
@@ -215,6 +235,14 @@ func (o *IronCoreAPIServerOptions) Config() (*apiserver.Config, error) {
 		return nil, err
 	}
 
+	if o.EnableMachinePoolAuthorizer && o.SharedInformerFactory != nil && serverConfig.Authorization.Authorizer != nil {
+		machinePoolAuthorizer, err := NewMachinePoolAuthorizer(o.SharedInformerFactory)
+		if err != nil {
+			return nil, fmt.Errorf("error creating machine pool authorizer: %w", err)
+		}
+		serverConfig.Authorization.Authorizer = union.New(machinePoolAuthorizer, serverConfig.Authorization.Authorizer)
+	}
+
 	apiResourceConfig := NewResourceConfig()
 
 	config := &apiserver.Config{
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	"github.com/ironcore-dev/ironcore/client-go/informers"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+var machinePoolRestrictedResources = map[schema.GroupResource]vertexKind{
+	storagev1alpha1.Resource("volumes"):              volumeVertexKind,
+	networkingv1alpha1.Resource("networkinterfaces"): networkInterfaceVertexKind,
+}
+
+// MachinePoolAuthorizer authorizes reads of machine pool identities based on a graph of the objects
+// reachable from the Machines scheduled onto their MachinePool.
+//
+// A machine pool identity may only get individual Volumes and NetworkInterfaces that are reachable from a
+// Machine of its MachinePool. The same applies to lists and watches scoped to a single object via a
+// metadata.name field selector. Unscoped lists and watches, which the machinepoollet uses to fill its caches,
+// are left to the delegated authorization, collection deletes are denied.
+//
+// MachineClasses are cluster-scoped and not sensitive. Machine pools need all of them to compute their capacity,
+// hence they may always be read.
+//
+// All other requests are not decided by this authorizer.
+//
+// Secrets are served by the kube-apiserver and are hence not covered by this authorizer.
+type MachinePoolAuthorizer struct {
+	graph *machinePoolGraph
+}
+
+var _ authorizer.Authorizer = (*MachinePoolAuthorizer)(nil)
+
+// NewMachinePoolAuthorizer creates a new MachinePoolAuthorizer that builds its graph from the informers
+// of the given SharedInformerFactory. The SharedInformerFactory has to be started for the graph to be populated.
+func NewMachinePoolAuthorizer(f informers.SharedInformerFactory) (*MachinePoolAuthorizer, error) {
+	graph := newMachinePoolGraph()
+
+	if _, err := f.Compute().V1alpha1().Machines().Informer().AddEventHandler(graph.machineEventHandler()); err != nil {
+		return nil, fmt.Errorf("error adding machine event handler: %w", err)
+	}
+
+	return &MachinePoolAuthorizer{graph: graph}, nil
+}
+
+func machinePoolNameFromUser(groups []string, name string) (string, bool) {
+	if !slices.Contains(groups, computev1alpha1.MachinePoolsGroup) {
+		return "", false
+	}
+	return strings.CutPrefix(name, computev1alpha1.MachinePoolUserNamePrefix)
+}
+
+func (a *MachinePoolAuthorizer) Authorize(ctx context.Context, attrs authorizer.Attributes) (authorizer.Decision, string, error) {
+	user := attrs.GetUser()
+	if user == nil {
+		return authorizer.DecisionNoOpinion, "", nil
+	}
+
+	machinePoolName, ok := machinePoolNameFromUser(user.GetGroups(), user.GetName())
+	if !ok {
+		return authorizer.DecisionNoOpinion, "", nil
+	}
+	if machinePoolName == "" {
+		return authorizer.DecisionDeny, "could not determine machine pool from user", nil
+	}
+
+	if !attrs.IsResourceRequest() {
+		return authorizer.DecisionNoOpinion, "", nil
+	}
+
+	resource := schema.GroupResource{Group: attrs.GetAPIGroup(), Resource: attrs.GetResource()}
+	if resource == computev1alpha1.Resource("machineclasses") && isReadVerb(attrs.GetVerb()) {
+		return authorizer.DecisionAllow, "", nil
+	}
+
+	kind, ok := machinePoolRestrictedResources[resource]
+	if !ok {
+		return authorizer.DecisionNoOpinion, "", nil
+	}
+
+	if attrs.GetVerb() == "deletecollection" {
+		return authorizer.DecisionDeny, fmt.Sprintf("machine pool %q may not delete collections of %s", machinePoolName, attrs.GetResource()), nil
+	}
+	if !isReadVerb(attrs.GetVerb()) {
+		return authorizer.DecisionNoOpinion, "", nil
+	}
+
+	// For list and watch requests, the name is only set if they are scoped to a single object via field selector.
+	if attrs.GetName() == "" {
+		return authorizer.DecisionNoOpinion, "", nil
+	}
+
+	from := vertex{kind: kind, namespace: attrs.GetNamespace(), name: attrs.GetName()}
+	if !a.graph.reachesMachinePool(from, machinePoolName) {
+		return authorizer.DecisionDeny, fmt.Sprintf("no relationship found between machine pool %q and this object", machinePoolName), nil
+	}
+	return authorizer.DecisionAllow, "", nil
+}
+
+func isReadVerb(verb string) bool {
+	switch verb {
+	case "get", "list", "watch":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+var _ = Describe("MachinePoolAuthorizer", func() {
+	var (
+		graph *machinePoolGraph
+		authz *MachinePoolAuthorizer
+
+		machinePoolUser = &user.DefaultInfo{
+			Name:   computev1alpha1.MachinePoolCommonName("my-pool"),
+			Groups: []string{computev1alpha1.MachinePoolsGroup},
+		}
+	)
+	BeforeEach(func() {
+		graph = newMachinePoolGraph()
+		authz = &MachinePoolAuthorizer{graph: graph}
+
+		graph.setMachine(&computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "my-machine"},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: "my-class"},
+				MachinePoolRef:  &corev1.LocalObjectReference{Name: "my-pool"},
+				Volumes: []computev1alpha1.Volume{
+					{
+						Name: "root",
+						VolumeSource: computev1alpha1.VolumeSource{
+							VolumeRef: &corev1.LocalObjectReference{Name: "my-volume"},
+						},
+					},
+				},
+			},
+		})
+		graph.setMachine(&computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "other-machine"},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: "other-class"},
+				MachinePoolRef:  &corev1.LocalObjectReference{Name: "other-pool"},
+				Volumes: []computev1alpha1.Volume{
+					{
+						Name: "root",
+						VolumeSource: computev1alpha1.VolumeSource{
+							VolumeRef: &corev1.LocalObjectReference{Name: "other-volume"},
+						},
+					},
+				},
+			},
+		})
+	})
+
+	authorize := func(userInfo user.Info, verb, group, resource, namespace, name string) authorizer.Decision {
+		decision, _, err := authz.Authorize(context.TODO(), authorizer.AttributesRecord{
+			User:            userInfo,
+			Verb:            verb,
+			APIGroup:        group,
+			Resource:        resource,
+			Namespace:       namespace,
+			Name:            name,
+			ResourceRequest: true,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return decision
+	}
+
+	get := func(userInfo user.Info, group, resource, namespace, name string) authorizer.Decision {
+		return authorize(userInfo, "get", group, resource, namespace, name)
+	}
+
+	It("should allow getting objects reachable from machines of the pool", func() {
+		Expect(get(machinePoolUser, "storage.ironcore.dev", "volumes", "foo", "my-volume")).To(Equal(authorizer.DecisionAllow))
+	})
+
+	It("should allow reading all machine classes", func() {
+		for _, verb := range []string{"get", "list", "watch"} {
+			Expect(authorize(machinePoolUser, verb, "compute.ironcore.dev", "machineclasses", "", "other-class")).To(Equal(authorizer.DecisionAllow))
+			Expect(authorize(machinePoolUser, verb, "compute.ironcore.dev", "machineclasses", "", "unused-class")).To(Equal(authorizer.DecisionAllow))
+		}
+		Expect(authorize(machinePoolUser, "list", "compute.ironcore.dev", "machineclasses", "", "")).To(Equal(authorizer.DecisionAllow))
+		Expect(authorize(machinePoolUser, "delete", "compute.ironcore.dev", "machineclasses", "", "my-class")).To(Equal(authorizer.DecisionNoOpinion))
+	})
+
+	It("should deny getting objects not reachable from machines of the pool", func() {
+		Expect(get(machinePoolUser, "storage.ironcore.dev", "volumes", "foo", "other-volume")).To(Equal(authorizer.DecisionDeny))
+		Expect(get(machinePoolUser, "storage.ironcore.dev", "volumes", "bar", "my-volume")).To(Equal(authorizer.DecisionDeny))
+	})
+
+	It("should deny getting objects after the machine was removed from the pool", func() {
+		graph.deleteMachine("foo", "my-machine")
+		Expect(get(machinePoolUser, "storage.ironcore.dev", "volumes", "foo", "my-volume")).To(Equal(authorizer.DecisionDeny))
+	})
+
+	It("should leave unscoped lists and watches to the delegated authorization", func() {
+		for _, verb := range []string{"list", "watch"} {
+			Expect(authorize(machinePoolUser, verb, "storage.ironcore.dev", "volumes", "foo", "")).To(Equal(authorizer.DecisionNoOpinion))
+			Expect(authorize(machinePoolUser, verb, "storage.ironcore.dev", "volumes", "", "")).To(Equal(authorizer.DecisionNoOpinion))
+			Expect(authorize(machinePoolUser, verb, "networking.ironcore.dev", "networkinterfaces", "foo", "")).To(Equal(authorizer.DecisionNoOpinion))
+		}
+	})
+
+	It("should only allow lists and watches scoped to a reachable object", func() {
+		for _, verb := range []string{"list", "watch"} {
+
+			Expect(authorize(machinePoolUser, verb, "storage.ironcore.dev", "volumes", "foo", "other-volume")).To(Equal(authorizer.DecisionDeny))
+			Expect(authorize(machinePoolUser, verb, "storage.ironcore.dev", "volumes", "foo", "my-volume")).To(Equal(authorizer.DecisionAllow))
+		}
+	})
+
+	It("should deny deleting collections of restricted resources", func() {
+		Expect(authorize(machinePoolUser, "deletecollection", "storage.ironcore.dev", "volumes", "foo", "")).To(Equal(authorizer.DecisionDeny))
+		Expect(authorize(machinePoolUser, "deletecollection", "networking.ironcore.dev", "networkinterfaces", "foo", "")).To(Equal(authorizer.DecisionDeny))
+	})
+
+	It("should have no opinion on other users and resources", func() {
+		Expect(get(&user.DefaultInfo{Name: "admin"}, "storage.ironcore.dev", "volumes", "foo", "other-volume")).To(Equal(authorizer.DecisionNoOpinion))
+		Expect(authorize(&user.DefaultInfo{Name: "admin"}, "list", "storage.ironcore.dev", "volumes", "foo", "")).To(Equal(authorizer.DecisionNoOpinion))
+		Expect(get(machinePoolUser, "compute.ironcore.dev", "machines", "foo", "other-machine")).To(Equal(authorizer.DecisionNoOpinion))
+		Expect(get(machinePoolUser, "", "secrets", "foo", "my-ignition")).To(Equal(authorizer.DecisionNoOpinion))
+	})
+})
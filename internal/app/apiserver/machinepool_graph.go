@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"sync"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+)
+
+type vertexKind string
+
+const (
+	volumeVertexKind           vertexKind = "Volume"
+	networkInterfaceVertexKind vertexKind = "NetworkInterface"
+	machineVertexKind          vertexKind = "Machine"
+	machinePoolVertexKind      vertexKind = "MachinePool"
+)
+
+type vertex struct {
+	kind      vertexKind
+	namespace string
+	name      string
+}
+
+type edge struct {
+	from vertex
+	to   vertex
+}
+
+// machinePoolGraph tracks which objects are reachable from the Machines scheduled onto a MachinePool.
+// Edges point from a referenced object towards the object referencing it, ending at a MachinePool:
+//
+//	Volume / NetworkInterface / MachineClass -> Machine -> MachinePool
+type machinePoolGraph struct {
+	lock sync.RWMutex
+
+	// edges maps a vertex to the vertices it has an edge to.
+	edges map[vertex]sets.Set[vertex]
+	// edgesBySource maps the vertex of an object to the edges its references contributed.
+	edgesBySource map[vertex][]edge
+}
+
+func newMachinePoolGraph() *machinePoolGraph {
+	return &machinePoolGraph{
+		edges:         make(map[vertex]sets.Set[vertex]),
+		edgesBySource: make(map[vertex][]edge),
+	}
+}
+
+func (g *machinePoolGraph) removeSourceLocked(source vertex) {
+	for _, e := range g.edgesBySource[source] {
+		tos := g.edges[e.from]
+		tos.Delete(e.to)
+		if tos.Len() == 0 {
+			delete(g.edges, e.from)
+		}
+	}
+	delete(g.edgesBySource, source)
+}
+
+func (g *machinePoolGraph) setSource(source vertex, edges []edge) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.removeSourceLocked(source)
+	if len(edges) == 0 {
+		return
+	}
+
+	for _, e := range edges {
+		tos, ok := g.edges[e.from]
+		if !ok {
+			tos = sets.New[vertex]()
+			g.edges[e.from] = tos
+		}
+		tos.Insert(e.to)
+	}
+	g.edgesBySource[source] = edges
+}
+
+func (g *machinePoolGraph) deleteSource(source vertex) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.removeSourceLocked(source)
+}
+
+// reachesMachinePool reports whether there is a path from the given vertex to the MachinePool with the given name.
+func (g *machinePoolGraph) reachesMachinePool(from vertex, machinePoolName string) bool {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	target := vertex{kind: machinePoolVertexKind, name: machinePoolName}
+	visited := sets.New(from)
+	queue := []vertex{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for next := range g.edges[current] {
+			if next == target {
+				return true
+			}
+			if visited.Has(next) {
+				continue
+			}
+			visited.Insert(next)
+			queue = append(queue, next)
+		}
+	}
+	return false
+}
+
+func machineVertex(namespace, name string) vertex {
+	return vertex{kind: machineVertexKind, namespace: namespace, name: name}
+}
+
+func (g *machinePoolGraph) setMachine(machine *computev1alpha1.Machine) {
+	source := machineVertex(machine.Namespace, machine.Name)
+
+	machinePoolRef := machine.Spec.MachinePoolRef
+	if machinePoolRef == nil {
+		g.deleteSource(source)
+		return
+	}
+
+	edges := []edge{
+		{from: source, to: vertex{kind: machinePoolVertexKind, name: machinePoolRef.Name}},
+	}
+	for _, name := range computev1alpha1.MachineVolumeNames(machine) {
+		edges = append(edges, edge{from: vertex{kind: volumeVertexKind, namespace: machine.Namespace, name: name}, to: source})
+	}
+	for _, name := range computev1alpha1.MachineNetworkInterfaceNames(machine) {
+		edges = append(edges, edge{from: vertex{kind: networkInterfaceVertexKind, namespace: machine.Namespace, name: name}, to: source})
+	}
+	g.setSource(source, edges)
+}
+
+func (g *machinePoolGraph) deleteMachine(namespace, name string) {
+	g.deleteSource(machineVertex(namespace, name))
+}
+
+func objectFromDeleted[T any](obj interface{}) (T, bool) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	t, ok := obj.(T)
+	return t, ok
+}
+
+func (g *machinePoolGraph) machineEventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if machine, ok := obj.(*computev1alpha1.Machine); ok {
+				g.setMachine(machine)
+			}
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			if machine, ok := newObj.(*computev1alpha1.Machine); ok {
+				g.setMachine(machine)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if machine, ok := objectFromDeleted[*computev1alpha1.Machine](obj); ok {
+				g.deleteMachine(machine.Namespace, machine.Name)
+			}
+		},
+	}
+}
@@ -4,12 +4,14 @@
 package app_test
 
 import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	. "github.com/ironcore-dev/ironcore/utils/testing"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -162,6 +164,25 @@ var _ = Describe("Compute", func() {
 			}))
 		})
 
+		It("should reject machines referencing a non-existing machine class unless soft validation is requested", func() {
+			By("creating a machine referencing a non-existing machine class")
+			machine := &computev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "machine-",
+				},
+				Spec: computev1alpha1.MachineSpec{
+					MachineClassRef: corev1.LocalObjectReference{Name: "non-existing"},
+				},
+			}
+			err := k8sClient.Create(ctx, machine)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue(), "unexpected error %v", err)
+
+			By("creating the machine with soft reference validation")
+			machine.Annotations = map[string]string{commonv1alpha1.SoftReferenceValidationAnnotation: "true"}
+			Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+		})
+
 		It("should allow listing machines filtering by machine pool name", func() {
 			const (
 				machinePool1 = "machine-pool-1"
@@ -63,7 +63,7 @@ var _ = Describe("Storage", func() {
 					GenerateName: "volume-",
 				},
 				Spec: storagev1alpha1.VolumeSpec{
-					VolumeClassRef: &corev1.LocalObjectReference{Name: "my-class"},
+					VolumeClassRef: &corev1.LocalObjectReference{Name: volumeClass.Name},
 					VolumePoolRef:  &corev1.LocalObjectReference{Name: volumePool1},
 					Resources: corev1alpha1.ResourceList{
 						corev1alpha1.ResourceStorage: resource.MustParse("10Gi"),
@@ -79,7 +79,7 @@ var _ = Describe("Storage", func() {
 					GenerateName: "volume-",
 				},
 				Spec: storagev1alpha1.VolumeSpec{
-					VolumeClassRef: &corev1.LocalObjectReference{Name: "my-class"},
+					VolumeClassRef: &corev1.LocalObjectReference{Name: volumeClass.Name},
 					VolumePoolRef:  &corev1.LocalObjectReference{Name: volumePool2},
 					Resources: corev1alpha1.ResourceList{
 						corev1alpha1.ResourceStorage: resource.MustParse("10Gi"),
@@ -95,7 +95,7 @@ var _ = Describe("Storage", func() {
 					GenerateName: "volume-",
 				},
 				Spec: storagev1alpha1.VolumeSpec{
-					VolumeClassRef: &corev1.LocalObjectReference{Name: "my-class"},
+					VolumeClassRef: &corev1.LocalObjectReference{Name: volumeClass.Name},
 					Resources: corev1alpha1.ResourceList{
 						corev1alpha1.ResourceStorage: resource.MustParse("10Gi"),
 					},
@@ -211,7 +211,7 @@ var _ = Describe("Storage", func() {
 					GenerateName: "bucket-",
 				},
 				Spec: storagev1alpha1.BucketSpec{
-					BucketClassRef: &corev1.LocalObjectReference{Name: "my-class"},
+					BucketClassRef: &corev1.LocalObjectReference{Name: bucketClass.Name},
 					BucketPoolRef:  &corev1.LocalObjectReference{Name: bucketPool1},
 				},
 			}
@@ -224,7 +224,7 @@ var _ = Describe("Storage", func() {
 					GenerateName: "bucket-",
 				},
 				Spec: storagev1alpha1.BucketSpec{
-					BucketClassRef: &corev1.LocalObjectReference{Name: "my-class"},
+					BucketClassRef: &corev1.LocalObjectReference{Name: bucketClass.Name},
 					BucketPoolRef:  &corev1.LocalObjectReference{Name: bucketPool2},
 				},
 			}
@@ -237,7 +237,7 @@ var _ = Describe("Storage", func() {
 					GenerateName: "bucket-",
 				},
 				Spec: storagev1alpha1.BucketSpec{
-					BucketClassRef: &corev1.LocalObjectReference{Name: "my-class"},
+					BucketClassRef: &corev1.LocalObjectReference{Name: bucketClass.Name},
 				},
 			}
 			Expect(k8sClient.Create(ctx, bucket3)).To(Succeed())
@@ -11,8 +11,8 @@ import (
 )
 
 const (
-	PrefixSpecIPFamilyField      = "spec.ipFamily"
-	PrefixSpecParentRefNameField = "spec.parentRef.name"
+	PrefixSpecIPFamilyField     = "spec.ipFamily"
+	PrefixSpecParentRefKeyField = "spec.parentRef.key"
 )
 
 func SetupPrefixSpecIPFamilyFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
@@ -22,13 +22,28 @@ func SetupPrefixSpecIPFamilyFieldIndexer(ctx context.Context, indexer client.Fie
 	})
 }
 
-func SetupPrefixSpecParentRefFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
-	return indexer.IndexField(ctx, &ipamv1alpha1.Prefix{}, PrefixSpecParentRefNameField, func(obj client.Object) []string {
+// PrefixParentKey returns the key of the parent referenced by the prefix.
+// The parent is looked up in the namespace of the prefix unless spec.parentNamespace is set.
+func PrefixParentKey(prefix *ipamv1alpha1.Prefix) (client.ObjectKey, bool) {
+	parentRef := prefix.Spec.ParentRef
+	if parentRef == nil {
+		return client.ObjectKey{}, false
+	}
+
+	namespace := prefix.Spec.ParentNamespace
+	if namespace == "" {
+		namespace = prefix.Namespace
+	}
+	return client.ObjectKey{Namespace: namespace, Name: parentRef.Name}, true
+}
+
+func SetupPrefixSpecParentRefKeyFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &ipamv1alpha1.Prefix{}, PrefixSpecParentRefKeyField, func(obj client.Object) []string {
 		prefix := obj.(*ipamv1alpha1.Prefix)
-		parentRef := prefix.Spec.ParentRef
-		if parentRef == nil {
-			return []string{""}
+		parentKey, ok := PrefixParentKey(prefix)
+		if !ok {
+			return nil
 		}
-		return []string{parentRef.Name}
+		return []string{parentKey.String()}
 	})
 }
@@ -11,8 +11,8 @@ import (
 )
 
 const (
-	PrefixAllocationSpecIPFamilyField      = "spec.ipFamily"
-	PrefixAllocationSpecPrefixRefNameField = "spec.prefixRef.name"
+	PrefixAllocationSpecIPFamilyField     = "spec.ipFamily"
+	PrefixAllocationSpecPrefixRefKeyField = "spec.prefixRef.key"
 )
 
 func SetupPrefixAllocationSpecIPFamilyFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
@@ -22,13 +22,28 @@ func SetupPrefixAllocationSpecIPFamilyFieldIndexer(ctx context.Context, indexer
 	})
 }
 
-func SetupPrefixAllocationSpecPrefixRefNameField(ctx context.Context, indexer client.FieldIndexer) error {
-	return indexer.IndexField(ctx, &ipamv1alpha1.PrefixAllocation{}, PrefixAllocationSpecPrefixRefNameField, func(obj client.Object) []string {
+// PrefixAllocationPrefixKey returns the key of the prefix referenced by the allocation.
+// The prefix is looked up in the namespace of the allocation unless spec.prefixNamespace is set.
+func PrefixAllocationPrefixKey(allocation *ipamv1alpha1.PrefixAllocation) (client.ObjectKey, bool) {
+	prefixRef := allocation.Spec.PrefixRef
+	if prefixRef == nil {
+		return client.ObjectKey{}, false
+	}
+
+	namespace := allocation.Spec.PrefixNamespace
+	if namespace == "" {
+		namespace = allocation.Namespace
+	}
+	return client.ObjectKey{Namespace: namespace, Name: prefixRef.Name}, true
+}
+
+func SetupPrefixAllocationSpecPrefixRefKeyField(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &ipamv1alpha1.PrefixAllocation{}, PrefixAllocationSpecPrefixRefKeyField, func(obj client.Object) []string {
 		allocation := obj.(*ipamv1alpha1.PrefixAllocation)
-		prefixRef := allocation.Spec.PrefixRef
-		if prefixRef == nil {
+		prefixKey, ok := PrefixAllocationPrefixKey(allocation)
+		if !ok {
 			return nil
 		}
-		return []string{prefixRef.Name}
+		return []string{prefixKey.String()}
 	})
 }
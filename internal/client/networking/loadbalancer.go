@@ -14,6 +14,8 @@ const (
 	LoadBalancerPrefixNamesField = "loadbalancer-prefix-names"
 
 	LoadBalancerNetworkNameField = "loadbalancer-network-name"
+
+	LoadBalancerPublicIPPoolNameField = "loadbalancer-public-ip-pool-name"
 )
 
 func SetupLoadBalancerPrefixNamesFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
@@ -29,3 +31,14 @@ func SetupLoadBalancerNetworkNameFieldIndexer(ctx context.Context, indexer clien
 		return []string{loadBalancer.Spec.NetworkRef.Name}
 	})
 }
+
+func SetupLoadBalancerPublicIPPoolNameFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &networkingv1alpha1.LoadBalancer{}, LoadBalancerPublicIPPoolNameField, func(obj client.Object) []string {
+		loadBalancer := obj.(*networkingv1alpha1.LoadBalancer)
+		var names []string
+		for _, publicIPPoolRef := range loadBalancer.Spec.PublicIPPoolRefs {
+			names = append(names, publicIPPoolRef.Name)
+		}
+		return names
+	})
+}
@@ -10,7 +10,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const NATGatewayNetworkNameField = "natgateway-network-name"
+const (
+	NATGatewayNetworkNameField = "natgateway-network-name"
+
+	NATGatewayPublicIPPoolNameField = "natgateway-public-ip-pool-name"
+)
 
 func SetupNATGatewayNetworkNameFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
 	return indexer.IndexField(ctx, &v1alpha1.NATGateway{}, NATGatewayNetworkNameField, func(obj client.Object) []string {
@@ -18,3 +22,14 @@ func SetupNATGatewayNetworkNameFieldIndexer(ctx context.Context, indexer client.
 		return []string{natGateway.Spec.NetworkRef.Name}
 	})
 }
+
+func SetupNATGatewayPublicIPPoolNameFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &v1alpha1.NATGateway{}, NATGatewayPublicIPPoolNameField, func(obj client.Object) []string {
+		natGateway := obj.(*v1alpha1.NATGateway)
+		publicIPPoolRef := natGateway.Spec.PublicIPPoolRef
+		if publicIPPoolRef == nil {
+			return nil
+		}
+		return []string{publicIPPoolRef.Name}
+	})
+}
@@ -14,6 +14,7 @@ const (
 	NetworkInterfacePrefixNamesField        = "networkinterface-prefix-names"
 	NetworkInterfaceVirtualIPNamesField     = "networkinterface-virtual-ip-names"
 	NetworkInterfaceSpecNetworkRefNameField = "spec.networkRef.name"
+	NetworkInterfaceSpecSubnetRefNameField  = "spec.subnetRef.name"
 )
 
 func SetupNetworkInterfacePrefixNamesFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
@@ -47,3 +48,15 @@ func SetupNetworkInterfaceNetworkNameFieldIndexer(ctx context.Context, indexer c
 		return []string{nic.Spec.NetworkRef.Name}
 	})
 }
+
+func SetupNetworkInterfaceSubnetNameFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &networkingv1alpha1.NetworkInterface{}, NetworkInterfaceSpecSubnetRefNameField, func(obj client.Object) []string {
+		nic := obj.(*networkingv1alpha1.NetworkInterface)
+
+		subnetRef := nic.Spec.SubnetRef
+		if subnetRef == nil {
+			return []string{""}
+		}
+		return []string{subnetRef.Name}
+	})
+}
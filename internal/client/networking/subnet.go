@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+
+	"github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const SubnetNetworkNameField = "subnet-network-name"
+
+func SetupSubnetNetworkNameFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &v1alpha1.Subnet{}, SubnetNetworkNameField, func(obj client.Object) []string {
+		subnet := obj.(*v1alpha1.Subnet)
+		return []string{subnet.Spec.NetworkRef.Name}
+	})
+}
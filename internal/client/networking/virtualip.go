@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const VirtualIPPublicIPPoolNameField = "virtualip-public-ip-pool-name"
+
+func SetupVirtualIPPublicIPPoolNameFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &networkingv1alpha1.VirtualIP{}, VirtualIPPublicIPPoolNameField, func(obj client.Object) []string {
+		virtualIP := obj.(*networkingv1alpha1.VirtualIP)
+		publicIPPoolRef := virtualIP.Spec.PublicIPPoolRef
+		if publicIPPoolRef == nil {
+			return nil
+		}
+		return []string{publicIPPoolRef.Name}
+	})
+}
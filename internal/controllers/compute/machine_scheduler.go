@@ -13,6 +13,10 @@ import (
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
 	"github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler"
+	"github.com/ironcore-dev/ironcore/utils/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
@@ -32,6 +36,8 @@ const (
 	outOfCapacity = "OutOfCapacity"
 )
 
+var tracer = otel.Tracer("github.com/ironcore-dev/ironcore/internal/controllers/compute")
+
 type MachineScheduler struct {
 	record.EventRecorder
 	client.Client
@@ -169,7 +175,14 @@ func (s *MachineScheduler) assume(assumed *computev1alpha1.Machine, nodeName str
 	return nil
 }
 
-func (s *MachineScheduler) bindingCycle(ctx context.Context, log logr.Logger, assumedInstance *computev1alpha1.Machine) error {
+func (s *MachineScheduler) bindingCycle(ctx context.Context, log logr.Logger, assumedInstance *computev1alpha1.Machine) (retErr error) {
+	ctx, span := tracer.Start(ctx, "MachineScheduler.bindingCycle", trace.WithAttributes(
+		attribute.String("machine.namespace", assumedInstance.Namespace),
+		attribute.String("machine.name", assumedInstance.Name),
+		attribute.String("machine.pool", assumedInstance.Spec.MachinePoolRef.Name),
+	))
+	defer func() { tracing.End(span, retErr) }()
+
 	if err := s.bind(ctx, log, assumedInstance); err != nil {
 		return fmt.Errorf("error binding: %w", err)
 	}
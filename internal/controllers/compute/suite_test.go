@@ -14,6 +14,7 @@ import (
 	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/admission/plugin/referencevalidation"
 	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
 	networkingclient "github.com/ironcore-dev/ironcore/internal/client/networking"
 	"github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler"
@@ -101,6 +102,8 @@ var _ = BeforeSuite(func() {
 		Host:         testEnvExt.APIServiceInstallOptions.LocalServingHost,
 		Port:         testEnvExt.APIServiceInstallOptions.LocalServingPort,
 		CertDir:      testEnvExt.APIServiceInstallOptions.LocalServingCertDir,
+		// The controller tests create objects before the objects they reference.
+		Args: apiserver.EmptyProcessArgs().Set("disable-admission-plugins", referencevalidation.PluginName),
 	})
 	Expect(err).NotTo(HaveOccurred())
 
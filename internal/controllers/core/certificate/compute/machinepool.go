@@ -22,6 +22,10 @@ var (
 		certificatesv1.UsageKeyEncipherment,
 		certificatesv1.UsageClientAuth,
 	)
+	MachinePoolRequiredUsagesNoRSA = sets.New[certificatesv1.KeyUsage](
+		certificatesv1.UsageDigitalSignature,
+		certificatesv1.UsageClientAuth,
+	)
 )
 
 func IsMachinePoolClientCert(csr *certificatesv1.CertificateSigningRequest, x509cr *x509.CertificateRequest) bool {
@@ -54,7 +58,7 @@ func ValidateMachinePoolClientCSR(req *x509.CertificateRequest, usages sets.Set[
 		return fmt.Errorf("subject common name does not begin with %s", computev1alpha1.MachinePoolUserNamePrefix)
 	}
 
-	if !MachinePoolRequiredUsages.Equal(usages) {
+	if !MachinePoolRequiredUsages.Equal(usages) && !MachinePoolRequiredUsagesNoRSA.Equal(usages) {
 		return fmt.Errorf("usages did not match %v", sets.List(MachinePoolRequiredUsages))
 	}
 
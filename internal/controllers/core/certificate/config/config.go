@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package config allows declaring certificate signing request recognizers in a configuration file,
+// so that client certificates of additional agent types can be auto-approved without rebuilding.
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/ironcore-dev/ironcore/internal/controllers/core/certificate/generic"
+	certificateironcore "github.com/ironcore-dev/ironcore/internal/controllers/core/certificate/ironcore"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Configuration is the certificate approval configuration.
+type Configuration struct {
+	// Recognizers are the additional recognizers to approve certificate signing requests with.
+	Recognizers []Recognizer `json:"recognizers"`
+}
+
+// Recognizer declares a client certificate recognizer.
+type Recognizer struct {
+	// Name is the name of the recognizer.
+	Name string `json:"name"`
+	// SignerName is the signer name the certificate signing request has to specify.
+	// Defaults to kubernetes.io/kube-apiserver-client. For other signers, the controller manager additionally
+	// has to be allowed to approve certificates of that signer.
+	SignerName string `json:"signerName,omitempty"`
+	// Organization is the only organization the certificate subject may contain.
+	Organization string `json:"organization"`
+	// CommonNamePrefix is the prefix the certificate subject common name has to start with.
+	CommonNamePrefix string `json:"commonNamePrefix"`
+	// AllowedUsages are the usages the certificate signing request may specify.
+	// Defaults to generic.DefaultClientAllowedUsages.
+	AllowedUsages []certificatesv1.KeyUsage `json:"allowedUsages,omitempty"`
+	// Subresource is the certificatesigningrequests subresource the requester has to be allowed to create.
+	Subresource string `json:"subresource"`
+	// SuccessMessage is the message of the approval condition.
+	SuccessMessage string `json:"successMessage,omitempty"`
+}
+
+// Load loads and validates the configuration from the given yaml or json data.
+func Load(data []byte) (*Configuration, error) {
+	cfg := &Configuration{}
+	if err := yaml.NewYAMLOrJSONDecoder(bytes.NewBuffer(data), 4096).Decode(cfg); err != nil {
+		return nil, fmt.Errorf("error unmarshalling certificate approval configuration: %w", err)
+	}
+
+	SetDefaults(cfg)
+	if errs := Validate(cfg); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid certificate approval configuration: %w", errs.ToAggregate())
+	}
+	return cfg, nil
+}
+
+// LoadFromFile loads and validates the configuration from the given file.
+func LoadFromFile(filename string) (*Configuration, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file at %q: %w", filename, err)
+	}
+
+	return Load(data)
+}
+
+// SetDefaults sets the defaults of all recognizers of the configuration.
+func SetDefaults(cfg *Configuration) {
+	for i := range cfg.Recognizers {
+		recognizer := &cfg.Recognizers[i]
+		if recognizer.SignerName == "" {
+			recognizer.SignerName = certificatesv1.KubeAPIServerClientSignerName
+		}
+		if len(recognizer.AllowedUsages) == 0 {
+			recognizer.AllowedUsages = sets.List(generic.DefaultClientAllowedUsages)
+		}
+		if recognizer.SuccessMessage == "" {
+			recognizer.SuccessMessage = fmt.Sprintf("Auto approving %s client certificate after SubjectAccessReview.", recognizer.Name)
+		}
+	}
+}
+
+// builtinSubresources returns the subresources of the built-in recognizers.
+func builtinSubresources() sets.Set[string] {
+	res := sets.New[string]()
+	for _, recognizer := range certificateironcore.Recognizers {
+		res.Insert(recognizer.Permission().Subresource)
+	}
+	return res
+}
+
+// Validate validates the configuration.
+//
+// Subresources of the built-in recognizers may not be reused, as that would allow requesters permitted to
+// create a built-in subresource to get certificates of a configured recognizer approved, and vice versa.
+func Validate(cfg *Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+
+	recognizersPath := field.NewPath("recognizers")
+	names := sets.New[string]()
+	subresources := sets.New[string]()
+	reservedSubresources := builtinSubresources()
+	for i, recognizer := range cfg.Recognizers {
+		fldPath := recognizersPath.Index(i)
+
+		if recognizer.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must specify name"))
+		} else if names.Has(recognizer.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), recognizer.Name))
+		} else {
+			names.Insert(recognizer.Name)
+		}
+
+		if recognizer.SignerName == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("signerName"), "must specify signer name"))
+		}
+
+		if recognizer.Organization == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("organization"), "must specify organization"))
+		}
+
+		if recognizer.CommonNamePrefix == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("commonNamePrefix"), "must specify common name prefix"))
+		}
+
+		usages := sets.New[certificatesv1.KeyUsage]()
+		for j, usage := range recognizer.AllowedUsages {
+			if usages.Has(usage) {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Child("allowedUsages").Index(j), usage))
+			}
+			usages.Insert(usage)
+		}
+
+		switch {
+		case recognizer.Subresource == "":
+			allErrs = append(allErrs, field.Required(fldPath.Child("subresource"), "must specify subresource"))
+		case subresources.Has(recognizer.Subresource):
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("subresource"), recognizer.Subresource))
+		case reservedSubresources.Has(recognizer.Subresource):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("subresource"), recognizer.Subresource, "subresource is reserved for a built-in recognizer"))
+		default:
+			for _, msg := range validation.IsDNS1123Label(recognizer.Subresource) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("subresource"), recognizer.Subresource, msg))
+			}
+			subresources.Insert(recognizer.Subresource)
+		}
+	}
+
+	return allErrs
+}
+
+// Recognizers creates the recognizers declared in the configuration.
+func Recognizers(cfg *Configuration) []generic.CertificateSigningRequestRecognizer {
+	res := make([]generic.CertificateSigningRequestRecognizer, 0, len(cfg.Recognizers))
+	for _, recognizer := range cfg.Recognizers {
+		res = append(res, generic.NewClientCertificateRecognizer(generic.ClientCertificateRecognizerOptions{
+			SignerName:       recognizer.SignerName,
+			Organization:     recognizer.Organization,
+			CommonNamePrefix: recognizer.CommonNamePrefix,
+			AllowedUsages:    sets.New(recognizer.AllowedUsages...),
+			Subresource:      recognizer.Subresource,
+			SuccessMessage:   recognizer.SuccessMessage,
+		}))
+	}
+	return res
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+
+	. "github.com/ironcore-dev/ironcore/internal/controllers/core/certificate/config"
+	"github.com/ironcore-dev/ironcore/internal/controllers/core/certificate/generic"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var _ = Describe("Config", func() {
+	Describe("LoadFromFile", func() {
+		It("should load and default the recognizers", func() {
+			cfg, err := LoadFromFile("./testdata/recognizers.yaml")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cfg.Recognizers).To(Equal([]Recognizer{
+				{
+					Name:             "storage agent",
+					SignerName:       certificatesv1.KubeAPIServerClientSignerName,
+					Organization:     "example.com:system:storage-agents",
+					CommonNamePrefix: "example.com:system:storage-agent:",
+					AllowedUsages:    sets.List(generic.DefaultClientAllowedUsages),
+					Subresource:      "storageagentclient",
+					SuccessMessage:   "Auto approving storage agent client certificate after SubjectAccessReview.",
+				},
+				{
+					Name:             "gateway",
+					SignerName:       "example.com/gateway",
+					Organization:     "example.com:system:gateways",
+					CommonNamePrefix: "example.com:system:gateway:",
+					AllowedUsages: []certificatesv1.KeyUsage{
+						certificatesv1.UsageDigitalSignature,
+						certificatesv1.UsageClientAuth,
+					},
+					Subresource:    "gatewayclient",
+					SuccessMessage: "Auto approving gateway client certificate.",
+				},
+			}))
+		})
+	})
+
+	Describe("Load", func() {
+		It("should reject invalid recognizers", func() {
+			_, err := Load([]byte(`
+recognizers:
+- name: foo
+  organization: foo
+  commonNamePrefix: "foo:"
+  subresource: Invalid_Subresource
+- name: foo
+  subresource: fooclient
+`))
+			Expect(err).To(MatchError(SatisfyAll(
+				ContainSubstring("recognizers[0].subresource: Invalid value"),
+				ContainSubstring("recognizers[1].name: Duplicate value"),
+				ContainSubstring("recognizers[1].organization: Required value"),
+				ContainSubstring("recognizers[1].commonNamePrefix: Required value"),
+			)))
+		})
+
+		It("should reject recognizers reusing the subresource of a built-in recognizer", func() {
+			_, err := Load([]byte(`
+recognizers:
+- name: foo
+  organization: foo
+  commonNamePrefix: "foo:"
+  subresource: machinepoolclient
+`))
+			Expect(err).To(MatchError(ContainSubstring(`recognizers[0].subresource: Invalid value: "machinepoolclient": subresource is reserved for a built-in recognizer`)))
+		})
+	})
+
+	Describe("Recognizers", func() {
+		var (
+			cfg *Configuration
+			csr *certificatesv1.CertificateSigningRequest
+		)
+		BeforeEach(func() {
+			var err error
+			cfg, err = LoadFromFile("./testdata/recognizers.yaml")
+			Expect(err).NotTo(HaveOccurred())
+
+			csr = &certificatesv1.CertificateSigningRequest{
+				Spec: certificatesv1.CertificateSigningRequestSpec{
+					SignerName: certificatesv1.KubeAPIServerClientSignerName,
+					Usages: []certificatesv1.KeyUsage{
+						certificatesv1.UsageDigitalSignature,
+						certificatesv1.UsageClientAuth,
+					},
+				},
+			}
+		})
+
+		It("should recognize matching client certificates", func() {
+			recognizers := Recognizers(cfg)
+			Expect(recognizers).To(HaveLen(2))
+
+			x509CR := &x509.CertificateRequest{
+				Subject: pkix.Name{
+					CommonName:   "example.com:system:storage-agent:my-agent",
+					Organization: []string{"example.com:system:storage-agents"},
+				},
+			}
+			Expect(recognizers[0].Recognize(csr, x509CR)).To(BeTrue())
+			Expect(recognizers[0].Permission().Subresource).To(Equal("storageagentclient"))
+			Expect(recognizers[1].Recognize(csr, x509CR)).To(BeFalse())
+		})
+
+		It("should not recognize client certificates with other usages or subjects", func() {
+			recognizer := Recognizers(cfg)[0]
+
+			x509CR := &x509.CertificateRequest{
+				Subject: pkix.Name{
+					CommonName:   "example.com:system:storage-agent:my-agent",
+					Organization: []string{"example.com:system:storage-agents"},
+				},
+				DNSNames: []string{"my-agent.example.com"},
+			}
+			Expect(recognizer.Recognize(csr, x509CR)).To(BeFalse())
+
+			x509CR.DNSNames = nil
+			csr.Spec.Usages = []certificatesv1.KeyUsage{certificatesv1.UsageServerAuth}
+			Expect(recognizer.Recognize(csr, x509CR)).To(BeFalse())
+		})
+	})
+})
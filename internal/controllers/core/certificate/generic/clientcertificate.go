@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package generic
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	authv1 "k8s.io/api/authorization/v1"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// DefaultClientAllowedUsages are the usages a kube-apiserver client certificate signing request of a configured
+// recognizer may specify by default, independent of whether an RSA or another key is used.
+var DefaultClientAllowedUsages = sets.New[certificatesv1.KeyUsage](
+	certificatesv1.UsageDigitalSignature,
+	certificatesv1.UsageKeyEncipherment,
+	certificatesv1.UsageClientAuth,
+)
+
+// ClientCertificateRecognizerOptions describe a client certificate that may be auto-approved.
+type ClientCertificateRecognizerOptions struct {
+	// SignerName is the signer name the certificate signing request has to specify.
+	SignerName string
+	// Organization is the only organization the certificate subject may contain.
+	Organization string
+	// CommonNamePrefix is the prefix the certificate subject common name has to start with.
+	CommonNamePrefix string
+	// AllowedUsages are the usages the certificate signing request may specify.
+	AllowedUsages sets.Set[certificatesv1.KeyUsage]
+	// Subresource is the certificatesigningrequests subresource the requester has to be allowed to create.
+	Subresource string
+	// SuccessMessage is the message of the approval condition.
+	SuccessMessage string
+}
+
+// NewClientCertificateRecognizer creates a new CertificateSigningRequestRecognizer for client certificates
+// matching the given options. It backs the recognizers loaded from a certificate approval configuration file,
+// the built-in recognizers require their exact usages instead.
+func NewClientCertificateRecognizer(opts ClientCertificateRecognizerOptions) CertificateSigningRequestRecognizer {
+	return NewCertificateSigningRequestRecognizer(
+		func(csr *certificatesv1.CertificateSigningRequest, x509CR *x509.CertificateRequest) bool {
+			if csr.Spec.SignerName != opts.SignerName {
+				return false
+			}
+			return ValidateClientCSR(x509CR, sets.New(csr.Spec.Usages...), opts) == nil
+		},
+		authv1.ResourceAttributes{
+			Group:       certificatesv1.GroupName,
+			Resource:    "certificatesigningrequests",
+			Verb:        "create",
+			Subresource: opts.Subresource,
+		},
+		opts.SuccessMessage,
+	)
+}
+
+// ValidateClientCSR validates the given client certificate request against the given options.
+// The requested usages have to be a non-empty subset of the allowed usages.
+func ValidateClientCSR(req *x509.CertificateRequest, usages sets.Set[certificatesv1.KeyUsage], opts ClientCertificateRecognizerOptions) error {
+	if !slices.Equal([]string{opts.Organization}, req.Subject.Organization) {
+		return fmt.Errorf("organization is not %s", opts.Organization)
+	}
+
+	if len(req.DNSNames) > 0 {
+		return fmt.Errorf("dns subject alternative names are not allowed")
+	}
+	if len(req.EmailAddresses) > 0 {
+		return fmt.Errorf("email subject alternative names are not allowed")
+	}
+	if len(req.IPAddresses) > 0 {
+		return fmt.Errorf("ip subject alternative names are not allowed")
+	}
+	if len(req.URIs) > 0 {
+		return fmt.Errorf("uri subject alternative names are not allowed")
+	}
+
+	if !strings.HasPrefix(req.Subject.CommonName, opts.CommonNamePrefix) {
+		return fmt.Errorf("subject common name does not begin with %s", opts.CommonNamePrefix)
+	}
+
+	if usages.Len() == 0 {
+		return fmt.Errorf("usages must not be empty")
+	}
+	if !opts.AllowedUsages.IsSuperset(usages) {
+		return fmt.Errorf("usages %v are not allowed", sets.List(usages.Difference(opts.AllowedUsages)))
+	}
+
+	return nil
+}
@@ -22,6 +22,10 @@ var (
 		certificatesv1.UsageKeyEncipherment,
 		certificatesv1.UsageClientAuth,
 	)
+	NetworkPluginRequiredUsagesNoRSA = sets.New[certificatesv1.KeyUsage](
+		certificatesv1.UsageDigitalSignature,
+		certificatesv1.UsageClientAuth,
+	)
 )
 
 func IsNetworkPluginClientCert(csr *certificatesv1.CertificateSigningRequest, x509cr *x509.CertificateRequest) bool {
@@ -54,7 +58,7 @@ func ValidateNetworkPluginClientCSR(req *x509.CertificateRequest, usages sets.Se
 		return fmt.Errorf("subject common name does not begin with %s", networkingv1alpha1.NetworkPluginUserNamePrefix)
 	}
 
-	if !NetworkPluginRequiredUsages.Equal(usages) {
+	if !NetworkPluginRequiredUsages.Equal(usages) && !NetworkPluginRequiredUsagesNoRSA.Equal(usages) {
 		return fmt.Errorf("usages did not match %v", sets.List(NetworkPluginRequiredUsages))
 	}
 
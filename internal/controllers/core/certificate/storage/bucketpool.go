@@ -22,6 +22,10 @@ var (
 		certificatesv1.UsageKeyEncipherment,
 		certificatesv1.UsageClientAuth,
 	)
+	BucketPoolRequiredUsagesNoRSA = sets.New[certificatesv1.KeyUsage](
+		certificatesv1.UsageDigitalSignature,
+		certificatesv1.UsageClientAuth,
+	)
 )
 
 func IsBucketPoolClientCert(csr *certificatesv1.CertificateSigningRequest, x509cr *x509.CertificateRequest) bool {
@@ -54,7 +58,7 @@ func ValidateBucketPoolClientCSR(req *x509.CertificateRequest, usages sets.Set[c
 		return fmt.Errorf("subject common name does not begin with %s", storagev1alpha1.BucketPoolUserNamePrefix)
 	}
 
-	if !BucketPoolRequiredUsages.Equal(usages) {
+	if !BucketPoolRequiredUsages.Equal(usages) && !BucketPoolRequiredUsagesNoRSA.Equal(usages) {
 		return fmt.Errorf("usages did not match %v", sets.List(BucketPoolRequiredUsages))
 	}
 
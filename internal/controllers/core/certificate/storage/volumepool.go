@@ -22,6 +22,10 @@ var (
 		certificatesv1.UsageKeyEncipherment,
 		certificatesv1.UsageClientAuth,
 	)
+	VolumePoolRequiredUsagesNoRSA = sets.New[certificatesv1.KeyUsage](
+		certificatesv1.UsageDigitalSignature,
+		certificatesv1.UsageClientAuth,
+	)
 )
 
 func IsVolumePoolClientCert(csr *certificatesv1.CertificateSigningRequest, x509cr *x509.CertificateRequest) bool {
@@ -54,7 +58,7 @@ func ValidateVolumePoolClientCSR(req *x509.CertificateRequest, usages sets.Set[c
 		return fmt.Errorf("subject common name does not begin with %s", storagev1alpha1.VolumePoolUserNamePrefix)
 	}
 
-	if !VolumePoolRequiredUsages.Equal(usages) {
+	if !VolumePoolRequiredUsages.Equal(usages) && !VolumePoolRequiredUsagesNoRSA.Equal(usages) {
 		return fmt.Errorf("usages did not match %v", sets.List(VolumePoolRequiredUsages))
 	}
 
@@ -61,5 +61,61 @@ var _ = Describe("CertificateApprovalController", func() {
 			networkingv1alpha1.NetworkPluginCommonName("my-plugin"),
 			networkingv1alpha1.NetworkPluginsGroup,
 		),
+		Entry("configured storage agent",
+			"example.com:system:storage-agent:my-agent",
+			"example.com:system:storage-agents",
+		),
 	)
+
+	It("should not approve built-in client certificates whose usages do not match exactly", func(ctx SpecContext) {
+		By("creating a machine pool certificate signing request with only the client auth usage")
+		csr, _, _, err := utilcertificate.GenerateAndCreateCertificateSigningRequest(
+			ctx,
+			k8sClient,
+			certificatesv1.KubeAPIServerClientSignerName,
+			&x509.CertificateRequest{
+				Subject: pkix.Name{
+					CommonName:   computev1alpha1.MachinePoolCommonName("my-pool"),
+					Organization: []string{computev1alpha1.MachinePoolsGroup},
+				},
+			},
+			func(privateKey any) []certificatesv1.KeyUsage {
+				return []certificatesv1.KeyUsage{certificatesv1.UsageClientAuth}
+			},
+			nil,
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("asserting the csr is not approved")
+		Consistently(ctx, Object(csr)).Should(
+			HaveField("Status.Conditions", Not(ContainElement(
+				HaveField("Type", certificatesv1.CertificateApproved),
+			))),
+		)
+	})
+
+	It("should not approve certificate signing requests no recognizer recognizes", func(ctx SpecContext) {
+		By("creating a certificate signing request of an unknown identity")
+		csr, _, _, err := utilcertificate.GenerateAndCreateCertificateSigningRequest(
+			ctx,
+			k8sClient,
+			certificatesv1.KubeAPIServerClientSignerName,
+			&x509.CertificateRequest{
+				Subject: pkix.Name{
+					CommonName:   "example.com:system:unknown:my-identity",
+					Organization: []string{"example.com:system:unknowns"},
+				},
+			},
+			utilcertificate.DefaultKubeAPIServerClientGetUsages,
+			nil,
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("asserting the csr is not approved although its requester may create any subresource")
+		Consistently(ctx, Object(csr)).Should(
+			HaveField("Status.Conditions", Not(ContainElement(
+				HaveField("Type", certificatesv1.CertificateApproved),
+			))),
+		)
+	})
 })
@@ -6,6 +6,7 @@ package core_test
 import (
 	"context"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	"github.com/ironcore-dev/ironcore/internal/controllers/core"
+	certificateconfig "github.com/ironcore-dev/ironcore/internal/controllers/core/certificate/config"
 	certificateironcore "github.com/ironcore-dev/ironcore/internal/controllers/core/certificate/ironcore"
 	quotacontrollergeneric "github.com/ironcore-dev/ironcore/internal/controllers/core/quota/generic"
 	quotacontrollerironcore "github.com/ironcore-dev/ironcore/internal/controllers/core/quota/ironcore"
@@ -121,9 +123,14 @@ var _ = BeforeSuite(func() {
 		Registry:  registry,
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
+	certificateApprovalConfig, err := certificateconfig.LoadFromFile("./certificate/config/testdata/recognizers.yaml")
+	Expect(err).NotTo(HaveOccurred())
+
 	Expect((&core.CertificateApprovalReconciler{
-		Client:      k8sManager.GetClient(),
-		Recognizers: certificateironcore.Recognizers,
+		Client: k8sManager.GetClient(),
+		Recognizers: append(slices.Clip(certificateironcore.Recognizers),
+			certificateconfig.Recognizers(certificateApprovalConfig)...,
+		),
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
 	mgrCtx, cancel := context.WithCancel(context.Background())
@@ -138,7 +138,16 @@ func ResourceDeletedOrUsageChangedPredicate(ctx context.Context, log logr.Logger
 				return false
 			}
 
-			return !quota.Equals(oldUsage, newUsage)
+			if !quota.Equals(oldUsage, newUsage) {
+				return true
+			}
+
+			scopesChanged, err := quota.EvaluatorScopesChanged(evaluator, event.ObjectOld, event.ObjectNew)
+			if err != nil {
+				log.Error(err, "Error determining whether scopes changed")
+				return false
+			}
+			return scopesChanged
 		},
 		GenericFunc: func(event event.GenericEvent) bool {
 			return false
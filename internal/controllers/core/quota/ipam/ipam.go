@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/controllers/core/quota/generic"
+)
+
+var (
+	replenishReconcilersBuilder generic.ReplenishReconcilersBuilder
+	NewReplenishReconcilers     = replenishReconcilersBuilder.NewReplenishReconcilers
+)
+
+func init() {
+	replenishReconcilersBuilder.Register(
+		&ipamv1alpha1.Prefix{},
+		&ipamv1alpha1.PrefixAllocation{},
+	)
+}
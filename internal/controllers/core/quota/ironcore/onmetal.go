@@ -6,6 +6,8 @@ package ironcore
 import (
 	"github.com/ironcore-dev/ironcore/internal/controllers/core/quota/compute"
 	"github.com/ironcore-dev/ironcore/internal/controllers/core/quota/generic"
+	"github.com/ironcore-dev/ironcore/internal/controllers/core/quota/ipam"
+	"github.com/ironcore-dev/ironcore/internal/controllers/core/quota/networking"
 	"github.com/ironcore-dev/ironcore/internal/controllers/core/quota/storage"
 )
 
@@ -18,5 +20,7 @@ func init() {
 	replenishReconcilersBuilder.Add(
 		compute.NewReplenishReconcilers,
 		storage.NewReplenishReconcilers,
+		networking.NewReplenishReconcilers,
+		ipam.NewReplenishReconcilers,
 	)
 }
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/controllers/core/quota/generic"
+)
+
+var (
+	replenishReconcilersBuilder generic.ReplenishReconcilersBuilder
+	NewReplenishReconcilers     = replenishReconcilersBuilder.NewReplenishReconcilers
+)
+
+func init() {
+	replenishReconcilersBuilder.Register(
+		&networkingv1alpha1.VirtualIP{},
+		&networkingv1alpha1.LoadBalancer{},
+		&networkingv1alpha1.NATGateway{},
+	)
+}
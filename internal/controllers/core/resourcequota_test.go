@@ -93,4 +93,57 @@ var _ = Describe("ResourceNamespaceController", func() {
 		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(ns), ns)).Should(Succeed())
 		Expect(ns.ResourceVersion).NotTo(Equal(preMachineDeletionNamespaceResourceVersion))
 	})
+
+	It("should only account powered-on machines for quotas scoped to powered-on machines", func() {
+		By("creating a resource quota scoped to powered-on machines")
+		resourceQuota := &corev1alpha1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "resource-quota-",
+			},
+			Spec: corev1alpha1.ResourceQuotaSpec{
+				Hard: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceRequestsCPU: resource.MustParse("2"),
+				},
+				ScopeSelector: &corev1alpha1.ResourceScopeSelector{
+					MatchExpressions: []corev1alpha1.ResourceScopeSelectorRequirement{
+						{
+							ScopeName: corev1alpha1.ResourceScopeMachinePower,
+							Operator:  corev1alpha1.ResourceScopeSelectorOperatorIn,
+							Values:    []string{string(computev1alpha1.PowerOn)},
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, resourceQuota)).To(Succeed())
+
+		By("creating a powered-off machine")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "machine-",
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+				Power:           computev1alpha1.PowerOff,
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+
+		By("waiting for the resource quota to not account the machine")
+		Eventually(Object(resourceQuota)).Should(HaveField("Status.Used", corev1alpha1.ResourceList{
+			corev1alpha1.ResourceRequestsCPU: resource.MustParse("0"),
+		}))
+
+		By("powering on the machine")
+		base := machine.DeepCopy()
+		machine.Spec.Power = computev1alpha1.PowerOn
+		Expect(k8sClient.Patch(ctx, machine, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the resource quota to account the machine")
+		Eventually(Object(resourceQuota)).Should(HaveField("Status.Used", corev1alpha1.ResourceList{
+			corev1alpha1.ResourceRequestsCPU: resource.MustParse("1"),
+		}))
+	})
 })
@@ -0,0 +1,270 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"crypto/rand"
+	"math/big"
+	"net/netip"
+
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	utilsipam "github.com/ironcore-dev/ironcore/utils/ipam"
+	"go4.org/netipx"
+	"k8s.io/utils/ptr"
+)
+
+// ipSetRemoveFreePrefix removes a free prefix of the given length from the set using the given strategy.
+func ipSetRemoveFreePrefix(
+	set *netipx.IPSet,
+	bits uint8,
+	strategy ipamv1alpha1.PrefixAllocationStrategy,
+) (netip.Prefix, *netipx.IPSet, bool) {
+	var (
+		prefix netip.Prefix
+		ok     bool
+	)
+	switch strategy {
+	case ipamv1alpha1.PrefixAllocationStrategyFirstFit:
+		prefix, ok = firstFreePrefix(set, bits)
+	case ipamv1alpha1.PrefixAllocationStrategyRandom:
+		prefix, ok = randomFreePrefix(set, bits)
+	default:
+		return set.RemoveFreePrefix(bits)
+	}
+	if !ok {
+		return netip.Prefix{}, set, false
+	}
+
+	var sb netipx.IPSetBuilder
+	sb.AddSet(set)
+	sb.RemovePrefix(prefix)
+	newSet, _ := sb.IPSet()
+	return prefix, newSet, true
+}
+
+// firstFreePrefix returns the free prefix of the given length with the lowest address.
+func firstFreePrefix(set *netipx.IPSet, bits uint8) (netip.Prefix, bool) {
+	// IPSet.Prefixes returns the minimal set of prefixes sorted by address, each aligned to its own length.
+	for _, free := range set.Prefixes() {
+		if free.Bits() <= int(bits) {
+			return netip.PrefixFrom(free.Addr(), int(bits)), true
+		}
+	}
+	return netip.Prefix{}, false
+}
+
+// randomFreePrefix returns a uniformly chosen free prefix of the given length.
+func randomFreePrefix(set *netipx.IPSet, bits uint8) (netip.Prefix, bool) {
+	type candidate struct {
+		block netip.Prefix
+		count *big.Int
+	}
+
+	var (
+		candidates []candidate
+		total      = new(big.Int)
+	)
+	for _, free := range set.Prefixes() {
+		if free.Bits() > int(bits) {
+			continue
+		}
+
+		count := new(big.Int).Lsh(big.NewInt(1), uint(int(bits)-free.Bits()))
+		candidates = append(candidates, candidate{free, count})
+		total.Add(total, count)
+	}
+	if len(candidates) == 0 {
+		return netip.Prefix{}, false
+	}
+
+	n, err := rand.Int(rand.Reader, total)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	for _, c := range candidates {
+		if n.Cmp(c.count) >= 0 {
+			n.Sub(n, c.count)
+			continue
+		}
+
+		addr := addrToInt(c.block.Addr())
+		addr.Add(addr, n.Lsh(n, uint(c.block.Addr().BitLen())-uint(bits)))
+		return netip.PrefixFrom(addrFromInt(addr, c.block.Addr().Is4()), int(bits)), true
+	}
+	return netip.Prefix{}, false
+}
+
+// ipSetRemoveRange removes the given range from the set if it is fully contained.
+func ipSetRemoveRange(set *netipx.IPSet, rng netipx.IPRange) (*netipx.IPSet, bool) {
+	if !rng.IsValid() || !set.ContainsRange(rng) {
+		return set, false
+	}
+	var sb netipx.IPSetBuilder
+	sb.AddSet(set)
+	sb.RemoveRange(rng)
+	set, _ = sb.IPSet()
+	return set, true
+}
+
+// ipSetRemoveFreeRange removes a contiguous range of the given number of addresses from the set
+// using the given strategy.
+func ipSetRemoveFreeRange(
+	set *netipx.IPSet,
+	length int32,
+	strategy ipamv1alpha1.PrefixAllocationStrategy,
+) (netipx.IPRange, *netipx.IPSet, bool) {
+	n := big.NewInt(int64(length))
+
+	var (
+		candidates []netipx.IPRange
+		// positions is the number of possible start addresses per candidate.
+		positions []*big.Int
+		total     = new(big.Int)
+	)
+	for _, free := range set.Ranges() {
+		size := utilsipam.IPRangeSize(free)
+		if size.Cmp(n) < 0 {
+			continue
+		}
+
+		candidates = append(candidates, free)
+		position := new(big.Int).Sub(size, n)
+		position.Add(position, big.NewInt(1))
+		positions = append(positions, position)
+		total.Add(total, position)
+	}
+	if len(candidates) == 0 {
+		return netipx.IPRange{}, set, false
+	}
+
+	var (
+		from netip.Addr
+		ok   bool
+	)
+	switch strategy {
+	case ipamv1alpha1.PrefixAllocationStrategyFirstFit:
+		from, ok = candidates[0].From(), true
+	case ipamv1alpha1.PrefixAllocationStrategyRandom:
+		from, ok = randomRangeStart(candidates, positions, total)
+	default:
+		best := 0
+		for i := range candidates {
+			if positions[i].Cmp(positions[best]) < 0 {
+				best = i
+			}
+		}
+		from, ok = candidates[best].From(), true
+	}
+	if !ok {
+		return netipx.IPRange{}, set, false
+	}
+
+	to := addrToInt(from)
+	to.Add(to, n)
+	to.Sub(to, big.NewInt(1))
+	rng := netipx.IPRangeFrom(from, addrFromInt(to, from.Is4()))
+
+	set, ok = ipSetRemoveRange(set, rng)
+	return rng, set, ok
+}
+
+func randomRangeStart(candidates []netipx.IPRange, positions []*big.Int, total *big.Int) (netip.Addr, bool) {
+	n, err := rand.Int(rand.Reader, total)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	for i, candidate := range candidates {
+		if n.Cmp(positions[i]) >= 0 {
+			n.Sub(n, positions[i])
+			continue
+		}
+
+		from := addrToInt(candidate.From())
+		from.Add(from, n)
+		return addrFromInt(from, candidate.From().Is4()), true
+	}
+	return netip.Addr{}, false
+}
+
+// ipSetRemoveFreeIPs removes the given number of the lowest free addresses from the set.
+func ipSetRemoveFreeIPs(set *netipx.IPSet, count int32) ([]netip.Addr, *netipx.IPSet, bool) {
+	var ips []netip.Addr
+	for _, free := range set.Ranges() {
+		for addr := free.From(); int32(len(ips)) < count; addr = addr.Next() {
+			ips = append(ips, addr)
+			if addr == free.To() {
+				break
+			}
+		}
+		if int32(len(ips)) == count {
+			break
+		}
+	}
+	if int32(len(ips)) < count {
+		return nil, set, false
+	}
+
+	var sb netipx.IPSetBuilder
+	sb.AddSet(set)
+	for _, ip := range ips {
+		sb.Remove(ip)
+	}
+	set, _ = sb.IPSet()
+	return ips, set, true
+}
+
+func addrToInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+func addrFromInt(i *big.Int, is4 bool) netip.Addr {
+	if is4 {
+		var b [4]byte
+		i.FillBytes(b[:])
+		return netip.AddrFrom4(b)
+	}
+	var b [16]byte
+	i.FillBytes(b[:])
+	return netip.AddrFrom16(b)
+}
+
+// ipSetSize returns the number of addresses contained in the set.
+func ipSetSize(set *netipx.IPSet) *big.Int {
+	size := new(big.Int)
+	for _, rng := range set.Ranges() {
+		size.Add(size, utilsipam.IPRangeSize(rng))
+	}
+	return size
+}
+
+// computePrefixUtilization computes the utilization of a prefix from the set of its addresses,
+// its reserved and its used addresses.
+func computePrefixUtilization(total, reserved, used *netipx.IPSet) *ipamv1alpha1.PrefixUtilization {
+	var freeBuilder netipx.IPSetBuilder
+	freeBuilder.AddSet(total)
+	freeBuilder.RemoveSet(reserved)
+	freeBuilder.RemoveSet(used)
+	free, _ := freeBuilder.IPSet()
+
+	// Used addresses that are also reserved are only reported as reserved.
+	var usedBuilder netipx.IPSetBuilder
+	usedBuilder.AddSet(used)
+	usedBuilder.RemoveSet(reserved)
+	used, _ = usedBuilder.IPSet()
+
+	var largestFreePrefixLength *int32
+	for _, prefix := range free.Prefixes() {
+		if largestFreePrefixLength == nil || int32(prefix.Bits()) < *largestFreePrefixLength {
+			largestFreePrefixLength = ptr.To(int32(prefix.Bits()))
+		}
+	}
+
+	return &ipamv1alpha1.PrefixUtilization{
+		Total:                   ipSetSize(total).String(),
+		Reserved:                ipSetSize(reserved).String(),
+		Used:                    ipSetSize(used).String(),
+		Free:                    ipSetSize(free).String(),
+		LargestFreePrefixLength: largestFreePrefixLength,
+	}
+}
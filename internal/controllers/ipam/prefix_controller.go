@@ -18,10 +18,13 @@ import (
 	ipamclient "github.com/ironcore-dev/ironcore/internal/client/ipam"
 	"github.com/ironcore-dev/ironcore/utils/equality"
 	"go4.org/netipx"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,30 +35,87 @@ import (
 const (
 	prefixFinalizer                   = "ipam.ironcore.dev/prefix"
 	prefixAllocationRequesterUIDLabel = "ipam.ironcore.dev/requester-uid"
+
+	namespaceNotGranted = "NamespaceNotGranted"
 )
 
+// allocationResult is the result of acquiring a PrefixAllocation.
+// Depending on the request, exactly one of its fields is set.
+type allocationResult struct {
+	prefix  netip.Prefix
+	ipRange netipx.IPRange
+	ips     []netip.Addr
+}
+
+func allocationResultFromStatus(status *ipamv1alpha1.PrefixAllocationStatus) allocationResult {
+	var res allocationResult
+	if status.Prefix.IsValid() {
+		res.prefix = status.Prefix.Prefix
+	}
+	if status.Range.IsValid() {
+		res.ipRange = status.Range.Range()
+	}
+	for _, ip := range status.IPs {
+		res.ips = append(res.ips, ip.Addr)
+	}
+	return res
+}
+
+// usedPrefixes returns the prefixes covering the addresses of the result.
+func (a allocationResult) usedPrefixes() []commonv1alpha1.IPPrefix {
+	var res []commonv1alpha1.IPPrefix
+	if a.prefix.IsValid() {
+		res = append(res, commonv1alpha1.IPPrefix{Prefix: a.prefix})
+	}
+	if a.ipRange.IsValid() {
+		for _, prefix := range a.ipRange.Prefixes() {
+			res = append(res, commonv1alpha1.IPPrefix{Prefix: prefix})
+		}
+	}
+	for _, ip := range a.ips {
+		res = append(res, commonv1alpha1.IPPrefix{Prefix: netip.PrefixFrom(ip, ip.BitLen())})
+	}
+	return res
+}
+
 func (r *PrefixReconciler) acquireAllocation(
 	prefix *ipamv1alpha1.Prefix,
 	set *netipx.IPSet,
 	allocation *ipamv1alpha1.PrefixAllocation,
-) (res netip.Prefix, newSet *netipx.IPSet, ok bool, terminal bool) {
+) (res allocationResult, newSet *netipx.IPSet, ok bool, terminal bool) {
 	if !prefixCompatibleWithAllocation(prefix, allocation) {
-		return netip.Prefix{}, set, false, true
+		return allocationResult{}, set, false, true
 	}
 
 	switch {
 	case allocation.Spec.Prefix.IsValid():
 		requestedPrefix := allocation.Spec.Prefix.Prefix
 		if set, ok := r.ipSetRemovePrefix(set, requestedPrefix); ok {
-			return requestedPrefix, set, true, true
+			return allocationResult{prefix: requestedPrefix}, set, true, true
 		}
-		return netip.Prefix{}, set, false, false
+		return allocationResult{}, set, false, false
 	case allocation.Spec.PrefixLength > 0:
 		requestedPrefixLength := allocation.Spec.PrefixLength
-		if prefix, set, ok := set.RemoveFreePrefix(uint8(requestedPrefixLength)); ok {
-			return prefix, set, true, true
+		if prefix, set, ok := ipSetRemoveFreePrefix(set, uint8(requestedPrefixLength), prefix.Spec.AllocationStrategy); ok {
+			return allocationResult{prefix: prefix}, set, true, true
+		}
+		return allocationResult{}, set, false, false
+	case allocation.Spec.Range.IsValid():
+		requestedRange := allocation.Spec.Range.Range()
+		if set, ok := ipSetRemoveRange(set, requestedRange); ok {
+			return allocationResult{ipRange: requestedRange}, set, true, true
+		}
+		return allocationResult{}, set, false, false
+	case allocation.Spec.RangeLength > 0:
+		if rng, set, ok := ipSetRemoveFreeRange(set, allocation.Spec.RangeLength, prefix.Spec.AllocationStrategy); ok {
+			return allocationResult{ipRange: rng}, set, true, true
 		}
-		return netip.Prefix{}, set, false, false
+		return allocationResult{}, set, false, false
+	case allocation.Spec.IPCount > 0:
+		if ips, set, ok := ipSetRemoveFreeIPs(set, allocation.Spec.IPCount); ok {
+			return allocationResult{ips: ips}, set, true, true
+		}
+		return allocationResult{}, set, false, false
 	default:
 		panic(fmt.Sprintf("unhandled allocation %#v", allocation))
 	}
@@ -74,6 +134,7 @@ func (r *PrefixReconciler) ipSetRemovePrefix(set *netipx.IPSet, prefix netip.Pre
 
 // PrefixReconciler reconciles a Prefix object
 type PrefixReconciler struct {
+	record.EventRecorder
 	client.Client
 	APIReader               client.Reader
 	Scheme                  *runtime.Scheme
@@ -103,6 +164,8 @@ func (r *PrefixReconciler) forgetAllocationBackoffFor(key client.ObjectKey) {
 //+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixes/finalizers,verbs=update
 //+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixallocations,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixallocations/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixgrants,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -132,13 +195,15 @@ func (r *PrefixReconciler) delete(ctx context.Context, log logr.Logger, prefix *
 
 	log.V(1).Info("Listing prefix allocations")
 	allocationList := &ipamv1alpha1.PrefixAllocationList{}
-	if err := r.APIReader.List(ctx, allocationList, client.InNamespace(prefix.Namespace)); err != nil {
+	// Allocations may reference the prefix from other namespaces, thus list across all namespaces.
+	if err := r.APIReader.List(ctx, allocationList); err != nil {
 		return ctrl.Result{}, fmt.Errorf("error listing dependent allocations: %w", err)
 	}
 
+	prefixKey := client.ObjectKeyFromObject(prefix)
 	var dependentAllocations []string
 	for _, allocation := range allocationList.Items {
-		if prefixRef := allocation.Spec.PrefixRef; prefixRef == nil || prefixRef.Name != prefix.Name {
+		if allocationPrefixKey, ok := ipamclient.PrefixAllocationPrefixKey(&allocation); !ok || allocationPrefixKey != prefixKey {
 			continue
 		}
 
@@ -146,7 +211,7 @@ func (r *PrefixReconciler) delete(ctx context.Context, log logr.Logger, prefix *
 			continue
 		}
 
-		dependentAllocations = append(dependentAllocations, allocation.Name)
+		dependentAllocations = append(dependentAllocations, client.ObjectKeyFromObject(&allocation).String())
 	}
 	if len(dependentAllocations) > 0 {
 		log.V(1).Info("There are still dependent allocations", "DependentAllocations", dependentAllocations)
@@ -187,6 +252,10 @@ func (r *PrefixReconciler) allocationMatchesPrefix(allocation *ipamv1alpha1.Pref
 		return false
 	}
 
+	if prefix.Spec.ParentNamespace != allocation.Spec.PrefixNamespace {
+		return false
+	}
+
 	if !equality.Semantic.DeepEqual(prefix.Spec.ParentSelector, allocation.Spec.PrefixSelector) {
 		return false
 	}
@@ -249,11 +318,12 @@ func (r *PrefixReconciler) newAllocationForPrefix(prefix *ipamv1alpha1.Prefix) (
 			},
 		},
 		Spec: ipamv1alpha1.PrefixAllocationSpec{
-			IPFamily:       prefix.Spec.IPFamily,
-			Prefix:         allocationPrefix,
-			PrefixLength:   allocationPrefixLength,
-			PrefixRef:      prefix.Spec.ParentRef,
-			PrefixSelector: prefix.Spec.ParentSelector,
+			IPFamily:        prefix.Spec.IPFamily,
+			Prefix:          allocationPrefix,
+			PrefixLength:    allocationPrefixLength,
+			PrefixRef:       prefix.Spec.ParentRef,
+			PrefixNamespace: prefix.Spec.ParentNamespace,
+			PrefixSelector:  prefix.Spec.ParentSelector,
 		},
 	}
 	if err := controllerutil.SetControllerReference(prefix, allocation, r.Scheme); err != nil {
@@ -376,14 +446,14 @@ func (r *PrefixReconciler) createNewAllocation(ctx context.Context, prefix *ipam
 
 func (r *PrefixReconciler) canRetryAllocation(ctx context.Context, prefix *ipamv1alpha1.Prefix, allocation *ipamv1alpha1.PrefixAllocation) (bool, error) {
 	// We can always retry if we ended up on a bad prefix with scheduling.
-	if prefix.Spec.ParentRef == nil {
+	parentKey, ok := ipamclient.PrefixParentKey(prefix)
+	if !ok {
 		return true, nil
 	}
 
 	// If the user request a specific parent prefix to host a prefix, we have to check whether the
 	// parent prefix now can host the prefix.
 	parentPrefix := &ipamv1alpha1.Prefix{}
-	parentKey := client.ObjectKey{Namespace: prefix.Namespace, Name: prefix.Spec.ParentRef.Name}
 	if err := r.Get(ctx, parentKey, parentPrefix); err != nil {
 		if !apierrors.IsNotFound(err) {
 			return false, err
@@ -456,35 +526,81 @@ func (r *PrefixReconciler) assignPrefix(ctx context.Context, prefix *ipamv1alpha
 	return nil
 }
 
-func (r *PrefixReconciler) processAllocations(ctx context.Context, log logr.Logger, prefix *ipamv1alpha1.Prefix) (used []commonv1alpha1.IPPrefix, err error) {
+// prefixIPSets returns the set of all addresses of the prefix and the set of its reserved addresses.
+func prefixIPSets(prefix *ipamv1alpha1.Prefix) (total, reserved *netipx.IPSet, err error) {
+	var totalBuilder netipx.IPSetBuilder
+	totalBuilder.AddPrefix(prefix.Spec.Prefix.Prefix)
+	total, err = totalBuilder.IPSet()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building prefix set: %w", err)
+	}
+
+	var reservedBuilder netipx.IPSetBuilder
+	for _, reservedRange := range prefix.Spec.ReservedRanges {
+		reservedBuilder.AddRange(reservedRange.Range())
+	}
+	reservedBuilder.Intersect(total)
+	reserved, err = reservedBuilder.IPSet()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building reserved set: %w", err)
+	}
+	return total, reserved, nil
+}
+
+func (r *PrefixReconciler) processAllocations(
+	ctx context.Context,
+	log logr.Logger,
+	prefix *ipamv1alpha1.Prefix,
+) (used []commonv1alpha1.IPPrefix, utilization *ipamv1alpha1.PrefixUtilization, err error) {
 	list := &ipamv1alpha1.PrefixAllocationList{}
 	log.V(1).Info("Listing referencing allocations")
 	if err := r.List(ctx, list,
-		client.InNamespace(prefix.Namespace),
-		client.MatchingFields{ipamclient.PrefixAllocationSpecPrefixRefNameField: prefix.Name},
+		client.MatchingFields{ipamclient.PrefixAllocationSpecPrefixRefKeyField: client.ObjectKeyFromObject(prefix).String()},
 	); err != nil {
-		return nil, fmt.Errorf("error listing allocations: %w", err)
+		return nil, nil, fmt.Errorf("error listing allocations: %w", err)
+	}
+
+	grantedNamespaces, err := r.grantedNamespaces(ctx, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalSet, reservedSet, err := prefixIPSets(prefix)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	var (
 		availableBuilder netipx.IPSetBuilder
 		newAllocations   []ipamv1alpha1.PrefixAllocation
 	)
-	availableBuilder.AddPrefix(prefix.Spec.Prefix.Prefix)
+	availableBuilder.AddSet(totalSet)
+	availableBuilder.RemoveSet(reservedSet)
 	for _, allocation := range list.Items {
 		allocationPhase := allocation.Status.Phase
 		switch {
 		case allocationPhase == ipamv1alpha1.PrefixAllocationPhaseAllocated:
-			used = append(used, *allocation.Status.Prefix)
-			availableBuilder.RemovePrefix(allocation.Status.Prefix.Prefix)
-		case allocationPhase != ipamv1alpha1.PrefixAllocationPhaseFailed:
+			for _, usedPrefix := range allocationResultFromStatus(&allocation.Status).usedPrefixes() {
+				used = append(used, usedPrefix)
+				availableBuilder.RemovePrefix(usedPrefix.Prefix)
+			}
+		case allocationPhase == ipamv1alpha1.PrefixAllocationPhaseFailed:
+		case allocation.Namespace != prefix.Namespace && !grantedNamespaces.Has(allocation.Namespace):
+			log.V(1).Info("Allocation namespace is not granted to allocate from prefix",
+				"Allocation", client.ObjectKeyFromObject(&allocation),
+			)
+			r.Eventf(&allocation, corev1.EventTypeWarning, namespaceNotGranted,
+				"Namespace %s is not granted to allocate from prefix %s, create a PrefixGrant in namespace %s",
+				allocation.Namespace, prefix.Name, prefix.Namespace,
+			)
+		default:
 			newAllocations = append(newAllocations, allocation)
 		}
 	}
 
 	availableSet, err := availableBuilder.IPSet()
 	if err != nil {
-		return nil, fmt.Errorf("error building available set: %w", err)
+		return nil, nil, fmt.Errorf("error building available set: %w", err)
 	}
 
 	for _, newAllocation := range newAllocations {
@@ -495,26 +611,61 @@ func (r *PrefixReconciler) processAllocations(ctx context.Context, log logr.Logg
 		}
 
 		availableSet = newAvailableSet
-		if res.IsValid() {
-			used = append(used, commonv1alpha1.IPPrefix{Prefix: res})
-		}
+		used = append(used, res.usedPrefixes()...)
 	}
 
 	// Sort for deterministic status
 	sort.Slice(used, func(i, j int) bool { return used[i].String() < used[j].String() })
-	return used, nil
+
+	var usedBuilder netipx.IPSetBuilder
+	for _, usedPrefix := range used {
+		usedBuilder.AddPrefix(usedPrefix.Prefix)
+	}
+	usedSet, err := usedBuilder.IPSet()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building used set: %w", err)
+	}
+
+	return used, computePrefixUtilization(totalSet, reservedSet, usedSet), nil
+}
+
+// grantedNamespaces returns the namespaces that are granted to allocate from the prefix via PrefixGrants.
+func (r *PrefixReconciler) grantedNamespaces(ctx context.Context, prefix *ipamv1alpha1.Prefix) (sets.Set[string], error) {
+	grantList := &ipamv1alpha1.PrefixGrantList{}
+	if err := r.List(ctx, grantList, client.InNamespace(prefix.Namespace)); err != nil {
+		return nil, fmt.Errorf("error listing prefix grants: %w", err)
+	}
+
+	namespaces := sets.New[string]()
+	for _, grant := range grantList.Items {
+		if grant.Spec.PrefixRef.Name == prefix.Name {
+			namespaces.Insert(grant.Spec.Namespaces...)
+		}
+	}
+	return namespaces, nil
 }
 
 func (r *PrefixReconciler) patchAllocationStatus(
 	ctx context.Context,
 	allocation *ipamv1alpha1.PrefixAllocation,
-	prefix *commonv1alpha1.IPPrefix,
+	res allocationResult,
 	phase ipamv1alpha1.PrefixAllocationPhase,
 ) error {
 	now := metav1.Now()
 	base := allocation.DeepCopy()
 
-	allocation.Status.Prefix = prefix
+	allocation.Status.Prefix = nil
+	if res.prefix.IsValid() {
+		allocation.Status.Prefix = commonv1alpha1.NewIPPrefix(res.prefix)
+	}
+	allocation.Status.Range = nil
+	if res.ipRange.IsValid() {
+		allocation.Status.Range = commonv1alpha1.NewIPRangePtr(res.ipRange)
+	}
+	allocation.Status.IPs = nil
+	for _, ip := range res.ips {
+		allocation.Status.IPs = append(allocation.Status.IPs, commonv1alpha1.NewIP(ip))
+	}
 	if allocation.Status.Phase != phase {
 		allocation.Status.LastPhaseTransitionTime = &now
 	}
@@ -531,30 +682,30 @@ func (r *PrefixReconciler) processAllocation(
 	prefix *ipamv1alpha1.Prefix,
 	available *netipx.IPSet,
 	allocation *ipamv1alpha1.PrefixAllocation,
-) (*netipx.IPSet, netip.Prefix, error) {
+) (*netipx.IPSet, allocationResult, error) {
 	log = log.WithValues("AllocationKey", client.ObjectKeyFromObject(allocation))
 	if !allocation.DeletionTimestamp.IsZero() {
-		return available, netip.Prefix{}, nil
+		return available, allocationResult{}, nil
 	}
 
 	res, newAvailableSet, ok, terminal := r.acquireAllocation(prefix, available, allocation)
 	switch {
 	case !ok && terminal:
 		log.V(1).Info("Marking terminally non-allocatable allocation as failed")
-		if err := r.patchAllocationStatus(ctx, allocation, allocation.Status.Prefix, ipamv1alpha1.PrefixAllocationPhaseFailed); client.IgnoreNotFound(err) != nil {
-			return available, netip.Prefix{}, fmt.Errorf("could not mark allocation as failed: %w", err)
+		if err := r.patchAllocationStatus(ctx, allocation, allocationResult{}, ipamv1alpha1.PrefixAllocationPhaseFailed); client.IgnoreNotFound(err) != nil {
+			return available, allocationResult{}, fmt.Errorf("could not mark allocation as failed: %w", err)
 		}
-		return available, netip.Prefix{}, nil
+		return available, allocationResult{}, nil
 	case !ok:
 		log.V(1).Info("Marking non-allocatable allocation as pending")
-		if err := r.patchAllocationStatus(ctx, allocation, allocation.Status.Prefix, ipamv1alpha1.PrefixAllocationPhasePending); client.IgnoreNotFound(err) != nil {
-			return available, netip.Prefix{}, fmt.Errorf("could not mark allocation as pending: %w", err)
+		if err := r.patchAllocationStatus(ctx, allocation, allocationResult{}, ipamv1alpha1.PrefixAllocationPhasePending); client.IgnoreNotFound(err) != nil {
+			return available, allocationResult{}, fmt.Errorf("could not mark allocation as pending: %w", err)
 		}
-		return available, netip.Prefix{}, nil
+		return available, allocationResult{}, nil
 	default:
 		log.V(1).Info("Marking allocation as allocated")
-		if err := r.patchAllocationStatus(ctx, allocation, commonv1alpha1.NewIPPrefix(res), ipamv1alpha1.PrefixAllocationPhaseAllocated); err != nil {
-			return available, netip.Prefix{}, fmt.Errorf("error marking allocation as succeeded: %w", err)
+		if err := r.patchAllocationStatus(ctx, allocation, res, ipamv1alpha1.PrefixAllocationPhaseAllocated); err != nil {
+			return available, allocationResult{}, fmt.Errorf("error marking allocation as succeeded: %w", err)
 		}
 		return newAvailableSet, res, nil
 	}
@@ -578,14 +729,15 @@ func (r *PrefixReconciler) reconcile(ctx context.Context, log logr.Logger, prefi
 		return ctrl.Result{RequeueAfter: backoff}, nil
 	}
 
-	used, err := r.processAllocations(ctx, log, prefix)
+	used, utilization, err := r.processAllocations(ctx, log, prefix)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("error computing usages: %w", err)
 	}
 
-	log.V(1).Info("Updating status", "Used", used)
+	log.V(1).Info("Updating status", "Used", used, "Utilization", utilization)
 	base := prefix.DeepCopy()
 	prefix.Status.Used = used
+	prefix.Status.Utilization = utilization
 	if err := r.Status().Patch(ctx, prefix, client.MergeFrom(base)); err != nil {
 		return ctrl.Result{}, fmt.Errorf("error patching status: %w", err)
 	}
@@ -611,21 +763,32 @@ func (r *PrefixReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&ipamv1alpha1.Prefix{},
 			r.enqueueByPrefixParentSelector(),
 		).
+		Watches(
+			&ipamv1alpha1.PrefixGrant{},
+			r.enqueueByPrefixGrantPrefixRef(),
+		).
 		Complete(r)
 }
 
 func (r *PrefixReconciler) enqueueByAllocationPrefixRef() handler.EventHandler {
 	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
 		allocation := obj.(*ipamv1alpha1.PrefixAllocation)
-		prefixRef := allocation.Spec.PrefixRef
-		if prefixRef == nil {
+		prefixKey, ok := ipamclient.PrefixAllocationPrefixKey(allocation)
+		if !ok {
 			return nil
 		}
+		return []ctrl.Request{{NamespacedName: prefixKey}}
+	})
+}
+
+func (r *PrefixReconciler) enqueueByPrefixGrantPrefixRef() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		grant := obj.(*ipamv1alpha1.PrefixGrant)
 		return []ctrl.Request{
 			{
 				NamespacedName: client.ObjectKey{
-					Namespace: allocation.Namespace,
-					Name:      prefixRef.Name,
+					Namespace: grant.Namespace,
+					Name:      grant.Spec.PrefixRef.Name,
 				},
 			},
 		}
@@ -682,8 +845,7 @@ func (r *PrefixReconciler) enqueueByPrefixParentRef() handler.EventHandler {
 
 		list := &ipamv1alpha1.PrefixList{}
 		if err := r.List(ctx, list,
-			client.InNamespace(prefix.Namespace),
-			client.MatchingFields{ipamclient.PrefixSpecParentRefNameField: prefix.Name},
+			client.MatchingFields{ipamclient.PrefixSpecParentRefKeyField: client.ObjectKeyFromObject(prefix).String()},
 		); err != nil {
 			log.Error(err, "Error listing prefixes with parent", "Key", client.ObjectKeyFromObject(prefix))
 			return nil
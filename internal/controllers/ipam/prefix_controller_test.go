@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -111,6 +112,234 @@ var _ = Describe("PrefixReconciler", func() {
 		Expect(allocation.Status.Prefix).To(HaveValue(Equal(expectedChildPrefix)))
 	})
 
+	It("should not allocate reserved ranges and report the utilization", func() {
+		By("creating a root prefix with reserved ranges")
+		rootPrefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-root-",
+			},
+			Spec: ipamv1alpha1.PrefixSpec{
+				Prefix: commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/24"),
+				ReservedRanges: []commonv1alpha1.IPRange{
+					commonv1alpha1.MustParseIPRange("10.0.0.0-10.0.0.1"),
+				},
+				AllocationStrategy: ipamv1alpha1.PrefixAllocationStrategyFirstFit,
+			},
+		}
+		Expect(k8sClient.Create(ctx, rootPrefix)).To(Succeed())
+
+		By("waiting for the root prefix to report its utilization")
+		rootPrefixKey := client.ObjectKeyFromObject(rootPrefix)
+		Eventually(func(g Gomega) {
+			Expect(k8sClient.Get(ctx, rootPrefixKey, rootPrefix)).To(Succeed())
+			g.Expect(rootPrefix.Status.Phase).To(Equal(ipamv1alpha1.PrefixPhaseAllocated))
+			g.Expect(rootPrefix.Status.Utilization).To(Equal(&ipamv1alpha1.PrefixUtilization{
+				Total:                   "256",
+				Reserved:                "2",
+				Used:                    "0",
+				Free:                    "254",
+				LargestFreePrefixLength: ptr.To[int32](25),
+			}))
+		}).Should(Succeed())
+
+		By("creating a child prefix")
+		childPrefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-child-",
+			},
+			Spec: ipamv1alpha1.PrefixSpec{
+				IPFamily:     corev1.IPv4Protocol,
+				PrefixLength: 28,
+				ParentRef: &corev1.LocalObjectReference{
+					Name: rootPrefix.Name,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, childPrefix)).To(Succeed())
+
+		By("waiting for the child prefix to be allocated outside the reserved ranges")
+		childPrefixKey := client.ObjectKeyFromObject(childPrefix)
+		Eventually(func(g Gomega) {
+			Expect(k8sClient.Get(ctx, childPrefixKey, childPrefix)).To(Succeed())
+			g.Expect(childPrefix.Status.Phase).To(Equal(ipamv1alpha1.PrefixPhaseAllocated))
+			g.Expect(childPrefix.Spec.Prefix).To(Equal(commonv1alpha1.MustParseNewIPPrefix("10.0.0.16/28")))
+		}).Should(Succeed())
+
+		By("asserting the parent's utilization has been updated")
+		Eventually(func(g Gomega) {
+			Expect(k8sClient.Get(ctx, rootPrefixKey, rootPrefix)).To(Succeed())
+			g.Expect(rootPrefix.Status.Utilization).To(Equal(&ipamv1alpha1.PrefixUtilization{
+				Total:                   "256",
+				Reserved:                "2",
+				Used:                    "16",
+				Free:                    "238",
+				LargestFreePrefixLength: ptr.To[int32](25),
+			}))
+		}).Should(Succeed())
+	})
+
+	It("should allocate ranges and single ips and release them on deletion", func() {
+		By("creating a root prefix")
+		rootPrefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-root-",
+			},
+			Spec: ipamv1alpha1.PrefixSpec{
+				Prefix: commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/24"),
+				ReservedRanges: []commonv1alpha1.IPRange{
+					commonv1alpha1.MustParseIPRange("10.0.0.0-10.0.0.1"),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, rootPrefix)).To(Succeed())
+
+		By("creating a range allocation")
+		rangeAllocation := &ipamv1alpha1.PrefixAllocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-range-",
+			},
+			Spec: ipamv1alpha1.PrefixAllocationSpec{
+				IPFamily:    corev1.IPv4Protocol,
+				RangeLength: 37,
+				PrefixRef:   &corev1.LocalObjectReference{Name: rootPrefix.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, rangeAllocation)).To(Succeed())
+
+		By("waiting for the range allocation to be allocated")
+		rangeAllocationKey := client.ObjectKeyFromObject(rangeAllocation)
+		Eventually(func(g Gomega) {
+			Expect(k8sClient.Get(ctx, rangeAllocationKey, rangeAllocation)).To(Succeed())
+			g.Expect(rangeAllocation.Status.Phase).To(Equal(ipamv1alpha1.PrefixAllocationPhaseAllocated))
+			g.Expect(rangeAllocation.Status.Range).To(Equal(commonv1alpha1.MustParseNewIPRange("10.0.0.2-10.0.0.38")))
+		}).Should(Succeed())
+
+		By("creating an ip count allocation")
+		ipsAllocation := &ipamv1alpha1.PrefixAllocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-ips-",
+			},
+			Spec: ipamv1alpha1.PrefixAllocationSpec{
+				IPFamily:  corev1.IPv4Protocol,
+				IPCount:   2,
+				PrefixRef: &corev1.LocalObjectReference{Name: rootPrefix.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, ipsAllocation)).To(Succeed())
+
+		By("waiting for the ip count allocation to be allocated with the next free ips")
+		ipsAllocationKey := client.ObjectKeyFromObject(ipsAllocation)
+		Eventually(func(g Gomega) {
+			Expect(k8sClient.Get(ctx, ipsAllocationKey, ipsAllocation)).To(Succeed())
+			g.Expect(ipsAllocation.Status.Phase).To(Equal(ipamv1alpha1.PrefixAllocationPhaseAllocated))
+			g.Expect(ipsAllocation.Status.IPs).To(Equal(commonv1alpha1.MustParseIPs("10.0.0.39", "10.0.0.40")))
+		}).Should(Succeed())
+
+		By("asserting the root prefix reports the used addresses")
+		rootPrefixKey := client.ObjectKeyFromObject(rootPrefix)
+		Eventually(func(g Gomega) {
+			Expect(k8sClient.Get(ctx, rootPrefixKey, rootPrefix)).To(Succeed())
+			g.Expect(rootPrefix.Status.Utilization).To(HaveField("Used", "39"))
+		}).Should(Succeed())
+
+		By("deleting the range allocation")
+		Expect(k8sClient.Delete(ctx, rangeAllocation)).To(Succeed())
+
+		By("asserting the range has been released")
+		Eventually(func(g Gomega) {
+			Expect(k8sClient.Get(ctx, rootPrefixKey, rootPrefix)).To(Succeed())
+			g.Expect(rootPrefix.Status.Utilization).To(HaveField("Used", "2"))
+			g.Expect(rootPrefix.Status.Used).To(ConsistOf(
+				commonv1alpha1.MustParseIPPrefix("10.0.0.39/32"),
+				commonv1alpha1.MustParseIPPrefix("10.0.0.40/32"),
+			))
+		}).Should(Succeed())
+	})
+
+	It("should only allocate child prefixes from other namespaces if granted", func() {
+		By("creating a tenant namespace")
+		tenantNs := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-tenant-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, tenantNs)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, tenantNs)
+
+		By("creating a root prefix")
+		rootPrefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-root-",
+			},
+			Spec: ipamv1alpha1.PrefixSpec{
+				Prefix: commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/24"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, rootPrefix)).To(Succeed())
+
+		By("creating a child prefix in the tenant namespace")
+		childPrefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    tenantNs.Name,
+				GenerateName: "test-child-",
+			},
+			Spec: ipamv1alpha1.PrefixSpec{
+				IPFamily:        corev1.IPv4Protocol,
+				PrefixLength:    28,
+				ParentRef:       &corev1.LocalObjectReference{Name: rootPrefix.Name},
+				ParentNamespace: ns.Name,
+			},
+		}
+		Expect(k8sClient.Create(ctx, childPrefix)).To(Succeed())
+
+		By("asserting the child prefix is not allocated")
+		childPrefixKey := client.ObjectKeyFromObject(childPrefix)
+		Consistently(func(g Gomega) {
+			Expect(k8sClient.Get(ctx, childPrefixKey, childPrefix)).To(Succeed())
+			g.Expect(childPrefix.Status.Phase).NotTo(Equal(ipamv1alpha1.PrefixPhaseAllocated))
+		}).Should(Succeed())
+
+		By("asserting an event reports the missing grant")
+		Eventually(prefixRecorder.Events).Should(Receive(SatisfyAll(
+			ContainSubstring(namespaceNotGranted),
+			ContainSubstring(tenantNs.Name),
+			ContainSubstring(rootPrefix.Name),
+		)))
+
+		By("granting the tenant namespace to allocate from the root prefix")
+		grant := &ipamv1alpha1.PrefixGrant{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-grant-",
+			},
+			Spec: ipamv1alpha1.PrefixGrantSpec{
+				PrefixRef:  corev1.LocalObjectReference{Name: rootPrefix.Name},
+				Namespaces: []string{tenantNs.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, grant)).To(Succeed())
+
+		By("waiting for the child prefix to be allocated")
+		Eventually(func(g Gomega) {
+			Expect(k8sClient.Get(ctx, childPrefixKey, childPrefix)).To(Succeed())
+			g.Expect(childPrefix.Status.Phase).To(Equal(ipamv1alpha1.PrefixPhaseAllocated))
+			g.Expect(childPrefix.Spec.Prefix).To(Equal(commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/28")))
+		}).Should(Succeed())
+
+		By("asserting the root prefix reports the child prefix as used")
+		rootPrefixKey := client.ObjectKeyFromObject(rootPrefix)
+		Eventually(func(g Gomega) {
+			Expect(k8sClient.Get(ctx, rootPrefixKey, rootPrefix)).To(Succeed())
+			g.Expect(rootPrefix.Status.Used).To(ConsistOf(commonv1alpha1.MustParseIPPrefix("10.0.0.0/28")))
+		}).Should(Succeed())
+	})
+
 	It("should leave prefixes in pending state when they can't be allocated", func() {
 		By("creating a root prefix")
 		prefixValue := commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/24")
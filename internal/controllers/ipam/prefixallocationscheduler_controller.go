@@ -101,6 +101,9 @@ func prefixCompatibleWithAllocation(prefix *ipamv1alpha1.Prefix, allocation *ipa
 	if allocation.Spec.PrefixLength > 0 && int32(prefix.Spec.Prefix.Bits()) >= allocation.Spec.PrefixLength {
 		return false
 	}
+	if rng := allocation.Spec.Range; rng.IsValid() && (!prefix.Spec.Prefix.Contains(rng.From.Addr) || !prefix.Spec.Prefix.Contains(rng.To.Addr)) {
+		return false
+	}
 	return true
 }
 
@@ -111,6 +114,9 @@ func prefixFitsAllocation(prefix *ipamv1alpha1.Prefix, allocation *ipamv1alpha1.
 
 	var bldr netipx.IPSetBuilder
 	bldr.AddPrefix(prefix.Spec.Prefix.Prefix)
+	for _, reservedRange := range prefix.Spec.ReservedRanges {
+		bldr.RemoveRange(reservedRange.Range())
+	}
 	for _, used := range prefix.Status.Used {
 		bldr.RemovePrefix(used.Prefix)
 	}
@@ -122,6 +128,14 @@ func prefixFitsAllocation(prefix *ipamv1alpha1.Prefix, allocation *ipamv1alpha1.
 	case allocation.Spec.PrefixLength > 0:
 		_, _, ok := set.RemoveFreePrefix(uint8(allocation.Spec.PrefixLength))
 		return ok
+	case allocation.Spec.Range.IsValid():
+		return set.ContainsRange(allocation.Spec.Range.Range())
+	case allocation.Spec.RangeLength > 0:
+		_, _, ok := ipSetRemoveFreeRange(set, allocation.Spec.RangeLength, ipamv1alpha1.PrefixAllocationStrategyFirstFit)
+		return ok
+	case allocation.Spec.IPCount > 0:
+		_, _, ok := ipSetRemoveFreeIPs(set, allocation.Spec.IPCount)
+		return ok
 	default:
 		panic(fmt.Sprintf("unhandled allocation %#v", allocation))
 	}
@@ -40,6 +40,8 @@ var (
 	k8sClient  client.Client
 	testEnv    *envtest.Environment
 	testEnvExt *utilsenvtest.EnvironmentExtensions
+
+	prefixRecorder = record.NewFakeRecorder(1024)
 )
 
 func TestAPIs(t *testing.T) {
@@ -101,12 +103,13 @@ var _ = BeforeSuite(func() {
 	ctx, cancel := context.WithCancel(context.Background())
 	DeferCleanup(cancel)
 	Expect(ipamclient.SetupPrefixSpecIPFamilyFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
-	Expect(ipamclient.SetupPrefixSpecParentRefFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(ipamclient.SetupPrefixSpecParentRefKeyFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
 	Expect(ipamclient.SetupPrefixAllocationSpecIPFamilyFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
-	Expect(ipamclient.SetupPrefixAllocationSpecPrefixRefNameField(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(ipamclient.SetupPrefixAllocationSpecPrefixRefKeyField(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
 
 	// Register reconcilers
 	err = (&PrefixReconciler{
+		EventRecorder:           prefixRecorder,
 		Client:                  k8sManager.GetClient(),
 		APIReader:               k8sManager.GetAPIReader(),
 		Scheme:                  k8sManager.GetScheme(),
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 
 	"github.com/ironcore-dev/ironcore/utils/annotations"
 
@@ -31,6 +32,7 @@ type LoadBalancerEphemeralPrefixReconciler struct {
 }
 
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=loadbalancers,verbs=get;list;watch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=loadbalancers/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixes,verbs=get;list;watch;create;update;patch;delete
 
 func (r *LoadBalancerEphemeralPrefixReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -73,6 +75,13 @@ func (r *LoadBalancerEphemeralPrefixReconciler) ephemeralLoadBalancerPrefixByNam
 		annotations.SetDefaultEphemeralManagedBy(prefix)
 		_ = ctrl.SetControllerReference(loadBalancer, prefix, r.Scheme())
 		res[prefixName] = prefix
+
+		if delegatedPrefixLength := ephemeral.DelegatedPrefixLength; delegatedPrefixLength != nil {
+			delegatedPrefixName := networkingv1alpha1.LoadBalancerDelegatedPrefixIPAMPrefixName(loadBalancer.Name, i)
+			delegatedPrefix := ephemeralDelegatedPrefix(delegatedPrefixName, prefix, *delegatedPrefixLength)
+			_ = ctrl.SetControllerReference(loadBalancer, delegatedPrefix, r.Scheme())
+			res[delegatedPrefixName] = delegatedPrefix
+		}
 	}
 
 	return res
@@ -168,6 +177,22 @@ func (r *LoadBalancerEphemeralPrefixReconciler) reconcile(ctx context.Context, l
 		return ctrl.Result{}, fmt.Errorf("error managing ephemeral prefixes: %w", err)
 	}
 
+	var delegatedPrefixNames []string
+	for i, loadBalancerIP := range loadBalancer.Spec.IPs {
+		if ephemeral := loadBalancerIP.Ephemeral; ephemeral != nil && ephemeral.DelegatedPrefixLength != nil {
+			delegatedPrefixNames = append(delegatedPrefixNames, networkingv1alpha1.LoadBalancerDelegatedPrefixIPAMPrefixName(loadBalancer.Name, i))
+		}
+	}
+	delegatedPrefixes := allocatedDelegatedPrefixes(loadBalancer, delegatedPrefixNames, prefixList.Items)
+	if !slices.Equal(loadBalancer.Status.DelegatedPrefixes, delegatedPrefixes) {
+		log.V(1).Info("Updating delegated prefixes", "DelegatedPrefixes", delegatedPrefixes)
+		base := loadBalancer.DeepCopy()
+		loadBalancer.Status.DelegatedPrefixes = delegatedPrefixes
+		if err := r.Status().Patch(ctx, loadBalancer, client.MergeFrom(base)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error updating delegated prefixes: %w", err)
+		}
+	}
+
 	log.V(1).Info("Reconciled")
 	return ctrl.Result{}, nil
 }
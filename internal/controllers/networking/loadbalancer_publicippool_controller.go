@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	networkingclient "github.com/ironcore-dev/ironcore/internal/client/networking"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// LoadBalancerPublicIPPoolReconciler allocates one IP per ip family of a public LoadBalancer referencing
+// PublicIPPools and releases the allocations once the LoadBalancer is gone.
+type LoadBalancerPublicIPPoolReconciler struct {
+	client.Client
+
+	// PoolNamespace is the namespace the ipam Prefixes of all PublicIPPools are managed in.
+	PoolNamespace string
+}
+
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=loadbalancers,verbs=get;list;watch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=loadbalancers/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=publicippools,verbs=get;list;watch
+//+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixes,verbs=get;list;watch;create;update;patch;delete
+
+func (r *LoadBalancerPublicIPPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	loadBalancer := &networkingv1alpha1.LoadBalancer{}
+	if err := r.Get(ctx, req.NamespacedName, loadBalancer); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting load balancer %s: %w", req.NamespacedName, err)
+		}
+
+		log.V(1).Info("Load balancer not found, releasing public ip pool allocations")
+		if err := releasePublicIPPoolAllocations(ctx, r.Client, r.PoolNamespace, publicIPPoolClaimerKindLoadBalancer, req.NamespacedName, ""); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcileExists(ctx, log, loadBalancer)
+}
+
+func (r *LoadBalancerPublicIPPoolReconciler) reconcileExists(ctx context.Context, log logr.Logger, loadBalancer *networkingv1alpha1.LoadBalancer) (ctrl.Result, error) {
+	if !loadBalancer.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcile(ctx, log, loadBalancer)
+}
+
+func (r *LoadBalancerPublicIPPoolReconciler) reconcile(ctx context.Context, log logr.Logger, loadBalancer *networkingv1alpha1.LoadBalancer) (ctrl.Result, error) {
+	log.V(1).Info("Reconcile")
+
+	log.V(1).Info("Releasing public ip pool allocations of previous load balancers with the same name")
+	if err := releasePublicIPPoolAllocations(ctx, r.Client, r.PoolNamespace, publicIPPoolClaimerKindLoadBalancer, client.ObjectKeyFromObject(loadBalancer), loadBalancer.UID); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if loadBalancer.Spec.Type != networkingv1alpha1.LoadBalancerTypePublic || len(loadBalancer.Spec.PublicIPPoolRefs) == 0 {
+		log.V(1).Info("Load balancer does not allocate from a public ip pool, nothing to do")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Applying public ip pool allocations")
+	ipByFamily := make(map[corev1.IPFamily]commonv1alpha1.IP)
+	for _, publicIPPoolRef := range loadBalancer.Spec.PublicIPPoolRefs {
+		ip, err := applyPublicIPPoolAllocation(ctx, r.Client, r.PoolNamespace, publicIPPoolClaimerKindLoadBalancer, loadBalancer, publicIPPoolRef.Name, loadBalancer.Spec.IPFamilies)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if ip != nil {
+			ipByFamily[ip.Family()] = *ip
+		}
+	}
+
+	var ips []commonv1alpha1.IP
+	for _, ipFamily := range loadBalancer.Spec.IPFamilies {
+		ip, ok := ipByFamily[ipFamily]
+		if !ok {
+			log.V(1).Info("Public ip pool allocation is not ready yet", "IPFamily", ipFamily)
+			return ctrl.Result{}, nil
+		}
+		ips = append(ips, ip)
+	}
+
+	if slices.Equal(loadBalancer.Status.IPs, ips) {
+		log.V(1).Info("Load balancer status is up-to-date")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Updating load balancer status", "IPs", ips)
+	base := loadBalancer.DeepCopy()
+	loadBalancer.Status.IPs = ips
+	if err := r.Status().Patch(ctx, loadBalancer, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error patching load balancer status: %w", err)
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+func (r *LoadBalancerPublicIPPoolReconciler) loadBalancerPublicIPPoolPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		loadBalancer := obj.(*networkingv1alpha1.LoadBalancer)
+		return len(loadBalancer.Spec.PublicIPPoolRefs) > 0
+	})
+}
+
+func (r *LoadBalancerPublicIPPoolReconciler) enqueueByPublicIPPool() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		pool := obj.(*networkingv1alpha1.PublicIPPool)
+		log := ctrl.LoggerFrom(ctx)
+
+		loadBalancerList := &networkingv1alpha1.LoadBalancerList{}
+		if err := r.List(ctx, loadBalancerList,
+			client.MatchingFields{
+				networkingclient.LoadBalancerPublicIPPoolNameField: pool.Name,
+			},
+		); err != nil {
+			log.Error(err, "Error listing load balancers")
+			return nil
+		}
+
+		var reqs []ctrl.Request
+		for _, loadBalancer := range loadBalancerList.Items {
+			reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&loadBalancer)})
+		}
+		return reqs
+	})
+}
+
+func (r *LoadBalancerPublicIPPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("loadbalancerpublicippool").
+		For(
+			&networkingv1alpha1.LoadBalancer{},
+			builder.WithPredicates(r.loadBalancerPublicIPPoolPredicate()),
+		).
+		Watches(
+			&ipamv1alpha1.Prefix{},
+			enqueueByPublicIPPoolAllocation(publicIPPoolClaimerKindLoadBalancer),
+		).
+		Watches(
+			&networkingv1alpha1.PublicIPPool{},
+			r.enqueueByPublicIPPool(),
+		).
+		Complete(r)
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	networkingclient "github.com/ironcore-dev/ironcore/internal/client/networking"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// NATGatewayPublicIPPoolReconciler allocates the IP of a NATGateway referencing a PublicIPPool
+// and releases the allocation once the NATGateway is gone.
+type NATGatewayPublicIPPoolReconciler struct {
+	client.Client
+
+	// PoolNamespace is the namespace the ipam Prefixes of all PublicIPPools are managed in.
+	PoolNamespace string
+}
+
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=natgateways,verbs=get;list;watch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=natgateways/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=publicippools,verbs=get;list;watch
+//+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixes,verbs=get;list;watch;create;update;patch;delete
+
+func (r *NATGatewayPublicIPPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	natGateway := &networkingv1alpha1.NATGateway{}
+	if err := r.Get(ctx, req.NamespacedName, natGateway); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting NAT gateway %s: %w", req.NamespacedName, err)
+		}
+
+		log.V(1).Info("NAT gateway not found, releasing public ip pool allocations")
+		if err := releasePublicIPPoolAllocations(ctx, r.Client, r.PoolNamespace, publicIPPoolClaimerKindNATGateway, req.NamespacedName, ""); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcileExists(ctx, log, natGateway)
+}
+
+func (r *NATGatewayPublicIPPoolReconciler) reconcileExists(ctx context.Context, log logr.Logger, natGateway *networkingv1alpha1.NATGateway) (ctrl.Result, error) {
+	if !natGateway.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcile(ctx, log, natGateway)
+}
+
+func (r *NATGatewayPublicIPPoolReconciler) reconcile(ctx context.Context, log logr.Logger, natGateway *networkingv1alpha1.NATGateway) (ctrl.Result, error) {
+	log.V(1).Info("Reconcile")
+
+	log.V(1).Info("Releasing public ip pool allocations of previous NAT gateways with the same name")
+	if err := releasePublicIPPoolAllocations(ctx, r.Client, r.PoolNamespace, publicIPPoolClaimerKindNATGateway, client.ObjectKeyFromObject(natGateway), natGateway.UID); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	publicIPPoolRef := natGateway.Spec.PublicIPPoolRef
+	if publicIPPoolRef == nil {
+		log.V(1).Info("NAT gateway does not allocate from a public ip pool, nothing to do")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Applying public ip pool allocation")
+	ip, err := applyPublicIPPoolAllocation(ctx, r.Client, r.PoolNamespace, publicIPPoolClaimerKindNATGateway, natGateway, publicIPPoolRef.Name, []corev1.IPFamily{natGateway.Spec.IPFamily})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if ip == nil {
+		log.V(1).Info("Public ip pool allocation is not ready yet")
+		return ctrl.Result{}, nil
+	}
+
+	ips := []commonv1alpha1.IP{*ip}
+	if slices.Equal(natGateway.Status.IPs, ips) {
+		log.V(1).Info("NAT gateway status is up-to-date")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Updating NAT gateway status", "IPs", ips)
+	base := natGateway.DeepCopy()
+	natGateway.Status.IPs = ips
+	if err := r.Status().Patch(ctx, natGateway, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error patching NAT gateway status: %w", err)
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+func (r *NATGatewayPublicIPPoolReconciler) natGatewayPublicIPPoolPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		natGateway := obj.(*networkingv1alpha1.NATGateway)
+		return natGateway.Spec.PublicIPPoolRef != nil
+	})
+}
+
+func (r *NATGatewayPublicIPPoolReconciler) enqueueByPublicIPPool() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		pool := obj.(*networkingv1alpha1.PublicIPPool)
+		log := ctrl.LoggerFrom(ctx)
+
+		natGatewayList := &networkingv1alpha1.NATGatewayList{}
+		if err := r.List(ctx, natGatewayList,
+			client.MatchingFields{
+				networkingclient.NATGatewayPublicIPPoolNameField: pool.Name,
+			},
+		); err != nil {
+			log.Error(err, "Error listing NAT gateways")
+			return nil
+		}
+
+		var reqs []ctrl.Request
+		for _, natGateway := range natGatewayList.Items {
+			reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&natGateway)})
+		}
+		return reqs
+	})
+}
+
+func (r *NATGatewayPublicIPPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("natgatewaypublicippool").
+		For(
+			&networkingv1alpha1.NATGateway{},
+			builder.WithPredicates(r.natGatewayPublicIPPoolPredicate()),
+		).
+		Watches(
+			&ipamv1alpha1.Prefix{},
+			enqueueByPublicIPPoolAllocation(publicIPPoolClaimerKindNATGateway),
+		).
+		Watches(
+			&networkingv1alpha1.PublicIPPool{},
+			r.enqueueByPublicIPPool(),
+		).
+		Complete(r)
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/utils/annotations"
+	klogutils "github.com/ironcore-dev/ironcore/utils/klog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// NetworkEphemeralPrefixReconciler manages the root ipam Prefixes for the prefixes of a Network.
+type NetworkEphemeralPrefixReconciler struct {
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=networks,verbs=get;list;watch
+//+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixes,verbs=get;list;watch;create;update;patch;delete
+
+func (r *NetworkEphemeralPrefixReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	network := &networkingv1alpha1.Network{}
+	if err := r.Get(ctx, req.NamespacedName, network); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	return r.reconcileExists(ctx, log, network)
+}
+
+func (r *NetworkEphemeralPrefixReconciler) reconcileExists(ctx context.Context, log logr.Logger, network *networkingv1alpha1.Network) (ctrl.Result, error) {
+	if !network.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcile(ctx, log, network)
+}
+
+func (r *NetworkEphemeralPrefixReconciler) ephemeralNetworkPrefixByName(network *networkingv1alpha1.Network) map[string]*ipamv1alpha1.Prefix {
+	res := make(map[string]*ipamv1alpha1.Prefix)
+
+	for i, networkPrefix := range network.Spec.Prefixes {
+		prefixName := networkingv1alpha1.NetworkPrefixIPAMPrefixName(network.Name, i)
+		prefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: network.Namespace,
+				Name:      prefixName,
+			},
+			Spec: ipamv1alpha1.PrefixSpec{
+				IPFamily: networkPrefix.IP().Family(),
+				Prefix:   commonv1alpha1.PtrToIPPrefix(networkPrefix),
+			},
+		}
+		annotations.SetDefaultEphemeralManagedBy(prefix)
+		_ = ctrl.SetControllerReference(network, prefix, r.Scheme())
+		res[prefixName] = prefix
+	}
+
+	return res
+}
+
+func (r *NetworkEphemeralPrefixReconciler) handleExistingPrefix(ctx context.Context, log logr.Logger, network *networkingv1alpha1.Network, shouldManage bool, prefix *ipamv1alpha1.Prefix) error {
+	if annotations.IsDefaultEphemeralControlledBy(prefix, network) {
+		if shouldManage {
+			log.V(1).Info("Ephemeral prefix is present and controlled by network")
+			return nil
+		}
+
+		if !prefix.DeletionTimestamp.IsZero() {
+			log.V(1).Info("Undesired ephemeral prefix is already deleting")
+			return nil
+		}
+
+		log.V(1).Info("Deleting undesired ephemeral prefix")
+		if err := r.Delete(ctx, prefix); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("error deleting prefix %s: %w", prefix.Name, err)
+		}
+		return nil
+	}
+
+	if shouldManage {
+		log.V(1).Info("Won't adopt unmanaged prefix")
+	}
+	return nil
+}
+
+func (r *NetworkEphemeralPrefixReconciler) handleCreatePrefix(
+	ctx context.Context,
+	log logr.Logger,
+	network *networkingv1alpha1.Network,
+	prefix *ipamv1alpha1.Prefix,
+) error {
+	log.V(1).Info("Creating prefix")
+	prefixKey := client.ObjectKeyFromObject(prefix)
+	err := r.Create(ctx, prefix)
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	// Due to a fast resync, we might get an already exists error.
+	// In this case, try to fetch the prefix again and, when successful, treat it as managing
+	// an existing prefix.
+	if err := r.Get(ctx, prefixKey, prefix); err != nil {
+		return fmt.Errorf("error getting prefix %s after already exists: %w", prefixKey.Name, err)
+	}
+
+	// Treat a retrieved prefix as an existing we should manage.
+	log.V(1).Info("Retrieved prefix after already exists conflict")
+	return r.handleExistingPrefix(ctx, log, network, true, prefix)
+}
+
+func (r *NetworkEphemeralPrefixReconciler) reconcile(ctx context.Context, log logr.Logger, network *networkingv1alpha1.Network) (ctrl.Result, error) {
+	log.V(1).Info("Reconcile")
+
+	log.V(1).Info("Listing prefixes")
+	prefixList := &ipamv1alpha1.PrefixList{}
+	if err := r.List(ctx, prefixList,
+		client.InNamespace(network.Namespace),
+	); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing prefixes: %w", err)
+	}
+	log.V(5).Info("Listed prefixes", "Prefixes", klogutils.KObjStructSlice(prefixList.Items))
+
+	var (
+		ephemPrefixByName = r.ephemeralNetworkPrefixByName(network)
+		errs              []error
+	)
+	for _, prefix := range prefixList.Items {
+		prefixName := prefix.Name
+		_, shouldManage := ephemPrefixByName[prefixName]
+		delete(ephemPrefixByName, prefixName)
+		log := log.WithValues("Prefix", klog.KObj(&prefix), "ShouldManage", shouldManage)
+		if err := r.handleExistingPrefix(ctx, log, network, shouldManage, &prefix); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, prefix := range ephemPrefixByName {
+		log := log.WithValues("Prefix", klog.KObj(prefix))
+		if err := r.handleCreatePrefix(ctx, log, network, prefix); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error managing ephemeral prefixes: %w", err)
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+func (r *NetworkEphemeralPrefixReconciler) networkNotDeletingPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		network := obj.(*networkingv1alpha1.Network)
+		return network.DeletionTimestamp.IsZero()
+	})
+}
+
+func (r *NetworkEphemeralPrefixReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("networkephemeralprefix").
+		For(
+			&networkingv1alpha1.Network{},
+			builder.WithPredicates(
+				r.networkNotDeletingPredicate(),
+			),
+		).
+		Owns(&ipamv1alpha1.Prefix{}).
+		Complete(r)
+}
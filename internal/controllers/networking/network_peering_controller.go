@@ -6,8 +6,10 @@ package networking
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/go-logr/logr"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -24,6 +26,7 @@ type NetworkPeeringReconciler struct {
 
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=networks,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=networks/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=subnets,verbs=get;list;watch
 
 func (r *NetworkPeeringReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -47,10 +50,10 @@ func (r *NetworkPeeringReconciler) reconcile(ctx context.Context, log logr.Logge
 	log.V(1).Info("Reconcile")
 
 	var peeringClaimRefs []networkingv1alpha1.NetworkPeeringClaimRef
-	var peeringNames []string
+	var peeringStatuses []networkingv1alpha1.NetworkPeeringStatus
 
 	for _, peering := range network.Spec.Peerings {
-		peeringClaimRef, err := r.reconcilePeering(ctx, log, network, peering)
+		peeringClaimRef, peeringStatus, err := r.reconcilePeering(ctx, log, network, peering)
 
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("[network peering %s] %w", peering.Name, err)
@@ -61,7 +64,8 @@ func (r *NetworkPeeringReconciler) reconcile(ctx context.Context, log logr.Logge
 		}
 
 		if peering.Name != "" {
-			peeringNames = append(peeringNames, peering.Name)
+			peeringStatus.Name = peering.Name
+			peeringStatuses = append(peeringStatuses, peeringStatus)
 		}
 	}
 
@@ -72,9 +76,9 @@ func (r *NetworkPeeringReconciler) reconcile(ctx context.Context, log logr.Logge
 		}
 	}
 
-	if len(peeringNames) > 0 {
+	if len(peeringStatuses) > 0 {
 		log.V(1).Info("Network peering status require network status update")
-		if err := r.updateStatus(ctx, log, network, peeringNames); err != nil {
+		if err := r.updateStatus(ctx, log, network, peeringStatuses); err != nil {
 			return ctrl.Result{}, fmt.Errorf("error updating network status: %w", err)
 		}
 	}
@@ -87,17 +91,10 @@ func (r *NetworkPeeringReconciler) updateStatus(
 	ctx context.Context,
 	log logr.Logger,
 	network *networkingv1alpha1.Network,
-	peeringNames []string,
+	peeringStatuses []networkingv1alpha1.NetworkPeeringStatus,
 ) error {
 	base := network.DeepCopy()
-	newStatusPeerings := make([]networkingv1alpha1.NetworkPeeringStatus, 0, len(peeringNames))
-	for _, name := range peeringNames {
-		newStatusPeerings = append(newStatusPeerings, networkingv1alpha1.NetworkPeeringStatus{
-			Name:  name,
-			State: networkingv1alpha1.NetworkPeeringStatePending,
-		})
-	}
-	network.Status.Peerings = newStatusPeerings
+	network.Status.Peerings = peeringStatuses
 
 	log.V(1).Info("Updating network status peerings", "", network.Status.Peerings)
 	if err := r.Status().Patch(ctx, network, client.StrategicMergeFrom(base)); err != nil {
@@ -130,8 +127,9 @@ func (r *NetworkPeeringReconciler) reconcilePeering(
 	log logr.Logger,
 	network *networkingv1alpha1.Network,
 	peering networkingv1alpha1.NetworkPeering,
-) (networkingv1alpha1.NetworkPeeringClaimRef, error) {
+) (networkingv1alpha1.NetworkPeeringClaimRef, networkingv1alpha1.NetworkPeeringStatus, error) {
 	networkKey := client.ObjectKeyFromObject(network)
+	pendingStatus := networkingv1alpha1.NetworkPeeringStatus{State: networkingv1alpha1.NetworkPeeringStatePending}
 
 	targetNetwork := &networkingv1alpha1.Network{}
 	targetNetworkRef := peering.NetworkRef
@@ -145,11 +143,11 @@ func (r *NetworkPeeringReconciler) reconcilePeering(
 	log.V(1).Info("Getting target network")
 	if err := r.Get(ctx, targetNetworkKey, targetNetwork); err != nil {
 		if !apierrors.IsNotFound(err) {
-			return networkingv1alpha1.NetworkPeeringClaimRef{}, fmt.Errorf("error getting target network %s: %w", targetNetworkKey, err)
+			return networkingv1alpha1.NetworkPeeringClaimRef{}, pendingStatus, fmt.Errorf("error getting target network %s: %w", targetNetworkKey, err)
 		}
 
 		log.V(1).Info("Target network not found")
-		return networkingv1alpha1.NetworkPeeringClaimRef{}, nil
+		return networkingv1alpha1.NetworkPeeringClaimRef{}, pendingStatus, nil
 	}
 
 	for _, targetPeering := range targetNetwork.Spec.Peerings {
@@ -166,7 +164,25 @@ func (r *NetworkPeeringReconciler) reconcilePeering(
 
 		if targetNetwork.Status.State != networkingv1alpha1.NetworkStateAvailable {
 			log.V(1).Info("Target network is not available yet")
-			return networkingv1alpha1.NetworkPeeringClaimRef{}, nil
+			return networkingv1alpha1.NetworkPeeringClaimRef{}, pendingStatus, nil
+		}
+
+		log.V(1).Info("Determining advertised prefixes")
+		advertisedPrefixes, err := r.advertisedPrefixes(ctx, log, network, peering)
+		if err != nil {
+			return networkingv1alpha1.NetworkPeeringClaimRef{}, pendingStatus, err
+		}
+
+		targetAdvertisedPrefixes, err := r.advertisedPrefixes(ctx, log.WithValues("Network", targetNetworkKey), targetNetwork, targetPeering)
+		if err != nil {
+			return networkingv1alpha1.NetworkPeeringClaimRef{}, pendingStatus, err
+		}
+
+		if prefix, targetPrefix, ok := overlappingPrefixes(advertisedPrefixes, targetAdvertisedPrefixes); ok {
+			log.V(1).Info("Advertised prefixes overlap with target network advertised prefixes", "Prefix", prefix, "TargetPrefix", targetPrefix)
+			return networkingv1alpha1.NetworkPeeringClaimRef{}, networkingv1alpha1.NetworkPeeringStatus{
+				State: networkingv1alpha1.NetworkPeeringStateError,
+			}, nil
 		}
 
 		log.V(1).Info("Target network peering matches")
@@ -176,11 +192,94 @@ func (r *NetworkPeeringReconciler) reconcilePeering(
 			UID:       targetNetwork.UID,
 		}
 
-		return peeringClaimRef, nil
+		return peeringClaimRef, networkingv1alpha1.NetworkPeeringStatus{
+			State:    networkingv1alpha1.NetworkPeeringStatePending,
+			Prefixes: targetAdvertisedPrefixes,
+		}, nil
 	}
 
 	log.V(1).Info("No matching target peering found")
-	return networkingv1alpha1.NetworkPeeringClaimRef{}, nil
+	return networkingv1alpha1.NetworkPeeringClaimRef{}, pendingStatus, nil
+}
+
+// advertisedPrefixes returns the prefixes the given network advertises over the given peering.
+// If the peering does not restrict its prefixes, all prefixes of the network are advertised.
+// Otherwise, the restricted prefixes are intersected with the prefixes of the network, if it specifies any.
+func (r *NetworkPeeringReconciler) advertisedPrefixes(
+	ctx context.Context,
+	log logr.Logger,
+	network *networkingv1alpha1.Network,
+	peering networkingv1alpha1.NetworkPeering,
+) ([]commonv1alpha1.IPPrefix, error) {
+	if len(peering.Prefixes) == 0 {
+		return network.Spec.Prefixes, nil
+	}
+
+	var res []commonv1alpha1.IPPrefix
+	for _, peeringPrefix := range peering.Prefixes {
+		switch {
+		case peeringPrefix.Prefix != nil:
+			res = append(res, *peeringPrefix.Prefix)
+		case peeringPrefix.SubnetRef != nil:
+			subnet := &networkingv1alpha1.Subnet{}
+			subnetKey := client.ObjectKey{Namespace: network.Namespace, Name: peeringPrefix.SubnetRef.Name}
+			if err := r.Get(ctx, subnetKey, subnet); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, fmt.Errorf("error getting subnet %s: %w", subnetKey, err)
+				}
+				log.Info("Advertised subnet not found, not advertising it", "Peering", peering.Name, "Subnet", subnetKey.Name)
+				continue
+			}
+
+			if subnet.Spec.NetworkRef.Name != network.Name {
+				log.Info("Advertised subnet belongs to another network, not advertising it",
+					"Peering", peering.Name, "Subnet", subnetKey.Name, "SubnetNetwork", subnet.Spec.NetworkRef.Name)
+				continue
+			}
+
+			res = append(res, subnet.Spec.Prefixes...)
+		}
+	}
+
+	if len(network.Spec.Prefixes) == 0 {
+		return res, nil
+	}
+	return intersectPrefixes(res, network.Spec.Prefixes), nil
+}
+
+// intersectPrefixes returns the parts of the given prefixes that are contained in the network prefixes.
+// As two prefixes either are disjoint or one contains the other, the intersection of two prefixes is
+// the more specific one of them, if they overlap.
+func intersectPrefixes(prefixes, networkPrefixes []commonv1alpha1.IPPrefix) []commonv1alpha1.IPPrefix {
+	var res []commonv1alpha1.IPPrefix
+	for _, prefix := range prefixes {
+		for _, networkPrefix := range networkPrefixes {
+			if !prefix.Overlaps(networkPrefix.Prefix) {
+				continue
+			}
+
+			intersection := prefix
+			if networkPrefix.Bits() > prefix.Bits() {
+				intersection = networkPrefix
+			}
+			if !slices.Contains(res, intersection) {
+				res = append(res, intersection)
+			}
+		}
+	}
+	return res
+}
+
+// overlappingPrefixes reports the first pair of overlapping prefixes of the given prefixes, if any.
+func overlappingPrefixes(prefixes, targetPrefixes []commonv1alpha1.IPPrefix) (commonv1alpha1.IPPrefix, commonv1alpha1.IPPrefix, bool) {
+	for _, prefix := range prefixes {
+		for _, targetPrefix := range targetPrefixes {
+			if prefix.Overlaps(targetPrefix.Prefix) {
+				return prefix, targetPrefix, true
+			}
+		}
+	}
+	return commonv1alpha1.IPPrefix{}, commonv1alpha1.IPPrefix{}, false
 }
 
 func (r *NetworkPeeringReconciler) enqueuePeeringReferencedNetworks() handler.EventHandler {
@@ -201,6 +300,34 @@ func (r *NetworkPeeringReconciler) enqueuePeeringReferencedNetworks() handler.Ev
 	})
 }
 
+func (r *NetworkPeeringReconciler) enqueueBySubnet() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		subnet := obj.(*networkingv1alpha1.Subnet)
+		log := ctrl.LoggerFrom(ctx)
+
+		network := &networkingv1alpha1.Network{}
+		networkKey := client.ObjectKey{Namespace: subnet.Namespace, Name: subnet.Spec.NetworkRef.Name}
+		if err := r.Get(ctx, networkKey, network); err != nil {
+			if !apierrors.IsNotFound(err) {
+				log.Error(err, "Error getting network", "NetworkKey", networkKey)
+			}
+			return nil
+		}
+
+		// Both the network of the subnet and the networks it peers with report the advertised prefixes.
+		reqs := sets.New(ctrl.Request{NamespacedName: networkKey})
+		for _, peering := range network.Spec.Peerings {
+			refNamespace := peering.NetworkRef.Namespace
+			if refNamespace == "" {
+				refNamespace = network.Namespace
+			}
+
+			reqs.Insert(ctrl.Request{NamespacedName: client.ObjectKey{Namespace: refNamespace, Name: peering.NetworkRef.Name}})
+		}
+		return reqs.UnsortedList()
+	})
+}
+
 func (r *NetworkPeeringReconciler) networkStateAvailablePredicate() predicate.Predicate {
 	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
 		network := obj.(*networkingv1alpha1.Network)
@@ -220,5 +347,9 @@ func (r *NetworkPeeringReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			r.enqueuePeeringReferencedNetworks(),
 			builder.WithPredicates(r.networkStateAvailablePredicate()),
 		).
+		Watches(
+			&networkingv1alpha1.Subnet{},
+			r.enqueueBySubnet(),
+		).
 		Complete(r)
 }
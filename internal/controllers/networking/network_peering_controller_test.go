@@ -4,10 +4,12 @@
 package networking
 
 import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	. "github.com/ironcore-dev/ironcore/utils/testing"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -550,4 +552,187 @@ var _ = Describe("NetworkPeeringController", func() {
 		Eventually(Get(network2)).Should(Satisfy(apierrors.IsNotFound))
 		Eventually(Get(network3)).Should(Satisfy(apierrors.IsNotFound))
 	})
+
+	It("should not peer networks with overlapping prefixes", func(ctx SpecContext) {
+		By("creating a network network-1")
+		network1 := &networkingv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "network-overlap-1",
+			},
+			Spec: networkingv1alpha1.NetworkSpec{
+				Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+				Peerings: []networkingv1alpha1.NetworkPeering{
+					{
+						Name: "peering-1",
+						NetworkRef: networkingv1alpha1.NetworkPeeringNetworkRef{
+							Name: "network-overlap-2",
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, network1)).To(Succeed())
+
+		By("creating a network network-2 with an overlapping prefix")
+		network2 := &networkingv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "network-overlap-2",
+			},
+			Spec: networkingv1alpha1.NetworkSpec{
+				Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.128.0/24")},
+				Peerings: []networkingv1alpha1.NetworkPeering{
+					{
+						Name: "peering-1",
+						NetworkRef: networkingv1alpha1.NetworkPeeringNetworkRef{
+							Name: "network-overlap-1",
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, network2)).To(Succeed())
+
+		By("patching networks as available")
+		baseNetwork1 := network1.DeepCopy()
+		network1.Status.State = networkingv1alpha1.NetworkStateAvailable
+		Expect(k8sClient.Status().Patch(ctx, network1, client.MergeFrom(baseNetwork1))).To(Succeed())
+
+		baseNetwork2 := network2.DeepCopy()
+		network2.Status.State = networkingv1alpha1.NetworkStateAvailable
+		Expect(k8sClient.Status().Patch(ctx, network2, client.MergeFrom(baseNetwork2))).To(Succeed())
+
+		By("waiting for the network peerings to report an error")
+		Eventually(Object(network1)).Should(HaveField("Status.Peerings", ConsistOf(networkingv1alpha1.NetworkPeeringStatus{
+			Name:  "peering-1",
+			State: networkingv1alpha1.NetworkPeeringStateError,
+		})))
+		Eventually(Object(network2)).Should(HaveField("Status.Peerings", ConsistOf(networkingv1alpha1.NetworkPeeringStatus{
+			Name:  "peering-1",
+			State: networkingv1alpha1.NetworkPeeringStateError,
+		})))
+
+		By("asserting the networks don't claim each other")
+		Consistently(Object(network1)).Should(HaveField("Spec.PeeringClaimRefs", BeEmpty()))
+		Consistently(Object(network2)).Should(HaveField("Spec.PeeringClaimRefs", BeEmpty()))
+	})
+
+	It("should report the effective prefixes of filtered network peerings", func(ctx SpecContext) {
+		By("creating a shared services subnet")
+		subnet := &networkingv1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "shared-services",
+			},
+			Spec: networkingv1alpha1.SubnetSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "network-filtered-1"},
+				Prefixes:   []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.1.0/24")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, subnet)).To(Succeed())
+
+		By("creating a network only advertising the shared services subnet")
+		network1 := &networkingv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "network-filtered-1",
+			},
+			Spec: networkingv1alpha1.NetworkSpec{
+				Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+				Peerings: []networkingv1alpha1.NetworkPeering{
+					{
+						Name: "peering-1",
+						NetworkRef: networkingv1alpha1.NetworkPeeringNetworkRef{
+							Name: "network-filtered-2",
+						},
+						Prefixes: []networkingv1alpha1.PeeringPrefix{
+							{
+								Name:      "shared-services",
+								SubnetRef: &corev1.LocalObjectReference{Name: subnet.Name},
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, network1)).To(Succeed())
+
+		By("creating a network advertising all of its prefixes")
+		network2 := &networkingv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "network-filtered-2",
+			},
+			Spec: networkingv1alpha1.NetworkSpec{
+				Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.1.0.0/16")},
+				Peerings: []networkingv1alpha1.NetworkPeering{
+					{
+						Name: "peering-1",
+						NetworkRef: networkingv1alpha1.NetworkPeeringNetworkRef{
+							Name: "network-filtered-1",
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, network2)).To(Succeed())
+
+		By("patching networks as available")
+		baseNetwork1 := network1.DeepCopy()
+		network1.Status.State = networkingv1alpha1.NetworkStateAvailable
+		Expect(k8sClient.Status().Patch(ctx, network1, client.MergeFrom(baseNetwork1))).To(Succeed())
+
+		baseNetwork2 := network2.DeepCopy()
+		network2.Status.State = networkingv1alpha1.NetworkStateAvailable
+		Expect(k8sClient.Status().Patch(ctx, network2, client.MergeFrom(baseNetwork2))).To(Succeed())
+
+		By("waiting for the network peerings to report the effective prefixes")
+		Eventually(Object(network1)).Should(HaveField("Status.Peerings", ConsistOf(networkingv1alpha1.NetworkPeeringStatus{
+			Name:     "peering-1",
+			State:    networkingv1alpha1.NetworkPeeringStatePending,
+			Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.1.0.0/16")},
+		})))
+		Eventually(Object(network2)).Should(HaveField("Status.Peerings", ConsistOf(networkingv1alpha1.NetworkPeeringStatus{
+			Name:     "peering-1",
+			State:    networkingv1alpha1.NetworkPeeringStatePending,
+			Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.1.0/24")},
+		})))
+	})
+
+	DescribeTable("intersectPrefixes",
+		func(prefixes, networkPrefixes, expected []commonv1alpha1.IPPrefix) {
+			Expect(intersectPrefixes(prefixes, networkPrefixes)).To(Equal(expected))
+		},
+		Entry("prefix contained in a network prefix",
+			[]commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.1.0/24")},
+			[]commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+			[]commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.1.0/24")},
+		),
+		Entry("prefix containing network prefixes",
+			[]commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/8")},
+			[]commonv1alpha1.IPPrefix{
+				commonv1alpha1.MustParseIPPrefix("10.0.0.0/16"),
+				commonv1alpha1.MustParseIPPrefix("10.1.0.0/16"),
+				commonv1alpha1.MustParseIPPrefix("192.168.0.0/16"),
+			},
+			[]commonv1alpha1.IPPrefix{
+				commonv1alpha1.MustParseIPPrefix("10.0.0.0/16"),
+				commonv1alpha1.MustParseIPPrefix("10.1.0.0/16"),
+			},
+		),
+		Entry("prefix outside of the network prefixes",
+			[]commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("172.16.0.0/24")},
+			[]commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+			nil,
+		),
+		Entry("duplicate intersections",
+			[]commonv1alpha1.IPPrefix{
+				commonv1alpha1.MustParseIPPrefix("10.0.0.0/8"),
+				commonv1alpha1.MustParseIPPrefix("10.0.0.0/12"),
+			},
+			[]commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+			[]commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+		),
+	)
 })
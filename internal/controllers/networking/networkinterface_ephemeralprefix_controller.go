@@ -8,16 +8,20 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 
 	"github.com/ironcore-dev/ironcore/utils/annotations"
 
 	"github.com/go-logr/logr"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	networkingclient "github.com/ironcore-dev/ironcore/internal/client/networking"
 	klogutils "github.com/ironcore-dev/ironcore/utils/klog"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -26,12 +30,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
+const (
+	subnetNetworkMismatch = "SubnetNetworkMismatch"
+	subnetIPFamilyMissing = "SubnetIPFamilyMissing"
+)
+
 type NetworkInterfaceEphemeralPrefixReconciler struct {
+	record.EventRecorder
 	client.Client
 }
 
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=networkinterfaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=networkinterfaces/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=subnets,verbs=get;list;watch
 //+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixes,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *NetworkInterfaceEphemeralPrefixReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -51,7 +64,11 @@ func (r *NetworkInterfaceEphemeralPrefixReconciler) reconcileExists(ctx context.
 	return r.reconcile(ctx, log, nic)
 }
 
-func (r *NetworkInterfaceEphemeralPrefixReconciler) ephemeralNetworkInterfacePrefixByName(nic *networkingv1alpha1.NetworkInterface) map[string]*ipamv1alpha1.Prefix {
+func (r *NetworkInterfaceEphemeralPrefixReconciler) ephemeralNetworkInterfacePrefixByName(
+	log logr.Logger,
+	nic *networkingv1alpha1.NetworkInterface,
+	subnet *networkingv1alpha1.Subnet,
+) map[string]*ipamv1alpha1.Prefix {
 	res := make(map[string]*ipamv1alpha1.Prefix)
 
 	for i, nicIP := range nic.Spec.IPs {
@@ -68,11 +85,29 @@ func (r *NetworkInterfaceEphemeralPrefixReconciler) ephemeralNetworkInterfacePre
 				Labels:      ephemeral.PrefixTemplate.Labels,
 				Annotations: maps.Clone(ephemeral.PrefixTemplate.Annotations),
 			},
-			Spec: ephemeral.PrefixTemplate.Spec,
+			Spec: *ephemeral.PrefixTemplate.Spec.DeepCopy(),
+		}
+		if subnet != nil && prefix.Spec.ParentRef == nil && prefix.Spec.ParentSelector == nil {
+			idx := networkingv1alpha1.SubnetPrefixIndexByIPFamily(subnet, prefix.Spec.IPFamily)
+			if idx < 0 {
+				log.V(1).Info("Subnet has no prefix for ip family", "Prefix", prefixName, "IPFamily", prefix.Spec.IPFamily)
+				r.Eventf(nic, corev1.EventTypeWarning, subnetIPFamilyMissing,
+					"Subnet %s has no prefix of ip family %s for ip %d", subnet.Name, prefix.Spec.IPFamily, i)
+				continue
+			}
+
+			prefix.Spec.ParentRef = &corev1.LocalObjectReference{Name: networkingv1alpha1.SubnetPrefixIPAMPrefixName(subnet.Name, idx)}
 		}
 		annotations.SetDefaultEphemeralManagedBy(prefix)
 		_ = ctrl.SetControllerReference(nic, prefix, r.Scheme())
 		res[prefixName] = prefix
+
+		if delegatedPrefixLength := ephemeral.DelegatedPrefixLength; delegatedPrefixLength != nil {
+			delegatedPrefixName := networkingv1alpha1.NetworkInterfaceDelegatedPrefixIPAMPrefixName(nic.Name, i)
+			delegatedPrefix := ephemeralDelegatedPrefix(delegatedPrefixName, prefix, *delegatedPrefixLength)
+			_ = ctrl.SetControllerReference(nic, delegatedPrefix, r.Scheme())
+			res[delegatedPrefixName] = delegatedPrefix
+		}
 	}
 
 	for i, nicPrefix := range nic.Spec.Prefixes {
@@ -98,6 +133,49 @@ func (r *NetworkInterfaceEphemeralPrefixReconciler) ephemeralNetworkInterfacePre
 	return res
 }
 
+// ephemeralDelegatedPrefix returns the Prefix delegated alongside the given ephemeral ip Prefix.
+// The delegated Prefix is allocated from the same parent and with the same ip family as the ip Prefix.
+func ephemeralDelegatedPrefix(name string, ipPrefix *ipamv1alpha1.Prefix, prefixLength int32) *ipamv1alpha1.Prefix {
+	prefix := &ipamv1alpha1.Prefix{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   ipPrefix.Namespace,
+			Name:        name,
+			Labels:      maps.Clone(ipPrefix.Labels),
+			Annotations: maps.Clone(ipPrefix.Annotations),
+		},
+		Spec: ipamv1alpha1.PrefixSpec{
+			IPFamily:       ipPrefix.Spec.IPFamily,
+			PrefixLength:   prefixLength,
+			ParentRef:      ipPrefix.Spec.ParentRef,
+			ParentSelector: ipPrefix.Spec.ParentSelector,
+		},
+	}
+	annotations.SetDefaultEphemeralManagedBy(prefix)
+	return prefix
+}
+
+// allocatedDelegatedPrefixes returns the allocated prefixes of the delegated Prefixes with the given names
+// that are controlled by the owner.
+func allocatedDelegatedPrefixes(owner client.Object, names []string, prefixes []ipamv1alpha1.Prefix) []commonv1alpha1.IPPrefix {
+	var res []commonv1alpha1.IPPrefix
+	for _, name := range names {
+		idx := slices.IndexFunc(prefixes, func(prefix ipamv1alpha1.Prefix) bool { return prefix.Name == name })
+		if idx < 0 {
+			continue
+		}
+
+		prefix := prefixes[idx]
+		if !annotations.IsDefaultEphemeralControlledBy(&prefix, owner) ||
+			prefix.Status.Phase != ipamv1alpha1.PrefixPhaseAllocated ||
+			prefix.Spec.Prefix == nil {
+			continue
+		}
+
+		res = append(res, *prefix.Spec.Prefix)
+	}
+	return res
+}
+
 func (r *NetworkInterfaceEphemeralPrefixReconciler) handleExistingPrefix(ctx context.Context, log logr.Logger, nic *networkingv1alpha1.NetworkInterface, shouldManage bool, prefix *ipamv1alpha1.Prefix) error {
 	if annotations.IsDefaultEphemeralControlledBy(prefix, nic) {
 		if shouldManage {
@@ -151,9 +229,45 @@ func (r *NetworkInterfaceEphemeralPrefixReconciler) handleCreatePrefix(
 	return r.handleExistingPrefix(ctx, log, nic, true, prefix)
 }
 
+// getSubnet returns the subnet referenced by the network interface. If the subnet does not exist or
+// belongs to another network, false is returned.
+func (r *NetworkInterfaceEphemeralPrefixReconciler) getSubnet(ctx context.Context, nic *networkingv1alpha1.NetworkInterface) (*networkingv1alpha1.Subnet, bool, error) {
+	subnetRef := nic.Spec.SubnetRef
+	if subnetRef == nil {
+		return nil, true, nil
+	}
+
+	subnet := &networkingv1alpha1.Subnet{}
+	subnetKey := client.ObjectKey{Namespace: nic.Namespace, Name: subnetRef.Name}
+	if err := r.Get(ctx, subnetKey, subnet); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, false, fmt.Errorf("error getting subnet %s: %w", subnetKey.Name, err)
+		}
+		return nil, false, nil
+	}
+
+	if subnet.Spec.NetworkRef.Name != nic.Spec.NetworkRef.Name {
+		// Requeueing won't help, the network interface is enqueued again once it or the subnet changes.
+		r.Eventf(nic, corev1.EventTypeWarning, subnetNetworkMismatch,
+			"Subnet %s belongs to network %s, not to network %s",
+			subnet.Name, subnet.Spec.NetworkRef.Name, nic.Spec.NetworkRef.Name)
+		return nil, false, nil
+	}
+	return subnet, true, nil
+}
+
 func (r *NetworkInterfaceEphemeralPrefixReconciler) reconcile(ctx context.Context, log logr.Logger, nic *networkingv1alpha1.NetworkInterface) (ctrl.Result, error) {
 	log.V(1).Info("Reconcile")
 
+	subnet, ok, err := r.getSubnet(ctx, nic)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !ok {
+		log.V(1).Info("Subnet not found or not usable")
+		return ctrl.Result{}, nil
+	}
+
 	log.V(1).Info("Listing prefixes")
 	prefixList := &ipamv1alpha1.PrefixList{}
 	if err := r.List(ctx, prefixList,
@@ -164,7 +278,7 @@ func (r *NetworkInterfaceEphemeralPrefixReconciler) reconcile(ctx context.Contex
 	log.V(5).Info("Listed prefixes", "Prefixes", klogutils.KObjStructSlice(prefixList.Items))
 
 	var (
-		ephemNicByName = r.ephemeralNetworkInterfacePrefixByName(nic)
+		ephemNicByName = r.ephemeralNetworkInterfacePrefixByName(log, nic, subnet)
 		errs           []error
 	)
 	for _, prefix := range prefixList.Items {
@@ -188,6 +302,22 @@ func (r *NetworkInterfaceEphemeralPrefixReconciler) reconcile(ctx context.Contex
 		return ctrl.Result{}, fmt.Errorf("error managing ephemeral prefixes: %w", err)
 	}
 
+	var delegatedPrefixNames []string
+	for i, nicIP := range nic.Spec.IPs {
+		if ephemeral := nicIP.Ephemeral; ephemeral != nil && ephemeral.DelegatedPrefixLength != nil {
+			delegatedPrefixNames = append(delegatedPrefixNames, networkingv1alpha1.NetworkInterfaceDelegatedPrefixIPAMPrefixName(nic.Name, i))
+		}
+	}
+	delegatedPrefixes := append(slices.Clone(nic.Spec.DelegatedPrefixes), allocatedDelegatedPrefixes(nic, delegatedPrefixNames, prefixList.Items)...)
+	if !slices.Equal(nic.Status.DelegatedPrefixes, delegatedPrefixes) {
+		log.V(1).Info("Updating delegated prefixes", "DelegatedPrefixes", delegatedPrefixes)
+		base := nic.DeepCopy()
+		nic.Status.DelegatedPrefixes = delegatedPrefixes
+		if err := r.Status().Patch(ctx, nic, client.MergeFrom(base)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error updating delegated prefixes: %w", err)
+		}
+	}
+
 	log.V(1).Info("Reconciled")
 	return ctrl.Result{}, nil
 }
@@ -227,6 +357,34 @@ func (r *NetworkInterfaceEphemeralPrefixReconciler) enqueueByPrefix() handler.Ev
 	})
 }
 
+func (r *NetworkInterfaceEphemeralPrefixReconciler) enqueueBySubnet() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		subnet := obj.(*networkingv1alpha1.Subnet)
+		log := ctrl.LoggerFrom(ctx)
+
+		nicList := &networkingv1alpha1.NetworkInterfaceList{}
+		if err := r.List(ctx, nicList,
+			client.InNamespace(subnet.Namespace),
+			client.MatchingFields{
+				networkingclient.NetworkInterfaceSpecSubnetRefNameField: subnet.Name,
+			},
+		); err != nil {
+			log.Error(err, "Error listing network interfaces")
+			return nil
+		}
+
+		var reqs []ctrl.Request
+		for _, nic := range nicList.Items {
+			if !nic.DeletionTimestamp.IsZero() {
+				continue
+			}
+
+			reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&nic)})
+		}
+		return reqs
+	})
+}
+
 func (r *NetworkInterfaceEphemeralPrefixReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("networkinterfaceephemeralprefix").
@@ -241,5 +399,9 @@ func (r *NetworkInterfaceEphemeralPrefixReconciler) SetupWithManager(mgr ctrl.Ma
 			&ipamv1alpha1.Prefix{},
 			r.enqueueByPrefix(),
 		).
+		Watches(
+			&networkingv1alpha1.Subnet{},
+			r.enqueueBySubnet(),
+		).
 		Complete(r)
 }
@@ -13,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 )
@@ -125,4 +126,246 @@ var _ = Describe("NetworkInterfaceEphemeralPrefix", func() {
 		By("asserting that the prefix is not being deleted")
 		Eventually(Object(externalPrefix)).Should(HaveField("DeletionTimestamp", BeNil()))
 	})
+
+	It("should allocate ephemeral IP prefixes from the subnet of a network interface", func(ctx SpecContext) {
+		By("creating a subnet")
+		subnet := &networkingv1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "subnet-",
+			},
+			Spec: networkingv1alpha1.SubnetSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				Prefixes: []commonv1alpha1.IPPrefix{
+					commonv1alpha1.MustParseIPPrefix("10.0.0.0/24"),
+					commonv1alpha1.MustParseIPPrefix("fd00::/64"),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, subnet)).To(Succeed())
+
+		By("creating a network interface referencing the subnet")
+		nic := &networkingv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: networkingv1alpha1.NetworkInterfaceSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				SubnetRef:  &corev1.LocalObjectReference{Name: subnet.Name},
+				IPs: []networkingv1alpha1.IPSource{
+					{
+						Ephemeral: &networkingv1alpha1.EphemeralPrefixSource{
+							PrefixTemplate: &ipamv1alpha1.PrefixTemplateSpec{
+								Spec: ipamv1alpha1.PrefixSpec{
+									IPFamily:     corev1.IPv6Protocol,
+									PrefixLength: 128,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("waiting for the prefix to be allocated from the subnet prefix of the same ip family")
+		prefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      networkingv1alpha1.NetworkInterfaceIPIPAMPrefixName(nic.Name, 0),
+			},
+		}
+		Eventually(Object(prefix)).Should(SatisfyAll(
+			BeControlledBy(nic),
+			HaveField("Spec", ipamv1alpha1.PrefixSpec{
+				IPFamily:     corev1.IPv6Protocol,
+				PrefixLength: 128,
+				ParentRef:    &corev1.LocalObjectReference{Name: networkingv1alpha1.SubnetPrefixIPAMPrefixName(subnet.Name, 1)},
+			}),
+		))
+	})
+
+	It("should report subnets of another network and missing ip families without requeueing", func(ctx SpecContext) {
+		By("creating a subnet of another network with only an IPv4 prefix")
+		otherSubnet := &networkingv1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "subnet-",
+			},
+			Spec: networkingv1alpha1.SubnetSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "other-network"},
+				Prefixes:   []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/24")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, otherSubnet)).To(Succeed())
+
+		By("creating a network interface referencing the subnet")
+		nic := &networkingv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: networkingv1alpha1.NetworkInterfaceSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				SubnetRef:  &corev1.LocalObjectReference{Name: otherSubnet.Name},
+				IPs: []networkingv1alpha1.IPSource{
+					{
+						Ephemeral: &networkingv1alpha1.EphemeralPrefixSource{
+							PrefixTemplate: &ipamv1alpha1.PrefixTemplateSpec{
+								Spec: ipamv1alpha1.PrefixSpec{
+									IPFamily:     corev1.IPv6Protocol,
+									PrefixLength: 128,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("waiting for the subnet network mismatch to be reported")
+		Eventually(networkInterfaceEphemeralPrefixRecorder.Events).Should(Receive(SatisfyAll(
+			ContainSubstring(subnetNetworkMismatch),
+			ContainSubstring(otherSubnet.Name),
+		)))
+
+		prefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      networkingv1alpha1.NetworkInterfaceIPIPAMPrefixName(nic.Name, 0),
+			},
+		}
+		Consistently(Get(prefix)).Should(Satisfy(apierrors.IsNotFound))
+
+		By("creating a subnet of the network with only an IPv4 prefix")
+		ipv4Subnet := &networkingv1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "subnet-",
+			},
+			Spec: networkingv1alpha1.SubnetSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				Prefixes:   []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/24")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, ipv4Subnet)).To(Succeed())
+
+		By("creating a network interface referencing the IPv4 subnet")
+		ipv4NIC := &networkingv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: *nic.Spec.DeepCopy(),
+		}
+		ipv4NIC.Spec.SubnetRef = &corev1.LocalObjectReference{Name: ipv4Subnet.Name}
+		Expect(k8sClient.Create(ctx, ipv4NIC)).To(Succeed())
+
+		By("waiting for the missing IPv6 subnet prefix to be reported")
+		Eventually(networkInterfaceEphemeralPrefixRecorder.Events).Should(Receive(SatisfyAll(
+			ContainSubstring(subnetIPFamilyMissing),
+			ContainSubstring(ipv4Subnet.Name),
+		)))
+		ipv4Prefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      networkingv1alpha1.NetworkInterfaceIPIPAMPrefixName(ipv4NIC.Name, 0),
+			},
+		}
+		Consistently(Get(ipv4Prefix)).Should(Satisfy(apierrors.IsNotFound))
+	})
+
+	It("should delegate a prefix to a network interface and report it in its status", func(ctx SpecContext) {
+		By("creating a network interface with a delegated prefix length")
+		nic := &networkingv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: networkingv1alpha1.NetworkInterfaceSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				IPFamilies: []corev1.IPFamily{corev1.IPv6Protocol},
+				IPs: []networkingv1alpha1.IPSource{
+					{
+						Ephemeral: &networkingv1alpha1.EphemeralPrefixSource{
+							PrefixTemplate: &ipamv1alpha1.PrefixTemplateSpec{
+								Spec: ipamv1alpha1.PrefixSpec{
+									IPFamily:     corev1.IPv6Protocol,
+									PrefixLength: 128,
+									ParentRef:    &corev1.LocalObjectReference{Name: "root"},
+								},
+							},
+							DelegatedPrefixLength: ptr.To[int32](64),
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("waiting for the delegated prefix to exist")
+		delegatedPrefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      networkingv1alpha1.NetworkInterfaceDelegatedPrefixIPAMPrefixName(nic.Name, 0),
+			},
+		}
+		Eventually(Object(delegatedPrefix)).Should(SatisfyAll(
+			BeControlledBy(nic),
+			HaveField("Spec", ipamv1alpha1.PrefixSpec{
+				IPFamily:     corev1.IPv6Protocol,
+				PrefixLength: 64,
+				ParentRef:    &corev1.LocalObjectReference{Name: "root"},
+			}),
+		))
+
+		By("allocating the delegated prefix")
+		Eventually(Update(delegatedPrefix, func() {
+			delegatedPrefix.Spec.Prefix = commonv1alpha1.MustParseNewIPPrefix("fd00:0:0:1::/64")
+		})).Should(Succeed())
+		Eventually(UpdateStatus(delegatedPrefix, func() {
+			delegatedPrefix.Status.Phase = ipamv1alpha1.PrefixPhaseAllocated
+		})).Should(Succeed())
+
+		By("waiting for the network interface to report the delegated prefix")
+		Eventually(Object(nic)).Should(HaveField("Status.DelegatedPrefixes", ConsistOf(
+			commonv1alpha1.MustParseIPPrefix("fd00:0:0:1::/64"),
+		)))
+	})
+
+	It("should report the static delegated prefixes of a network interface in its status", func(ctx SpecContext) {
+		By("creating a network interface with a static delegated prefix")
+		nic := &networkingv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: networkingv1alpha1.NetworkInterfaceSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				IPs: []networkingv1alpha1.IPSource{
+					{Value: commonv1alpha1.MustParseNewIP("10.0.0.1")},
+				},
+				DelegatedPrefixes: []commonv1alpha1.IPPrefix{
+					commonv1alpha1.MustParseIPPrefix("10.1.0.0/24"),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("waiting for the network interface to report the delegated prefix")
+		Eventually(Object(nic)).Should(HaveField("Status.DelegatedPrefixes", ConsistOf(
+			commonv1alpha1.MustParseIPPrefix("10.1.0.0/24"),
+		)))
+
+		By("removing the delegated prefix")
+		Eventually(Update(nic, func() {
+			nic.Spec.DelegatedPrefixes = nil
+		})).Should(Succeed())
+
+		By("waiting for the network interface to no longer report the delegated prefix")
+		Eventually(Object(nic)).Should(HaveField("Status.DelegatedPrefixes", BeEmpty()))
+	})
 })
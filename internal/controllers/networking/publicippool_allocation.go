@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/utils/annotations"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+const (
+	publicIPPoolClaimerKindVirtualIP    = "VirtualIP"
+	publicIPPoolClaimerKindLoadBalancer = "LoadBalancer"
+	publicIPPoolClaimerKindNATGateway   = "NATGateway"
+)
+
+// publicIPPoolAllocation returns the ipam Prefix allocating the idx-th IP from the given PublicIPPool
+// for the given claimer. The allocation lives in the pool namespace, hence the claimer is recorded in labels
+// instead of an owner reference.
+func publicIPPoolAllocation(poolNamespace, claimerKind string, claimer client.Object, pool *networkingv1alpha1.PublicIPPool, idx int) *ipamv1alpha1.Prefix {
+	prefix := &ipamv1alpha1.Prefix{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: poolNamespace,
+			Name:      networkingv1alpha1.PublicIPPoolAllocationIPAMPrefixName(claimer.GetUID(), idx),
+			Labels: map[string]string{
+				networkingv1alpha1.PublicIPPoolAllocationLabel:       pool.Name,
+				networkingv1alpha1.PublicIPPoolClaimerKindLabel:      claimerKind,
+				networkingv1alpha1.PublicIPPoolClaimerNamespaceLabel: claimer.GetNamespace(),
+				networkingv1alpha1.PublicIPPoolClaimerNameLabel:      claimer.GetName(),
+				networkingv1alpha1.PublicIPPoolClaimerUIDLabel:       string(claimer.GetUID()),
+			},
+		},
+		Spec: ipamv1alpha1.PrefixSpec{
+			IPFamily:     pool.Spec.IPFamily,
+			PrefixLength: ipFamilyBits(pool.Spec.IPFamily),
+			ParentSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					networkingv1alpha1.PublicIPPoolPrefixLabel: pool.Name,
+				},
+			},
+		},
+	}
+	annotations.SetDefaultEphemeralManagedBy(prefix)
+	return prefix
+}
+
+func ipFamilyBits(ipFamily corev1.IPFamily) int32 {
+	switch ipFamily {
+	case corev1.IPv4Protocol:
+		return 32
+	case corev1.IPv6Protocol:
+		return 128
+	default:
+		return 0
+	}
+}
+
+// applyPublicIPPoolAllocation ensures the allocation of an IP from the PublicIPPool with the given name exists
+// for the claimer if the PublicIPPool serves one of the given ip families. The allocation is indexed by the
+// position of the ip family of the PublicIPPool in ipFamilies, so a claimer can allocate one IP per ip family.
+// It returns the allocated IP or nil if the IP is not yet allocated or the PublicIPPool does not exist / serve
+// any of the ip families.
+func applyPublicIPPoolAllocation(
+	ctx context.Context,
+	c client.Client,
+	poolNamespace string,
+	claimerKind string,
+	claimer client.Object,
+	poolName string,
+	ipFamilies []corev1.IPFamily,
+) (*commonv1alpha1.IP, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	pool := &networkingv1alpha1.PublicIPPool{}
+	if err := c.Get(ctx, client.ObjectKey{Name: poolName}, pool); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting public ip pool %s: %w", poolName, err)
+		}
+
+		log.V(1).Info("Public IP pool not found", "PublicIPPool", poolName)
+		return nil, nil
+	}
+	idx := slices.Index(ipFamilies, pool.Spec.IPFamily)
+	if idx < 0 {
+		log.V(1).Info("Public IP pool does not serve any requested ip family", "PublicIPPool", poolName, "IPFamilies", ipFamilies)
+		return nil, nil
+	}
+
+	prefix := publicIPPoolAllocation(poolNamespace, claimerKind, claimer, pool, idx)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(prefix), prefix); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting public ip pool allocation %s: %w", prefix.Name, err)
+		}
+
+		log.V(1).Info("Creating public ip pool allocation", "Prefix", prefix.Name)
+		if err := c.Create(ctx, prefix); err != nil {
+			return nil, fmt.Errorf("error creating public ip pool allocation %s: %w", prefix.Name, err)
+		}
+		return nil, nil
+	}
+
+	if prefix.Status.Phase != ipamv1alpha1.PrefixPhaseAllocated || !prefix.Spec.Prefix.IsValid() {
+		log.V(1).Info("Public ip pool allocation is not yet allocated", "Prefix", prefix.Name)
+		return nil, nil
+	}
+	return &commonv1alpha1.IP{Addr: prefix.Spec.Prefix.Addr()}, nil
+}
+
+// releasePublicIPPoolAllocations deletes all allocations of the claimer with the given kind and key
+// whose claimer uid does not match keepUID. An empty keepUID releases all allocations.
+func releasePublicIPPoolAllocations(
+	ctx context.Context,
+	c client.Client,
+	poolNamespace string,
+	claimerKind string,
+	claimerKey client.ObjectKey,
+	keepUID types.UID,
+) error {
+	prefixList := &ipamv1alpha1.PrefixList{}
+	if err := c.List(ctx, prefixList,
+		client.InNamespace(poolNamespace),
+		client.MatchingLabels{
+			networkingv1alpha1.PublicIPPoolClaimerKindLabel:      claimerKind,
+			networkingv1alpha1.PublicIPPoolClaimerNamespaceLabel: claimerKey.Namespace,
+			networkingv1alpha1.PublicIPPoolClaimerNameLabel:      claimerKey.Name,
+		},
+	); err != nil {
+		return fmt.Errorf("error listing public ip pool allocations: %w", err)
+	}
+
+	var errs []error
+	for _, prefix := range prefixList.Items {
+		if keepUID != "" && prefix.Labels[networkingv1alpha1.PublicIPPoolClaimerUIDLabel] == string(keepUID) {
+			continue
+		}
+		if !prefix.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		ctrl.LoggerFrom(ctx).V(1).Info("Releasing public ip pool allocation", "Prefix", prefix.Name)
+		if err := c.Delete(ctx, &prefix); client.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("error deleting public ip pool allocation %s: %w", prefix.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// enqueueByPublicIPPoolAllocation enqueues the claimer of the given kind of a public ip pool allocation.
+func enqueueByPublicIPPoolAllocation(claimerKind string) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		labels := obj.GetLabels()
+		if labels[networkingv1alpha1.PublicIPPoolClaimerKindLabel] != claimerKind {
+			return nil
+		}
+
+		name := labels[networkingv1alpha1.PublicIPPoolClaimerNameLabel]
+		if name == "" {
+			return nil
+		}
+		return []ctrl.Request{{NamespacedName: client.ObjectKey{
+			Namespace: labels[networkingv1alpha1.PublicIPPoolClaimerNamespaceLabel],
+			Name:      name,
+		}}}
+	})
+}
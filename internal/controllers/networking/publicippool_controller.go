@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/utils/annotations"
+	klogutils "github.com/ironcore-dev/ironcore/utils/klog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// PublicIPPoolReconciler manages the root ipam Prefixes backing a PublicIPPool in the PoolNamespace.
+// IPs of VirtualIPs, LoadBalancers and NATGateways referencing the PublicIPPool are allocated from these Prefixes.
+type PublicIPPoolReconciler struct {
+	client.Client
+
+	// PoolNamespace is the namespace the ipam Prefixes of all PublicIPPools are managed in.
+	PoolNamespace string
+}
+
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=publicippools,verbs=get;list;watch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=publicippools/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixes,verbs=get;list;watch;create;update;patch;delete
+
+func (r *PublicIPPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	pool := &networkingv1alpha1.PublicIPPool{}
+	if err := r.Get(ctx, req.NamespacedName, pool); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	return r.reconcileExists(ctx, log, pool)
+}
+
+func (r *PublicIPPoolReconciler) reconcileExists(ctx context.Context, log logr.Logger, pool *networkingv1alpha1.PublicIPPool) (ctrl.Result, error) {
+	if !pool.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcile(ctx, log, pool)
+}
+
+func (r *PublicIPPoolReconciler) ephemeralPoolPrefixByName(pool *networkingv1alpha1.PublicIPPool) map[string]*ipamv1alpha1.Prefix {
+	res := make(map[string]*ipamv1alpha1.Prefix)
+	for i, poolPrefix := range pool.Spec.Prefixes {
+		prefixName := networkingv1alpha1.PublicIPPoolPrefixIPAMPrefixName(pool.Name, i)
+		prefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: r.PoolNamespace,
+				Name:      prefixName,
+				Labels: map[string]string{
+					networkingv1alpha1.PublicIPPoolPrefixLabel: pool.Name,
+				},
+			},
+			Spec: ipamv1alpha1.PrefixSpec{
+				IPFamily: pool.Spec.IPFamily,
+				Prefix:   commonv1alpha1.PtrToIPPrefix(poolPrefix),
+			},
+		}
+		annotations.SetDefaultEphemeralManagedBy(prefix)
+		_ = ctrl.SetControllerReference(pool, prefix, r.Scheme())
+		res[prefixName] = prefix
+	}
+	return res
+}
+
+func (r *PublicIPPoolReconciler) handleExistingPrefix(ctx context.Context, log logr.Logger, pool *networkingv1alpha1.PublicIPPool, shouldManage bool, prefix *ipamv1alpha1.Prefix) error {
+	if annotations.IsDefaultEphemeralControlledBy(prefix, pool) {
+		if shouldManage {
+			log.V(1).Info("Ephemeral prefix is present and controlled by public ip pool")
+			return nil
+		}
+
+		if !prefix.DeletionTimestamp.IsZero() {
+			log.V(1).Info("Undesired ephemeral prefix is already deleting")
+			return nil
+		}
+
+		log.V(1).Info("Deleting undesired ephemeral prefix")
+		if err := r.Delete(ctx, prefix); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("error deleting prefix %s: %w", prefix.Name, err)
+		}
+		return nil
+	}
+
+	if shouldManage {
+		log.V(1).Info("Won't adopt unmanaged prefix")
+	}
+	return nil
+}
+
+func (r *PublicIPPoolReconciler) handleCreatePrefix(
+	ctx context.Context,
+	log logr.Logger,
+	pool *networkingv1alpha1.PublicIPPool,
+	prefix *ipamv1alpha1.Prefix,
+) error {
+	log.V(1).Info("Creating prefix")
+	prefixKey := client.ObjectKeyFromObject(prefix)
+	err := r.Create(ctx, prefix)
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	// Due to a fast resync, we might get an already exists error.
+	// In this case, try to fetch the prefix again and, when successful, treat it as managing
+	// an existing prefix.
+	if err := r.Get(ctx, prefixKey, prefix); err != nil {
+		return fmt.Errorf("error getting prefix %s after already exists: %w", prefixKey.Name, err)
+	}
+
+	// Treat a retrieved prefix as an existing we should manage.
+	log.V(1).Info("Retrieved prefix after already exists conflict")
+	return r.handleExistingPrefix(ctx, log, pool, true, prefix)
+}
+
+func (r *PublicIPPoolReconciler) countUsed(ctx context.Context, pool *networkingv1alpha1.PublicIPPool) (int32, error) {
+	allocationList := &ipamv1alpha1.PrefixList{}
+	if err := r.List(ctx, allocationList,
+		client.InNamespace(r.PoolNamespace),
+		client.MatchingLabels{networkingv1alpha1.PublicIPPoolAllocationLabel: pool.Name},
+	); err != nil {
+		return 0, fmt.Errorf("error listing public ip pool allocations: %w", err)
+	}
+
+	var used int32
+	for _, allocation := range allocationList.Items {
+		if allocation.Status.Phase == ipamv1alpha1.PrefixPhaseAllocated {
+			used++
+		}
+	}
+	return used, nil
+}
+
+func (r *PublicIPPoolReconciler) updateStatus(ctx context.Context, pool *networkingv1alpha1.PublicIPPool, state networkingv1alpha1.PublicIPPoolState, used int32) error {
+	if pool.Status.State == state && pool.Status.Used == used {
+		return nil
+	}
+
+	base := pool.DeepCopy()
+	if pool.Status.State != state {
+		now := metav1.Now()
+		pool.Status.State = state
+		pool.Status.LastStateTransitionTime = &now
+	}
+	pool.Status.Used = used
+	if err := r.Status().Patch(ctx, pool, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error patching public ip pool status: %w", err)
+	}
+	return nil
+}
+
+func (r *PublicIPPoolReconciler) reconcile(ctx context.Context, log logr.Logger, pool *networkingv1alpha1.PublicIPPool) (ctrl.Result, error) {
+	log.V(1).Info("Reconcile")
+
+	log.V(1).Info("Listing prefixes")
+	prefixList := &ipamv1alpha1.PrefixList{}
+	if err := r.List(ctx, prefixList,
+		client.InNamespace(r.PoolNamespace),
+		client.MatchingLabels{networkingv1alpha1.PublicIPPoolPrefixLabel: pool.Name},
+	); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing prefixes: %w", err)
+	}
+	log.V(5).Info("Listed prefixes", "Prefixes", klogutils.KObjStructSlice(prefixList.Items))
+
+	var (
+		ephemPrefixByName = r.ephemeralPoolPrefixByName(pool)
+		numDesired        = len(ephemPrefixByName)
+		numAllocated      int
+		errs              []error
+	)
+	for _, prefix := range prefixList.Items {
+		prefixName := prefix.Name
+		_, shouldManage := ephemPrefixByName[prefixName]
+		delete(ephemPrefixByName, prefixName)
+		log := log.WithValues("Prefix", klog.KObj(&prefix), "ShouldManage", shouldManage)
+		if err := r.handleExistingPrefix(ctx, log, pool, shouldManage, &prefix); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if shouldManage && annotations.IsDefaultEphemeralControlledBy(&prefix, pool) &&
+			prefix.Status.Phase == ipamv1alpha1.PrefixPhaseAllocated {
+			numAllocated++
+		}
+	}
+
+	for _, prefix := range ephemPrefixByName {
+		log := log.WithValues("Prefix", klog.KObj(prefix))
+		if err := r.handleCreatePrefix(ctx, log, pool, prefix); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error managing ephemeral prefixes: %w", err)
+	}
+
+	log.V(1).Info("Counting used IPs")
+	used, err := r.countUsed(ctx, pool)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	state := networkingv1alpha1.PublicIPPoolStatePending
+	if numAllocated == numDesired {
+		state = networkingv1alpha1.PublicIPPoolStateAvailable
+	}
+
+	log.V(1).Info("Updating public ip pool status", "State", state, "Used", used)
+	if err := r.updateStatus(ctx, pool, state, used); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+func (r *PublicIPPoolReconciler) publicIPPoolNotDeletingPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		pool := obj.(*networkingv1alpha1.PublicIPPool)
+		return pool.DeletionTimestamp.IsZero()
+	})
+}
+
+func (r *PublicIPPoolReconciler) enqueueByAllocation() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		poolName, ok := obj.GetLabels()[networkingv1alpha1.PublicIPPoolAllocationLabel]
+		if !ok || obj.GetNamespace() != r.PoolNamespace {
+			return nil
+		}
+		return []ctrl.Request{{NamespacedName: client.ObjectKey{Name: poolName}}}
+	})
+}
+
+func (r *PublicIPPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("publicippool").
+		For(
+			&networkingv1alpha1.PublicIPPool{},
+			builder.WithPredicates(
+				r.publicIPPoolNotDeletingPredicate(),
+			),
+		).
+		Owns(&ipamv1alpha1.Prefix{}).
+		Watches(
+			&ipamv1alpha1.Prefix{},
+			r.enqueueByAllocation(),
+		).
+		Complete(r)
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("PublicIPPoolController", func() {
+	ns := SetupNamespace(&k8sClient)
+
+	It("should manage the prefixes of a public ip pool and allocate virtual IPs from it", func(ctx SpecContext) {
+		By("creating a public ip pool")
+		pool := &networkingv1alpha1.PublicIPPool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "pool-",
+			},
+			Spec: networkingv1alpha1.PublicIPPoolSpec{
+				IPFamily: corev1.IPv4Protocol,
+				Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("203.0.113.0/24")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pool)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, pool)
+
+		By("waiting for the pool prefix to exist")
+		poolPrefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: publicIPPoolNamespace,
+				Name:      networkingv1alpha1.PublicIPPoolPrefixIPAMPrefixName(pool.Name, 0),
+			},
+		}
+		Eventually(Object(poolPrefix)).Should(SatisfyAll(
+			BeControlledBy(pool),
+			HaveField("Labels", HaveKeyWithValue(networkingv1alpha1.PublicIPPoolPrefixLabel, pool.Name)),
+			HaveField("Spec", ipamv1alpha1.PrefixSpec{
+				IPFamily: corev1.IPv4Protocol,
+				Prefix:   commonv1alpha1.MustParseNewIPPrefix("203.0.113.0/24"),
+			}),
+		))
+
+		By("asserting the pool is pending")
+		Eventually(Object(pool)).Should(HaveField("Status.State", networkingv1alpha1.PublicIPPoolStatePending))
+
+		By("marking the pool prefix as allocated")
+		Eventually(UpdateStatus(poolPrefix, func() {
+			poolPrefix.Status.Phase = ipamv1alpha1.PrefixPhaseAllocated
+		})).Should(Succeed())
+
+		By("waiting for the pool to be available")
+		Eventually(Object(pool)).Should(HaveField("Status.State", networkingv1alpha1.PublicIPPoolStateAvailable))
+
+		By("creating a virtual IP referencing the pool")
+		virtualIP := &networkingv1alpha1.VirtualIP{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "vip-",
+			},
+			Spec: networkingv1alpha1.VirtualIPSpec{
+				Type:            networkingv1alpha1.VirtualIPTypePublic,
+				IPFamily:        corev1.IPv4Protocol,
+				PublicIPPoolRef: &corev1.LocalObjectReference{Name: pool.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, virtualIP)).To(Succeed())
+
+		By("waiting for the allocation to exist")
+		allocation := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: publicIPPoolNamespace,
+				Name:      networkingv1alpha1.PublicIPPoolAllocationIPAMPrefixName(virtualIP.UID, 0),
+			},
+		}
+		Eventually(Object(allocation)).Should(SatisfyAll(
+			HaveField("Labels", SatisfyAll(
+				HaveKeyWithValue(networkingv1alpha1.PublicIPPoolAllocationLabel, pool.Name),
+				HaveKeyWithValue(networkingv1alpha1.PublicIPPoolClaimerNameLabel, virtualIP.Name),
+			)),
+			HaveField("Spec", ipamv1alpha1.PrefixSpec{
+				IPFamily:     corev1.IPv4Protocol,
+				PrefixLength: 32,
+				ParentSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{networkingv1alpha1.PublicIPPoolPrefixLabel: pool.Name},
+				},
+			}),
+		))
+
+		By("allocating the allocation")
+		Eventually(Update(allocation, func() {
+			allocation.Spec.Prefix = commonv1alpha1.MustParseNewIPPrefix("203.0.113.10/32")
+		})).Should(Succeed())
+		Eventually(UpdateStatus(allocation, func() {
+			allocation.Status.Phase = ipamv1alpha1.PrefixPhaseAllocated
+		})).Should(Succeed())
+
+		By("waiting for the virtual IP to report the allocated IP")
+		Eventually(Object(virtualIP)).Should(HaveField("Status.IP", Equal(commonv1alpha1.MustParseNewIP("203.0.113.10"))))
+
+		By("waiting for the pool to report the used IP")
+		Eventually(Object(pool)).Should(HaveField("Status.Used", int32(1)))
+
+		By("deleting the virtual IP")
+		Expect(k8sClient.Delete(ctx, virtualIP)).To(Succeed())
+
+		By("waiting for the allocation to be released")
+		Eventually(Get(allocation)).Should(Satisfy(apierrors.IsNotFound))
+	})
+
+	It("should allocate one IP per ip family for a dual-stack public load balancer", func(ctx SpecContext) {
+		By("creating a public ip pool per ip family")
+		var pools []*networkingv1alpha1.PublicIPPool
+		for _, poolSpec := range []networkingv1alpha1.PublicIPPoolSpec{
+			{
+				IPFamily: corev1.IPv4Protocol,
+				Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("198.51.100.0/24")},
+			},
+			{
+				IPFamily: corev1.IPv6Protocol,
+				Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("2001:db8::/64")},
+			},
+		} {
+			pool := &networkingv1alpha1.PublicIPPool{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "pool-",
+				},
+				Spec: poolSpec,
+			}
+			Expect(k8sClient.Create(ctx, pool)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, pool)
+			pools = append(pools, pool)
+		}
+
+		By("creating a network")
+		network := &networkingv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "network-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, network)).To(Succeed())
+
+		By("creating a dual-stack public load balancer referencing the pools")
+		loadBalancer := &networkingv1alpha1.LoadBalancer{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "lb-",
+			},
+			Spec: networkingv1alpha1.LoadBalancerSpec{
+				Type:       networkingv1alpha1.LoadBalancerTypePublic,
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				PublicIPPoolRefs: []corev1.LocalObjectReference{
+					{Name: pools[0].Name},
+					{Name: pools[1].Name},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, loadBalancer)).To(Succeed())
+
+		By("allocating the allocation of each ip family")
+		for i, prefix := range []string{"198.51.100.10/32", "2001:db8::10/128"} {
+			allocation := &ipamv1alpha1.Prefix{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: publicIPPoolNamespace,
+					Name:      networkingv1alpha1.PublicIPPoolAllocationIPAMPrefixName(loadBalancer.UID, i),
+				},
+			}
+			Eventually(Object(allocation)).Should(SatisfyAll(
+				HaveField("Labels", HaveKeyWithValue(networkingv1alpha1.PublicIPPoolAllocationLabel, pools[i].Name)),
+				HaveField("Spec.IPFamily", pools[i].Spec.IPFamily),
+			))
+
+			Eventually(Update(allocation, func() {
+				allocation.Spec.Prefix = commonv1alpha1.MustParseNewIPPrefix(prefix)
+			})).Should(Succeed())
+			Eventually(UpdateStatus(allocation, func() {
+				allocation.Status.Phase = ipamv1alpha1.PrefixPhaseAllocated
+			})).Should(Succeed())
+		}
+
+		By("waiting for the load balancer to report one IP per ip family")
+		Eventually(Object(loadBalancer)).Should(HaveField("Status.IPs", Equal([]commonv1alpha1.IP{
+			commonv1alpha1.MustParseIP("198.51.100.10"),
+			commonv1alpha1.MustParseIP("2001:db8::10"),
+		})))
+	})
+})
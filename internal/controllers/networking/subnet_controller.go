@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	networkingclient "github.com/ironcore-dev/ironcore/internal/client/networking"
+	"github.com/ironcore-dev/ironcore/utils/annotations"
+	klogutils "github.com/ironcore-dev/ironcore/utils/klog"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// SubnetReconciler allocates the prefixes of a Subnet as ipam Prefixes. If the Network of the Subnet
+// specifies prefixes, the Subnet prefixes are allocated from the corresponding network ipam Prefixes,
+// which makes ipam enforce containment and non-overlap of Subnets.
+type SubnetReconciler struct {
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=subnets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=subnets/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=networks,verbs=get;list;watch
+//+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixes,verbs=get;list;watch;create;update;patch;delete
+
+func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	subnet := &networkingv1alpha1.Subnet{}
+	if err := r.Get(ctx, req.NamespacedName, subnet); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	return r.reconcileExists(ctx, log, subnet)
+}
+
+func (r *SubnetReconciler) reconcileExists(ctx context.Context, log logr.Logger, subnet *networkingv1alpha1.Subnet) (ctrl.Result, error) {
+	if !subnet.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcile(ctx, log, subnet)
+}
+
+// networkPrefixIndexContaining returns the index of the network prefix containing the given prefix or -1 if
+// there is none.
+func networkPrefixIndexContaining(network *networkingv1alpha1.Network, prefix commonv1alpha1.IPPrefix) int {
+	for i, networkPrefix := range network.Spec.Prefixes {
+		if networkPrefix.Bits() <= prefix.Bits() && networkPrefix.Contains(prefix.Addr()) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *SubnetReconciler) ephemeralSubnetPrefixByName(
+	log logr.Logger,
+	subnet *networkingv1alpha1.Subnet,
+	network *networkingv1alpha1.Network,
+) (map[string]*ipamv1alpha1.Prefix, bool) {
+	var (
+		res   = make(map[string]*ipamv1alpha1.Prefix)
+		valid = true
+	)
+
+	for i, subnetPrefix := range subnet.Spec.Prefixes {
+		var parentRef *corev1.LocalObjectReference
+		if len(network.Spec.Prefixes) > 0 {
+			idx := networkPrefixIndexContaining(network, subnetPrefix)
+			if idx < 0 {
+				log.V(1).Info("Subnet prefix is not contained in any network prefix", "Prefix", subnetPrefix)
+				valid = false
+				continue
+			}
+
+			parentRef = &corev1.LocalObjectReference{Name: networkingv1alpha1.NetworkPrefixIPAMPrefixName(network.Name, idx)}
+		}
+
+		prefixName := networkingv1alpha1.SubnetPrefixIPAMPrefixName(subnet.Name, i)
+		prefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: subnet.Namespace,
+				Name:      prefixName,
+			},
+			Spec: ipamv1alpha1.PrefixSpec{
+				IPFamily:  subnetPrefix.IP().Family(),
+				Prefix:    commonv1alpha1.PtrToIPPrefix(subnetPrefix),
+				ParentRef: parentRef,
+			},
+		}
+		annotations.SetDefaultEphemeralManagedBy(prefix)
+		_ = ctrl.SetControllerReference(subnet, prefix, r.Scheme())
+		res[prefixName] = prefix
+	}
+
+	return res, valid
+}
+
+func (r *SubnetReconciler) handleExistingPrefix(ctx context.Context, log logr.Logger, subnet *networkingv1alpha1.Subnet, shouldManage bool, prefix *ipamv1alpha1.Prefix) error {
+	if annotations.IsDefaultEphemeralControlledBy(prefix, subnet) {
+		if shouldManage {
+			log.V(1).Info("Ephemeral prefix is present and controlled by subnet")
+			return nil
+		}
+
+		if !prefix.DeletionTimestamp.IsZero() {
+			log.V(1).Info("Undesired ephemeral prefix is already deleting")
+			return nil
+		}
+
+		log.V(1).Info("Deleting undesired ephemeral prefix")
+		if err := r.Delete(ctx, prefix); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("error deleting prefix %s: %w", prefix.Name, err)
+		}
+		return nil
+	}
+
+	if shouldManage {
+		log.V(1).Info("Won't adopt unmanaged prefix")
+	}
+	return nil
+}
+
+func (r *SubnetReconciler) handleCreatePrefix(
+	ctx context.Context,
+	log logr.Logger,
+	subnet *networkingv1alpha1.Subnet,
+	prefix *ipamv1alpha1.Prefix,
+) error {
+	log.V(1).Info("Creating prefix")
+	prefixKey := client.ObjectKeyFromObject(prefix)
+	err := r.Create(ctx, prefix)
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	// Due to a fast resync, we might get an already exists error.
+	// In this case, try to fetch the prefix again and, when successful, treat it as managing
+	// an existing prefix.
+	if err := r.Get(ctx, prefixKey, prefix); err != nil {
+		return fmt.Errorf("error getting prefix %s after already exists: %w", prefixKey.Name, err)
+	}
+
+	// Treat a retrieved prefix as an existing we should manage.
+	log.V(1).Info("Retrieved prefix after already exists conflict")
+	return r.handleExistingPrefix(ctx, log, subnet, true, prefix)
+}
+
+func (r *SubnetReconciler) updateStatus(ctx context.Context, subnet *networkingv1alpha1.Subnet, state networkingv1alpha1.SubnetState) error {
+	if subnet.Status.State == state {
+		return nil
+	}
+
+	base := subnet.DeepCopy()
+	now := metav1.Now()
+	subnet.Status.State = state
+	subnet.Status.LastStateTransitionTime = &now
+	if err := r.Status().Patch(ctx, subnet, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error patching subnet status: %w", err)
+	}
+	return nil
+}
+
+func (r *SubnetReconciler) reconcile(ctx context.Context, log logr.Logger, subnet *networkingv1alpha1.Subnet) (ctrl.Result, error) {
+	log.V(1).Info("Reconcile")
+
+	log.V(1).Info("Getting network")
+	network := &networkingv1alpha1.Network{}
+	networkKey := client.ObjectKey{Namespace: subnet.Namespace, Name: subnet.Spec.NetworkRef.Name}
+	if err := r.Get(ctx, networkKey, network); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting network %s: %w", networkKey.Name, err)
+		}
+
+		log.V(1).Info("Network not found")
+		if err := r.updateStatus(ctx, subnet, networkingv1alpha1.SubnetStatePending); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Listing prefixes")
+	prefixList := &ipamv1alpha1.PrefixList{}
+	if err := r.List(ctx, prefixList,
+		client.InNamespace(subnet.Namespace),
+	); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing prefixes: %w", err)
+	}
+	log.V(5).Info("Listed prefixes", "Prefixes", klogutils.KObjStructSlice(prefixList.Items))
+
+	var (
+		ephemPrefixByName, valid = r.ephemeralSubnetPrefixByName(log, subnet, network)
+		numDesired               = len(ephemPrefixByName)
+		numAllocated             int
+		errs                     []error
+	)
+	for _, prefix := range prefixList.Items {
+		prefixName := prefix.Name
+		_, shouldManage := ephemPrefixByName[prefixName]
+		delete(ephemPrefixByName, prefixName)
+		log := log.WithValues("Prefix", klog.KObj(&prefix), "ShouldManage", shouldManage)
+		if err := r.handleExistingPrefix(ctx, log, subnet, shouldManage, &prefix); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if shouldManage && annotations.IsDefaultEphemeralControlledBy(&prefix, subnet) &&
+			prefix.Status.Phase == ipamv1alpha1.PrefixPhaseAllocated {
+			numAllocated++
+		}
+	}
+
+	for _, prefix := range ephemPrefixByName {
+		log := log.WithValues("Prefix", klog.KObj(prefix))
+		if err := r.handleCreatePrefix(ctx, log, subnet, prefix); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error managing ephemeral prefixes: %w", err)
+	}
+
+	var state networkingv1alpha1.SubnetState
+	switch {
+	case !valid:
+		state = networkingv1alpha1.SubnetStateError
+	case numAllocated == numDesired:
+		state = networkingv1alpha1.SubnetStateAvailable
+	default:
+		state = networkingv1alpha1.SubnetStatePending
+	}
+
+	log.V(1).Info("Updating subnet status", "State", state)
+	if err := r.updateStatus(ctx, subnet, state); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+func (r *SubnetReconciler) subnetNotDeletingPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		subnet := obj.(*networkingv1alpha1.Subnet)
+		return subnet.DeletionTimestamp.IsZero()
+	})
+}
+
+func (r *SubnetReconciler) enqueueByNetwork() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		network := obj.(*networkingv1alpha1.Network)
+		log := ctrl.LoggerFrom(ctx)
+
+		subnetList := &networkingv1alpha1.SubnetList{}
+		if err := r.List(ctx, subnetList,
+			client.InNamespace(network.Namespace),
+			client.MatchingFields{
+				networkingclient.SubnetNetworkNameField: network.Name,
+			},
+		); err != nil {
+			log.Error(err, "Error listing subnets")
+			return nil
+		}
+
+		var reqs []ctrl.Request
+		for _, subnet := range subnetList.Items {
+			if !subnet.DeletionTimestamp.IsZero() {
+				continue
+			}
+
+			reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&subnet)})
+		}
+		return reqs
+	})
+}
+
+func (r *SubnetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("subnet").
+		For(
+			&networkingv1alpha1.Subnet{},
+			builder.WithPredicates(
+				r.subnetNotDeletingPredicate(),
+			),
+		).
+		Owns(&ipamv1alpha1.Prefix{}).
+		Watches(
+			&networkingv1alpha1.Network{},
+			r.enqueueByNetwork(),
+		).
+		Complete(r)
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("SubnetController", func() {
+	ns := SetupNamespace(&k8sClient)
+
+	It("should allocate the subnet prefixes from the network prefixes", func(ctx SpecContext) {
+		By("creating a network with prefixes")
+		network := &networkingv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "network-",
+			},
+			Spec: networkingv1alpha1.NetworkSpec{
+				Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, network)).To(Succeed())
+
+		By("waiting for the network prefix to exist")
+		networkPrefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      networkingv1alpha1.NetworkPrefixIPAMPrefixName(network.Name, 0),
+			},
+		}
+		Eventually(Object(networkPrefix)).Should(SatisfyAll(
+			BeControlledBy(network),
+			HaveField("Spec", ipamv1alpha1.PrefixSpec{
+				IPFamily: corev1.IPv4Protocol,
+				Prefix:   commonv1alpha1.MustParseNewIPPrefix("10.0.0.0/16"),
+			}),
+		))
+
+		By("creating a subnet")
+		subnet := &networkingv1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "subnet-",
+			},
+			Spec: networkingv1alpha1.SubnetSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				Prefixes:   []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.1.0/24")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, subnet)).To(Succeed())
+
+		By("waiting for the subnet prefix to be allocated from the network prefix")
+		subnetPrefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      networkingv1alpha1.SubnetPrefixIPAMPrefixName(subnet.Name, 0),
+			},
+		}
+		Eventually(Object(subnetPrefix)).Should(SatisfyAll(
+			BeControlledBy(subnet),
+			HaveField("Spec", ipamv1alpha1.PrefixSpec{
+				IPFamily:  corev1.IPv4Protocol,
+				Prefix:    commonv1alpha1.MustParseNewIPPrefix("10.0.1.0/24"),
+				ParentRef: &corev1.LocalObjectReference{Name: networkPrefix.Name},
+			}),
+		))
+		By("asserting the subnet is pending")
+		Eventually(Object(subnet)).Should(HaveField("Status.State", networkingv1alpha1.SubnetStatePending))
+
+		By("patching the subnet prefix as allocated")
+		baseSubnetPrefix := subnetPrefix.DeepCopy()
+		subnetPrefix.Status.Phase = ipamv1alpha1.PrefixPhaseAllocated
+		Expect(k8sClient.Status().Patch(ctx, subnetPrefix, client.MergeFrom(baseSubnetPrefix))).To(Succeed())
+
+		By("waiting for the subnet to be available")
+		Eventually(Object(subnet)).Should(HaveField("Status.State", networkingv1alpha1.SubnetStateAvailable))
+	})
+
+	It("should report an error if the subnet prefixes are not contained in the network prefixes", func(ctx SpecContext) {
+		By("creating a network with prefixes")
+		network := &networkingv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "network-",
+			},
+			Spec: networkingv1alpha1.NetworkSpec{
+				Prefixes: []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("10.0.0.0/16")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, network)).To(Succeed())
+
+		By("creating a subnet outside of the network prefixes")
+		subnet := &networkingv1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "subnet-",
+			},
+			Spec: networkingv1alpha1.SubnetSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				Prefixes:   []commonv1alpha1.IPPrefix{commonv1alpha1.MustParseIPPrefix("192.168.0.0/24")},
+			},
+		}
+		Expect(k8sClient.Create(ctx, subnet)).To(Succeed())
+
+		By("waiting for the subnet to report an error")
+		Eventually(Object(subnet)).Should(HaveField("Status.State", networkingv1alpha1.SubnetStateError))
+
+		By("asserting no subnet prefix is created")
+		subnetPrefix := &ipamv1alpha1.Prefix{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      networkingv1alpha1.SubnetPrefixIPAMPrefixName(subnet.Name, 0),
+			},
+		}
+		Consistently(Get(subnetPrefix)).Should(Satisfy(apierrors.IsNotFound))
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"github.com/ironcore-dev/controller-utils/buildutils"
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/admission/plugin/referencevalidation"
 	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
 	ipamclient "github.com/ironcore-dev/ironcore/internal/client/ipam"
 	networkingclient "github.com/ironcore-dev/ironcore/internal/client/networking"
@@ -18,7 +19,10 @@ import (
 	"github.com/ironcore-dev/ironcore/utils/envtest/apiserver"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/lru"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,12 +41,16 @@ const (
 	eventuallyTimeout    = 3 * time.Second
 	consistentlyDuration = 1 * time.Second
 	apiServiceTimeout    = 5 * time.Minute
+
+	publicIPPoolNamespace = "public-ip-pools"
 )
 
 var (
 	k8sClient  client.Client
 	testEnv    *envtest.Environment
 	testEnvExt *utilsenvtest.EnvironmentExtensions
+
+	networkInterfaceEphemeralPrefixRecorder = record.NewFakeRecorder(1024)
 )
 
 func TestAPIs(t *testing.T) {
@@ -88,6 +96,8 @@ var _ = BeforeSuite(func() {
 		Host:         testEnvExt.APIServiceInstallOptions.LocalServingHost,
 		Port:         testEnvExt.APIServiceInstallOptions.LocalServingPort,
 		CertDir:      testEnvExt.APIServiceInstallOptions.LocalServingCertDir,
+		// The controller tests create objects before the objects they reference.
+		Args: apiserver.EmptyProcessArgs().Set("disable-admission-plugins", referencevalidation.PluginName),
 	})
 	Expect(err).NotTo(HaveOccurred())
 
@@ -111,9 +121,9 @@ var _ = BeforeSuite(func() {
 	Expect(computeclient.SetupMachineSpecMachineClassRefNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
 
 	Expect(ipamclient.SetupPrefixSpecIPFamilyFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
-	Expect(ipamclient.SetupPrefixSpecParentRefFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(ipamclient.SetupPrefixSpecParentRefKeyFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
 	Expect(ipamclient.SetupPrefixAllocationSpecIPFamilyFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
-	Expect(ipamclient.SetupPrefixAllocationSpecPrefixRefNameField(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(ipamclient.SetupPrefixAllocationSpecPrefixRefKeyField(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
 
 	Expect(networkingclient.SetupNetworkInterfaceNetworkNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
 	Expect(networkingclient.SetupNetworkInterfaceVirtualIPNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
@@ -122,12 +132,22 @@ var _ = BeforeSuite(func() {
 	Expect(networkingclient.SetupNetworkSpecPeeringClaimRefNamesFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
 	Expect(networkingclient.SetupNetworkInterfacePrefixNamesFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
 	Expect(networkingclient.SetupLoadBalancerPrefixNamesFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(networkingclient.SetupNetworkInterfaceSubnetNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(networkingclient.SetupSubnetNetworkNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(networkingclient.SetupVirtualIPPublicIPPoolNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(networkingclient.SetupLoadBalancerPublicIPPoolNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(networkingclient.SetupNATGatewayPublicIPPoolNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+
+	Expect(k8sClient.Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: publicIPPoolNamespace},
+	})).To(Succeed())
 
 	// Register reconcilers
 	Expect((&VirtualIPReleaseReconciler{
-		Client:       k8sManager.GetClient(),
-		APIReader:    k8sManager.GetAPIReader(),
-		AbsenceCache: lru.New(100),
+		Client:        k8sManager.GetClient(),
+		APIReader:     k8sManager.GetAPIReader(),
+		AbsenceCache:  lru.New(100),
+		PoolNamespace: publicIPPoolNamespace,
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
 	Expect((&LoadBalancerReconciler{
@@ -162,13 +182,42 @@ var _ = BeforeSuite(func() {
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
 	Expect((&NetworkInterfaceEphemeralPrefixReconciler{
-		Client: k8sManager.GetClient(),
+		EventRecorder: networkInterfaceEphemeralPrefixRecorder,
+		Client:        k8sManager.GetClient(),
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
 	Expect((&NetworkInterfaceEphemeralVirtualIPReconciler{
 		Client: k8sManager.GetClient(),
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
+	Expect((&NetworkEphemeralPrefixReconciler{
+		Client: k8sManager.GetClient(),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&SubnetReconciler{
+		Client: k8sManager.GetClient(),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&PublicIPPoolReconciler{
+		Client:        k8sManager.GetClient(),
+		PoolNamespace: publicIPPoolNamespace,
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&VirtualIPPublicIPPoolReconciler{
+		Client:        k8sManager.GetClient(),
+		PoolNamespace: publicIPPoolNamespace,
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&LoadBalancerPublicIPPoolReconciler{
+		Client:        k8sManager.GetClient(),
+		PoolNamespace: publicIPPoolNamespace,
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&NATGatewayPublicIPPoolReconciler{
+		Client:        k8sManager.GetClient(),
+		PoolNamespace: publicIPPoolNamespace,
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
 	go func() {
 		defer GinkgoRecover()
 		Expect(k8sManager.Start(ctx)).To(Succeed())
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	networkingclient "github.com/ironcore-dev/ironcore/internal/client/networking"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// VirtualIPPublicIPPoolReconciler allocates the IP of a VirtualIP referencing a PublicIPPool.
+// The allocation is released by the VirtualIPReleaseReconciler once the VirtualIP is gone.
+type VirtualIPPublicIPPoolReconciler struct {
+	client.Client
+
+	// PoolNamespace is the namespace the ipam Prefixes of all PublicIPPools are managed in.
+	PoolNamespace string
+}
+
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=virtualips,verbs=get;list;watch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=virtualips/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=networking.ironcore.dev,resources=publicippools,verbs=get;list;watch
+//+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixes,verbs=get;list;watch;create;update;patch;delete
+
+func (r *VirtualIPPublicIPPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	virtualIP := &networkingv1alpha1.VirtualIP{}
+	if err := r.Get(ctx, req.NamespacedName, virtualIP); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	return r.reconcileExists(ctx, log, virtualIP)
+}
+
+func (r *VirtualIPPublicIPPoolReconciler) reconcileExists(ctx context.Context, log logr.Logger, virtualIP *networkingv1alpha1.VirtualIP) (ctrl.Result, error) {
+	if !virtualIP.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcile(ctx, log, virtualIP)
+}
+
+func (r *VirtualIPPublicIPPoolReconciler) reconcile(ctx context.Context, log logr.Logger, virtualIP *networkingv1alpha1.VirtualIP) (ctrl.Result, error) {
+	log.V(1).Info("Reconcile")
+
+	publicIPPoolRef := virtualIP.Spec.PublicIPPoolRef
+	if publicIPPoolRef == nil {
+		log.V(1).Info("Virtual IP does not reference a public ip pool, nothing to do")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Applying public ip pool allocation")
+	ip, err := applyPublicIPPoolAllocation(ctx, r.Client, r.PoolNamespace, publicIPPoolClaimerKindVirtualIP, virtualIP, publicIPPoolRef.Name, []corev1.IPFamily{virtualIP.Spec.IPFamily})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if ip == nil {
+		log.V(1).Info("Public ip pool allocation is not ready yet")
+		return ctrl.Result{}, nil
+	}
+
+	if currentIP := virtualIP.Status.IP; currentIP != nil && *currentIP == *ip {
+		log.V(1).Info("Virtual IP status is up-to-date")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Updating virtual IP status", "IP", ip)
+	base := virtualIP.DeepCopy()
+	virtualIP.Status.IP = ip
+	if err := r.Status().Patch(ctx, virtualIP, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error patching virtual IP status: %w", err)
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+func (r *VirtualIPPublicIPPoolReconciler) virtualIPPublicIPPoolPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		virtualIP := obj.(*networkingv1alpha1.VirtualIP)
+		return virtualIP.Spec.PublicIPPoolRef != nil
+	})
+}
+
+func (r *VirtualIPPublicIPPoolReconciler) enqueueByPublicIPPool() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		pool := obj.(*networkingv1alpha1.PublicIPPool)
+		log := ctrl.LoggerFrom(ctx)
+
+		virtualIPList := &networkingv1alpha1.VirtualIPList{}
+		if err := r.List(ctx, virtualIPList,
+			client.MatchingFields{
+				networkingclient.VirtualIPPublicIPPoolNameField: pool.Name,
+			},
+		); err != nil {
+			log.Error(err, "Error listing virtual IPs")
+			return nil
+		}
+
+		var reqs []ctrl.Request
+		for _, virtualIP := range virtualIPList.Items {
+			reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&virtualIP)})
+		}
+		return reqs
+	})
+}
+
+func (r *VirtualIPPublicIPPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("virtualippublicippool").
+		For(
+			&networkingv1alpha1.VirtualIP{},
+			builder.WithPredicates(r.virtualIPPublicIPPoolPredicate()),
+		).
+		Watches(
+			&ipamv1alpha1.Prefix{},
+			enqueueByPublicIPPoolAllocation(publicIPPoolClaimerKindVirtualIP),
+		).
+		Watches(
+			&networkingv1alpha1.PublicIPPool{},
+			r.enqueueByPublicIPPool(),
+		).
+		Complete(r)
+}
@@ -8,9 +8,11 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/lru"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -24,16 +26,28 @@ type VirtualIPReleaseReconciler struct {
 	APIReader client.Reader
 
 	AbsenceCache *lru.Cache
+
+	// PoolNamespace is the namespace the ipam Prefixes of all PublicIPPools are managed in.
+	// If set, public ip pool allocations of virtual IPs that are gone are released.
+	PoolNamespace string
 }
 
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=virtualips,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=networkinterfaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=ipam.ironcore.dev,resources=prefixes,verbs=get;list;watch;delete
 
 func (r *VirtualIPReleaseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	virtualIP := &networkingv1alpha1.VirtualIP{}
 	if err := r.Get(ctx, req.NamespacedName, virtualIP); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting virtual IP %s: %w", req.NamespacedName, err)
+		}
+
+		if err := r.releasePublicIPPoolAllocations(ctx, log, req.NamespacedName, ""); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
 	}
 
 	return r.reconcileExists(ctx, log, virtualIP)
@@ -81,9 +95,22 @@ func (r *VirtualIPReleaseReconciler) releaseVirtualIP(ctx context.Context, virtu
 	return nil
 }
 
+func (r *VirtualIPReleaseReconciler) releasePublicIPPoolAllocations(ctx context.Context, log logr.Logger, virtualIPKey client.ObjectKey, keepUID types.UID) error {
+	if r.PoolNamespace == "" {
+		return nil
+	}
+
+	log.V(1).Info("Releasing public ip pool allocations of absent virtual IPs")
+	return releasePublicIPPoolAllocations(ctx, r.Client, r.PoolNamespace, publicIPPoolClaimerKindVirtualIP, virtualIPKey, keepUID)
+}
+
 func (r *VirtualIPReleaseReconciler) reconcile(ctx context.Context, log logr.Logger, virtualIP *networkingv1alpha1.VirtualIP) (ctrl.Result, error) {
 	log.V(1).Info("Reconcile")
 
+	if err := r.releasePublicIPPoolAllocations(ctx, log, client.ObjectKeyFromObject(virtualIP), virtualIP.UID); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	if virtualIP.Spec.TargetRef == nil {
 		log.V(1).Info("Virtual IP is not claimed, nothing to do")
 		return ctrl.Result{}, nil
@@ -115,7 +142,7 @@ func (r *VirtualIPReleaseReconciler) reconcile(ctx context.Context, log logr.Log
 func (r *VirtualIPReleaseReconciler) virtualIPClaimedPredicate() predicate.Predicate {
 	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
 		virtualIP := obj.(*networkingv1alpha1.VirtualIP)
-		return virtualIP.Spec.TargetRef != nil
+		return virtualIP.Spec.TargetRef != nil || virtualIP.Spec.PublicIPPoolRef != nil
 	})
 }
 
@@ -168,5 +195,9 @@ func (r *VirtualIPReleaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			r.enqueueByNetworkInterface(),
 			builder.WithPredicates(r.networkInterfaceDeletingPredicate()),
 		).
+		Watches(
+			&ipamv1alpha1.Prefix{},
+			enqueueByPublicIPPoolAllocation(publicIPPoolClaimerKindVirtualIP),
+		).
 		Complete(r)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/ironcore-dev/controller-utils/buildutils"
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/admission/plugin/referencevalidation"
 	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
 	storageclient "github.com/ironcore-dev/ironcore/internal/client/storage"
 	"github.com/ironcore-dev/ironcore/internal/controllers/storage/scheduler"
@@ -103,6 +104,8 @@ var _ = BeforeSuite(func() {
 		Host:         testEnvExt.APIServiceInstallOptions.LocalServingHost,
 		Port:         testEnvExt.APIServiceInstallOptions.LocalServingPort,
 		CertDir:      testEnvExt.APIServiceInstallOptions.LocalServingCertDir,
+		// The controller tests create objects before the objects they reference.
+		Args: apiserver.EmptyProcessArgs().Set("disable-admission-plugins", referencevalidation.PluginName),
 	})
 	Expect(err).NotTo(HaveOccurred())
 
@@ -50,16 +50,53 @@ func (m *machineEvaluator) MatchesResourceName(name corev1alpha1.ResourceName) b
 }
 
 func (m *machineEvaluator) MatchesResourceScopeSelectorRequirement(item client.Object, req corev1alpha1.ResourceScopeSelectorRequirement) (bool, error) {
-	machine := item.(*computev1alpha1.Machine)
+	machine, err := toExternalMachineOrError(item)
+	if err != nil {
+		return false, err
+	}
 
 	switch req.ScopeName {
 	case corev1alpha1.ResourceScopeMachineClass:
 		return machineMatchesMachineClassScope(machine, req.Operator, req.Values), nil
+	case corev1alpha1.ResourceScopeMachinePower:
+		return quota.MatchesScopeValue(req.Operator, string(machinePower(machine)), true, req.Values), nil
+	case corev1alpha1.ResourceScopeMachinePool:
+		machinePoolRef := machine.Spec.MachinePoolRef
+		return quota.MatchesScopeValue(req.Operator, machinePoolName(machine), machinePoolRef != nil, req.Values), nil
 	default:
 		return false, nil
 	}
 }
 
+func (m *machineEvaluator) ScopesChanged(oldItem, newItem client.Object) (bool, error) {
+	oldMachine, err := toExternalMachineOrError(oldItem)
+	if err != nil {
+		return false, err
+	}
+	newMachine, err := toExternalMachineOrError(newItem)
+	if err != nil {
+		return false, err
+	}
+
+	return machinePower(oldMachine) != machinePower(newMachine) ||
+		machinePoolName(oldMachine) != machinePoolName(newMachine), nil
+}
+
+// machinePower returns the desired power state of the machine, defaulting to computev1alpha1.PowerOn.
+func machinePower(machine *computev1alpha1.Machine) computev1alpha1.Power {
+	if machine.Spec.Power == "" {
+		return computev1alpha1.PowerOn
+	}
+	return machine.Spec.Power
+}
+
+func machinePoolName(machine *computev1alpha1.Machine) string {
+	if machine.Spec.MachinePoolRef == nil {
+		return ""
+	}
+	return machine.Spec.MachinePoolRef.Name
+}
+
 func machineMatchesMachineClassScope(machine *computev1alpha1.Machine, op corev1alpha1.ResourceScopeSelectorOperator, values []string) bool {
 	machineClassName := machine.Spec.MachineClassRef.Name
 
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"github.com/ironcore-dev/ironcore/utils/quota"
+)
+
+func NewEvaluators() []quota.Evaluator {
+	return []quota.Evaluator{
+		NewPrefixEvaluator(),
+		NewPrefixAllocationEvaluator(),
+	}
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/ipam"
+	internalipamv1alpha1 "github.com/ironcore-dev/ironcore/internal/apis/ipam/v1alpha1"
+	"github.com/ironcore-dev/ironcore/utils/quota"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	prefixResource          = ipamv1alpha1.Resource("prefixes")
+	prefixCountResourceName = corev1alpha1.ObjectCountQuotaResourceNameFor(prefixResource)
+
+	PrefixResourceNames = sets.New(
+		prefixCountResourceName,
+		corev1alpha1.ResourceIPv4Addresses,
+		corev1alpha1.ResourceIPv6Subnets,
+	)
+)
+
+type prefixEvaluator struct{}
+
+func NewPrefixEvaluator() quota.Evaluator {
+	return &prefixEvaluator{}
+}
+
+func (m *prefixEvaluator) Type() client.Object {
+	return &ipamv1alpha1.Prefix{}
+}
+
+func (m *prefixEvaluator) MatchesResourceName(name corev1alpha1.ResourceName) bool {
+	return PrefixResourceNames.Has(name)
+}
+
+func (m *prefixEvaluator) MatchesResourceScopeSelectorRequirement(item client.Object, req corev1alpha1.ResourceScopeSelectorRequirement) (bool, error) {
+	if _, err := toExternalPrefixOrError(item); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+func toExternalPrefixOrError(obj client.Object) (*ipamv1alpha1.Prefix, error) {
+	switch t := obj.(type) {
+	case *ipamv1alpha1.Prefix:
+		return t, nil
+	case *ipam.Prefix:
+		prefix := &ipamv1alpha1.Prefix{}
+		if err := internalipamv1alpha1.Convert_ipam_Prefix_To_v1alpha1_Prefix(t, prefix, nil); err != nil {
+			return nil, err
+		}
+		return prefix, nil
+	default:
+		return nil, fmt.Errorf("expect *ipam.Prefix or *ipamv1alpha1.Prefix but got %v", t)
+	}
+}
+
+// prefixBits returns the ip family and the length of the address space of the prefix.
+// If the prefix has not been allocated yet, the requested prefix length is used.
+func prefixBits(prefix *ipamv1alpha1.Prefix) (corev1.IPFamily, int, bool) {
+	if p := prefix.Spec.Prefix; p.IsValid() {
+		return p.IP().Family(), p.Bits(), true
+	}
+	if prefix.Spec.PrefixLength > 0 {
+		return prefix.Spec.IPFamily, int(prefix.Spec.PrefixLength), true
+	}
+	return "", 0, false
+}
+
+// powerOfTwo returns 2^exp, saturating at math.MaxInt64.
+func powerOfTwo(exp int) int64 {
+	if exp >= 63 {
+		return math.MaxInt64
+	}
+	return int64(1) << exp
+}
+
+func (m *prefixEvaluator) Usage(ctx context.Context, item client.Object) (corev1alpha1.ResourceList, error) {
+	prefix, err := toExternalPrefixOrError(item)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := corev1alpha1.ResourceList{
+		prefixCountResourceName: resource.MustParse("1"),
+	}
+
+	// Only root prefixes are charged for their address space. Child prefixes consume the space of their
+	// parent via a PrefixAllocation that is charged if it crosses namespaces.
+	if prefix.Spec.ParentRef != nil || prefix.Spec.ParentSelector != nil {
+		return usage, nil
+	}
+
+	ipFamily, bits, ok := prefixBits(prefix)
+	if !ok {
+		return usage, nil
+	}
+
+	addPrefixUsage(usage, ipFamily, bits)
+	return usage, nil
+}
+
+// addPrefixUsage adds the address space of a prefix with the given ip family and bits to the usage.
+func addPrefixUsage(usage corev1alpha1.ResourceList, ipFamily corev1.IPFamily, bits int) {
+	switch ipFamily {
+	case corev1.IPv4Protocol:
+		usage[corev1alpha1.ResourceIPv4Addresses] = *resource.NewQuantity(powerOfTwo(32-bits), resource.DecimalSI)
+	case corev1.IPv6Protocol:
+		usage[corev1alpha1.ResourceIPv6Subnets] = *resource.NewQuantity(powerOfTwo(max(64-bits, 0)), resource.DecimalSI)
+	}
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/ipam"
+	internalipamv1alpha1 "github.com/ironcore-dev/ironcore/internal/apis/ipam/v1alpha1"
+	utilsipam "github.com/ironcore-dev/ironcore/utils/ipam"
+	"github.com/ironcore-dev/ironcore/utils/quota"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	PrefixAllocationResourceNames = sets.New(
+		corev1alpha1.ResourceIPv4Addresses,
+		corev1alpha1.ResourceIPv6Subnets,
+	)
+)
+
+type prefixAllocationEvaluator struct{}
+
+// NewPrefixAllocationEvaluator creates an evaluator charging the namespace of a PrefixAllocation for the
+// address space it allocates from a prefix in another namespace. Allocations from a prefix of the same namespace
+// are not charged, as the address space of that prefix is already accounted for.
+func NewPrefixAllocationEvaluator() quota.Evaluator {
+	return &prefixAllocationEvaluator{}
+}
+
+func (m *prefixAllocationEvaluator) Type() client.Object {
+	return &ipamv1alpha1.PrefixAllocation{}
+}
+
+func (m *prefixAllocationEvaluator) MatchesResourceName(name corev1alpha1.ResourceName) bool {
+	return PrefixAllocationResourceNames.Has(name)
+}
+
+func (m *prefixAllocationEvaluator) MatchesResourceScopeSelectorRequirement(item client.Object, req corev1alpha1.ResourceScopeSelectorRequirement) (bool, error) {
+	if _, err := toExternalPrefixAllocationOrError(item); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+func toExternalPrefixAllocationOrError(obj client.Object) (*ipamv1alpha1.PrefixAllocation, error) {
+	switch t := obj.(type) {
+	case *ipamv1alpha1.PrefixAllocation:
+		return t, nil
+	case *ipam.PrefixAllocation:
+		allocation := &ipamv1alpha1.PrefixAllocation{}
+		if err := internalipamv1alpha1.Convert_ipam_PrefixAllocation_To_v1alpha1_PrefixAllocation(t, allocation, nil); err != nil {
+			return nil, err
+		}
+		return allocation, nil
+	default:
+		return nil, fmt.Errorf("expect *ipam.PrefixAllocation or *ipamv1alpha1.PrefixAllocation but got %v", t)
+	}
+}
+
+// ipv6SubnetsOfRange returns the number of /64 subnets the given range touches.
+func ipv6SubnetsOfRange(from, to []byte) int64 {
+	fromSubnet := new(big.Int).SetBytes(from[:8])
+	subnets := new(big.Int).SetBytes(to[:8])
+	subnets.Sub(subnets, fromSubnet)
+	subnets.Add(subnets, big.NewInt(1))
+	if !subnets.IsInt64() {
+		return math.MaxInt64
+	}
+	return subnets.Int64()
+}
+
+// ipv6SubnetsOfAddresses returns the number of /64 subnets required to hold the given number of addresses.
+func ipv6SubnetsOfAddresses(addresses int64) int64 {
+	subnetSize := new(big.Int).Lsh(big.NewInt(1), 64)
+	subnets := new(big.Int).SetInt64(addresses)
+	subnets.Add(subnets, subnetSize)
+	subnets.Sub(subnets, big.NewInt(1))
+	subnets.Div(subnets, subnetSize)
+	return subnets.Int64()
+}
+
+func (m *prefixAllocationEvaluator) Usage(ctx context.Context, item client.Object) (corev1alpha1.ResourceList, error) {
+	allocation, err := toExternalPrefixAllocationOrError(item)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := corev1alpha1.ResourceList{}
+	if prefixNamespace := allocation.Spec.PrefixNamespace; prefixNamespace == "" || prefixNamespace == allocation.Namespace {
+		return usage, nil
+	}
+
+	spec := &allocation.Spec
+	switch {
+	case spec.Prefix.IsValid():
+		addPrefixUsage(usage, spec.Prefix.IP().Family(), spec.Prefix.Bits())
+	case spec.PrefixLength > 0:
+		addPrefixUsage(usage, spec.IPFamily, int(spec.PrefixLength))
+	case spec.Range.IsValid():
+		rng := spec.Range.Range()
+		if rng.From().Is4() {
+			usage[corev1alpha1.ResourceIPv4Addresses] = *resource.NewQuantity(utilsipam.IPRangeSize(rng).Int64(), resource.DecimalSI)
+		} else {
+			usage[corev1alpha1.ResourceIPv6Subnets] = *resource.NewQuantity(ipv6SubnetsOfRange(rng.From().AsSlice(), rng.To().AsSlice()), resource.DecimalSI)
+		}
+	case spec.RangeLength > 0 || spec.IPCount > 0:
+		addresses := int64(max(spec.RangeLength, spec.IPCount))
+		switch spec.IPFamily {
+		case corev1.IPv4Protocol:
+			usage[corev1alpha1.ResourceIPv4Addresses] = *resource.NewQuantity(addresses, resource.DecimalSI)
+		case corev1.IPv6Protocol:
+			usage[corev1alpha1.ResourceIPv6Subnets] = *resource.NewQuantity(ipv6SubnetsOfAddresses(addresses), resource.DecimalSI)
+		}
+	}
+	return usage, nil
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/ironcore-dev/ironcore/client-go/ironcore"
 	"github.com/ironcore-dev/ironcore/internal/quota/evaluator/compute"
 	"github.com/ironcore-dev/ironcore/internal/quota/evaluator/generic"
+	"github.com/ironcore-dev/ironcore/internal/quota/evaluator/ipam"
+	"github.com/ironcore-dev/ironcore/internal/quota/evaluator/networking"
 	"github.com/ironcore-dev/ironcore/internal/quota/evaluator/storage"
 	"github.com/ironcore-dev/ironcore/utils/quota"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -22,6 +24,8 @@ func NewEvaluators(
 
 	evaluators = append(evaluators, compute.NewEvaluators(machineClassCapabilities)...)
 	evaluators = append(evaluators, storage.NewEvaluators(volumeClassCapabilities, bucketClassCapabilities)...)
+	evaluators = append(evaluators, networking.NewEvaluators()...)
+	evaluators = append(evaluators, ipam.NewEvaluators()...)
 
 	return evaluators
 }
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+	"fmt"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	internalnetworkingv1alpha1 "github.com/ironcore-dev/ironcore/internal/apis/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/utils/quota"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	loadBalancerResource          = networkingv1alpha1.Resource("loadbalancers")
+	loadBalancerCountResourceName = corev1alpha1.ObjectCountQuotaResourceNameFor(loadBalancerResource)
+
+	LoadBalancerResourceNames = sets.New(
+		loadBalancerCountResourceName,
+		corev1alpha1.ResourcePublicIPs,
+	)
+)
+
+type loadBalancerEvaluator struct{}
+
+func NewLoadBalancerEvaluator() quota.Evaluator {
+	return &loadBalancerEvaluator{}
+}
+
+func (m *loadBalancerEvaluator) Type() client.Object {
+	return &networkingv1alpha1.LoadBalancer{}
+}
+
+func (m *loadBalancerEvaluator) MatchesResourceName(name corev1alpha1.ResourceName) bool {
+	return LoadBalancerResourceNames.Has(name)
+}
+
+func (m *loadBalancerEvaluator) MatchesResourceScopeSelectorRequirement(item client.Object, req corev1alpha1.ResourceScopeSelectorRequirement) (bool, error) {
+	loadBalancer, err := toExternalLoadBalancerOrError(item)
+	if err != nil {
+		return false, err
+	}
+
+	switch req.ScopeName {
+	case corev1alpha1.ResourceScopePublicIPPool:
+		return matchesPublicIPPoolRefsScope(loadBalancer.Spec.PublicIPPoolRefs, req.Operator, req.Values), nil
+	default:
+		return false, nil
+	}
+}
+
+func toExternalLoadBalancerOrError(obj client.Object) (*networkingv1alpha1.LoadBalancer, error) {
+	switch t := obj.(type) {
+	case *networkingv1alpha1.LoadBalancer:
+		return t, nil
+	case *networking.LoadBalancer:
+		loadBalancer := &networkingv1alpha1.LoadBalancer{}
+		if err := internalnetworkingv1alpha1.Convert_networking_LoadBalancer_To_v1alpha1_LoadBalancer(t, loadBalancer, nil); err != nil {
+			return nil, err
+		}
+		return loadBalancer, nil
+	default:
+		return nil, fmt.Errorf("expect *networking.LoadBalancer or *networkingv1alpha1.LoadBalancer but got %v", t)
+	}
+}
+
+func (m *loadBalancerEvaluator) Usage(ctx context.Context, item client.Object) (corev1alpha1.ResourceList, error) {
+	loadBalancer, err := toExternalLoadBalancerOrError(item)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := corev1alpha1.ResourceList{
+		loadBalancerCountResourceName: resource.MustParse("1"),
+	}
+	if n := len(loadBalancer.Spec.PublicIPPoolRefs); n > 0 {
+		usage[corev1alpha1.ResourcePublicIPs] = *resource.NewQuantity(int64(n), resource.DecimalSI)
+	}
+	return usage, nil
+}
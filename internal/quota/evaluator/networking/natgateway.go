@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+	"fmt"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	internalnetworkingv1alpha1 "github.com/ironcore-dev/ironcore/internal/apis/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/utils/quota"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	natGatewayResource          = networkingv1alpha1.Resource("natgateways")
+	natGatewayCountResourceName = corev1alpha1.ObjectCountQuotaResourceNameFor(natGatewayResource)
+
+	NATGatewayResourceNames = sets.New(
+		natGatewayCountResourceName,
+		corev1alpha1.ResourcePublicIPs,
+	)
+)
+
+type natGatewayEvaluator struct{}
+
+func NewNATGatewayEvaluator() quota.Evaluator {
+	return &natGatewayEvaluator{}
+}
+
+func (m *natGatewayEvaluator) Type() client.Object {
+	return &networkingv1alpha1.NATGateway{}
+}
+
+func (m *natGatewayEvaluator) MatchesResourceName(name corev1alpha1.ResourceName) bool {
+	return NATGatewayResourceNames.Has(name)
+}
+
+func (m *natGatewayEvaluator) MatchesResourceScopeSelectorRequirement(item client.Object, req corev1alpha1.ResourceScopeSelectorRequirement) (bool, error) {
+	natGateway, err := toExternalNATGatewayOrError(item)
+	if err != nil {
+		return false, err
+	}
+
+	switch req.ScopeName {
+	case corev1alpha1.ResourceScopePublicIPPool:
+		return matchesPublicIPPoolScope(natGateway.Spec.PublicIPPoolRef, req.Operator, req.Values), nil
+	default:
+		return false, nil
+	}
+}
+
+func toExternalNATGatewayOrError(obj client.Object) (*networkingv1alpha1.NATGateway, error) {
+	switch t := obj.(type) {
+	case *networkingv1alpha1.NATGateway:
+		return t, nil
+	case *networking.NATGateway:
+		natGateway := &networkingv1alpha1.NATGateway{}
+		if err := internalnetworkingv1alpha1.Convert_networking_NATGateway_To_v1alpha1_NATGateway(t, natGateway, nil); err != nil {
+			return nil, err
+		}
+		return natGateway, nil
+	default:
+		return nil, fmt.Errorf("expect *networking.NATGateway or *networkingv1alpha1.NATGateway but got %v", t)
+	}
+}
+
+func (m *natGatewayEvaluator) Usage(ctx context.Context, item client.Object) (corev1alpha1.ResourceList, error) {
+	natGateway, err := toExternalNATGatewayOrError(item)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := corev1alpha1.ResourceList{
+		natGatewayCountResourceName: resource.MustParse("1"),
+	}
+	if natGateway.Spec.PublicIPPoolRef != nil {
+		usage[corev1alpha1.ResourcePublicIPs] = resource.MustParse("1")
+	}
+	return usage, nil
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore/utils/quota"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func NewEvaluators() []quota.Evaluator {
+	return []quota.Evaluator{
+		NewVirtualIPEvaluator(),
+		NewLoadBalancerEvaluator(),
+		NewNATGatewayEvaluator(),
+	}
+}
+
+func matchesPublicIPPoolScope(publicIPPoolRef *corev1.LocalObjectReference, op corev1alpha1.ResourceScopeSelectorOperator, values []string) bool {
+	switch op {
+	case corev1alpha1.ResourceScopeSelectorOperatorExists:
+		return publicIPPoolRef != nil
+	case corev1alpha1.ResourceScopeSelectorOperatorDoesNotExist:
+		return publicIPPoolRef == nil
+	case corev1alpha1.ResourceScopeSelectorOperatorIn:
+		if publicIPPoolRef == nil {
+			return false
+		}
+		return slices.Contains(values, publicIPPoolRef.Name)
+	case corev1alpha1.ResourceScopeSelectorOperatorNotIn:
+		if publicIPPoolRef == nil {
+			return false
+		}
+		return !slices.Contains(values, publicIPPoolRef.Name)
+	default:
+		return false
+	}
+}
+
+// matchesPublicIPPoolRefsScope is like matchesPublicIPPoolScope for objects referencing a PublicIPPool per ip family.
+// The object is In if any of its PublicIPPools is in the values and NotIn if none of them is.
+func matchesPublicIPPoolRefsScope(publicIPPoolRefs []corev1.LocalObjectReference, op corev1alpha1.ResourceScopeSelectorOperator, values []string) bool {
+	if len(publicIPPoolRefs) == 0 {
+		return matchesPublicIPPoolScope(nil, op, values)
+	}
+	if op == corev1alpha1.ResourceScopeSelectorOperatorNotIn {
+		return !matchesPublicIPPoolRefsScope(publicIPPoolRefs, corev1alpha1.ResourceScopeSelectorOperatorIn, values)
+	}
+	return slices.ContainsFunc(publicIPPoolRefs, func(publicIPPoolRef corev1.LocalObjectReference) bool {
+		return matchesPublicIPPoolScope(&publicIPPoolRef, op, values)
+	})
+}
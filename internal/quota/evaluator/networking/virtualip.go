@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networking
+
+import (
+	"context"
+	"fmt"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	internalnetworkingv1alpha1 "github.com/ironcore-dev/ironcore/internal/apis/networking/v1alpha1"
+	"github.com/ironcore-dev/ironcore/utils/quota"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	virtualIPResource          = networkingv1alpha1.Resource("virtualips")
+	virtualIPCountResourceName = corev1alpha1.ObjectCountQuotaResourceNameFor(virtualIPResource)
+
+	VirtualIPResourceNames = sets.New(
+		virtualIPCountResourceName,
+		corev1alpha1.ResourcePublicIPs,
+	)
+)
+
+type virtualIPEvaluator struct{}
+
+func NewVirtualIPEvaluator() quota.Evaluator {
+	return &virtualIPEvaluator{}
+}
+
+func (m *virtualIPEvaluator) Type() client.Object {
+	return &networkingv1alpha1.VirtualIP{}
+}
+
+func (m *virtualIPEvaluator) MatchesResourceName(name corev1alpha1.ResourceName) bool {
+	return VirtualIPResourceNames.Has(name)
+}
+
+func (m *virtualIPEvaluator) MatchesResourceScopeSelectorRequirement(item client.Object, req corev1alpha1.ResourceScopeSelectorRequirement) (bool, error) {
+	virtualIP, err := toExternalVirtualIPOrError(item)
+	if err != nil {
+		return false, err
+	}
+
+	switch req.ScopeName {
+	case corev1alpha1.ResourceScopePublicIPPool:
+		return matchesPublicIPPoolScope(virtualIP.Spec.PublicIPPoolRef, req.Operator, req.Values), nil
+	default:
+		return false, nil
+	}
+}
+
+func toExternalVirtualIPOrError(obj client.Object) (*networkingv1alpha1.VirtualIP, error) {
+	switch t := obj.(type) {
+	case *networkingv1alpha1.VirtualIP:
+		return t, nil
+	case *networking.VirtualIP:
+		virtualIP := &networkingv1alpha1.VirtualIP{}
+		if err := internalnetworkingv1alpha1.Convert_networking_VirtualIP_To_v1alpha1_VirtualIP(t, virtualIP, nil); err != nil {
+			return nil, err
+		}
+		return virtualIP, nil
+	default:
+		return nil, fmt.Errorf("expect *networking.VirtualIP or *networkingv1alpha1.VirtualIP but got %v", t)
+	}
+}
+
+func (m *virtualIPEvaluator) Usage(ctx context.Context, item client.Object) (corev1alpha1.ResourceList, error) {
+	virtualIP, err := toExternalVirtualIPOrError(item)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := corev1alpha1.ResourceList{
+		virtualIPCountResourceName: resource.MustParse("1"),
+	}
+	if virtualIP.Spec.PublicIPPoolRef != nil {
+		usage[corev1alpha1.ResourcePublicIPs] = resource.MustParse("1")
+	}
+	return usage, nil
+}
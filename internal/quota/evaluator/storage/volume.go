@@ -48,16 +48,56 @@ func (m *volumeEvaluator) MatchesResourceName(name corev1alpha1.ResourceName) bo
 }
 
 func (m *volumeEvaluator) MatchesResourceScopeSelectorRequirement(item client.Object, req corev1alpha1.ResourceScopeSelectorRequirement) (bool, error) {
-	volume := item.(*storagev1alpha1.Volume)
+	volume, err := toExternalVolumeOrError(item)
+	if err != nil {
+		return false, err
+	}
 
 	switch req.ScopeName {
 	case corev1alpha1.ResourceScopeVolumeClass:
 		return volumeMatchesVolumeClassScope(volume, req.Operator, req.Values), nil
+	case corev1alpha1.ResourceScopeVolumePool:
+		return quota.MatchesScopeValue(req.Operator, volumePoolName(volume), volume.Spec.VolumePoolRef != nil, req.Values), nil
+	case corev1alpha1.ResourceScopeVolumeEncryption:
+		return volumeMatchesVolumeEncryptionScope(volume, req.Operator), nil
 	default:
 		return false, nil
 	}
 }
 
+func (m *volumeEvaluator) ScopesChanged(oldItem, newItem client.Object) (bool, error) {
+	oldVolume, err := toExternalVolumeOrError(oldItem)
+	if err != nil {
+		return false, err
+	}
+	newVolume, err := toExternalVolumeOrError(newItem)
+	if err != nil {
+		return false, err
+	}
+
+	return volumePoolName(oldVolume) != volumePoolName(newVolume), nil
+}
+
+func volumePoolName(volume *storagev1alpha1.Volume) string {
+	if volume.Spec.VolumePoolRef == nil {
+		return ""
+	}
+	return volume.Spec.VolumePoolRef.Name
+}
+
+func volumeMatchesVolumeEncryptionScope(volume *storagev1alpha1.Volume, op corev1alpha1.ResourceScopeSelectorOperator) bool {
+	encrypted := volume.Spec.Encryption != nil
+
+	switch op {
+	case corev1alpha1.ResourceScopeSelectorOperatorExists:
+		return encrypted
+	case corev1alpha1.ResourceScopeSelectorOperatorDoesNotExist:
+		return !encrypted
+	default:
+		return false
+	}
+}
+
 func volumeMatchesVolumeClassScope(volume *storagev1alpha1.Volume, op corev1alpha1.ResourceScopeSelectorOperator, values []string) bool {
 	volumeClassRef := volume.Spec.VolumeClassRef
 
@@ -67,6 +107,9 @@ func volumeMatchesVolumeClassScope(volume *storagev1alpha1.Volume, op corev1alph
 	case corev1alpha1.ResourceScopeSelectorOperatorDoesNotExist:
 		return volumeClassRef == nil
 	case corev1alpha1.ResourceScopeSelectorOperatorIn:
+		if volumeClassRef == nil {
+			return false
+		}
 		return slices.Contains(values, volumeClassRef.Name)
 	case corev1alpha1.ResourceScopeSelectorOperatorNotIn:
 		if volumeClassRef == nil {
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/core"
+	"github.com/ironcore-dev/ironcore/internal/registry/core/resourcelimit"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+)
+
+type ResourceLimitStorage struct {
+	ResourceLimit *REST
+}
+
+type REST struct {
+	*genericregistry.Store
+}
+
+func NewStorage(optsGetter generic.RESTOptionsGetter) (ResourceLimitStorage, error) {
+	store := &genericregistry.Store{
+		NewFunc: func() runtime.Object {
+			return &core.ResourceLimit{}
+		},
+		NewListFunc: func() runtime.Object {
+			return &core.ResourceLimitList{}
+		},
+		PredicateFunc:             resourcelimit.MatchResourceLimit,
+		DefaultQualifiedResource:  core.Resource("resourcelimits"),
+		SingularQualifiedResource: core.Resource("resourcelimit"),
+
+		CreateStrategy: resourcelimit.Strategy,
+		UpdateStrategy: resourcelimit.Strategy,
+		DeleteStrategy: resourcelimit.Strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: resourcelimit.GetAttrs}
+	if err := store.CompleteWithOptions(options); err != nil {
+		return ResourceLimitStorage{}, err
+	}
+
+	return ResourceLimitStorage{
+		ResourceLimit: &REST{store},
+	}, nil
+}
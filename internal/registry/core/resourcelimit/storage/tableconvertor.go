@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/core"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type convertor struct{}
+
+var (
+	objectMetaSwaggerDoc = metav1.ObjectMeta{}.SwaggerDoc()
+
+	headers = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
+		{Name: "Limits", Type: "string", Description: "Types of objects that are limited"},
+		{Name: "Allowed Classes", Type: "string", Description: "Class types that are restricted"},
+		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
+	}
+)
+
+func newTableConvertor() *convertor {
+	return &convertor{}
+}
+
+func formatLimitTypes(limits []core.ResourceLimitItem) string {
+	types := make([]string, 0, len(limits))
+	for _, limit := range limits {
+		types = append(types, string(limit.Type))
+	}
+	return strings.Join(types, ",")
+}
+
+func formatClassTypes(allowedClasses []core.AllowedClasses) string {
+	classTypes := make([]string, 0, len(allowedClasses))
+	for _, allowed := range allowedClasses {
+		classTypes = append(classTypes, string(allowed.ClassType))
+	}
+	return strings.Join(classTypes, ",")
+}
+
+func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tab := &metav1.Table{
+		ColumnDefinitions: headers,
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		tab.ResourceVersion = m.GetResourceVersion()
+		tab.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			tab.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	tab.Rows, err = table.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) (cells []interface{}, err error) {
+		resourceLimit := obj.(*core.ResourceLimit)
+
+		cells = append(cells, name)
+		cells = append(cells, formatLimitTypes(resourceLimit.Spec.Limits))
+		cells = append(cells, formatClassTypes(resourceLimit.Spec.AllowedClasses))
+		cells = append(cells, age)
+
+		return cells, nil
+	})
+	return tab, err
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcelimit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/core"
+	"github.com/ironcore-dev/ironcore/internal/apis/core/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	resourceLimit, ok := obj.(*core.ResourceLimit)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a ResourceLimit")
+	}
+	return resourceLimit.Labels, SelectableFields(resourceLimit), nil
+}
+
+func MatchResourceLimit(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(resourceLimit *core.ResourceLimit) fields.Set {
+	return generic.ObjectMetaFieldsSet(&resourceLimit.ObjectMeta, true)
+}
+
+type resourceLimitStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = resourceLimitStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (resourceLimitStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (resourceLimitStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+}
+
+func (resourceLimitStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+}
+
+func (resourceLimitStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	resourceLimit := obj.(*core.ResourceLimit)
+	return validation.ValidateResourceLimit(resourceLimit)
+}
+
+func (resourceLimitStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (resourceLimitStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (resourceLimitStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (resourceLimitStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (resourceLimitStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newResourceLimit := obj.(*core.ResourceLimit)
+	oldResourceLimit := old.(*core.ResourceLimit)
+	return validation.ValidateResourceLimitUpdate(newResourceLimit, oldResourceLimit)
+}
+
+func (resourceLimitStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	"github.com/ironcore-dev/ironcore/internal/api"
 	"github.com/ironcore-dev/ironcore/internal/apis/core"
+	resourcelimitstorage "github.com/ironcore-dev/ironcore/internal/registry/core/resourcelimit/storage"
 	resourcequotastorage "github.com/ironcore-dev/ironcore/internal/registry/core/resourcequota/storage"
 	ironcoreserializer "github.com/ironcore-dev/ironcore/internal/serializer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -48,5 +49,12 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storageMap["resourcequotas"] = resourceQuotaStorage.ResourceQuota
 	storageMap["resourcequotas/status"] = resourceQuotaStorage.Status
 
+	resourceLimitStorage, err := resourcelimitstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["resourcelimits"] = resourceLimitStorage.ResourceLimit
+
 	return storageMap, nil
 }
@@ -6,6 +6,7 @@ package storage
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/ironcore-dev/ironcore/internal/apis/ipam"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -22,9 +23,9 @@ var (
 	headers = []metav1.TableColumnDefinition{
 		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
 		{Name: "Prefix", Type: "string", Description: "The targeted prefix"},
-		{Name: "Request", Type: "string", Description: "Requested prefix / prefix length"},
+		{Name: "Request", Type: "string", Description: "Requested prefix / prefix length / range / range length / ip count"},
 		{Name: "State", Type: "string", Description: "The allocation of the prefix"},
-		{Name: "Result", Type: "string", Description: "The resulted prefix, if any"},
+		{Name: "Result", Type: "string", Description: "The resulted prefix, range or ips, if any"},
 		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
 	}
 )
@@ -40,16 +41,33 @@ func prefixAllocationRequest(prefixAllocation *ipam.PrefixAllocation) string {
 		return spec.Prefix.String()
 	case spec.PrefixLength > 0:
 		return fmt.Sprintf("/%d", spec.PrefixLength)
+	case spec.Range.IsValid():
+		return spec.Range.String()
+	case spec.RangeLength > 0:
+		return fmt.Sprintf("range of %d", spec.RangeLength)
+	case spec.IPCount > 0:
+		return fmt.Sprintf("%d ips", spec.IPCount)
 	default:
 		return ""
 	}
 }
 
 func prefixAllocationResult(prefixAllocation *ipam.PrefixAllocation) string {
-	if prefix := prefixAllocation.Status.Prefix; prefix.IsValid() {
-		return prefix.String()
+	status := prefixAllocation.Status
+	switch {
+	case status.Prefix.IsValid():
+		return status.Prefix.String()
+	case status.Range.IsValid():
+		return status.Range.String()
+	case len(status.IPs) > 0:
+		ips := make([]string, 0, len(status.IPs))
+		for _, ip := range status.IPs {
+			ips = append(ips, ip.String())
+		}
+		return strings.Join(ips, ",")
+	default:
+		return ""
 	}
-	return ""
 }
 
 func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
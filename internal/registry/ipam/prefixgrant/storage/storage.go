@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/ipam"
+	"github.com/ironcore-dev/ironcore/internal/registry/ipam/prefixgrant"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+)
+
+type PrefixGrantStorage struct {
+	PrefixGrant *REST
+}
+
+type REST struct {
+	*genericregistry.Store
+}
+
+func NewStorage(optsGetter generic.RESTOptionsGetter) (PrefixGrantStorage, error) {
+	store := &genericregistry.Store{
+		NewFunc: func() runtime.Object {
+			return &ipam.PrefixGrant{}
+		},
+		NewListFunc: func() runtime.Object {
+			return &ipam.PrefixGrantList{}
+		},
+		PredicateFunc:             prefixgrant.MatchPrefixGrant,
+		DefaultQualifiedResource:  ipam.Resource("prefixgrants"),
+		SingularQualifiedResource: ipam.Resource("prefixgrant"),
+
+		CreateStrategy: prefixgrant.Strategy,
+		UpdateStrategy: prefixgrant.Strategy,
+		DeleteStrategy: prefixgrant.Strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: prefixgrant.GetAttrs}
+	if err := store.CompleteWithOptions(options); err != nil {
+		return PrefixGrantStorage{}, err
+	}
+
+	return PrefixGrantStorage{
+		PrefixGrant: &REST{store},
+	}, nil
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package prefixgrant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/ipam"
+	"github.com/ironcore-dev/ironcore/internal/apis/ipam/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	prefixGrant, ok := obj.(*ipam.PrefixGrant)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a PrefixGrant")
+	}
+	return prefixGrant.Labels, SelectableFields(prefixGrant), nil
+}
+
+func MatchPrefixGrant(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(prefixGrant *ipam.PrefixGrant) fields.Set {
+	return generic.ObjectMetaFieldsSet(&prefixGrant.ObjectMeta, true)
+}
+
+type prefixGrantStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = prefixGrantStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (prefixGrantStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (prefixGrantStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+}
+
+func (prefixGrantStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+}
+
+func (prefixGrantStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	prefixGrant := obj.(*ipam.PrefixGrant)
+	return validation.ValidatePrefixGrant(prefixGrant)
+}
+
+func (prefixGrantStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (prefixGrantStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (prefixGrantStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (prefixGrantStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (prefixGrantStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newPrefixGrant := obj.(*ipam.PrefixGrant)
+	oldPrefixGrant := old.(*ipam.PrefixGrant)
+	return validation.ValidatePrefixGrantUpdate(newPrefixGrant, oldPrefixGrant)
+}
+
+func (prefixGrantStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
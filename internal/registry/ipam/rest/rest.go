@@ -9,6 +9,7 @@ import (
 	"github.com/ironcore-dev/ironcore/internal/apis/ipam"
 	prefixstorage "github.com/ironcore-dev/ironcore/internal/registry/ipam/prefix/storage"
 	prefixallocationstorage "github.com/ironcore-dev/ironcore/internal/registry/ipam/prefixallocation/storage"
+	prefixgrantstorage "github.com/ironcore-dev/ironcore/internal/registry/ipam/prefixgrant/storage"
 	ironcoreserializer "github.com/ironcore-dev/ironcore/internal/serializer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/registry/generic"
@@ -57,5 +58,12 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storageMap["prefixallocations"] = prefixAllocationStorage.PrefixAllocation
 	storageMap["prefixallocations/status"] = prefixAllocationStorage.Status
 
+	prefixGrantStorage, err := prefixgrantstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["prefixgrants"] = prefixGrantStorage.PrefixGrant
+
 	return storageMap, nil
 }
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type convertor struct{}
+
+var (
+	objectMetaSwaggerDoc = metav1.ObjectMeta{}.SwaggerDoc()
+
+	headers = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
+		{Name: "IPFamily", Type: "string", Description: "The ip family of the public ip pool"},
+		{Name: "Prefixes", Type: "string", Description: "The prefixes of the public ip pool"},
+		{Name: "Used", Type: "integer", Description: "The number of ips allocated from the public ip pool"},
+		{Name: "State", Type: "string", Description: "The state of the public ip pool"},
+		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
+	}
+)
+
+func newTableConvertor() *convertor {
+	return &convertor{}
+}
+
+func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tab := &metav1.Table{
+		ColumnDefinitions: headers,
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		tab.ResourceVersion = m.GetResourceVersion()
+		tab.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			tab.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	tab.Rows, err = table.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) (cells []interface{}, err error) {
+		publicIPPool := obj.(*networking.PublicIPPool)
+
+		cells = append(cells, name)
+		cells = append(cells, publicIPPool.Spec.IPFamily)
+
+		prefixes := make([]string, len(publicIPPool.Spec.Prefixes))
+		for i, prefix := range publicIPPool.Spec.Prefixes {
+			prefixes[i] = prefix.String()
+		}
+		cells = append(cells, strings.Join(prefixes, ","))
+		cells = append(cells, publicIPPool.Status.Used)
+
+		switch state := publicIPPool.Status.State; state {
+		case "":
+			cells = append(cells, "<unknown>")
+		default:
+			cells = append(cells, state)
+		}
+
+		cells = append(cells, age)
+
+		return cells, nil
+	})
+	return tab, err
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package publicippool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking/validation"
+	"github.com/ironcore-dev/ironcore/utils/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	publicIPPool, ok := obj.(*networking.PublicIPPool)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a PublicIPPool")
+	}
+	return publicIPPool.Labels, SelectableFields(publicIPPool), nil
+}
+
+func MatchPublicIPPool(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(publicIPPool *networking.PublicIPPool) fields.Set {
+	return generic.ObjectMetaFieldsSet(&publicIPPool.ObjectMeta, false)
+}
+
+type publicIPPoolStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = publicIPPoolStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (publicIPPoolStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (publicIPPoolStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	publicIPPool := obj.(*networking.PublicIPPool)
+	publicIPPool.Status = networking.PublicIPPoolStatus{}
+	publicIPPool.Generation = 1
+}
+
+func (publicIPPoolStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newPublicIPPool, oldPublicIPPool := obj.(*networking.PublicIPPool), old.(*networking.PublicIPPool)
+	newPublicIPPool.Status = oldPublicIPPool.Status
+
+	if !equality.Semantic.DeepEqual(newPublicIPPool.Spec, oldPublicIPPool.Spec) {
+		newPublicIPPool.Generation = oldPublicIPPool.Generation + 1
+	}
+}
+
+func (publicIPPoolStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	publicIPPool := obj.(*networking.PublicIPPool)
+	return validation.ValidatePublicIPPool(publicIPPool)
+}
+
+func (publicIPPoolStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (publicIPPoolStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (publicIPPoolStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (publicIPPoolStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (publicIPPoolStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newPublicIPPool, oldPublicIPPool := obj.(*networking.PublicIPPool), old.(*networking.PublicIPPool)
+	return validation.ValidatePublicIPPoolUpdate(newPublicIPPool, oldPublicIPPool)
+}
+
+func (publicIPPoolStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type publicIPPoolStatusStrategy struct {
+	publicIPPoolStrategy
+}
+
+var StatusStrategy = publicIPPoolStatusStrategy{Strategy}
+
+func (publicIPPoolStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"networking.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (publicIPPoolStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newPublicIPPool, oldPublicIPPool := obj.(*networking.PublicIPPool), old.(*networking.PublicIPPool)
+	newPublicIPPool.Spec = oldPublicIPPool.Spec
+}
+
+func (publicIPPoolStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newPublicIPPool := obj.(*networking.PublicIPPool)
+	oldPublicIPPool := old.(*networking.PublicIPPool)
+	return validation.ValidatePublicIPPoolUpdate(newPublicIPPool, oldPublicIPPool)
+}
+
+func (publicIPPoolStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
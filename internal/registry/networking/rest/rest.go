@@ -13,6 +13,9 @@ import (
 	networkstorage "github.com/ironcore-dev/ironcore/internal/registry/networking/network/storage"
 	networkinterfacestorage "github.com/ironcore-dev/ironcore/internal/registry/networking/networkinterface/storage"
 	networkpolicystorage "github.com/ironcore-dev/ironcore/internal/registry/networking/networkpolicy/storage"
+	publicippoolstorage "github.com/ironcore-dev/ironcore/internal/registry/networking/publicippool/storage"
+	routetablestorage "github.com/ironcore-dev/ironcore/internal/registry/networking/routetable/storage"
+	subnetstorage "github.com/ironcore-dev/ironcore/internal/registry/networking/subnet/storage"
 	virtualipstorage "github.com/ironcore-dev/ironcore/internal/registry/networking/virtualip/storage"
 	ironcoreserializer "github.com/ironcore-dev/ironcore/internal/serializer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -103,5 +106,29 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storageMap["natgateways"] = natGatewayStorage.NATGateway
 	storageMap["natgateways/status"] = natGatewayStorage.Status
 
+	subnetStorage, err := subnetstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["subnets"] = subnetStorage.Subnet
+	storageMap["subnets/status"] = subnetStorage.Status
+
+	routeTableStorage, err := routetablestorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["routetables"] = routeTableStorage.RouteTable
+	storageMap["routetables/status"] = routeTableStorage.Status
+
+	publicIPPoolStorage, err := publicippoolstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["publicippools"] = publicIPPoolStorage.PublicIPPool
+	storageMap["publicippools/status"] = publicIPPoolStorage.Status
+
 	return storageMap, nil
 }
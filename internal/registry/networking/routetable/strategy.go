@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package routetable
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking/validation"
+	"github.com/ironcore-dev/ironcore/utils/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	routeTable, ok := obj.(*networking.RouteTable)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a RouteTable")
+	}
+	return routeTable.Labels, SelectableFields(routeTable), nil
+}
+
+func MatchRouteTable(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(routeTable *networking.RouteTable) fields.Set {
+	return generic.ObjectMetaFieldsSet(&routeTable.ObjectMeta, true)
+}
+
+type routeTableStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = routeTableStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (routeTableStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (routeTableStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	routeTable := obj.(*networking.RouteTable)
+	routeTable.Status = networking.RouteTableStatus{}
+	routeTable.Generation = 1
+}
+
+func (routeTableStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newRouteTable, oldRouteTable := obj.(*networking.RouteTable), old.(*networking.RouteTable)
+	newRouteTable.Status = oldRouteTable.Status
+
+	if !equality.Semantic.DeepEqual(newRouteTable.Spec, oldRouteTable.Spec) {
+		newRouteTable.Generation = oldRouteTable.Generation + 1
+	}
+}
+
+func (routeTableStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	routeTable := obj.(*networking.RouteTable)
+	return validation.ValidateRouteTable(routeTable)
+}
+
+func (routeTableStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (routeTableStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (routeTableStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (routeTableStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (routeTableStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newRouteTable, oldRouteTable := obj.(*networking.RouteTable), old.(*networking.RouteTable)
+	return validation.ValidateRouteTableUpdate(newRouteTable, oldRouteTable)
+}
+
+func (routeTableStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type routeTableStatusStrategy struct {
+	routeTableStrategy
+}
+
+var StatusStrategy = routeTableStatusStrategy{Strategy}
+
+func (routeTableStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"networking.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (routeTableStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newRouteTable, oldRouteTable := obj.(*networking.RouteTable), old.(*networking.RouteTable)
+	newRouteTable.Spec = oldRouteTable.Spec
+}
+
+func (routeTableStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newRouteTable := obj.(*networking.RouteTable)
+	oldRouteTable := old.(*networking.RouteTable)
+	return validation.ValidateRouteTableUpdate(newRouteTable, oldRouteTable)
+}
+
+func (routeTableStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
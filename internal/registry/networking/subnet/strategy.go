@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package subnet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking"
+	"github.com/ironcore-dev/ironcore/internal/apis/networking/validation"
+	"github.com/ironcore-dev/ironcore/utils/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	subnet, ok := obj.(*networking.Subnet)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a Subnet")
+	}
+	return subnet.Labels, SelectableFields(subnet), nil
+}
+
+func MatchSubnet(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(subnet *networking.Subnet) fields.Set {
+	return generic.ObjectMetaFieldsSet(&subnet.ObjectMeta, true)
+}
+
+type subnetStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = subnetStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (subnetStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (subnetStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	subnet := obj.(*networking.Subnet)
+	subnet.Status = networking.SubnetStatus{}
+	subnet.Generation = 1
+}
+
+func (subnetStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newSubnet, oldSubnet := obj.(*networking.Subnet), old.(*networking.Subnet)
+	newSubnet.Status = oldSubnet.Status
+
+	if !equality.Semantic.DeepEqual(newSubnet.Spec, oldSubnet.Spec) {
+		newSubnet.Generation = oldSubnet.Generation + 1
+	}
+}
+
+func (subnetStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	subnet := obj.(*networking.Subnet)
+	return validation.ValidateSubnet(subnet)
+}
+
+func (subnetStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (subnetStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (subnetStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (subnetStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (subnetStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newSubnet, oldSubnet := obj.(*networking.Subnet), old.(*networking.Subnet)
+	return validation.ValidateSubnetUpdate(newSubnet, oldSubnet)
+}
+
+func (subnetStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type subnetStatusStrategy struct {
+	subnetStrategy
+}
+
+var StatusStrategy = subnetStatusStrategy{Strategy}
+
+func (subnetStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"networking.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (subnetStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newSubnet, oldSubnet := obj.(*networking.Subnet), old.(*networking.Subnet)
+	newSubnet.Spec = oldSubnet.Spec
+}
+
+func (subnetStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newSubnet := obj.(*networking.Subnet)
+	oldSubnet := old.(*networking.Subnet)
+	return validation.ValidateSubnetUpdate(newSubnet, oldSubnet)
+}
+
+func (subnetStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
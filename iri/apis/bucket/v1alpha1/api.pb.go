@@ -60,6 +60,116 @@ func (BucketState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
 }
 
+type VersionRequest struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionRequest) Reset()      { *m = VersionRequest{} }
+func (*VersionRequest) ProtoMessage() {}
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+func (m *VersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionRequest.Merge(m, src)
+}
+func (m *VersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionRequest proto.InternalMessageInfo
+
+func (m *VersionRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type VersionResponse struct {
+	// Name of the bucket runtime.
+	RuntimeName string `protobuf:"bytes,1,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	// Version of the bucket runtime. The string must be
+	// semver-compatible.
+	RuntimeVersion string `protobuf:"bytes,2,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
+	// Capabilities are the optional capabilities supported by the bucket runtime.
+	Capabilities         []string `protobuf:"bytes,3,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionResponse) Reset()      { *m = VersionResponse{} }
+func (*VersionResponse) ProtoMessage() {}
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionResponse.Merge(m, src)
+}
+func (m *VersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionResponse proto.InternalMessageInfo
+
+func (m *VersionResponse) GetRuntimeName() string {
+	if m != nil {
+		return m.RuntimeName
+	}
+	return ""
+}
+
+func (m *VersionResponse) GetRuntimeVersion() string {
+	if m != nil {
+		return m.RuntimeVersion
+	}
+	return ""
+}
+
+func (m *VersionResponse) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 type BucketFilter struct {
 	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	LabelSelector        map[string]string `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -70,7 +180,7 @@ type BucketFilter struct {
 func (m *BucketFilter) Reset()      { *m = BucketFilter{} }
 func (*BucketFilter) ProtoMessage() {}
 func (*BucketFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
 }
 func (m *BucketFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,7 +232,7 @@ type BucketSpec struct {
 func (m *BucketSpec) Reset()      { *m = BucketSpec{} }
 func (*BucketSpec) ProtoMessage() {}
 func (*BucketSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
 }
 func (m *BucketSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,7 +278,7 @@ type BucketStatus struct {
 func (m *BucketStatus) Reset()      { *m = BucketStatus{} }
 func (*BucketStatus) ProtoMessage() {}
 func (*BucketStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
 }
 func (m *BucketStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,7 +332,7 @@ type Bucket struct {
 func (m *Bucket) Reset()      { *m = Bucket{} }
 func (*Bucket) ProtoMessage() {}
 func (*Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 func (m *Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +392,7 @@ type BucketClassCapabilities struct {
 func (m *BucketClassCapabilities) Reset()      { *m = BucketClassCapabilities{} }
 func (*BucketClassCapabilities) ProtoMessage() {}
 func (*BucketClassCapabilities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 func (m *BucketClassCapabilities) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -335,7 +445,7 @@ type BucketClass struct {
 func (m *BucketClass) Reset()      { *m = BucketClass{} }
 func (*BucketClass) ProtoMessage() {}
 func (*BucketClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 func (m *BucketClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +498,7 @@ type BucketAccess struct {
 func (m *BucketAccess) Reset()      { *m = BucketAccess{} }
 func (*BucketAccess) ProtoMessage() {}
 func (*BucketAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 func (m *BucketAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,7 +550,7 @@ type ListBucketsRequest struct {
 func (m *ListBucketsRequest) Reset()      { *m = ListBucketsRequest{} }
 func (*ListBucketsRequest) ProtoMessage() {}
 func (*ListBucketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 func (m *ListBucketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,7 +595,7 @@ type ListBucketsResponse struct {
 func (m *ListBucketsResponse) Reset()      { *m = ListBucketsResponse{} }
 func (*ListBucketsResponse) ProtoMessage() {}
 func (*ListBucketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 func (m *ListBucketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,6 +631,123 @@ func (m *ListBucketsResponse) GetBuckets() []*Bucket {
 	return nil
 }
 
+type WatchBucketsRequest struct {
+	Filter *BucketFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resource_version is the resource version to resume watching from.
+	// If empty, the watch starts with an added event for every existing bucket followed by a bookmark.
+	// If the resource version is no longer available, the watch fails with code OUT_OF_RANGE.
+	ResourceVersion      string   `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchBucketsRequest) Reset()      { *m = WatchBucketsRequest{} }
+func (*WatchBucketsRequest) ProtoMessage() {}
+func (*WatchBucketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+}
+func (m *WatchBucketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchBucketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchBucketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchBucketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBucketsRequest.Merge(m, src)
+}
+func (m *WatchBucketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchBucketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBucketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBucketsRequest proto.InternalMessageInfo
+
+func (m *WatchBucketsRequest) GetFilter() *BucketFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *WatchBucketsRequest) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+type WatchBucketsResponse struct {
+	Type                 v1alpha1.WatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=meta.v1alpha1.WatchEventType" json:"type,omitempty"`
+	Bucket               *Bucket                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	ResourceVersion      string                  `protobuf:"bytes,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *WatchBucketsResponse) Reset()      { *m = WatchBucketsResponse{} }
+func (*WatchBucketsResponse) ProtoMessage() {}
+func (*WatchBucketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+}
+func (m *WatchBucketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchBucketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchBucketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchBucketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBucketsResponse.Merge(m, src)
+}
+func (m *WatchBucketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchBucketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBucketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBucketsResponse proto.InternalMessageInfo
+
+func (m *WatchBucketsResponse) GetType() v1alpha1.WatchEventType {
+	if m != nil {
+		return m.Type
+	}
+	return v1alpha1.WatchEventType_WATCH_EVENT_ADDED
+}
+
+func (m *WatchBucketsResponse) GetBucket() *Bucket {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *WatchBucketsResponse) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
 type CreateBucketRequest struct {
 	Bucket               *Bucket  `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -530,7 +757,7 @@ type CreateBucketRequest struct {
 func (m *CreateBucketRequest) Reset()      { *m = CreateBucketRequest{} }
 func (*CreateBucketRequest) ProtoMessage() {}
 func (*CreateBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
 func (m *CreateBucketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +802,7 @@ type CreateBucketResponse struct {
 func (m *CreateBucketResponse) Reset()      { *m = CreateBucketResponse{} }
 func (*CreateBucketResponse) ProtoMessage() {}
 func (*CreateBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 func (m *CreateBucketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +847,7 @@ type DeleteBucketRequest struct {
 func (m *DeleteBucketRequest) Reset()      { *m = DeleteBucketRequest{} }
 func (*DeleteBucketRequest) ProtoMessage() {}
 func (*DeleteBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
 func (m *DeleteBucketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,7 +891,7 @@ type DeleteBucketResponse struct {
 func (m *DeleteBucketResponse) Reset()      { *m = DeleteBucketResponse{} }
 func (*DeleteBucketResponse) ProtoMessage() {}
 func (*DeleteBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 func (m *DeleteBucketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,7 +928,7 @@ type ListBucketClassesRequest struct {
 func (m *ListBucketClassesRequest) Reset()      { *m = ListBucketClassesRequest{} }
 func (*ListBucketClassesRequest) ProtoMessage() {}
 func (*ListBucketClassesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
 func (m *ListBucketClassesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +966,7 @@ type ListBucketClassesResponse struct {
 func (m *ListBucketClassesResponse) Reset()      { *m = ListBucketClassesResponse{} }
 func (*ListBucketClassesResponse) ProtoMessage() {}
 func (*ListBucketClassesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 func (m *ListBucketClassesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -777,6 +1004,8 @@ func (m *ListBucketClassesResponse) GetBucketClasses() []*BucketClass {
 
 func init() {
 	proto.RegisterEnum("bucket.v1alpha1.BucketState", BucketState_name, BucketState_value)
+	proto.RegisterType((*VersionRequest)(nil), "bucket.v1alpha1.VersionRequest")
+	proto.RegisterType((*VersionResponse)(nil), "bucket.v1alpha1.VersionResponse")
 	proto.RegisterType((*BucketFilter)(nil), "bucket.v1alpha1.BucketFilter")
 	proto.RegisterMapType((map[string]string)(nil), "bucket.v1alpha1.BucketFilter.LabelSelectorEntry")
 	proto.RegisterType((*BucketSpec)(nil), "bucket.v1alpha1.BucketSpec")
@@ -788,6 +1017,8 @@ func init() {
 	proto.RegisterMapType((map[string][]byte)(nil), "bucket.v1alpha1.BucketAccess.SecretDataEntry")
 	proto.RegisterType((*ListBucketsRequest)(nil), "bucket.v1alpha1.ListBucketsRequest")
 	proto.RegisterType((*ListBucketsResponse)(nil), "bucket.v1alpha1.ListBucketsResponse")
+	proto.RegisterType((*WatchBucketsRequest)(nil), "bucket.v1alpha1.WatchBucketsRequest")
+	proto.RegisterType((*WatchBucketsResponse)(nil), "bucket.v1alpha1.WatchBucketsResponse")
 	proto.RegisterType((*CreateBucketRequest)(nil), "bucket.v1alpha1.CreateBucketRequest")
 	proto.RegisterType((*CreateBucketResponse)(nil), "bucket.v1alpha1.CreateBucketResponse")
 	proto.RegisterType((*DeleteBucketRequest)(nil), "bucket.v1alpha1.DeleteBucketRequest")
@@ -799,59 +1030,69 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 822 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdf, 0x4e, 0x3b, 0x45,
-	0x14, 0xee, 0xb6, 0xbf, 0x5f, 0x85, 0xd3, 0xd2, 0x5f, 0x1d, 0x1a, 0xa9, 0x0b, 0x36, 0x64, 0xd5,
-	0x04, 0x49, 0xe8, 0x4a, 0x8d, 0x89, 0x98, 0x18, 0x6d, 0x4b, 0xc1, 0x86, 0x0a, 0x66, 0xaa, 0x92,
-	0x90, 0x98, 0x3a, 0xbb, 0x1d, 0x60, 0x64, 0xdb, 0x5d, 0x77, 0xa6, 0x24, 0xdc, 0xf9, 0x08, 0xde,
-	0xf9, 0x10, 0x3e, 0x81, 0x6f, 0xc0, 0xa5, 0x97, 0x5e, 0x4a, 0x7d, 0x11, 0xb3, 0x33, 0xd3, 0x65,
-	0x4b, 0xff, 0x80, 0x77, 0x33, 0x67, 0xbe, 0xf3, 0x9d, 0x6f, 0xce, 0xf9, 0x76, 0x07, 0x56, 0x49,
-	0xc0, 0xaa, 0x41, 0xe8, 0x0b, 0x1f, 0xbd, 0x71, 0x46, 0xee, 0x0d, 0x15, 0xd5, 0xdb, 0x7d, 0xe2,
-	0x05, 0xd7, 0x64, 0xdf, 0xdc, 0xbb, 0x62, 0xe2, 0x7a, 0xe4, 0x54, 0x5d, 0x7f, 0x60, 0x5f, 0xf9,
-	0x57, 0xbe, 0x2d, 0x71, 0xce, 0xe8, 0x52, 0xee, 0xe4, 0x46, 0xae, 0x54, 0xbe, 0x59, 0x4f, 0xc0,
-	0x59, 0xe8, 0x0f, 0x5d, 0x3f, 0xa4, 0x7b, 0x7d, 0x7a, 0x1b, 0x6f, 0x6c, 0x16, 0x32, 0x9b, 0x04,
-	0x8c, 0xdb, 0x03, 0x2a, 0x88, 0x3d, 0xa9, 0x63, 0xc7, 0x12, 0xac, 0x3f, 0x0d, 0xc8, 0x37, 0xa4,
-	0x8a, 0x23, 0xe6, 0x09, 0x1a, 0xa2, 0x02, 0xa4, 0x59, 0xbf, 0x6c, 0x6c, 0x1b, 0x3b, 0xab, 0x38,
-	0xcd, 0xfa, 0xe8, 0x1c, 0x0a, 0x1e, 0x71, 0xa8, 0xd7, 0xe3, 0xd4, 0xa3, 0xae, 0xf0, 0xc3, 0x72,
-	0x7a, 0x3b, 0xb3, 0x93, 0xab, 0x7d, 0x5c, 0x7d, 0x22, 0xbe, 0x9a, 0xa4, 0xa9, 0x76, 0xa2, 0x9c,
-	0xae, 0x4e, 0x69, 0x0d, 0x45, 0x78, 0x87, 0xd7, 0xbc, 0x64, 0xcc, 0xfc, 0x0a, 0xd0, 0x2c, 0x08,
-	0x15, 0x21, 0x73, 0x43, 0xef, 0x74, 0xfd, 0x68, 0x89, 0x4a, 0xf0, 0xfa, 0x96, 0x78, 0x23, 0x5a,
-	0x4e, 0xcb, 0x98, 0xda, 0x7c, 0x9e, 0xfe, 0xcc, 0xb0, 0x2c, 0x00, 0x55, 0xb3, 0x1b, 0x50, 0x37,
-	0xc2, 0xb9, 0x1e, 0xe1, 0x7c, 0x82, 0x93, 0x1b, 0xeb, 0x6e, 0x72, 0xbd, 0xae, 0x20, 0x62, 0xc4,
-	0x51, 0x0d, 0x5e, 0x73, 0x41, 0x04, 0x95, 0x15, 0x0a, 0xb5, 0xad, 0x05, 0xb7, 0x88, 0xd0, 0x14,
-	0x2b, 0x28, 0xfa, 0x14, 0xb2, 0xc4, 0x75, 0xa9, 0xa6, 0xce, 0xd5, 0xde, 0x5b, 0x90, 0x54, 0x97,
-	0x20, 0xac, 0xc1, 0xd6, 0x1f, 0x06, 0x64, 0xd5, 0x01, 0x3a, 0x80, 0x95, 0x68, 0x00, 0x7d, 0x22,
-	0x88, 0x2c, 0x1c, 0x71, 0x44, 0x81, 0x47, 0x86, 0x33, 0xe7, 0x67, 0xea, 0x8a, 0x6f, 0x34, 0x08,
-	0xc7, 0x70, 0x64, 0xc3, 0x2b, 0x1e, 0x50, 0x57, 0x97, 0xde, 0x5c, 0xa4, 0x37, 0xa0, 0x2e, 0x96,
-	0xc0, 0x48, 0x2d, 0x97, 0x77, 0x2d, 0x67, 0x96, 0xaa, 0x55, 0x0d, 0xc1, 0x1a, 0x6c, 0x7d, 0x09,
-	0x1b, 0x2a, 0xde, 0x8c, 0xfa, 0xd6, 0x24, 0x01, 0x71, 0x98, 0xc7, 0x04, 0xa3, 0x3c, 0x9a, 0x89,
-	0x08, 0xb8, 0x14, 0x9e, 0xc1, 0xd1, 0x12, 0x21, 0x78, 0xc5, 0xfc, 0x40, 0xf5, 0x23, 0x83, 0xe5,
-	0xda, 0xf2, 0x21, 0x97, 0x20, 0x88, 0x20, 0x43, 0x32, 0xa0, 0x7a, 0x92, 0x72, 0x8d, 0x3a, 0x90,
-	0x77, 0x13, 0xc4, 0xfa, 0x4e, 0x3b, 0x0b, 0x04, 0xce, 0x08, 0xc1, 0x53, 0xd9, 0x09, 0xeb, 0xaa,
-	0xc6, 0x23, 0x13, 0x56, 0xe8, 0xb0, 0x1f, 0xf8, 0x6c, 0x28, 0x74, 0xd9, 0x78, 0x8f, 0x4e, 0x21,
-	0xc7, 0xa9, 0x1b, 0x52, 0xd1, 0x93, 0x43, 0x50, 0x1e, 0xde, 0x5b, 0x3a, 0xc8, 0x6a, 0x57, 0x26,
-	0x1c, 0x12, 0x41, 0x94, 0x81, 0x81, 0xc7, 0x01, 0xf3, 0x0b, 0x78, 0xf3, 0xe4, 0xf8, 0x39, 0xeb,
-	0xe6, 0x93, 0xd6, 0x3d, 0x01, 0xd4, 0x61, 0x5c, 0xa8, 0x72, 0x1c, 0xd3, 0x5f, 0x46, 0x94, 0x8b,
-	0x68, 0x74, 0x97, 0xf2, 0xf3, 0x89, 0x4d, 0xb2, 0xec, 0x1b, 0xc3, 0x1a, 0x6c, 0x7d, 0x0d, 0xeb,
-	0x53, 0x64, 0x3c, 0xf0, 0x87, 0x9c, 0xa2, 0x7d, 0x78, 0x4b, 0xa5, 0x47, 0xa3, 0x8b, 0xae, 0xbb,
-	0xb1, 0x80, 0x0e, 0x4f, 0x70, 0xd6, 0x11, 0xac, 0x37, 0x43, 0x4a, 0x04, 0xd5, 0x07, 0x5a, 0x97,
-	0x0d, 0x59, 0x85, 0xd0, 0xba, 0x16, 0x12, 0x69, 0x98, 0x75, 0x0c, 0xa5, 0x69, 0x1e, 0x2d, 0xe9,
-	0x7f, 0x13, 0xd5, 0x60, 0xfd, 0x90, 0x7a, 0xf4, 0xa9, 0xa0, 0x4d, 0x58, 0x55, 0x80, 0x5e, 0xfc,
-	0xaf, 0x5a, 0x51, 0x81, 0x76, 0xdf, 0x7a, 0x07, 0x4a, 0xd3, 0x39, 0xaa, 0xb8, 0x65, 0x42, 0xf9,
-	0xb1, 0x4d, 0xd2, 0x5c, 0x74, 0xd2, 0x79, 0xeb, 0x27, 0x78, 0x77, 0xce, 0x99, 0x56, 0xdd, 0x84,
-	0x82, 0xae, 0xe6, 0xaa, 0x13, 0xdd, 0xcf, 0xad, 0x65, 0xc6, 0xc5, 0x6b, 0x4e, 0x92, 0x6c, 0xb7,
-	0x3d, 0xf9, 0x3c, 0xe4, 0xaf, 0x05, 0x21, 0x28, 0x34, 0xbe, 0x6f, 0x9e, 0xb4, 0xbe, 0xeb, 0x7d,
-	0xdb, 0x3a, 0x3d, 0x6c, 0x9f, 0x1e, 0x17, 0x53, 0xa8, 0x04, 0x45, 0x1d, 0xab, 0xff, 0x50, 0x6f,
-	0x77, 0xea, 0x8d, 0x4e, 0xab, 0x68, 0xa0, 0x22, 0xe4, 0x75, 0xb4, 0x85, 0xf1, 0x19, 0x2e, 0xa6,
-	0x6b, 0xbf, 0x67, 0x60, 0x4d, 0xdf, 0x6d, 0x34, 0x14, 0x6c, 0x40, 0xd1, 0x05, 0xe4, 0x12, 0x0e,
-	0x40, 0xef, 0xcf, 0x08, 0x9b, 0x35, 0x9b, 0xf9, 0xc1, 0x72, 0x90, 0x6e, 0x5a, 0x0a, 0xfd, 0x08,
-	0xf9, 0xe4, 0x2c, 0xd1, 0x6c, 0xde, 0x1c, 0xcb, 0x98, 0x1f, 0x3e, 0x83, 0x4a, 0xd2, 0x27, 0xa7,
-	0x35, 0x87, 0x7e, 0x8e, 0x01, 0xe6, 0xd0, 0xcf, 0x1d, 0x79, 0x0a, 0x79, 0xf0, 0xf6, 0xcc, 0x60,
-	0xd1, 0x47, 0x4b, 0xae, 0x3e, 0x6d, 0x0c, 0x73, 0xf7, 0x25, 0xd0, 0x49, 0xb5, 0xc6, 0xf9, 0xfd,
-	0x43, 0xc5, 0xf8, 0xfb, 0xa1, 0x92, 0xfa, 0x75, 0x5c, 0x31, 0xee, 0xc7, 0x15, 0xe3, 0xaf, 0x71,
-	0xc5, 0xf8, 0x67, 0x5c, 0x31, 0x7e, 0xfb, 0xb7, 0x92, 0xba, 0x38, 0x78, 0xf9, 0x73, 0xad, 0x8a,
-	0xc6, 0x0f, 0xb6, 0x93, 0x95, 0xaf, 0xf5, 0x27, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xdb,
-	0xde, 0xcc, 0x3d, 0x08, 0x00, 0x00,
+	// 991 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0xa9, 0x93, 0x1c, 0x3b, 0xb6, 0x99, 0x58, 0xd4, 0x6c, 0x8b, 0x09, 0x0b, 0x11,
+	0x69, 0xa4, 0xd8, 0x8d, 0x51, 0x25, 0x8a, 0x84, 0xc0, 0x71, 0xdc, 0x12, 0xd5, 0xb8, 0x68, 0x53,
+	0x1a, 0xa9, 0x52, 0x65, 0xc6, 0xeb, 0xd3, 0x64, 0xe9, 0x7a, 0x77, 0xd9, 0x19, 0x1b, 0xf9, 0x0e,
+	0xde, 0x80, 0x97, 0xe0, 0x8a, 0x27, 0xe0, 0x0d, 0x72, 0xc9, 0x25, 0x97, 0x24, 0xbc, 0x08, 0xda,
+	0x99, 0xd9, 0xcd, 0xfa, 0x37, 0x41, 0xe2, 0x6e, 0xe6, 0xcc, 0x77, 0xbe, 0xf3, 0x33, 0xdf, 0x99,
+	0x5d, 0xd8, 0xa0, 0xbe, 0x5d, 0xf5, 0x03, 0x8f, 0x7b, 0xa4, 0xd0, 0x1b, 0x5a, 0x6f, 0x91, 0x57,
+	0x47, 0x07, 0xd4, 0xf1, 0xcf, 0xe9, 0x81, 0xbe, 0x7f, 0x66, 0xf3, 0xf3, 0x61, 0xaf, 0x6a, 0x79,
+	0x83, 0xda, 0x99, 0x77, 0xe6, 0xd5, 0x04, 0xae, 0x37, 0x7c, 0x23, 0x76, 0x62, 0x23, 0x56, 0xd2,
+	0x5f, 0x6f, 0x24, 0xe0, 0x76, 0xe0, 0xb9, 0x96, 0x17, 0xe0, 0x7e, 0x1f, 0x47, 0xf1, 0xa6, 0x66,
+	0x07, 0x76, 0x8d, 0xfa, 0x36, 0xab, 0x0d, 0x90, 0xd3, 0x5a, 0x14, 0xa7, 0x16, 0xa7, 0x60, 0xec,
+	0x41, 0xfe, 0x25, 0x06, 0xcc, 0xf6, 0x5c, 0x13, 0x7f, 0x1c, 0x22, 0xe3, 0xa4, 0x0c, 0x6b, 0x23,
+	0x69, 0x29, 0x6b, 0xdb, 0xda, 0xee, 0x86, 0x19, 0x6d, 0x8d, 0x5f, 0x34, 0x28, 0xc4, 0x60, 0xe6,
+	0x7b, 0x2e, 0x43, 0xf2, 0x21, 0xe4, 0x82, 0xa1, 0xcb, 0xed, 0x01, 0x76, 0x5d, 0x3a, 0x40, 0xe5,
+	0x92, 0x55, 0xb6, 0x0e, 0x1d, 0x20, 0xf9, 0x04, 0x0a, 0x11, 0x24, 0x22, 0x4e, 0x09, 0x54, 0x5e,
+	0x99, 0x15, 0x27, 0x31, 0x20, 0x67, 0x51, 0x9f, 0xf6, 0x6c, 0xc7, 0xe6, 0x36, 0xb2, 0x72, 0x7a,
+	0x3b, 0xbd, 0xbb, 0x61, 0x4e, 0xd8, 0x8c, 0x3f, 0x34, 0xc8, 0x1d, 0x8a, 0xae, 0x3d, 0xb1, 0x1d,
+	0x8e, 0x01, 0xc9, 0x43, 0xca, 0xee, 0xab, 0xb0, 0x29, 0xbb, 0x4f, 0x4e, 0x21, 0xef, 0xd0, 0x1e,
+	0x3a, 0x5d, 0x86, 0x0e, 0x5a, 0xdc, 0x0b, 0xca, 0xa9, 0xed, 0xf4, 0x6e, 0xb6, 0xfe, 0xb0, 0x3a,
+	0xd5, 0xec, 0x6a, 0x92, 0xa6, 0xda, 0x0e, 0x7d, 0x4e, 0x94, 0x4b, 0xcb, 0xe5, 0xc1, 0xd8, 0xdc,
+	0x74, 0x92, 0x36, 0xfd, 0x2b, 0x20, 0xb3, 0x20, 0x52, 0x84, 0xf4, 0x5b, 0x1c, 0xab, 0xf8, 0xe1,
+	0x92, 0x94, 0xe0, 0xce, 0x88, 0x3a, 0x43, 0x54, 0x45, 0xca, 0xcd, 0xe7, 0xa9, 0xcf, 0x34, 0xc3,
+	0x00, 0x90, 0x31, 0x4f, 0x7c, 0xb4, 0x42, 0x9c, 0xe5, 0x50, 0xc6, 0x22, 0x9c, 0xd8, 0x18, 0xe3,
+	0xa8, 0xbc, 0x13, 0x4e, 0xf9, 0x90, 0x91, 0x3a, 0xdc, 0x61, 0x9c, 0x72, 0xd9, 0xd8, 0x7c, 0xfd,
+	0xfe, 0x82, 0x2a, 0x42, 0x34, 0x9a, 0x12, 0x4a, 0x1e, 0x41, 0x86, 0x5a, 0x16, 0x2a, 0xea, 0x6c,
+	0xfd, 0xfd, 0x05, 0x4e, 0x0d, 0x01, 0x32, 0x15, 0xd8, 0xf8, 0x5d, 0x83, 0x8c, 0x3c, 0x20, 0x8f,
+	0x61, 0x3d, 0x14, 0x4c, 0x9f, 0x72, 0x5a, 0xd6, 0x14, 0x47, 0x68, 0xb8, 0x66, 0x78, 0xde, 0xfb,
+	0x01, 0x2d, 0xfe, 0x8d, 0x02, 0x99, 0x31, 0x9c, 0xd4, 0x60, 0x95, 0xf9, 0x68, 0xa9, 0xd0, 0xf7,
+	0x16, 0xe5, 0xeb, 0xa3, 0x65, 0x0a, 0x60, 0x98, 0x2d, 0x13, 0xb5, 0x96, 0xd3, 0x4b, 0xb3, 0x95,
+	0x0d, 0x31, 0x15, 0xd8, 0xf8, 0x12, 0xee, 0x4a, 0x7b, 0x33, 0xec, 0x5b, 0x33, 0xa1, 0x91, 0xf0,
+	0x4e, 0xb8, 0xcf, 0x44, 0xe2, 0x69, 0x33, 0x5c, 0x12, 0x02, 0xab, 0xb6, 0xe7, 0xcb, 0x7e, 0xa4,
+	0x4d, 0xb1, 0x36, 0x3c, 0xc8, 0x26, 0x08, 0x42, 0x48, 0x42, 0xc0, 0x62, 0x4d, 0xda, 0x53, 0x82,
+	0x94, 0x35, 0xed, 0x2e, 0x48, 0x70, 0x26, 0x91, 0x85, 0xd2, 0x95, 0x8d, 0x27, 0x3a, 0xac, 0xa3,
+	0xdb, 0xf7, 0x3d, 0xdb, 0xe5, 0x2a, 0x6c, 0xbc, 0x27, 0x1d, 0xc8, 0x32, 0xb4, 0x02, 0xe4, 0x5d,
+	0x71, 0x09, 0x52, 0xc3, 0xfb, 0x4b, 0x2f, 0xb2, 0x7a, 0x22, 0x1c, 0x8e, 0x28, 0xa7, 0x52, 0xc0,
+	0xc0, 0x62, 0x83, 0xfe, 0x05, 0x14, 0xa6, 0x8e, 0x6f, 0x92, 0x6e, 0x2e, 0x29, 0xdd, 0x67, 0x40,
+	0xda, 0x36, 0xe3, 0x32, 0x1c, 0x8b, 0x9e, 0x8a, 0x47, 0x90, 0x79, 0x23, 0xc6, 0x27, 0x16, 0xc9,
+	0xb2, 0x19, 0x33, 0x15, 0xd8, 0xf8, 0x1a, 0xb6, 0x26, 0xc8, 0xd4, 0x53, 0x72, 0x00, 0x6b, 0xd2,
+	0x3d, 0xbc, 0xba, 0xb0, 0xdc, 0xbb, 0x0b, 0xe8, 0xcc, 0x08, 0x67, 0xfc, 0x04, 0x5b, 0xa7, 0x94,
+	0x5b, 0xe7, 0xff, 0x4b, 0x5e, 0xe4, 0x01, 0x14, 0x03, 0x64, 0xde, 0x30, 0xb0, 0xa6, 0x5f, 0xaa,
+	0x42, 0x64, 0x57, 0x4f, 0x95, 0xf1, 0x9b, 0x06, 0xa5, 0xc9, 0xc8, 0x71, 0x11, 0xab, 0x7c, 0xec,
+	0x47, 0xe3, 0x3a, 0x3d, 0x35, 0xc2, 0xa5, 0x35, 0x42, 0x97, 0xbf, 0x18, 0xfb, 0x68, 0x0a, 0x28,
+	0xa9, 0x41, 0x46, 0xa6, 0xa7, 0xf4, 0xb5, 0xb0, 0x6c, 0x05, 0x9b, 0x9b, 0x67, 0x7a, 0x7e, 0x9e,
+	0x4f, 0x60, 0xab, 0x19, 0x20, 0xe5, 0xa8, 0x28, 0x54, 0x83, 0xae, 0x43, 0x6a, 0xb7, 0x0a, 0x69,
+	0x3c, 0x85, 0xd2, 0x24, 0x8f, 0x2a, 0xf7, 0x3f, 0x13, 0xd5, 0x61, 0xeb, 0x08, 0x1d, 0x9c, 0x4e,
+	0xe8, 0x1e, 0x6c, 0x48, 0x40, 0x37, 0x7e, 0xcc, 0xd7, 0xa5, 0xe1, 0xb8, 0x6f, 0xbc, 0x0b, 0xa5,
+	0x49, 0x1f, 0x19, 0xdc, 0xd0, 0xa1, 0x7c, 0xad, 0x23, 0x31, 0x7d, 0x18, 0x49, 0xc0, 0xf8, 0x1e,
+	0xde, 0x9b, 0x73, 0xa6, 0xb2, 0x6e, 0x42, 0x5e, 0x45, 0xb3, 0xe4, 0x89, 0x12, 0xdc, 0xfd, 0x65,
+	0x93, 0x6d, 0x6e, 0xf6, 0x92, 0x64, 0x7b, 0xc7, 0x90, 0xbd, 0x7e, 0x98, 0x90, 0x10, 0xc8, 0x1f,
+	0x7e, 0xd7, 0x7c, 0xd6, 0x7a, 0xd1, 0xfd, 0xb6, 0xd5, 0x39, 0x3a, 0xee, 0x3c, 0x2d, 0xae, 0x90,
+	0x12, 0x14, 0x95, 0xad, 0xf1, 0xb2, 0x71, 0xdc, 0x6e, 0x1c, 0xb6, 0x5b, 0x45, 0x8d, 0x14, 0x21,
+	0xa7, 0xac, 0x2d, 0xd3, 0x7c, 0x6e, 0x16, 0x53, 0xf5, 0x8b, 0x55, 0xd8, 0x54, 0xb5, 0xc9, 0x2f,
+	0x22, 0xe9, 0xc0, 0x5a, 0xf4, 0x55, 0xfc, 0x60, 0x26, 0xa9, 0xc9, 0x0f, 0xb6, 0xbe, 0xbd, 0x18,
+	0xa0, 0x1a, 0xb5, 0x42, 0x5e, 0x41, 0x36, 0x31, 0x72, 0xe4, 0xa3, 0x19, 0x97, 0xd9, 0xe9, 0xd6,
+	0x3f, 0x5e, 0x0e, 0x8a, 0xb9, 0xbb, 0x90, 0x4b, 0x8e, 0x02, 0x99, 0xf5, 0x9b, 0x33, 0xa3, 0xfa,
+	0xce, 0x0d, 0xa8, 0x88, 0xfe, 0xa1, 0x46, 0x5e, 0x43, 0x2e, 0x29, 0xbe, 0x39, 0x01, 0xe6, 0x68,
+	0x5c, 0xdf, 0xb9, 0x01, 0x15, 0xe7, 0xff, 0x1a, 0x72, 0x49, 0x79, 0xcd, 0xa1, 0x9f, 0xa3, 0x58,
+	0x7d, 0xe7, 0x06, 0x54, 0x4c, 0xef, 0xc0, 0x3b, 0x33, 0x4a, 0x24, 0x0f, 0x96, 0xf4, 0x76, 0x52,
+	0xc9, 0xfa, 0xde, 0x6d, 0xa0, 0x51, 0xb4, 0xc3, 0xd3, 0x8b, 0xcb, 0x8a, 0xf6, 0xd7, 0x65, 0x65,
+	0xe5, 0xe7, 0xab, 0x8a, 0x76, 0x71, 0x55, 0xd1, 0xfe, 0xbc, 0xaa, 0x68, 0x7f, 0x5f, 0x55, 0xb4,
+	0x5f, 0xff, 0xa9, 0xac, 0xbc, 0x7a, 0x7c, 0xfb, 0x1f, 0x46, 0x19, 0x34, 0xfe, 0x65, 0xec, 0x65,
+	0xc4, 0xff, 0xe2, 0xa7, 0xff, 0x0e, 0x00, 0x99, 0x82, 0x92, 0xd9, 0xbf, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -866,7 +1107,9 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type BucketRuntimeClient interface {
+	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
 	ListBuckets(ctx context.Context, in *ListBucketsRequest, opts ...grpc.CallOption) (*ListBucketsResponse, error)
+	WatchBuckets(ctx context.Context, in *WatchBucketsRequest, opts ...grpc.CallOption) (BucketRuntime_WatchBucketsClient, error)
 	CreateBucket(ctx context.Context, in *CreateBucketRequest, opts ...grpc.CallOption) (*CreateBucketResponse, error)
 	DeleteBucket(ctx context.Context, in *DeleteBucketRequest, opts ...grpc.CallOption) (*DeleteBucketResponse, error)
 	ListBucketClasses(ctx context.Context, in *ListBucketClassesRequest, opts ...grpc.CallOption) (*ListBucketClassesResponse, error)
@@ -880,6 +1123,15 @@ func NewBucketRuntimeClient(cc *grpc.ClientConn) BucketRuntimeClient {
 	return &bucketRuntimeClient{cc}
 }
 
+func (c *bucketRuntimeClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/bucket.v1alpha1.BucketRuntime/Version", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bucketRuntimeClient) ListBuckets(ctx context.Context, in *ListBucketsRequest, opts ...grpc.CallOption) (*ListBucketsResponse, error) {
 	out := new(ListBucketsResponse)
 	err := c.cc.Invoke(ctx, "/bucket.v1alpha1.BucketRuntime/ListBuckets", in, out, opts...)
@@ -889,6 +1141,38 @@ func (c *bucketRuntimeClient) ListBuckets(ctx context.Context, in *ListBucketsRe
 	return out, nil
 }
 
+func (c *bucketRuntimeClient) WatchBuckets(ctx context.Context, in *WatchBucketsRequest, opts ...grpc.CallOption) (BucketRuntime_WatchBucketsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BucketRuntime_serviceDesc.Streams[0], "/bucket.v1alpha1.BucketRuntime/WatchBuckets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bucketRuntimeWatchBucketsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BucketRuntime_WatchBucketsClient interface {
+	Recv() (*WatchBucketsResponse, error)
+	grpc.ClientStream
+}
+
+type bucketRuntimeWatchBucketsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bucketRuntimeWatchBucketsClient) Recv() (*WatchBucketsResponse, error) {
+	m := new(WatchBucketsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *bucketRuntimeClient) CreateBucket(ctx context.Context, in *CreateBucketRequest, opts ...grpc.CallOption) (*CreateBucketResponse, error) {
 	out := new(CreateBucketResponse)
 	err := c.cc.Invoke(ctx, "/bucket.v1alpha1.BucketRuntime/CreateBucket", in, out, opts...)
@@ -918,7 +1202,9 @@ func (c *bucketRuntimeClient) ListBucketClasses(ctx context.Context, in *ListBuc
 
 // BucketRuntimeServer is the server API for BucketRuntime service.
 type BucketRuntimeServer interface {
+	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsResponse, error)
+	WatchBuckets(*WatchBucketsRequest, BucketRuntime_WatchBucketsServer) error
 	CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error)
 	DeleteBucket(context.Context, *DeleteBucketRequest) (*DeleteBucketResponse, error)
 	ListBucketClasses(context.Context, *ListBucketClassesRequest) (*ListBucketClassesResponse, error)
@@ -928,9 +1214,15 @@ type BucketRuntimeServer interface {
 type UnimplementedBucketRuntimeServer struct {
 }
 
+func (*UnimplementedBucketRuntimeServer) Version(ctx context.Context, req *VersionRequest) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
 func (*UnimplementedBucketRuntimeServer) ListBuckets(ctx context.Context, req *ListBucketsRequest) (*ListBucketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBuckets not implemented")
 }
+func (*UnimplementedBucketRuntimeServer) WatchBuckets(req *WatchBucketsRequest, srv BucketRuntime_WatchBucketsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBuckets not implemented")
+}
 func (*UnimplementedBucketRuntimeServer) CreateBucket(ctx context.Context, req *CreateBucketRequest) (*CreateBucketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBucket not implemented")
 }
@@ -945,6 +1237,24 @@ func RegisterBucketRuntimeServer(s *grpc.Server, srv BucketRuntimeServer) {
 	s.RegisterService(&_BucketRuntime_serviceDesc, srv)
 }
 
+func _BucketRuntime_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketRuntimeServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bucket.v1alpha1.BucketRuntime/Version",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketRuntimeServer).Version(ctx, req.(*VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BucketRuntime_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBucketsRequest)
 	if err := dec(in); err != nil {
@@ -963,6 +1273,27 @@ func _BucketRuntime_ListBuckets_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BucketRuntime_WatchBuckets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBucketsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BucketRuntimeServer).WatchBuckets(m, &bucketRuntimeWatchBucketsServer{stream})
+}
+
+type BucketRuntime_WatchBucketsServer interface {
+	Send(*WatchBucketsResponse) error
+	grpc.ServerStream
+}
+
+type bucketRuntimeWatchBucketsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bucketRuntimeWatchBucketsServer) Send(m *WatchBucketsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _BucketRuntime_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBucketRequest)
 	if err := dec(in); err != nil {
@@ -1021,6 +1352,10 @@ var _BucketRuntime_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "bucket.v1alpha1.BucketRuntime",
 	HandlerType: (*BucketRuntimeServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Version",
+			Handler:    _BucketRuntime_Version_Handler,
+		},
 		{
 			MethodName: "ListBuckets",
 			Handler:    _BucketRuntime_ListBuckets_Handler,
@@ -1038,11 +1373,17 @@ var _BucketRuntime_serviceDesc = grpc.ServiceDesc{
 			Handler:    _BucketRuntime_ListBucketClasses_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBuckets",
+			Handler:       _BucketRuntime_WatchBuckets_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api.proto",
 }
 
-func (m *BucketFilter) Marshal() (dAtA []byte, err error) {
+func (m *VersionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1052,46 +1393,27 @@ func (m *BucketFilter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BucketFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *VersionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BucketFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LabelSelector) > 0 {
-		for k := range m.LabelSelector {
-			v := m.LabelSelector[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintApi(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintApi(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintApi(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Version)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BucketSpec) Marshal() (dAtA []byte, err error) {
+func (m *VersionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1101,24 +1423,119 @@ func (m *BucketSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BucketSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *VersionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BucketSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Class) > 0 {
-		i -= len(m.Class)
-		copy(dAtA[i:], m.Class)
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Class)))
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Capabilities[iNdEx])
+			copy(dAtA[i:], m.Capabilities[iNdEx])
+			i = encodeVarintApi(dAtA, i, uint64(len(m.Capabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RuntimeVersion) > 0 {
+		i -= len(m.RuntimeVersion)
+		copy(dAtA[i:], m.RuntimeVersion)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.RuntimeVersion)))
 		i--
 		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
+	if len(m.RuntimeName) > 0 {
+		i -= len(m.RuntimeName)
+		copy(dAtA[i:], m.RuntimeName)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.RuntimeName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BucketFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LabelSelector) > 0 {
+		for k := range m.LabelSelector {
+			v := m.LabelSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApi(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BucketSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Class) > 0 {
+		i -= len(m.Class)
+		copy(dAtA[i:], m.Class)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Class)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *BucketStatus) Marshal() (dAtA []byte, err error) {
@@ -1418,6 +1835,95 @@ func (m *ListBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WatchBucketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResourceVersion) > 0 {
+		i -= len(m.ResourceVersion)
+		copy(dAtA[i:], m.ResourceVersion)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ResourceVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchBucketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResourceVersion) > 0 {
+		i -= len(m.ResourceVersion)
+		copy(dAtA[i:], m.ResourceVersion)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ResourceVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Bucket != nil {
+		{
+			size, err := m.Bucket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CreateBucketRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1612,6 +2118,42 @@ func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *VersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *VersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RuntimeName)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.RuntimeVersion)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *BucketFilter) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1768,6 +2310,43 @@ func (m *ListBucketsResponse) Size() (n int) {
 	return n
 }
 
+func (m *WatchBucketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ResourceVersion)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchBucketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovApi(uint64(m.Type))
+	}
+	if m.Bucket != nil {
+		l = m.Bucket.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ResourceVersion)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
 func (m *CreateBucketRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1846,6 +2425,28 @@ func sovApi(x uint64) (n int) {
 func sozApi(x uint64) (n int) {
 	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *VersionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionRequest{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionResponse{`,
+		`RuntimeName:` + fmt.Sprintf("%v", this.RuntimeName) + `,`,
+		`RuntimeVersion:` + fmt.Sprintf("%v", this.RuntimeVersion) + `,`,
+		`Capabilities:` + fmt.Sprintf("%v", this.Capabilities) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BucketFilter) String() string {
 	if this == nil {
 		return "nil"
@@ -1968,6 +2569,29 @@ func (this *ListBucketsResponse) String() string {
 	}, "")
 	return s
 }
+func (this *WatchBucketsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchBucketsRequest{`,
+		`Filter:` + strings.Replace(this.Filter.String(), "BucketFilter", "BucketFilter", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchBucketsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchBucketsResponse{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Bucket:` + strings.Replace(this.Bucket.String(), "Bucket", "Bucket", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CreateBucketRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -2036,8 +2660,236 @@ func valueToStringApi(v interface{}) string {
 	if rv.IsNil() {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *VersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *BucketFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -3179,6 +4031,261 @@ func (m *ListBucketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WatchBucketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchBucketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &BucketFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchBucketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchBucketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v1alpha1.WatchEventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bucket == nil {
+				m.Bucket = &Bucket{}
+			}
+			if err := m.Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CreateBucketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
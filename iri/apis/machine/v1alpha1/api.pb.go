@@ -618,6 +618,7 @@ type NetworkInterface struct {
 	NetworkId            string            `protobuf:"bytes,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
 	Ips                  []string          `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
 	Attributes           map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DelegatedPrefixes    []string          `protobuf:"bytes,5,rep,name=delegated_prefixes,json=delegatedPrefixes,proto3" json:"delegated_prefixes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
@@ -682,6 +683,13 @@ func (m *NetworkInterface) GetAttributes() map[string]string {
 	return nil
 }
 
+func (m *NetworkInterface) GetDelegatedPrefixes() []string {
+	if m != nil {
+		return m.DelegatedPrefixes
+	}
+	return nil
+}
+
 type MachineSpec struct {
 	Power                Power               `protobuf:"varint,1,opt,name=power,proto3,enum=machine.v1alpha1.Power" json:"power,omitempty"`
 	Image                *ImageSpec          `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
@@ -1130,7 +1138,9 @@ type VersionResponse struct {
 	RuntimeName string `protobuf:"bytes,1,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
 	// Version of the machine runtime. The string must be
 	// semver-compatible.
-	RuntimeVersion       string   `protobuf:"bytes,2,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
+	RuntimeVersion string `protobuf:"bytes,2,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
+	// Capabilities are the optional capabilities supported by the machine runtime.
+	Capabilities         []string `protobuf:"bytes,3,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -1181,6 +1191,13 @@ func (m *VersionResponse) GetRuntimeVersion() string {
 	return ""
 }
 
+func (m *VersionResponse) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 type ListMachinesRequest struct {
 	Filter               *MachineFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -1271,6 +1288,123 @@ func (m *ListMachinesResponse) GetMachines() []*Machine {
 	return nil
 }
 
+type WatchMachinesRequest struct {
+	Filter *MachineFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resource_version is the resource version to resume watching from.
+	// If empty, the watch starts with an added event for every existing machine followed by a bookmark.
+	// If the resource version is no longer available, the watch fails with code OUT_OF_RANGE.
+	ResourceVersion      string   `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchMachinesRequest) Reset()      { *m = WatchMachinesRequest{} }
+func (*WatchMachinesRequest) ProtoMessage() {}
+func (*WatchMachinesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+}
+func (m *WatchMachinesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchMachinesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchMachinesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchMachinesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchMachinesRequest.Merge(m, src)
+}
+func (m *WatchMachinesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchMachinesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchMachinesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchMachinesRequest proto.InternalMessageInfo
+
+func (m *WatchMachinesRequest) GetFilter() *MachineFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *WatchMachinesRequest) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+type WatchMachinesResponse struct {
+	Type                 v1alpha1.WatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=meta.v1alpha1.WatchEventType" json:"type,omitempty"`
+	Machine              *Machine                `protobuf:"bytes,2,opt,name=machine,proto3" json:"machine,omitempty"`
+	ResourceVersion      string                  `protobuf:"bytes,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *WatchMachinesResponse) Reset()      { *m = WatchMachinesResponse{} }
+func (*WatchMachinesResponse) ProtoMessage() {}
+func (*WatchMachinesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+}
+func (m *WatchMachinesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchMachinesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchMachinesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchMachinesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchMachinesResponse.Merge(m, src)
+}
+func (m *WatchMachinesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchMachinesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchMachinesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchMachinesResponse proto.InternalMessageInfo
+
+func (m *WatchMachinesResponse) GetType() v1alpha1.WatchEventType {
+	if m != nil {
+		return m.Type
+	}
+	return v1alpha1.WatchEventType_WATCH_EVENT_ADDED
+}
+
+func (m *WatchMachinesResponse) GetMachine() *Machine {
+	if m != nil {
+		return m.Machine
+	}
+	return nil
+}
+
+func (m *WatchMachinesResponse) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
 type CreateMachineRequest struct {
 	Machine              *Machine `protobuf:"bytes,1,opt,name=machine,proto3" json:"machine,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1280,7 +1414,7 @@ type CreateMachineRequest struct {
 func (m *CreateMachineRequest) Reset()      { *m = CreateMachineRequest{} }
 func (*CreateMachineRequest) ProtoMessage() {}
 func (*CreateMachineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
 func (m *CreateMachineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1325,7 +1459,7 @@ type CreateMachineResponse struct {
 func (m *CreateMachineResponse) Reset()      { *m = CreateMachineResponse{} }
 func (*CreateMachineResponse) ProtoMessage() {}
 func (*CreateMachineResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
 }
 func (m *CreateMachineResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,7 +1504,7 @@ type DeleteMachineRequest struct {
 func (m *DeleteMachineRequest) Reset()      { *m = DeleteMachineRequest{} }
 func (*DeleteMachineRequest) ProtoMessage() {}
 func (*DeleteMachineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 func (m *DeleteMachineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1414,7 +1548,7 @@ type DeleteMachineResponse struct {
 func (m *DeleteMachineResponse) Reset()      { *m = DeleteMachineResponse{} }
 func (*DeleteMachineResponse) ProtoMessage() {}
 func (*DeleteMachineResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
 }
 func (m *DeleteMachineResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1453,7 +1587,7 @@ type UpdateMachineAnnotationsRequest struct {
 func (m *UpdateMachineAnnotationsRequest) Reset()      { *m = UpdateMachineAnnotationsRequest{} }
 func (*UpdateMachineAnnotationsRequest) ProtoMessage() {}
 func (*UpdateMachineAnnotationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
 func (m *UpdateMachineAnnotationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1504,7 +1638,7 @@ type UpdateMachineAnnotationsResponse struct {
 func (m *UpdateMachineAnnotationsResponse) Reset()      { *m = UpdateMachineAnnotationsResponse{} }
 func (*UpdateMachineAnnotationsResponse) ProtoMessage() {}
 func (*UpdateMachineAnnotationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
 func (m *UpdateMachineAnnotationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1543,7 +1677,7 @@ type UpdateMachinePowerRequest struct {
 func (m *UpdateMachinePowerRequest) Reset()      { *m = UpdateMachinePowerRequest{} }
 func (*UpdateMachinePowerRequest) ProtoMessage() {}
 func (*UpdateMachinePowerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
 }
 func (m *UpdateMachinePowerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1594,7 +1728,7 @@ type UpdateMachinePowerResponse struct {
 func (m *UpdateMachinePowerResponse) Reset()      { *m = UpdateMachinePowerResponse{} }
 func (*UpdateMachinePowerResponse) ProtoMessage() {}
 func (*UpdateMachinePowerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
 func (m *UpdateMachinePowerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1633,7 +1767,7 @@ type AttachVolumeRequest struct {
 func (m *AttachVolumeRequest) Reset()      { *m = AttachVolumeRequest{} }
 func (*AttachVolumeRequest) ProtoMessage() {}
 func (*AttachVolumeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 func (m *AttachVolumeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1684,7 +1818,7 @@ type AttachVolumeResponse struct {
 func (m *AttachVolumeResponse) Reset()      { *m = AttachVolumeResponse{} }
 func (*AttachVolumeResponse) ProtoMessage() {}
 func (*AttachVolumeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
 func (m *AttachVolumeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1723,7 +1857,7 @@ type DetachVolumeRequest struct {
 func (m *DetachVolumeRequest) Reset()      { *m = DetachVolumeRequest{} }
 func (*DetachVolumeRequest) ProtoMessage() {}
 func (*DetachVolumeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
 }
 func (m *DetachVolumeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1774,7 +1908,7 @@ type DetachVolumeResponse struct {
 func (m *DetachVolumeResponse) Reset()      { *m = DetachVolumeResponse{} }
 func (*DetachVolumeResponse) ProtoMessage() {}
 func (*DetachVolumeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
 }
 func (m *DetachVolumeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1813,7 +1947,7 @@ type AttachNetworkInterfaceRequest struct {
 func (m *AttachNetworkInterfaceRequest) Reset()      { *m = AttachNetworkInterfaceRequest{} }
 func (*AttachNetworkInterfaceRequest) ProtoMessage() {}
 func (*AttachNetworkInterfaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
 }
 func (m *AttachNetworkInterfaceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +1998,7 @@ type AttachNetworkInterfaceResponse struct {
 func (m *AttachNetworkInterfaceResponse) Reset()      { *m = AttachNetworkInterfaceResponse{} }
 func (*AttachNetworkInterfaceResponse) ProtoMessage() {}
 func (*AttachNetworkInterfaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
 }
 func (m *AttachNetworkInterfaceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1903,7 +2037,7 @@ type DetachNetworkInterfaceRequest struct {
 func (m *DetachNetworkInterfaceRequest) Reset()      { *m = DetachNetworkInterfaceRequest{} }
 func (*DetachNetworkInterfaceRequest) ProtoMessage() {}
 func (*DetachNetworkInterfaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
 }
 func (m *DetachNetworkInterfaceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1954,7 +2088,7 @@ type DetachNetworkInterfaceResponse struct {
 func (m *DetachNetworkInterfaceResponse) Reset()      { *m = DetachNetworkInterfaceResponse{} }
 func (*DetachNetworkInterfaceResponse) ProtoMessage() {}
 func (*DetachNetworkInterfaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
 }
 func (m *DetachNetworkInterfaceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1991,7 +2125,7 @@ type StatusRequest struct {
 func (m *StatusRequest) Reset()      { *m = StatusRequest{} }
 func (*StatusRequest) ProtoMessage() {}
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
 }
 func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2029,7 +2163,7 @@ type StatusResponse struct {
 func (m *StatusResponse) Reset()      { *m = StatusResponse{} }
 func (*StatusResponse) ProtoMessage() {}
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
 }
 func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2074,7 +2208,7 @@ type ExecRequest struct {
 func (m *ExecRequest) Reset()      { *m = ExecRequest{} }
 func (*ExecRequest) ProtoMessage() {}
 func (*ExecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
 }
 func (m *ExecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2119,7 +2253,7 @@ type ExecResponse struct {
 func (m *ExecResponse) Reset()      { *m = ExecResponse{} }
 func (*ExecResponse) ProtoMessage() {}
 func (*ExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
 func (m *ExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2186,6 +2320,8 @@ func init() {
 	proto.RegisterType((*VersionResponse)(nil), "machine.v1alpha1.VersionResponse")
 	proto.RegisterType((*ListMachinesRequest)(nil), "machine.v1alpha1.ListMachinesRequest")
 	proto.RegisterType((*ListMachinesResponse)(nil), "machine.v1alpha1.ListMachinesResponse")
+	proto.RegisterType((*WatchMachinesRequest)(nil), "machine.v1alpha1.WatchMachinesRequest")
+	proto.RegisterType((*WatchMachinesResponse)(nil), "machine.v1alpha1.WatchMachinesResponse")
 	proto.RegisterType((*CreateMachineRequest)(nil), "machine.v1alpha1.CreateMachineRequest")
 	proto.RegisterType((*CreateMachineResponse)(nil), "machine.v1alpha1.CreateMachineResponse")
 	proto.RegisterType((*DeleteMachineRequest)(nil), "machine.v1alpha1.DeleteMachineRequest")
@@ -2212,123 +2348,131 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1848 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4b, 0x93, 0xdb, 0x58,
-	0x15, 0x6e, 0xb9, 0xdd, 0x0f, 0x1f, 0xbb, 0xdd, 0xce, 0xed, 0x4e, 0xc7, 0xd1, 0x60, 0xc7, 0x11,
-	0xc3, 0x24, 0xd5, 0x24, 0xf6, 0xb4, 0xc3, 0x3c, 0x48, 0xd5, 0x50, 0x38, 0xb6, 0x33, 0x71, 0xa5,
-	0xed, 0x0e, 0xea, 0x4e, 0x07, 0x28, 0x28, 0x95, 0x2c, 0xdf, 0xee, 0x16, 0x91, 0x25, 0x8f, 0x24,
-	0x7b, 0x68, 0x66, 0x33, 0xfc, 0x00, 0x0a, 0x36, 0xfc, 0x05, 0xd6, 0x50, 0xc5, 0x92, 0x1f, 0x30,
-	0xcb, 0xd9, 0xc1, 0x92, 0x69, 0xaa, 0x58, 0xf0, 0x2b, 0xa8, 0xfb, 0x90, 0x2c, 0xdb, 0x57, 0x7e,
-	0x0c, 0x0b, 0x76, 0xbe, 0x47, 0xe7, 0x7c, 0xe7, 0xa1, 0x73, 0xce, 0x77, 0x55, 0x86, 0x94, 0x3e,
-	0x30, 0xcb, 0x03, 0xd7, 0xf1, 0x1d, 0x94, 0xeb, 0xeb, 0xc6, 0x95, 0x69, 0xe3, 0xf2, 0xe8, 0x48,
-	0xb7, 0x06, 0x57, 0xfa, 0x91, 0xfc, 0xf8, 0xd2, 0xf4, 0xaf, 0x86, 0xdd, 0xb2, 0xe1, 0xf4, 0x2b,
-	0x97, 0xce, 0xa5, 0x53, 0xa1, 0x8a, 0xdd, 0xe1, 0x05, 0x3d, 0xd1, 0x03, 0xfd, 0xc5, 0x00, 0xe4,
-	0x5a, 0x44, 0xdd, 0x74, 0x1d, 0xdb, 0x70, 0x5c, 0xfc, 0xb8, 0x87, 0x47, 0xe1, 0xa1, 0x62, 0xba,
-	0x66, 0x45, 0x1f, 0x98, 0x5e, 0xa5, 0x8f, 0x7d, 0xbd, 0x12, 0xf8, 0xa9, 0x84, 0x31, 0x28, 0x7f,
-	0x4f, 0x00, 0x9c, 0x3b, 0xd6, 0xb0, 0x8f, 0x4f, 0x07, 0xd8, 0x40, 0x07, 0xb0, 0xd9, 0x73, 0xcd,
-	0x11, 0x76, 0xf3, 0x52, 0x49, 0x7a, 0x98, 0x52, 0xf9, 0x89, 0xc8, 0xaf, 0x74, 0xbb, 0x67, 0xe1,
-	0x7c, 0x82, 0xc9, 0xd9, 0x09, 0x1d, 0x03, 0xe8, 0xbe, 0xef, 0x9a, 0xdd, 0xa1, 0x8f, 0xbd, 0xfc,
-	0x7a, 0x69, 0xfd, 0x61, 0xba, 0xfa, 0xa8, 0x3c, 0x9d, 0x57, 0x79, 0xec, 0xa1, 0x5c, 0x0b, 0xd5,
-	0x9b, 0xb6, 0xef, 0x5e, 0xab, 0x11, 0x7b, 0xd4, 0x86, 0xb4, 0x87, 0x0d, 0x17, 0xfb, 0x5a, 0x4f,
-	0xf7, 0xf5, 0x7c, 0x72, 0x09, 0xb8, 0x53, 0xaa, 0xdf, 0xd0, 0x7d, 0x9d, 0xc3, 0x79, 0xa1, 0x40,
-	0xfe, 0x04, 0x76, 0xa7, 0xbc, 0xa1, 0x1c, 0xac, 0xbf, 0xc5, 0xd7, 0x3c, 0x39, 0xf2, 0x13, 0xed,
-	0xc3, 0xc6, 0x48, 0xb7, 0x86, 0x41, 0x62, 0xec, 0xf0, 0x34, 0xf1, 0xb1, 0x44, 0xcc, 0xa7, 0xd0,
-	0x17, 0x99, 0x67, 0x22, 0xe6, 0xca, 0xdf, 0x24, 0xd8, 0x69, 0xb3, 0xc8, 0x9f, 0x9b, 0x96, 0x8f,
-	0x5d, 0x94, 0x85, 0x84, 0xd9, 0xe3, 0xc6, 0x09, 0xb3, 0x87, 0x7e, 0x06, 0x59, 0x4b, 0xef, 0x62,
-	0x4b, 0xf3, 0xb0, 0x85, 0x0d, 0xdf, 0x71, 0xf3, 0x09, 0x9a, 0x71, 0x75, 0x36, 0xe3, 0x09, 0xa0,
-	0xf2, 0x31, 0xb1, 0x3a, 0xe5, 0x46, 0x2c, 0xef, 0x1d, 0x2b, 0x2a, 0x93, 0x7f, 0x0c, 0x68, 0x56,
-	0x69, 0x95, 0xec, 0x95, 0x5f, 0x40, 0x9e, 0x3b, 0xad, 0x5b, 0xba, 0xe7, 0xd5, 0xf5, 0x81, 0xde,
-	0x35, 0x2d, 0xd3, 0x37, 0xb1, 0x87, 0x0a, 0x00, 0xc6, 0x60, 0xa8, 0xf5, 0x4d, 0xcb, 0x32, 0x3d,
-	0x0a, 0xb7, 0xae, 0xa6, 0x8c, 0xc1, 0xb0, 0x4d, 0x05, 0xe8, 0x3e, 0x64, 0xfa, 0xb8, 0xef, 0xb8,
-	0xd7, 0x5a, 0xf7, 0x9a, 0xb4, 0x45, 0x82, 0x2a, 0xa4, 0x99, 0xec, 0x19, 0x11, 0x29, 0x7f, 0x96,
-	0x60, 0x8b, 0xc3, 0xa3, 0x1f, 0xc2, 0x36, 0xe9, 0x4e, 0xfa, 0xca, 0x09, 0x56, 0xba, 0x5a, 0x28,
-	0x13, 0xc1, 0x38, 0xfb, 0x93, 0xee, 0xaf, 0xb0, 0xe1, 0xb7, 0xb9, 0x92, 0x1a, 0xaa, 0xa3, 0x23,
-	0x48, 0x7a, 0x03, 0x6c, 0x50, 0x0f, 0xd4, 0x2c, 0xa6, 0x6e, 0xa4, 0x55, 0x54, 0xaa, 0x8a, 0x3e,
-	0x82, 0x4d, 0xcf, 0xd7, 0xfd, 0x21, 0xe9, 0x56, 0x62, 0x74, 0x2f, 0xde, 0x88, 0xaa, 0xa9, 0x5c,
-	0x5d, 0xb9, 0x0f, 0xa9, 0x56, 0x5f, 0xbf, 0x64, 0x73, 0xb2, 0x0f, 0x1b, 0x26, 0x39, 0xf0, 0x5a,
-	0xb2, 0x83, 0x72, 0x08, 0xa9, 0x66, 0x7f, 0xe0, 0x5f, 0x37, 0x4c, 0xef, 0x2d, 0x29, 0x92, 0x67,
-	0xfe, 0x06, 0xf3, 0x1a, 0xf0, 0x22, 0x11, 0x09, 0xab, 0xc0, 0xef, 0x92, 0x90, 0x63, 0x7d, 0x5c,
-	0x77, 0x6c, 0x1b, 0x1b, 0xbe, 0xe9, 0xd8, 0x2b, 0x8f, 0x9f, 0x2a, 0x18, 0xbf, 0x6a, 0xdc, 0xbc,
-	0x8c, 0xfd, 0xcc, 0x1d, 0xc2, 0x53, 0xd1, 0x10, 0x2e, 0x03, 0x3a, 0x67, 0x14, 0x91, 0x06, 0xbb,
-	0xd8, 0x36, 0xdc, 0xeb, 0x01, 0xd1, 0x64, 0xc0, 0x1b, 0x14, 0xf8, 0xc3, 0x25, 0x80, 0x9b, 0xa1,
-	0xe5, 0x18, 0x3c, 0x8b, 0x27, 0x84, 0xff, 0xdf, 0x59, 0x97, 0x6b, 0xb0, 0x27, 0x08, 0x72, 0xa5,
-	0x75, 0xf1, 0x57, 0x09, 0x36, 0x59, 0xe6, 0x08, 0x41, 0xd2, 0xd6, 0xfb, 0x41, 0x6f, 0xd1, 0xdf,
-	0xb4, 0x33, 0xf0, 0xc8, 0x34, 0xc2, 0x0e, 0x60, 0x27, 0xf4, 0x14, 0x00, 0x93, 0x96, 0xd3, 0x7a,
-	0xa6, 0xf7, 0x36, 0x9f, 0xa4, 0x2d, 0xfd, 0xce, 0x6c, 0x4d, 0xc3, 0xb6, 0x54, 0x53, 0x38, 0xec,
-	0xd0, 0x67, 0x00, 0x46, 0x58, 0xe5, 0xfc, 0x06, 0xb5, 0x55, 0x16, 0xbf, 0x0f, 0x35, 0x62, 0xa5,
-	0xfc, 0x47, 0x82, 0x5c, 0x07, 0xfb, 0x9f, 0x3b, 0xee, 0xdb, 0x96, 0xed, 0x63, 0xf7, 0x42, 0x37,
-	0xc4, 0x09, 0x14, 0x00, 0x6c, 0xa6, 0xa7, 0x99, 0x3d, 0x9e, 0x44, 0x8a, 0x4b, 0x5a, 0x3d, 0x52,
-	0x2a, 0x73, 0xc0, 0x5a, 0x38, 0xa5, 0x92, 0x9f, 0x53, 0xbd, 0x1d, 0xdb, 0x86, 0xd3, 0xce, 0xe7,
-	0xf5, 0xf6, 0xff, 0xd8, 0x25, 0xca, 0x5f, 0x12, 0x90, 0x8e, 0x6c, 0x14, 0xf4, 0x18, 0x36, 0x06,
-	0xce, 0xe7, 0x7c, 0x5a, 0xb3, 0xd5, 0x3b, 0xb3, 0xd1, 0xbd, 0x22, 0x8f, 0x55, 0xa6, 0x85, 0x8e,
-	0x82, 0xa5, 0x91, 0x88, 0x7b, 0x4d, 0xe1, 0x82, 0xe1, 0x1b, 0x85, 0xc4, 0x62, 0x90, 0xf5, 0x4b,
-	0x97, 0x55, 0x4a, 0x65, 0x07, 0xf4, 0x5d, 0xd8, 0x31, 0x2f, 0x6d, 0x73, 0x3c, 0x4b, 0x49, 0xda,
-	0x4d, 0x99, 0x40, 0x48, 0x47, 0xae, 0x0a, 0x5b, 0x23, 0xfa, 0xe6, 0x3c, 0x3e, 0x6a, 0xf9, 0xb8,
-	0x57, 0xab, 0x06, 0x8a, 0xe8, 0x27, 0x80, 0xc2, 0x97, 0x14, 0x14, 0xd4, 0xcb, 0x6f, 0x52, 0x73,
-	0x65, 0x71, 0xed, 0xd5, 0x5b, 0xf6, 0x94, 0xc4, 0x53, 0xfe, 0x94, 0x08, 0x69, 0x90, 0x2d, 0x54,
-	0x54, 0x81, 0x3d, 0xa7, 0xeb, 0x61, 0x77, 0x84, 0x7b, 0xda, 0x25, 0xb6, 0xb1, 0xab, 0xd3, 0xfe,
-	0x63, 0x1b, 0x12, 0x05, 0x8f, 0x3e, 0x0d, 0x9f, 0xa0, 0x1f, 0xc0, 0x06, 0xd9, 0xc1, 0xac, 0x6e,
-	0xd9, 0x6a, 0x71, 0xee, 0xc6, 0xc6, 0x2a, 0x53, 0x46, 0xef, 0x40, 0x8a, 0xd6, 0x50, 0x73, 0xf1,
-	0x05, 0x2f, 0xdf, 0x36, 0x15, 0xa8, 0xf8, 0x02, 0x7d, 0x3c, 0x2e, 0x0e, 0xeb, 0xac, 0x62, 0xec,
-	0x2d, 0x83, 0xb1, 0x40, 0x58, 0xa2, 0x37, 0xc2, 0x12, 0xb1, 0x0a, 0x3f, 0x5c, 0x5c, 0x22, 0x0e,
-	0x27, 0x28, 0x94, 0x03, 0x99, 0xa8, 0xc7, 0xb8, 0x2d, 0x20, 0xe4, 0x81, 0x27, 0x41, 0x85, 0xd6,
-	0x69, 0x85, 0x0a, 0xf3, 0x92, 0x09, 0x0a, 0xa4, 0xfc, 0x51, 0x82, 0x03, 0x71, 0x78, 0x2b, 0xf9,
-	0xfe, 0x64, 0xd2, 0xf7, 0x83, 0xe5, 0x6a, 0x10, 0xbe, 0x26, 0x3e, 0xf8, 0xc9, 0x70, 0xf0, 0x15,
-	0x17, 0x32, 0xd1, 0x9b, 0x87, 0x30, 0x98, 0x0e, 0x64, 0x8c, 0xc8, 0x8d, 0x84, 0x4f, 0xd4, 0x61,
-	0x6c, 0x67, 0xcc, 0xdc, 0x61, 0xd4, 0x09, 0x7b, 0x65, 0x08, 0x28, 0xaa, 0xc9, 0xcb, 0x50, 0x87,
-	0x1d, 0x0e, 0xa8, 0xb1, 0x29, 0x64, 0xd7, 0x93, 0xe2, 0x7c, 0x37, 0x6a, 0xa6, 0x1f, 0x0d, 0x5f,
-	0x86, 0xed, 0xcf, 0x86, 0xba, 0xed, 0x9b, 0xfe, 0x35, 0xbf, 0x09, 0x85, 0x67, 0xe5, 0x10, 0xb2,
-	0xe7, 0xd8, 0xf5, 0xc8, 0x52, 0xc5, 0x9f, 0x0d, 0xb1, 0xe7, 0xa3, 0x3c, 0x6c, 0x8d, 0x98, 0x84,
-	0xe7, 0x1b, 0x1c, 0x95, 0x5f, 0xc2, 0x6e, 0xa8, 0xeb, 0x0d, 0x1c, 0xdb, 0xc3, 0xe4, 0xa2, 0xe5,
-	0x0e, 0x6d, 0xdf, 0xec, 0x63, 0x2d, 0x52, 0xa1, 0x34, 0x97, 0x75, 0x48, 0xa1, 0x1e, 0xc0, 0x6e,
-	0xa0, 0x12, 0xe0, 0xb2, 0xd7, 0x97, 0xe5, 0x62, 0x8e, 0xa9, 0x74, 0x60, 0xef, 0xd8, 0xf4, 0x7c,
-	0x9e, 0x88, 0x17, 0xc4, 0xf3, 0x11, 0x6c, 0x5e, 0xd0, 0x4b, 0x27, 0xcf, 0xfd, 0xde, 0x82, 0xbb,
-	0xa9, 0xca, 0xd5, 0x95, 0x36, 0xec, 0x4f, 0xe2, 0xf1, 0x98, 0x3f, 0x80, 0x6d, 0x8e, 0x40, 0xca,
-	0x49, 0xa6, 0xe6, 0x6e, 0x2c, 0xa4, 0x1a, 0xaa, 0x2a, 0x2f, 0x61, 0xbf, 0xee, 0x62, 0xdd, 0xc7,
-	0xc1, 0x23, 0x1e, 0xdf, 0x13, 0xd8, 0xe2, 0x3a, 0x3c, 0xc0, 0x39, 0x68, 0x81, 0xa6, 0x72, 0x0c,
-	0xb7, 0xa7, 0xc0, 0x78, 0x70, 0xdf, 0x0a, 0xed, 0x03, 0xd8, 0x6f, 0x60, 0x0b, 0xcf, 0x84, 0x56,
-	0x00, 0x08, 0xba, 0x27, 0xbc, 0xf6, 0xa7, 0xb8, 0xa4, 0xd5, 0x53, 0xee, 0xc0, 0xed, 0x29, 0x33,
-	0x16, 0x84, 0xf2, 0x6f, 0x09, 0xee, 0xbd, 0x1e, 0xf4, 0xc6, 0xe1, 0xd5, 0x6c, 0xdb, 0xf1, 0xe9,
-	0x2a, 0xf4, 0x96, 0xc3, 0x46, 0x3d, 0x48, 0xeb, 0x63, 0x23, 0xfe, 0x59, 0xf1, 0x6c, 0x36, 0x97,
-	0x05, 0x6e, 0xca, 0x11, 0x11, 0x23, 0xd3, 0x28, 0xac, 0xfc, 0x23, 0xc8, 0x4d, 0x2b, 0xac, 0x44,
-	0xa7, 0x0a, 0x94, 0xe2, 0x03, 0xe0, 0xc5, 0x30, 0xe1, 0xee, 0x84, 0x0e, 0x23, 0xd4, 0xe5, 0xaa,
-	0x10, 0xd2, 0x73, 0x62, 0x19, 0x7a, 0x56, 0xbe, 0x03, 0xb2, 0xc8, 0x15, 0x0f, 0xe4, 0x02, 0xf6,
-	0x6a, 0xbe, 0xaf, 0x1b, 0x57, 0x9c, 0x33, 0x97, 0x0b, 0xe1, 0x7d, 0xd8, 0x64, 0xc4, 0xc1, 0x37,
-	0x54, 0x3c, 0x07, 0x73, 0x3d, 0xe5, 0x00, 0xf6, 0x27, 0xfd, 0x70, 0xff, 0x2f, 0x60, 0xaf, 0x81,
-	0x57, 0xf6, 0x1f, 0xec, 0xce, 0xc4, 0x78, 0x77, 0x12, 0x0f, 0x93, 0x48, 0xdc, 0xc3, 0xef, 0x25,
-	0x28, 0x30, 0xd7, 0x33, 0xbc, 0xbe, 0x9c, 0xb3, 0x13, 0xb8, 0x35, 0x43, 0x8d, 0x3c, 0xef, 0x65,
-	0x2e, 0x0f, 0xb9, 0x69, 0x4e, 0x54, 0x4a, 0x50, 0x8c, 0x0b, 0x88, 0xc7, 0xac, 0x42, 0x81, 0xe5,
-	0xf2, 0x2d, 0x43, 0x16, 0xd5, 0xa7, 0x04, 0xc5, 0x38, 0x4c, 0xee, 0x75, 0x17, 0x76, 0x38, 0x8f,
-	0x33, 0x2f, 0xca, 0x15, 0x64, 0x03, 0x01, 0xdf, 0x24, 0xe7, 0xb0, 0x3f, 0x41, 0x1d, 0x1a, 0xff,
-	0xe8, 0x64, 0x2b, 0xef, 0xdd, 0xf9, 0x0c, 0xc2, 0xb1, 0x50, 0x7f, 0x46, 0xa6, 0x3c, 0x82, 0x74,
-	0xf3, 0xd7, 0xd8, 0x58, 0x72, 0xc7, 0x94, 0x20, 0xc3, 0xb4, 0x79, 0x54, 0x39, 0x58, 0x1f, 0xba,
-	0x56, 0x30, 0x9d, 0x43, 0xd7, 0x3a, 0x7c, 0x17, 0x36, 0x68, 0x9f, 0xa3, 0x0c, 0x6c, 0xbf, 0x3a,
-	0x79, 0xd3, 0x54, 0xb5, 0x93, 0x4e, 0x6e, 0x0d, 0xed, 0x40, 0x8a, 0x9f, 0x9e, 0x3f, 0xcf, 0x49,
-	0x87, 0x1f, 0x42, 0x3a, 0x72, 0x81, 0x40, 0x08, 0xb2, 0xe7, 0x27, 0xc7, 0xaf, 0xdb, 0x4d, 0xed,
-	0x55, 0xb3, 0xd3, 0x68, 0x75, 0x3e, 0xcd, 0xad, 0xa1, 0x3d, 0xd8, 0xe5, 0xb2, 0xda, 0xd9, 0x59,
-	0xad, 0xfe, 0xa2, 0xd9, 0xc8, 0x49, 0x87, 0xe7, 0x70, 0x5b, 0x48, 0xfe, 0xa8, 0x00, 0x77, 0x3b,
-	0xcd, 0xb3, 0x37, 0x27, 0xea, 0x4b, 0xad, 0xd5, 0x39, 0x6b, 0xaa, 0xcf, 0x6b, 0xf5, 0x28, 0x58,
-	0x11, 0xe4, 0xd9, 0xc7, 0x11, 0xdc, 0x2f, 0xa5, 0xf0, 0x8e, 0xc0, 0xf0, 0xf6, 0x60, 0xb7, 0x5d,
-	0xab, 0xbf, 0x68, 0x75, 0xa6, 0x42, 0x0a, 0x84, 0xea, 0xeb, 0x4e, 0x87, 0x08, 0x25, 0x74, 0x1b,
-	0x6e, 0x05, 0xc2, 0xd3, 0xd7, 0xa7, 0x44, 0xb9, 0xd9, 0xc8, 0x25, 0xd0, 0x01, 0xa0, 0x40, 0x7c,
-	0xd6, 0x54, 0xdb, 0xad, 0x4e, 0xed, 0xac, 0xd9, 0xc8, 0xad, 0xa3, 0x3b, 0xb0, 0x37, 0x2d, 0x27,
-	0x38, 0xc9, 0xea, 0xd7, 0x29, 0xc8, 0x06, 0x9b, 0x9b, 0x31, 0x29, 0x7a, 0x05, 0x5b, 0x9c, 0x4d,
-	0x51, 0x49, 0x30, 0xe7, 0x13, 0x44, 0x2f, 0xdf, 0x9f, 0xa3, 0xc1, 0xdb, 0x6c, 0x0d, 0x69, 0x90,
-	0x89, 0x92, 0x28, 0xfa, 0xde, 0xac, 0x91, 0x80, 0xb4, 0xe5, 0xf7, 0x16, 0xa9, 0x85, 0x0e, 0xba,
-	0xb0, 0x33, 0xc1, 0x84, 0x48, 0x60, 0x2a, 0xe2, 0x5d, 0xf9, 0xc1, 0x42, 0xbd, 0xa8, 0x8f, 0x09,
-	0xa2, 0x13, 0xf9, 0x10, 0x11, 0xa8, 0xc8, 0x87, 0x98, 0x31, 0xd7, 0xd0, 0x6f, 0x25, 0xc8, 0xc7,
-	0x71, 0x09, 0x3a, 0x5a, 0x99, 0xf8, 0xe4, 0xea, 0x2a, 0x26, 0x7c, 0xb8, 0x1c, 0x40, 0xb3, 0xfc,
-	0x81, 0xbe, 0xbf, 0x00, 0x29, 0x4a, 0x68, 0xf2, 0xa3, 0xe5, 0x94, 0xb9, 0x43, 0x0d, 0x32, 0x51,
-	0xaa, 0x10, 0x75, 0x87, 0x80, 0xb2, 0x44, 0xdd, 0x21, 0x64, 0x1c, 0xda, 0x7e, 0x51, 0xa6, 0x10,
-	0x39, 0x10, 0x70, 0x92, 0xfc, 0xde, 0x22, 0xb5, 0xd0, 0xc1, 0x17, 0x70, 0x20, 0x5e, 0xf0, 0xa8,
-	0x12, 0x17, 0x64, 0xcc, 0xa2, 0x97, 0xdf, 0x5f, 0xde, 0x80, 0x97, 0xef, 0x0b, 0x38, 0x10, 0xef,
-	0x79, 0x91, 0xf3, 0xb9, 0x2c, 0x23, 0x72, 0x3e, 0x9f, 0x42, 0xd0, 0x4b, 0xd8, 0xe4, 0x1f, 0x19,
-	0x82, 0x1b, 0xf5, 0x04, 0xb9, 0xc8, 0xa5, 0x78, 0x05, 0x0e, 0xd6, 0x84, 0x24, 0x59, 0xf3, 0x48,
-	0xf0, 0xdd, 0x17, 0x21, 0x0b, 0xb9, 0x18, 0xf7, 0x98, 0xc1, 0x3c, 0xfb, 0xe9, 0x57, 0xdf, 0x14,
-	0xa5, 0x7f, 0x7c, 0x53, 0x5c, 0xfb, 0xf2, 0xa6, 0x28, 0x7d, 0x75, 0x53, 0x94, 0xbe, 0xbe, 0x29,
-	0x4a, 0xff, 0xbc, 0x29, 0x4a, 0x7f, 0xf8, 0x57, 0x71, 0xed, 0xe7, 0x4f, 0x57, 0xf8, 0xb3, 0x81,
-	0xb9, 0x09, 0xff, 0x6f, 0xe8, 0x6e, 0xd2, 0x3f, 0x1b, 0x9e, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x9f, 0xd1, 0x4c, 0xe5, 0xfd, 0x18, 0x00, 0x00,
+	// 1971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4b, 0x6f, 0xdb, 0xd8,
+	0x15, 0x36, 0x65, 0xf9, 0xa1, 0xa3, 0x87, 0x95, 0xeb, 0x47, 0x14, 0x4e, 0xad, 0x51, 0xd8, 0xe9,
+	0x38, 0x75, 0x13, 0x29, 0x56, 0x3a, 0x8f, 0x06, 0x98, 0xa2, 0x8a, 0xa5, 0x4c, 0x8c, 0xd8, 0xb2,
+	0x4b, 0x3b, 0x4e, 0x5b, 0x14, 0x20, 0x28, 0xea, 0xda, 0x66, 0x43, 0x91, 0x1c, 0xf2, 0x4a, 0x33,
+	0x9a, 0xd9, 0xcc, 0xfc, 0x80, 0xa2, 0xdd, 0x74, 0xd1, 0x3f, 0xd0, 0x45, 0x57, 0x2d, 0x50, 0x74,
+	0xd5, 0x1f, 0x30, 0xcb, 0xee, 0xda, 0x65, 0x27, 0x05, 0xfa, 0x3b, 0x8a, 0xcb, 0x7b, 0x49, 0x51,
+	0x14, 0xa9, 0x47, 0x5a, 0x60, 0x76, 0xba, 0x87, 0xdf, 0x79, 0x7d, 0x3c, 0xe7, 0x9e, 0x43, 0x08,
+	0x32, 0xaa, 0xad, 0x57, 0x6d, 0xc7, 0x22, 0x16, 0x2a, 0xf6, 0x54, 0xed, 0x46, 0x37, 0x71, 0x75,
+	0x70, 0xa0, 0x1a, 0xf6, 0x8d, 0x7a, 0x20, 0x3e, 0xb8, 0xd6, 0xc9, 0x4d, 0xbf, 0x53, 0xd5, 0xac,
+	0x5e, 0xed, 0xda, 0xba, 0xb6, 0x6a, 0x1e, 0xb0, 0xd3, 0xbf, 0xf2, 0x4e, 0xde, 0xc1, 0xfb, 0xc5,
+	0x0c, 0x88, 0x8d, 0x10, 0x5c, 0x77, 0x2c, 0x53, 0xb3, 0x1c, 0xfc, 0xa0, 0x8b, 0x07, 0xc1, 0xa1,
+	0xa6, 0x3b, 0x7a, 0x4d, 0xb5, 0x75, 0xb7, 0xd6, 0xc3, 0x44, 0xad, 0xf9, 0x7e, 0x6a, 0x41, 0x0c,
+	0xd2, 0x3f, 0x52, 0x00, 0x97, 0x96, 0xd1, 0xef, 0xe1, 0x73, 0x1b, 0x6b, 0x68, 0x07, 0x56, 0xbb,
+	0x8e, 0x3e, 0xc0, 0x4e, 0x49, 0xa8, 0x08, 0xf7, 0x32, 0x32, 0x3f, 0x51, 0xf9, 0x8d, 0x6a, 0x76,
+	0x0d, 0x5c, 0x4a, 0x31, 0x39, 0x3b, 0xa1, 0x63, 0x00, 0x95, 0x10, 0x47, 0xef, 0xf4, 0x09, 0x76,
+	0x4b, 0xcb, 0x95, 0xe5, 0x7b, 0xd9, 0xfa, 0xfd, 0x6a, 0x34, 0xaf, 0xea, 0xc8, 0x43, 0xb5, 0x11,
+	0xc0, 0x5b, 0x26, 0x71, 0x86, 0x72, 0x48, 0x1f, 0x9d, 0x40, 0xd6, 0xc5, 0x9a, 0x83, 0x89, 0xd2,
+	0x55, 0x89, 0x5a, 0x4a, 0xcf, 0x61, 0xee, 0xdc, 0xc3, 0x37, 0x55, 0xa2, 0x72, 0x73, 0x6e, 0x20,
+	0x10, 0x3f, 0x82, 0x8d, 0x88, 0x37, 0x54, 0x84, 0xe5, 0x57, 0x78, 0xc8, 0x93, 0xa3, 0x3f, 0xd1,
+	0x16, 0xac, 0x0c, 0x54, 0xa3, 0xef, 0x27, 0xc6, 0x0e, 0x8f, 0x53, 0x1f, 0x0a, 0x54, 0x3d, 0x62,
+	0x7d, 0x96, 0x7a, 0x2e, 0xa4, 0x2e, 0xfd, 0x4d, 0x80, 0xfc, 0x09, 0x8b, 0xfc, 0xa9, 0x6e, 0x10,
+	0xec, 0xa0, 0x02, 0xa4, 0xf4, 0x2e, 0x57, 0x4e, 0xe9, 0x5d, 0xf4, 0x73, 0x28, 0x18, 0x6a, 0x07,
+	0x1b, 0x8a, 0x8b, 0x0d, 0xac, 0x11, 0xcb, 0x29, 0xa5, 0xbc, 0x8c, 0xeb, 0x93, 0x19, 0x8f, 0x19,
+	0xaa, 0x1e, 0x53, 0xad, 0x73, 0xae, 0xc4, 0xf2, 0xce, 0x1b, 0x61, 0x99, 0xf8, 0x13, 0x40, 0x93,
+	0xa0, 0x45, 0xb2, 0x97, 0x7e, 0x09, 0x25, 0xee, 0xf4, 0xd0, 0x50, 0x5d, 0xf7, 0x50, 0xb5, 0xd5,
+	0x8e, 0x6e, 0xe8, 0x44, 0xc7, 0x2e, 0xda, 0x05, 0xd0, 0xec, 0xbe, 0xd2, 0xd3, 0x0d, 0x43, 0x77,
+	0x3d, 0x73, 0xcb, 0x72, 0x46, 0xb3, 0xfb, 0x27, 0x9e, 0x00, 0xdd, 0x85, 0x5c, 0x0f, 0xf7, 0x2c,
+	0x67, 0xa8, 0x74, 0x86, 0xb4, 0x2c, 0x52, 0x1e, 0x20, 0xcb, 0x64, 0x4f, 0xa8, 0x48, 0xfa, 0x93,
+	0x00, 0x6b, 0xdc, 0x3c, 0xfa, 0x11, 0xac, 0xd3, 0xea, 0xf4, 0x5e, 0x39, 0xb5, 0x95, 0xad, 0xef,
+	0x56, 0xa9, 0x60, 0x94, 0xfd, 0x69, 0xe7, 0x57, 0x58, 0x23, 0x27, 0x1c, 0x24, 0x07, 0x70, 0x74,
+	0x00, 0x69, 0xd7, 0xc6, 0x5a, 0x29, 0xe5, 0xab, 0x25, 0xf0, 0x46, 0x4b, 0x45, 0xf6, 0xa0, 0xe8,
+	0x03, 0x58, 0x75, 0x89, 0x4a, 0xfa, 0xb4, 0x5a, 0xa9, 0xd2, 0xdb, 0xc9, 0x4a, 0x1e, 0x4c, 0xe6,
+	0x70, 0xe9, 0x2e, 0x64, 0x8e, 0x7a, 0xea, 0x35, 0xeb, 0x93, 0x2d, 0x58, 0xd1, 0xe9, 0x81, 0x73,
+	0xc9, 0x0e, 0xd2, 0x3e, 0x64, 0x5a, 0x3d, 0x9b, 0x0c, 0x9b, 0xba, 0xfb, 0x8a, 0x92, 0xe4, 0xea,
+	0x9f, 0x63, 0xce, 0x01, 0x27, 0x89, 0x4a, 0x18, 0x03, 0xbf, 0x4e, 0x43, 0x91, 0xd5, 0xf1, 0xa1,
+	0x65, 0x9a, 0x58, 0x23, 0xba, 0x65, 0x2e, 0xdc, 0x7e, 0x72, 0x4c, 0xfb, 0xd5, 0x93, 0xfa, 0x65,
+	0xe4, 0x67, 0x6a, 0x13, 0x9e, 0xc7, 0x35, 0xe1, 0x3c, 0x46, 0xa7, 0xb4, 0x22, 0x52, 0x60, 0x03,
+	0x9b, 0x9a, 0x33, 0xb4, 0x29, 0x92, 0x19, 0x5e, 0xf1, 0x0c, 0xbf, 0x3f, 0x87, 0xe1, 0x56, 0xa0,
+	0x39, 0x32, 0x5e, 0xc0, 0x63, 0xc2, 0x6f, 0xb7, 0xd7, 0xc5, 0x06, 0x6c, 0xc6, 0x04, 0xb9, 0xd0,
+	0x75, 0xf1, 0x17, 0x01, 0x56, 0x59, 0xe6, 0x08, 0x41, 0xda, 0x54, 0x7b, 0x7e, 0x6d, 0x79, 0xbf,
+	0xbd, 0xca, 0xc0, 0x03, 0x5d, 0x0b, 0x2a, 0x80, 0x9d, 0xd0, 0x63, 0x00, 0x4c, 0x4b, 0x4e, 0xe9,
+	0xea, 0xee, 0xab, 0x52, 0xda, 0x2b, 0xe9, 0xb7, 0x26, 0x39, 0x0d, 0xca, 0x52, 0xce, 0x60, 0xff,
+	0x27, 0x7a, 0x02, 0xa0, 0x05, 0x2c, 0x97, 0x56, 0x3c, 0x5d, 0x69, 0xf6, 0xfb, 0x90, 0x43, 0x5a,
+	0xd2, 0xef, 0x53, 0x50, 0x6c, 0x63, 0xf2, 0xa9, 0xe5, 0xbc, 0x3a, 0x32, 0x09, 0x76, 0xae, 0x54,
+	0x2d, 0x3e, 0x81, 0x5d, 0x00, 0x93, 0xe1, 0x14, 0xbd, 0xcb, 0x93, 0xc8, 0x70, 0xc9, 0x51, 0x97,
+	0x52, 0xa5, 0xdb, 0xac, 0x84, 0x33, 0x32, 0xfd, 0x19, 0xa9, 0xed, 0xc4, 0x32, 0x8c, 0x3a, 0x9f,
+	0x5a, 0xdb, 0x0f, 0x00, 0x75, 0xb1, 0x81, 0xaf, 0x55, 0x82, 0xbb, 0x8a, 0xed, 0xe0, 0x2b, 0xfd,
+	0x33, 0xec, 0x7a, 0x95, 0x98, 0x91, 0x6f, 0x05, 0x4f, 0xce, 0xf8, 0x83, 0xff, 0xb1, 0xa8, 0xa4,
+	0x3f, 0xa7, 0x20, 0x1b, 0xba, 0x80, 0xd0, 0x03, 0x58, 0xb1, 0xad, 0x4f, 0x79, 0x73, 0x17, 0xea,
+	0xb7, 0x27, 0x93, 0x39, 0xa3, 0x8f, 0x65, 0x86, 0x42, 0x07, 0xfe, 0x1d, 0x93, 0x4a, 0x7a, 0xab,
+	0xc1, 0x7d, 0xc4, 0x2f, 0x20, 0x1a, 0x8b, 0x46, 0x6f, 0x6b, 0xef, 0x6e, 0xcb, 0xc8, 0xec, 0x80,
+	0xbe, 0x0b, 0x79, 0xfd, 0xda, 0xd4, 0x47, 0xad, 0x97, 0xf6, 0x8a, 0x2f, 0xe7, 0x0b, 0xbd, 0x0e,
+	0xad, 0xc3, 0xda, 0xc0, 0x7b, 0xd1, 0x2e, 0xef, 0xcc, 0x52, 0x52, 0x25, 0xc8, 0x3e, 0x10, 0xfd,
+	0x14, 0x50, 0xf0, 0x4e, 0x7d, 0xfe, 0xdd, 0xd2, 0x6a, 0x65, 0x39, 0xbe, 0x90, 0xa2, 0xaf, 0x4a,
+	0xbe, 0x65, 0x46, 0x24, 0xae, 0xf4, 0x87, 0x14, 0xe4, 0xc7, 0xee, 0x5f, 0x54, 0x83, 0x4d, 0xab,
+	0xe3, 0x62, 0x67, 0x80, 0xbb, 0xca, 0x35, 0x36, 0xb1, 0xa3, 0x7a, 0xe5, 0xca, 0x2e, 0x54, 0xe4,
+	0x3f, 0xfa, 0x38, 0x78, 0x82, 0x7e, 0x08, 0x2b, 0xf4, 0xca, 0x66, 0xbc, 0x15, 0xea, 0xe5, 0xa9,
+	0x17, 0x3c, 0x96, 0x19, 0x18, 0xbd, 0x05, 0x19, 0x8f, 0x43, 0xc5, 0xc1, 0x57, 0x9c, 0xbe, 0x75,
+	0x4f, 0x20, 0xe3, 0x2b, 0xf4, 0xe1, 0x88, 0x1c, 0x56, 0x88, 0xe5, 0xc4, 0xa5, 0x84, 0x0d, 0x8d,
+	0x80, 0xa2, 0x97, 0xb1, 0x14, 0x31, 0x86, 0xef, 0xcd, 0xa6, 0x88, 0x9b, 0x8b, 0x21, 0xca, 0x82,
+	0x5c, 0xd8, 0x63, 0xd2, 0xa5, 0x11, 0x3b, 0x36, 0x1e, 0xf9, 0x0c, 0x2d, 0x7b, 0x0c, 0xed, 0x4e,
+	0x4b, 0xc6, 0x27, 0x48, 0xfa, 0x9d, 0x00, 0x3b, 0xf1, 0xe1, 0x2d, 0xe4, 0xfb, 0xa3, 0x71, 0xdf,
+	0x7b, 0xf3, 0x71, 0x10, 0xbc, 0x26, 0x7e, 0x4f, 0xa4, 0x83, 0x7b, 0x42, 0x72, 0x20, 0x17, 0x5e,
+	0x54, 0x62, 0x83, 0x69, 0x43, 0x4e, 0x0b, 0x2d, 0x30, 0xbc, 0xa3, 0xf6, 0x13, 0x2b, 0x63, 0x62,
+	0xe5, 0x91, 0xc7, 0xf4, 0xa5, 0x3e, 0xa0, 0x30, 0x92, 0xd3, 0x70, 0x08, 0x79, 0x6e, 0x50, 0x61,
+	0x5d, 0xc8, 0xb6, 0x99, 0xf2, 0x74, 0x37, 0x72, 0xae, 0x17, 0x0e, 0x5f, 0x84, 0xf5, 0x4f, 0xfa,
+	0xaa, 0x49, 0x74, 0x32, 0xe4, 0x8b, 0x53, 0x70, 0x96, 0xf6, 0xa1, 0x70, 0x89, 0x1d, 0x97, 0xde,
+	0xc1, 0xf8, 0x93, 0x3e, 0x76, 0x09, 0x2a, 0xc1, 0xda, 0x80, 0x49, 0x78, 0xbe, 0xfe, 0x51, 0xfa,
+	0x4a, 0x80, 0x8d, 0x00, 0xec, 0xda, 0x96, 0xe9, 0x62, 0xba, 0x98, 0x39, 0x7d, 0x93, 0xe8, 0x3d,
+	0xac, 0x84, 0x28, 0xca, 0x72, 0x59, 0x9b, 0x32, 0xb5, 0x07, 0x1b, 0x3e, 0xc4, 0x37, 0xcc, 0xde,
+	0x5f, 0x81, 0x8b, 0xb9, 0x4d, 0x24, 0x45, 0x28, 0x65, 0x37, 0xf7, 0x38, 0x4d, 0x6d, 0xd8, 0x3c,
+	0xd6, 0x5d, 0xc2, 0xb3, 0x75, 0xfd, 0xa0, 0x3f, 0x80, 0xd5, 0x2b, 0x6f, 0x91, 0x2d, 0x09, 0x33,
+	0x56, 0x30, 0xb6, 0xef, 0xca, 0x1c, 0x2e, 0x9d, 0xc0, 0xd6, 0xb8, 0x3d, 0x9e, 0xd7, 0x7b, 0xb0,
+	0xce, 0x2d, 0x50, 0xce, 0x69, 0x6b, 0xdd, 0x49, 0x34, 0x29, 0x07, 0x50, 0xe9, 0x73, 0xd8, 0x7a,
+	0xa9, 0x12, 0xed, 0xe6, 0xff, 0x15, 0x1f, 0xfa, 0x3e, 0x14, 0x1d, 0xec, 0x5a, 0x7d, 0x47, 0x8b,
+	0xb2, 0xb7, 0xe1, 0xcb, 0x39, 0x7d, 0xd2, 0x1f, 0x05, 0xd8, 0x8e, 0x38, 0xe7, 0xc9, 0x1c, 0x40,
+	0x9a, 0x0c, 0x6d, 0xcc, 0x87, 0x44, 0x74, 0x15, 0xf6, 0x74, 0x5a, 0x03, 0x6c, 0x92, 0x8b, 0xa1,
+	0x8d, 0x65, 0x0f, 0x8a, 0x1e, 0xc1, 0x1a, 0x8f, 0x90, 0x57, 0xf6, 0x94, 0xf4, 0x7d, 0x64, 0x6c,
+	0xb0, 0xcb, 0xf1, 0xc1, 0x3e, 0x87, 0xad, 0x43, 0x07, 0xab, 0x04, 0xfb, 0x46, 0x38, 0x51, 0x21,
+	0xbf, 0xc2, 0xbc, 0x7e, 0xa5, 0x63, 0xd8, 0x8e, 0x18, 0xe3, 0x89, 0xbf, 0x91, 0xb5, 0xf7, 0x60,
+	0xab, 0x89, 0x0d, 0x3c, 0x11, 0xda, 0x2e, 0x80, 0xdf, 0x8b, 0xc1, 0x37, 0x57, 0x86, 0x4b, 0x8e,
+	0xba, 0xd2, 0x6d, 0xd8, 0x8e, 0xa8, 0xb1, 0x20, 0xa4, 0xff, 0x08, 0xf0, 0xf6, 0x0b, 0xbb, 0x3b,
+	0x0a, 0xaf, 0x61, 0x9a, 0x16, 0xf1, 0x06, 0x8b, 0x3b, 0x9f, 0x6d, 0xd4, 0x85, 0xac, 0x3a, 0x52,
+	0xe2, 0xdf, 0x74, 0x4f, 0x26, 0x73, 0x99, 0xe1, 0xa6, 0x1a, 0x12, 0xb1, 0x4d, 0x26, 0x6c, 0x56,
+	0xfc, 0x31, 0x14, 0xa3, 0x80, 0x85, 0x96, 0x13, 0x09, 0x2a, 0xc9, 0x01, 0x70, 0x32, 0x74, 0xb8,
+	0x33, 0x86, 0x61, 0xeb, 0xc9, 0x7c, 0x2c, 0x04, 0xcb, 0x4e, 0x6a, 0x9e, 0x65, 0x47, 0xfa, 0x0e,
+	0x88, 0x71, 0xae, 0x78, 0x20, 0x57, 0xb0, 0xd9, 0x20, 0x44, 0xd5, 0x6e, 0xf8, 0x06, 0x32, 0x5f,
+	0x08, 0x0f, 0x61, 0x95, 0x8d, 0x61, 0xde, 0x15, 0xc9, 0x1b, 0x0d, 0xc7, 0x49, 0x3b, 0xb0, 0x35,
+	0xee, 0x87, 0xfb, 0x7f, 0x06, 0x9b, 0x4d, 0xbc, 0xb0, 0x7f, 0x7f, 0x12, 0xa5, 0x46, 0x93, 0x88,
+	0x7a, 0x68, 0xe2, 0x18, 0x0f, 0xbf, 0x11, 0x60, 0x97, 0xb9, 0x9e, 0xd8, 0x92, 0xe6, 0x73, 0x76,
+	0x0a, 0xb7, 0x26, 0x16, 0x0d, 0x9e, 0xf7, 0x3c, 0xab, 0x58, 0x31, 0xba, 0x61, 0x48, 0x15, 0x28,
+	0x27, 0x05, 0xc4, 0x63, 0x96, 0x61, 0xb7, 0x89, 0xe3, 0x11, 0x6f, 0xcc, 0x4f, 0x05, 0xca, 0x4d,
+	0x3c, 0xd5, 0xeb, 0x06, 0xe4, 0xf9, 0x56, 0xc4, 0xbc, 0x48, 0x37, 0x50, 0xf0, 0x05, 0xfc, 0x26,
+	0xb9, 0x84, 0xad, 0xb1, 0x41, 0xac, 0xf0, 0x2f, 0x7e, 0x36, 0x1b, 0xde, 0x99, 0x3e, 0x8f, 0xb9,
+	0x2d, 0xd4, 0x9b, 0x90, 0x49, 0xf7, 0x21, 0xdb, 0xfa, 0x0c, 0x6b, 0x73, 0xde, 0x31, 0x15, 0xc8,
+	0x31, 0x34, 0x8f, 0xaa, 0x08, 0xcb, 0x7d, 0xc7, 0xf0, 0xbb, 0xb3, 0xef, 0x18, 0xfb, 0xef, 0xc0,
+	0x8a, 0x57, 0xe7, 0x28, 0x07, 0xeb, 0x67, 0xa7, 0x2f, 0x5b, 0xb2, 0x72, 0xda, 0x2e, 0x2e, 0xa1,
+	0x3c, 0x64, 0xf8, 0xe9, 0xe9, 0xd3, 0xa2, 0xb0, 0xff, 0x3e, 0x64, 0x43, 0xeb, 0x18, 0x42, 0x50,
+	0xb8, 0x3c, 0x3d, 0x7e, 0x71, 0xd2, 0x52, 0xce, 0x5a, 0xed, 0xe6, 0x51, 0xfb, 0xe3, 0xe2, 0x12,
+	0xda, 0x84, 0x0d, 0x2e, 0x6b, 0x5c, 0x5c, 0x34, 0x0e, 0x9f, 0xb5, 0x9a, 0x45, 0x61, 0xff, 0x12,
+	0xb6, 0x63, 0x57, 0x29, 0xb4, 0x0b, 0x77, 0xda, 0xad, 0x8b, 0x97, 0xa7, 0xf2, 0x73, 0xe5, 0xa8,
+	0x7d, 0xd1, 0x92, 0x9f, 0x36, 0x0e, 0xc3, 0xc6, 0xca, 0x20, 0x4e, 0x3e, 0x0e, 0xd9, 0xfd, 0x52,
+	0x08, 0x36, 0x2e, 0x66, 0x6f, 0x13, 0x36, 0x4e, 0x1a, 0x87, 0xcf, 0x8e, 0xda, 0x91, 0x90, 0x7c,
+	0xa1, 0xfc, 0xa2, 0xdd, 0xa6, 0x42, 0x01, 0x6d, 0xc3, 0x2d, 0x5f, 0x78, 0xfe, 0xe2, 0x9c, 0x82,
+	0x5b, 0xcd, 0x62, 0x0a, 0xed, 0x00, 0xf2, 0xc5, 0x17, 0x2d, 0xf9, 0xe4, 0xa8, 0xdd, 0xb8, 0x68,
+	0x35, 0x8b, 0xcb, 0xe8, 0x36, 0x6c, 0x46, 0xe5, 0xd4, 0x4e, 0xba, 0xfe, 0x57, 0x80, 0x82, 0x7f,
+	0x73, 0xb3, 0xb5, 0x04, 0x9d, 0xc1, 0x9a, 0xbf, 0x9a, 0x54, 0x62, 0xfa, 0x7c, 0x6c, 0x6d, 0x12,
+	0xef, 0x4e, 0x41, 0xf0, 0x32, 0x5b, 0x42, 0x0a, 0xe4, 0xc2, 0xdb, 0x06, 0xfa, 0xde, 0xa4, 0x52,
+	0xcc, 0x76, 0x23, 0xbe, 0x3b, 0x0b, 0x16, 0x38, 0xe8, 0x42, 0x7e, 0x6c, 0x05, 0x40, 0x31, 0xaa,
+	0x71, 0x0b, 0x8a, 0xb8, 0x37, 0x13, 0xe7, 0xfb, 0x78, 0x28, 0xa0, 0x0e, 0xe4, 0xc7, 0xe6, 0x6d,
+	0x9c, 0x97, 0xb8, 0xe9, 0x2e, 0xee, 0xcd, 0xc4, 0x05, 0x99, 0x74, 0x20, 0x3f, 0x36, 0x4e, 0xe3,
+	0x7c, 0xc4, 0x8d, 0x69, 0x71, 0x6f, 0x26, 0x2e, 0xf0, 0xf1, 0x95, 0x00, 0xa5, 0xa4, 0x89, 0x85,
+	0x0e, 0x16, 0x1e, 0xaf, 0x62, 0x7d, 0x11, 0x15, 0xde, 0xc2, 0x16, 0xa0, 0xc9, 0x29, 0x85, 0x7e,
+	0x30, 0xc3, 0x52, 0x78, 0x6c, 0x8a, 0xf7, 0xe7, 0x03, 0x73, 0x87, 0x0a, 0xe4, 0xc2, 0x03, 0x29,
+	0xae, 0x06, 0x63, 0x06, 0xa3, 0xf8, 0xee, 0x2c, 0x58, 0xb8, 0xc8, 0x9b, 0x78, 0xba, 0x83, 0x26,
+	0x9e, 0xcb, 0x41, 0xec, 0x58, 0x5b, 0x42, 0x5f, 0xc0, 0x4e, 0xfc, 0x18, 0x41, 0xb5, 0xa4, 0x20,
+	0x13, 0xc6, 0x89, 0xf8, 0x70, 0x7e, 0x05, 0x4e, 0xdf, 0x17, 0xb0, 0xd3, 0xc4, 0xf3, 0x3a, 0x6f,
+	0xe2, 0x05, 0x9d, 0x4f, 0x1f, 0x54, 0xe8, 0x39, 0xac, 0xf2, 0x0f, 0xc3, 0x98, 0x0f, 0x88, 0xb1,
+	0x11, 0x26, 0x56, 0x92, 0x01, 0xdc, 0x58, 0x0b, 0xd2, 0x74, 0x98, 0xa0, 0x98, 0x6f, 0xf5, 0xd0,
+	0x48, 0x12, 0xcb, 0x49, 0x8f, 0x99, 0x99, 0x27, 0x3f, 0xfb, 0xfa, 0x9b, 0xb2, 0xf0, 0xcf, 0x6f,
+	0xca, 0x4b, 0x5f, 0xbe, 0x2e, 0x0b, 0x5f, 0xbf, 0x2e, 0x0b, 0x7f, 0x7f, 0x5d, 0x16, 0xfe, 0xf5,
+	0xba, 0x2c, 0xfc, 0xf6, 0xdf, 0xe5, 0xa5, 0x5f, 0x3c, 0x5e, 0xe0, 0xff, 0x24, 0xe6, 0x26, 0xf8,
+	0x4b, 0xa9, 0xb3, 0xea, 0xfd, 0x9f, 0xf4, 0xe8, 0xbf, 0x03, 0x00, 0xbb, 0xec, 0x40, 0xe3, 0xe0,
+	0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2345,6 +2489,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MachineRuntimeClient interface {
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
 	ListMachines(ctx context.Context, in *ListMachinesRequest, opts ...grpc.CallOption) (*ListMachinesResponse, error)
+	WatchMachines(ctx context.Context, in *WatchMachinesRequest, opts ...grpc.CallOption) (MachineRuntime_WatchMachinesClient, error)
 	CreateMachine(ctx context.Context, in *CreateMachineRequest, opts ...grpc.CallOption) (*CreateMachineResponse, error)
 	DeleteMachine(ctx context.Context, in *DeleteMachineRequest, opts ...grpc.CallOption) (*DeleteMachineResponse, error)
 	UpdateMachineAnnotations(ctx context.Context, in *UpdateMachineAnnotationsRequest, opts ...grpc.CallOption) (*UpdateMachineAnnotationsResponse, error)
@@ -2383,6 +2528,38 @@ func (c *machineRuntimeClient) ListMachines(ctx context.Context, in *ListMachine
 	return out, nil
 }
 
+func (c *machineRuntimeClient) WatchMachines(ctx context.Context, in *WatchMachinesRequest, opts ...grpc.CallOption) (MachineRuntime_WatchMachinesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MachineRuntime_serviceDesc.Streams[0], "/machine.v1alpha1.MachineRuntime/WatchMachines", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineRuntimeWatchMachinesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineRuntime_WatchMachinesClient interface {
+	Recv() (*WatchMachinesResponse, error)
+	grpc.ClientStream
+}
+
+type machineRuntimeWatchMachinesClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineRuntimeWatchMachinesClient) Recv() (*WatchMachinesResponse, error) {
+	m := new(WatchMachinesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *machineRuntimeClient) CreateMachine(ctx context.Context, in *CreateMachineRequest, opts ...grpc.CallOption) (*CreateMachineResponse, error) {
 	out := new(CreateMachineResponse)
 	err := c.cc.Invoke(ctx, "/machine.v1alpha1.MachineRuntime/CreateMachine", in, out, opts...)
@@ -2477,6 +2654,7 @@ func (c *machineRuntimeClient) Exec(ctx context.Context, in *ExecRequest, opts .
 type MachineRuntimeServer interface {
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	ListMachines(context.Context, *ListMachinesRequest) (*ListMachinesResponse, error)
+	WatchMachines(*WatchMachinesRequest, MachineRuntime_WatchMachinesServer) error
 	CreateMachine(context.Context, *CreateMachineRequest) (*CreateMachineResponse, error)
 	DeleteMachine(context.Context, *DeleteMachineRequest) (*DeleteMachineResponse, error)
 	UpdateMachineAnnotations(context.Context, *UpdateMachineAnnotationsRequest) (*UpdateMachineAnnotationsResponse, error)
@@ -2499,6 +2677,9 @@ func (*UnimplementedMachineRuntimeServer) Version(ctx context.Context, req *Vers
 func (*UnimplementedMachineRuntimeServer) ListMachines(ctx context.Context, req *ListMachinesRequest) (*ListMachinesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMachines not implemented")
 }
+func (*UnimplementedMachineRuntimeServer) WatchMachines(req *WatchMachinesRequest, srv MachineRuntime_WatchMachinesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMachines not implemented")
+}
 func (*UnimplementedMachineRuntimeServer) CreateMachine(ctx context.Context, req *CreateMachineRequest) (*CreateMachineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMachine not implemented")
 }
@@ -2570,6 +2751,27 @@ func _MachineRuntime_ListMachines_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineRuntime_WatchMachines_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMachinesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineRuntimeServer).WatchMachines(m, &machineRuntimeWatchMachinesServer{stream})
+}
+
+type MachineRuntime_WatchMachinesServer interface {
+	Send(*WatchMachinesResponse) error
+	grpc.ServerStream
+}
+
+type machineRuntimeWatchMachinesServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineRuntimeWatchMachinesServer) Send(m *WatchMachinesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _MachineRuntime_CreateMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMachineRequest)
 	if err := dec(in); err != nil {
@@ -2803,7 +3005,13 @@ var _MachineRuntime_serviceDesc = grpc.ServiceDesc{
 			Handler:    _MachineRuntime_Exec_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMachines",
+			Handler:       _MachineRuntime_WatchMachines_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api.proto",
 }
 
@@ -3262,6 +3470,15 @@ func (m *NetworkInterface) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatedPrefixes) > 0 {
+		for iNdEx := len(m.DelegatedPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DelegatedPrefixes[iNdEx])
+			copy(dAtA[i:], m.DelegatedPrefixes[iNdEx])
+			i = encodeVarintApi(dAtA, i, uint64(len(m.DelegatedPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Attributes) > 0 {
 		for k := range m.Attributes {
 			v := m.Attributes[k]
@@ -3682,6 +3899,15 @@ func (m *VersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Capabilities[iNdEx])
+			copy(dAtA[i:], m.Capabilities[iNdEx])
+			i = encodeVarintApi(dAtA, i, uint64(len(m.Capabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.RuntimeVersion) > 0 {
 		i -= len(m.RuntimeVersion)
 		copy(dAtA[i:], m.RuntimeVersion)
@@ -3771,6 +3997,95 @@ func (m *ListMachinesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WatchMachinesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchMachinesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchMachinesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResourceVersion) > 0 {
+		i -= len(m.ResourceVersion)
+		copy(dAtA[i:], m.ResourceVersion)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ResourceVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchMachinesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchMachinesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchMachinesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResourceVersion) > 0 {
+		i -= len(m.ResourceVersion)
+		copy(dAtA[i:], m.ResourceVersion)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ResourceVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Machine != nil {
+		{
+			size, err := m.Machine.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CreateMachineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4626,6 +4941,12 @@ func (m *NetworkInterface) Size() (n int) {
 			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
 		}
 	}
+	if len(m.DelegatedPrefixes) > 0 {
+		for _, s := range m.DelegatedPrefixes {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4802,6 +5123,12 @@ func (m *VersionResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4833,39 +5160,76 @@ func (m *ListMachinesResponse) Size() (n int) {
 	return n
 }
 
-func (m *CreateMachineRequest) Size() (n int) {
+func (m *WatchMachinesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Machine != nil {
-		l = m.Machine.Size()
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ResourceVersion)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *CreateMachineResponse) Size() (n int) {
+func (m *WatchMachinesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Type != 0 {
+		n += 1 + sovApi(uint64(m.Type))
+	}
 	if m.Machine != nil {
 		l = m.Machine.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.ResourceVersion)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
-func (m *DeleteMachineRequest) Size() (n int) {
+func (m *CreateMachineRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.MachineId)
+	if m.Machine != nil {
+		l = m.Machine.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateMachineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Machine != nil {
+		l = m.Machine.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteMachineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MachineId)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
@@ -5269,6 +5633,7 @@ func (this *NetworkInterface) String() string {
 		`NetworkId:` + fmt.Sprintf("%v", this.NetworkId) + `,`,
 		`Ips:` + fmt.Sprintf("%v", this.Ips) + `,`,
 		`Attributes:` + mapStringForAttributes + `,`,
+		`DelegatedPrefixes:` + fmt.Sprintf("%v", this.DelegatedPrefixes) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5386,6 +5751,7 @@ func (this *VersionResponse) String() string {
 	s := strings.Join([]string{`&VersionResponse{`,
 		`RuntimeName:` + fmt.Sprintf("%v", this.RuntimeName) + `,`,
 		`RuntimeVersion:` + fmt.Sprintf("%v", this.RuntimeVersion) + `,`,
+		`Capabilities:` + fmt.Sprintf("%v", this.Capabilities) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5415,6 +5781,29 @@ func (this *ListMachinesResponse) String() string {
 	}, "")
 	return s
 }
+func (this *WatchMachinesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchMachinesRequest{`,
+		`Filter:` + strings.Replace(this.Filter.String(), "MachineFilter", "MachineFilter", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchMachinesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchMachinesResponse{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Machine:` + strings.Replace(this.Machine.String(), "Machine", "Machine", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CreateMachineRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -7546,6 +7935,38 @@ func (m *NetworkInterface) Unmarshal(dAtA []byte) error {
 			}
 			m.Attributes[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedPrefixes = append(m.DelegatedPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -8690,6 +9111,38 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.RuntimeVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -8881,6 +9334,261 @@ func (m *ListMachinesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WatchMachinesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchMachinesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchMachinesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &MachineFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchMachinesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchMachinesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchMachinesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v1alpha1.WatchEventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Machine", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Machine == nil {
+				m.Machine = &Machine{}
+			}
+			if err := m.Machine.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CreateMachineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
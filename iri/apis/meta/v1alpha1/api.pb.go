@@ -27,6 +27,75 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type WatchEventType int32
+
+const (
+	WatchEventType_WATCH_EVENT_ADDED    WatchEventType = 0
+	WatchEventType_WATCH_EVENT_MODIFIED WatchEventType = 1
+	WatchEventType_WATCH_EVENT_DELETED  WatchEventType = 2
+	// WATCH_EVENT_BOOKMARK events carry no object, only the resource version up to which
+	// all events have been sent.
+	WatchEventType_WATCH_EVENT_BOOKMARK WatchEventType = 3
+)
+
+var WatchEventType_name = map[int32]string{
+	0: "WATCH_EVENT_ADDED",
+	1: "WATCH_EVENT_MODIFIED",
+	2: "WATCH_EVENT_DELETED",
+	3: "WATCH_EVENT_BOOKMARK",
+}
+
+var WatchEventType_value = map[string]int32{
+	"WATCH_EVENT_ADDED":    0,
+	"WATCH_EVENT_MODIFIED": 1,
+	"WATCH_EVENT_DELETED":  2,
+	"WATCH_EVENT_BOOKMARK": 3,
+}
+
+func (x WatchEventType) String() string {
+	return proto.EnumName(WatchEventType_name, int32(x))
+}
+
+func (WatchEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+// ErrorReason classifies why an IRI request failed.
+type ErrorReason int32
+
+const (
+	// ERROR_REASON_UNKNOWN is used for failures that could not be classified.
+	ErrorReason_ERROR_REASON_UNKNOWN ErrorReason = 0
+	// ERROR_REASON_TRANSIENT failures are expected to resolve on their own, the request should be retried.
+	ErrorReason_ERROR_REASON_TRANSIENT ErrorReason = 1
+	// ERROR_REASON_INSUFFICIENT_CAPACITY means the runtime currently lacks the capacity to fulfill the request.
+	ErrorReason_ERROR_REASON_INSUFFICIENT_CAPACITY ErrorReason = 2
+	// ERROR_REASON_INVALID_SPEC means the request cannot succeed without being changed, e.g. due to an invalid image.
+	ErrorReason_ERROR_REASON_INVALID_SPEC ErrorReason = 3
+)
+
+var ErrorReason_name = map[int32]string{
+	0: "ERROR_REASON_UNKNOWN",
+	1: "ERROR_REASON_TRANSIENT",
+	2: "ERROR_REASON_INSUFFICIENT_CAPACITY",
+	3: "ERROR_REASON_INVALID_SPEC",
+}
+
+var ErrorReason_value = map[string]int32{
+	"ERROR_REASON_UNKNOWN":               0,
+	"ERROR_REASON_TRANSIENT":             1,
+	"ERROR_REASON_INSUFFICIENT_CAPACITY": 2,
+	"ERROR_REASON_INVALID_SPEC":          3,
+}
+
+func (x ErrorReason) String() string {
+	return proto.EnumName(ErrorReason_name, int32(x))
+}
+
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
 type ObjectMetadata struct {
 	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Annotations          map[string]string `protobuf:"bytes,2,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -112,38 +181,120 @@ func (m *ObjectMetadata) GetDeletedAt() int64 {
 	return 0
 }
 
+// ErrorDetail is attached to the status of a failed IRI request to classify the failure.
+type ErrorDetail struct {
+	Reason ErrorReason `protobuf:"varint,1,opt,name=reason,proto3,enum=meta.v1alpha1.ErrorReason" json:"reason,omitempty"`
+	// retry_after_seconds is the minimum number of seconds to wait before retrying the request, if non-zero.
+	RetryAfterSeconds int64 `protobuf:"varint,2,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+	// field is the path of the offending request field, if any (e.g. machine.spec.image).
+	Field                string   `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ErrorDetail) Reset()      { *m = ErrorDetail{} }
+func (*ErrorDetail) ProtoMessage() {}
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+func (m *ErrorDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorDetail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorDetail.Merge(m, src)
+}
+func (m *ErrorDetail) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorDetail proto.InternalMessageInfo
+
+func (m *ErrorDetail) GetReason() ErrorReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNKNOWN
+}
+
+func (m *ErrorDetail) GetRetryAfterSeconds() int64 {
+	if m != nil {
+		return m.RetryAfterSeconds
+	}
+	return 0
+}
+
+func (m *ErrorDetail) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("meta.v1alpha1.WatchEventType", WatchEventType_name, WatchEventType_value)
+	proto.RegisterEnum("meta.v1alpha1.ErrorReason", ErrorReason_name, ErrorReason_value)
 	proto.RegisterType((*ObjectMetadata)(nil), "meta.v1alpha1.ObjectMetadata")
 	proto.RegisterMapType((map[string]string)(nil), "meta.v1alpha1.ObjectMetadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "meta.v1alpha1.ObjectMetadata.LabelsEntry")
+	proto.RegisterType((*ErrorDetail)(nil), "meta.v1alpha1.ErrorDetail")
 }
 
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x4f, 0x4b, 0xfb, 0x40,
-	0x14, 0xec, 0x26, 0xbf, 0x16, 0xba, 0xe5, 0x57, 0xca, 0xe2, 0x21, 0x14, 0x5c, 0x8a, 0xa7, 0x7a,
-	0x68, 0x42, 0xf5, 0xe0, 0x9f, 0x83, 0x10, 0xc1, 0x9b, 0xa2, 0x14, 0xbc, 0x78, 0x91, 0x97, 0xe4,
-	0x99, 0xae, 0xa6, 0xd9, 0xb0, 0xdd, 0x16, 0x7a, 0xf3, 0x13, 0x88, 0x1f, 0xab, 0x47, 0x8f, 0x1e,
-	0x6d, 0xfc, 0x22, 0xd2, 0x4d, 0xd4, 0xd4, 0x83, 0xe0, 0xed, 0xcd, 0xcc, 0x9b, 0x49, 0x76, 0x1e,
-	0x6d, 0x42, 0x26, 0xdc, 0x4c, 0x49, 0x2d, 0xd9, 0xff, 0x09, 0x6a, 0x70, 0xe7, 0x43, 0x48, 0xb2,
-	0x31, 0x0c, 0xbb, 0x83, 0x58, 0xe8, 0xf1, 0x2c, 0x70, 0x43, 0x39, 0xf1, 0x62, 0x19, 0x4b, 0xcf,
-	0x6c, 0x05, 0xb3, 0x3b, 0x83, 0x0c, 0x30, 0x53, 0xe1, 0xde, 0x79, 0xb2, 0x69, 0xfb, 0x32, 0xb8,
-	0xc7, 0x50, 0x5f, 0xa0, 0x86, 0x08, 0x34, 0xb0, 0x36, 0xb5, 0x44, 0xe4, 0x90, 0x1e, 0xe9, 0x37,
-	0x47, 0x96, 0x88, 0xd8, 0x15, 0x6d, 0x41, 0x9a, 0x4a, 0x0d, 0x5a, 0xc8, 0x74, 0xea, 0x58, 0x3d,
-	0xbb, 0xdf, 0xda, 0x73, 0xdd, 0x8d, 0xcf, 0xba, 0x9b, 0x19, 0xae, 0xff, 0x6d, 0x38, 0x4b, 0xb5,
-	0x5a, 0x8c, 0xaa, 0x11, 0xcc, 0xa7, 0x8d, 0x04, 0x02, 0x4c, 0xa6, 0x8e, 0x6d, 0xc2, 0x76, 0x7f,
-	0x0f, 0x3b, 0x37, 0xbb, 0x45, 0x4e, 0x69, 0x64, 0x9c, 0xd2, 0x18, 0x53, 0x54, 0x26, 0xd1, 0xf9,
-	0xd7, 0x23, 0x7d, 0x7b, 0x54, 0x61, 0xd8, 0x36, 0xa5, 0xa1, 0x42, 0xd0, 0x18, 0xdd, 0x82, 0x76,
-	0xea, 0x46, 0x6f, 0x96, 0x8c, 0xaf, 0xd7, 0x72, 0x84, 0x09, 0x96, 0x72, 0xa3, 0x90, 0x4b, 0xc6,
-	0xd7, 0xdd, 0x13, 0xda, 0xf9, 0xf9, 0x02, 0xd6, 0xa1, 0xf6, 0x03, 0x2e, 0xca, 0x5e, 0xd6, 0x23,
-	0xdb, 0xa2, 0xf5, 0x39, 0x24, 0x33, 0x74, 0x2c, 0xc3, 0x15, 0xe0, 0xd8, 0x3a, 0x24, 0xdd, 0x23,
-	0xda, 0xaa, 0xfc, 0xf4, 0x5f, 0xac, 0xa7, 0xd7, 0xcb, 0x15, 0x27, 0xaf, 0x2b, 0x5e, 0x7b, 0xcc,
-	0x39, 0x59, 0xe6, 0x9c, 0xbc, 0xe4, 0x9c, 0xbc, 0xe5, 0x9c, 0x3c, 0xbf, 0xf3, 0xda, 0xcd, 0x41,
-	0xe5, 0xba, 0x42, 0xc9, 0x34, 0x94, 0x0a, 0x07, 0x11, 0xce, 0xbf, 0x80, 0x27, 0x94, 0xf0, 0x20,
-	0x13, 0x53, 0x6f, 0x5d, 0xa9, 0xf7, 0x59, 0x69, 0xd0, 0x30, 0xe7, 0xde, 0xff, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x99, 0x8d, 0x3d, 0xfa, 0x39, 0x02, 0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6b, 0xdb, 0x3e,
+	0x18, 0xc6, 0x23, 0xfb, 0xdb, 0x40, 0x15, 0xbe, 0xc1, 0x55, 0xbb, 0xcd, 0x0b, 0xd4, 0x94, 0x1e,
+	0x46, 0x57, 0xa8, 0x43, 0xbb, 0xc3, 0x7e, 0x1c, 0x06, 0x6a, 0xac, 0x32, 0xd3, 0xd6, 0x2e, 0x8a,
+	0xdb, 0xb2, 0x5d, 0x8c, 0x12, 0xab, 0xa9, 0x37, 0xd7, 0x0a, 0x8a, 0x12, 0xc8, 0x6d, 0xb7, 0xc1,
+	0x0e, 0x63, 0x7f, 0x56, 0x8f, 0x3b, 0xee, 0xb8, 0x66, 0xff, 0xc8, 0xb0, 0x9c, 0x6e, 0x4e, 0x0e,
+	0x83, 0xdd, 0xf4, 0xbe, 0x9f, 0xe7, 0x79, 0xa4, 0x57, 0x42, 0x70, 0x95, 0x0d, 0x53, 0x77, 0x28,
+	0x85, 0x12, 0xe8, 0xff, 0x1b, 0xae, 0x98, 0x3b, 0xd9, 0x67, 0xd9, 0xf0, 0x9a, 0xed, 0xb7, 0xf6,
+	0x06, 0xa9, 0xba, 0x1e, 0xf7, 0xdc, 0xbe, 0xb8, 0x69, 0x0f, 0xc4, 0x40, 0xb4, 0xb5, 0xaa, 0x37,
+	0xbe, 0xd2, 0x95, 0x2e, 0xf4, 0xaa, 0x74, 0x6f, 0x7f, 0x31, 0x61, 0x33, 0xec, 0xbd, 0xe7, 0x7d,
+	0x75, 0xca, 0x15, 0x4b, 0x98, 0x62, 0xa8, 0x09, 0x8d, 0x34, 0xb1, 0xc1, 0x16, 0xd8, 0x59, 0xa5,
+	0x46, 0x9a, 0xa0, 0x33, 0xd8, 0x60, 0x79, 0x2e, 0x14, 0x53, 0xa9, 0xc8, 0x47, 0xb6, 0xb1, 0x65,
+	0xee, 0x34, 0x0e, 0x5c, 0x77, 0x61, 0x5b, 0x77, 0x31, 0xc3, 0xc5, 0x7f, 0x0c, 0x24, 0x57, 0x72,
+	0x4a, 0xab, 0x11, 0x08, 0xc3, 0x7a, 0xc6, 0x7a, 0x3c, 0x1b, 0xd9, 0xa6, 0x0e, 0x7b, 0xfa, 0xf7,
+	0xb0, 0x13, 0xad, 0x2d, 0x73, 0xe6, 0x46, 0xe4, 0x40, 0x38, 0xe0, 0x39, 0x97, 0x3a, 0xd1, 0xfe,
+	0x6f, 0x0b, 0xec, 0x98, 0xb4, 0xd2, 0x41, 0x9b, 0x10, 0xf6, 0x25, 0x67, 0x8a, 0x27, 0x31, 0x53,
+	0xf6, 0x8a, 0xe6, 0xab, 0xf3, 0x0e, 0x56, 0x05, 0x4e, 0x78, 0xc6, 0xe7, 0xb8, 0x5e, 0xe2, 0x79,
+	0x07, 0xab, 0xd6, 0x6b, 0x68, 0x2d, 0x4f, 0x80, 0x2c, 0x68, 0x7e, 0xe0, 0xd3, 0xf9, 0xbd, 0x14,
+	0x4b, 0xb4, 0x01, 0x57, 0x26, 0x2c, 0x1b, 0x73, 0xdb, 0xd0, 0xbd, 0xb2, 0x78, 0x65, 0xbc, 0x00,
+	0xad, 0x97, 0xb0, 0x51, 0x39, 0xf4, 0xbf, 0x58, 0xb7, 0x3f, 0x01, 0xd8, 0x20, 0x52, 0x0a, 0xe9,
+	0x71, 0xc5, 0xd2, 0x0c, 0x1d, 0xc0, 0xba, 0xe4, 0x6c, 0x24, 0x72, 0x6d, 0x6f, 0x1e, 0xb4, 0x96,
+	0xee, 0x4a, 0x6b, 0xa9, 0x56, 0xd0, 0xb9, 0x12, 0xb9, 0x70, 0x5d, 0x72, 0x25, 0xa7, 0x31, 0xbb,
+	0x52, 0x5c, 0xc6, 0x23, 0xde, 0x17, 0x79, 0x32, 0xd2, 0x7b, 0x99, 0x74, 0x4d, 0x23, 0x5c, 0x90,
+	0x6e, 0x09, 0x8a, 0xd3, 0x5c, 0xa5, 0x3c, 0x4b, 0x6c, 0xb3, 0x3c, 0x8d, 0x2e, 0x76, 0x15, 0x6c,
+	0x5e, 0x32, 0xd5, 0xbf, 0x26, 0x13, 0x9e, 0xab, 0x68, 0x3a, 0xe4, 0xe8, 0x01, 0x5c, 0xbb, 0xc4,
+	0x51, 0xe7, 0x4d, 0x4c, 0x2e, 0x48, 0x10, 0xc5, 0xd8, 0xf3, 0x88, 0x67, 0xd5, 0x90, 0x0d, 0x37,
+	0xaa, 0xed, 0xd3, 0xd0, 0xf3, 0x8f, 0x7c, 0xe2, 0x59, 0x00, 0x3d, 0x82, 0xeb, 0x55, 0xe2, 0x91,
+	0x13, 0x12, 0x11, 0xcf, 0x32, 0x96, 0x2d, 0x87, 0x61, 0x78, 0x7c, 0x8a, 0xe9, 0xb1, 0x65, 0xee,
+	0x7e, 0xbe, 0x9f, 0xbf, 0x9c, 0xa9, 0x50, 0x12, 0x4a, 0x43, 0x1a, 0x53, 0x82, 0xbb, 0x61, 0x10,
+	0x9f, 0x07, 0xc7, 0x41, 0x78, 0x19, 0x58, 0x35, 0xd4, 0x82, 0x0f, 0x17, 0x48, 0x44, 0x71, 0xd0,
+	0xf5, 0x49, 0x10, 0x59, 0x00, 0x3d, 0x81, 0xdb, 0x0b, 0xcc, 0x0f, 0xba, 0xe7, 0x47, 0x47, 0x7e,
+	0xa7, 0xc0, 0x71, 0x07, 0x9f, 0xe1, 0x8e, 0x1f, 0xbd, 0xb5, 0x0c, 0xb4, 0x09, 0x1f, 0x2f, 0xe9,
+	0x2e, 0xf0, 0x89, 0xef, 0xc5, 0xdd, 0x33, 0xd2, 0xb1, 0xcc, 0xc3, 0xf3, 0xdb, 0x3b, 0x07, 0x7c,
+	0xbf, 0x73, 0x6a, 0x1f, 0x67, 0x0e, 0xb8, 0x9d, 0x39, 0xe0, 0xdb, 0xcc, 0x01, 0x3f, 0x66, 0x0e,
+	0xf8, 0xfa, 0xd3, 0xa9, 0xbd, 0x7b, 0x5e, 0xf9, 0x6a, 0xa9, 0x14, 0x79, 0x5f, 0x48, 0xbe, 0x97,
+	0xf0, 0xc9, 0xef, 0xa2, 0x9d, 0xca, 0xb4, 0xcd, 0x86, 0xe9, 0xa8, 0x5d, 0xbc, 0x59, 0xfb, 0xfe,
+	0xcd, 0x7a, 0x75, 0xfd, 0xf7, 0x9e, 0xfd, 0x1a, 0x00, 0x6c, 0x27, 0xe1, 0xf9, 0xc6, 0x03, 0x00,
+	0x00,
 }
 
 func (m *ObjectMetadata) Marshal() (dAtA []byte, err error) {
@@ -229,6 +380,46 @@ func (m *ObjectMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ErrorDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RetryAfterSeconds != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.RetryAfterSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Reason != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApi(v)
 	base := offset
@@ -278,6 +469,25 @@ func (m *ObjectMetadata) Size() (n int) {
 	return n
 }
 
+func (m *ErrorDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovApi(uint64(m.Reason))
+	}
+	if m.RetryAfterSeconds != 0 {
+		n += 1 + sovApi(uint64(m.RetryAfterSeconds))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
 func sovApi(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -319,6 +529,18 @@ func (this *ObjectMetadata) String() string {
 	}, "")
 	return s
 }
+func (this *ErrorDetail) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ErrorDetail{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`RetryAfterSeconds:` + fmt.Sprintf("%v", this.RetryAfterSeconds) + `,`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringApi(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -720,6 +942,126 @@ func (m *ObjectMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ErrorDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ErrorReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAfterSeconds", wireType)
+			}
+			m.RetryAfterSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryAfterSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApi(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -60,6 +60,116 @@ func (VolumeState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
 }
 
+type VersionRequest struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionRequest) Reset()      { *m = VersionRequest{} }
+func (*VersionRequest) ProtoMessage() {}
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+func (m *VersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionRequest.Merge(m, src)
+}
+func (m *VersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionRequest proto.InternalMessageInfo
+
+func (m *VersionRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type VersionResponse struct {
+	// Name of the volume runtime.
+	RuntimeName string `protobuf:"bytes,1,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	// Version of the volume runtime. The string must be
+	// semver-compatible.
+	RuntimeVersion string `protobuf:"bytes,2,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
+	// Capabilities are the optional capabilities supported by the volume runtime.
+	Capabilities         []string `protobuf:"bytes,3,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionResponse) Reset()      { *m = VersionResponse{} }
+func (*VersionResponse) ProtoMessage() {}
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionResponse.Merge(m, src)
+}
+func (m *VersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionResponse proto.InternalMessageInfo
+
+func (m *VersionResponse) GetRuntimeName() string {
+	if m != nil {
+		return m.RuntimeName
+	}
+	return ""
+}
+
+func (m *VersionResponse) GetRuntimeVersion() string {
+	if m != nil {
+		return m.RuntimeVersion
+	}
+	return ""
+}
+
+func (m *VersionResponse) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 type VolumeFilter struct {
 	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	LabelSelector        map[string]string `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -70,7 +180,7 @@ type VolumeFilter struct {
 func (m *VolumeFilter) Reset()      { *m = VolumeFilter{} }
 func (*VolumeFilter) ProtoMessage() {}
 func (*VolumeFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
 }
 func (m *VolumeFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,7 +232,7 @@ type VolumeResources struct {
 func (m *VolumeResources) Reset()      { *m = VolumeResources{} }
 func (*VolumeResources) ProtoMessage() {}
 func (*VolumeResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
 }
 func (m *VolumeResources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,7 +277,7 @@ type EncryptionSpec struct {
 func (m *EncryptionSpec) Reset()      { *m = EncryptionSpec{} }
 func (*EncryptionSpec) ProtoMessage() {}
 func (*EncryptionSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
 }
 func (m *EncryptionSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,7 +325,7 @@ type VolumeSpec struct {
 func (m *VolumeSpec) Reset()      { *m = VolumeSpec{} }
 func (*VolumeSpec) ProtoMessage() {}
 func (*VolumeSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 func (m *VolumeSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +392,7 @@ type VolumeStatus struct {
 func (m *VolumeStatus) Reset()      { *m = VolumeStatus{} }
 func (*VolumeStatus) ProtoMessage() {}
 func (*VolumeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 func (m *VolumeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +446,7 @@ type Volume struct {
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,7 +506,7 @@ type VolumeClassCapabilities struct {
 func (m *VolumeClassCapabilities) Reset()      { *m = VolumeClassCapabilities{} }
 func (*VolumeClassCapabilities) ProtoMessage() {}
 func (*VolumeClassCapabilities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 func (m *VolumeClassCapabilities) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,7 +559,7 @@ type VolumeClass struct {
 func (m *VolumeClass) Reset()      { *m = VolumeClass{} }
 func (*VolumeClass) ProtoMessage() {}
 func (*VolumeClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 func (m *VolumeClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +612,7 @@ type VolumeClassStatus struct {
 func (m *VolumeClassStatus) Reset()      { *m = VolumeClassStatus{} }
 func (*VolumeClassStatus) ProtoMessage() {}
 func (*VolumeClassStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 func (m *VolumeClassStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -557,7 +667,7 @@ type VolumeAccess struct {
 func (m *VolumeAccess) Reset()      { *m = VolumeAccess{} }
 func (*VolumeAccess) ProtoMessage() {}
 func (*VolumeAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
 }
 func (m *VolumeAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,7 +733,7 @@ type ListVolumesRequest struct {
 func (m *ListVolumesRequest) Reset()      { *m = ListVolumesRequest{} }
 func (*ListVolumesRequest) ProtoMessage() {}
 func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
 }
 func (m *ListVolumesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -668,7 +778,7 @@ type ListVolumesResponse struct {
 func (m *ListVolumesResponse) Reset()      { *m = ListVolumesResponse{} }
 func (*ListVolumesResponse) ProtoMessage() {}
 func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
 func (m *ListVolumesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,6 +814,123 @@ func (m *ListVolumesResponse) GetVolumes() []*Volume {
 	return nil
 }
 
+type WatchVolumesRequest struct {
+	Filter *VolumeFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resource_version is the resource version to resume watching from.
+	// If empty, the watch starts with an added event for every existing volume followed by a bookmark.
+	// If the resource version is no longer available, the watch fails with code OUT_OF_RANGE.
+	ResourceVersion      string   `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchVolumesRequest) Reset()      { *m = WatchVolumesRequest{} }
+func (*WatchVolumesRequest) ProtoMessage() {}
+func (*WatchVolumesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+}
+func (m *WatchVolumesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchVolumesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchVolumesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchVolumesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchVolumesRequest.Merge(m, src)
+}
+func (m *WatchVolumesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchVolumesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchVolumesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchVolumesRequest proto.InternalMessageInfo
+
+func (m *WatchVolumesRequest) GetFilter() *VolumeFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *WatchVolumesRequest) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+type WatchVolumesResponse struct {
+	Type                 v1alpha1.WatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=meta.v1alpha1.WatchEventType" json:"type,omitempty"`
+	Volume               *Volume                 `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	ResourceVersion      string                  `protobuf:"bytes,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *WatchVolumesResponse) Reset()      { *m = WatchVolumesResponse{} }
+func (*WatchVolumesResponse) ProtoMessage() {}
+func (*WatchVolumesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+}
+func (m *WatchVolumesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchVolumesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchVolumesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchVolumesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchVolumesResponse.Merge(m, src)
+}
+func (m *WatchVolumesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchVolumesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchVolumesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchVolumesResponse proto.InternalMessageInfo
+
+func (m *WatchVolumesResponse) GetType() v1alpha1.WatchEventType {
+	if m != nil {
+		return m.Type
+	}
+	return v1alpha1.WatchEventType_WATCH_EVENT_ADDED
+}
+
+func (m *WatchVolumesResponse) GetVolume() *Volume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *WatchVolumesResponse) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
 type CreateVolumeRequest struct {
 	Volume               *Volume  `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -713,7 +940,7 @@ type CreateVolumeRequest struct {
 func (m *CreateVolumeRequest) Reset()      { *m = CreateVolumeRequest{} }
 func (*CreateVolumeRequest) ProtoMessage() {}
 func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 func (m *CreateVolumeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,7 +986,7 @@ type ExpandVolumeRequest struct {
 func (m *ExpandVolumeRequest) Reset()      { *m = ExpandVolumeRequest{} }
 func (*ExpandVolumeRequest) ProtoMessage() {}
 func (*ExpandVolumeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
 func (m *ExpandVolumeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +1038,7 @@ type CreateVolumeResponse struct {
 func (m *CreateVolumeResponse) Reset()      { *m = CreateVolumeResponse{} }
 func (*CreateVolumeResponse) ProtoMessage() {}
 func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 func (m *CreateVolumeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +1082,7 @@ type ExpandVolumeResponse struct {
 func (m *ExpandVolumeResponse) Reset()      { *m = ExpandVolumeResponse{} }
 func (*ExpandVolumeResponse) ProtoMessage() {}
 func (*ExpandVolumeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 func (m *ExpandVolumeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,7 +1120,7 @@ type DeleteVolumeRequest struct {
 func (m *DeleteVolumeRequest) Reset()      { *m = DeleteVolumeRequest{} }
 func (*DeleteVolumeRequest) ProtoMessage() {}
 func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 func (m *DeleteVolumeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -937,7 +1164,7 @@ type DeleteVolumeResponse struct {
 func (m *DeleteVolumeResponse) Reset()      { *m = DeleteVolumeResponse{} }
 func (*DeleteVolumeResponse) ProtoMessage() {}
 func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
 func (m *DeleteVolumeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1201,7 @@ type StatusRequest struct {
 func (m *StatusRequest) Reset()      { *m = StatusRequest{} }
 func (*StatusRequest) ProtoMessage() {}
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
 }
 func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1239,7 @@ type StatusResponse struct {
 func (m *StatusResponse) Reset()      { *m = StatusResponse{} }
 func (*StatusResponse) ProtoMessage() {}
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1050,6 +1277,8 @@ func (m *StatusResponse) GetVolumeClassStatus() []*VolumeClassStatus {
 
 func init() {
 	proto.RegisterEnum("volume.v1alpha1.VolumeState", VolumeState_name, VolumeState_value)
+	proto.RegisterType((*VersionRequest)(nil), "volume.v1alpha1.VersionRequest")
+	proto.RegisterType((*VersionResponse)(nil), "volume.v1alpha1.VersionResponse")
 	proto.RegisterType((*VolumeFilter)(nil), "volume.v1alpha1.VolumeFilter")
 	proto.RegisterMapType((map[string]string)(nil), "volume.v1alpha1.VolumeFilter.LabelSelectorEntry")
 	proto.RegisterType((*VolumeResources)(nil), "volume.v1alpha1.VolumeResources")
@@ -1066,6 +1295,8 @@ func init() {
 	proto.RegisterMapType((map[string][]byte)(nil), "volume.v1alpha1.VolumeAccess.SecretDataEntry")
 	proto.RegisterType((*ListVolumesRequest)(nil), "volume.v1alpha1.ListVolumesRequest")
 	proto.RegisterType((*ListVolumesResponse)(nil), "volume.v1alpha1.ListVolumesResponse")
+	proto.RegisterType((*WatchVolumesRequest)(nil), "volume.v1alpha1.WatchVolumesRequest")
+	proto.RegisterType((*WatchVolumesResponse)(nil), "volume.v1alpha1.WatchVolumesResponse")
 	proto.RegisterType((*CreateVolumeRequest)(nil), "volume.v1alpha1.CreateVolumeRequest")
 	proto.RegisterType((*ExpandVolumeRequest)(nil), "volume.v1alpha1.ExpandVolumeRequest")
 	proto.RegisterType((*CreateVolumeResponse)(nil), "volume.v1alpha1.CreateVolumeResponse")
@@ -1079,73 +1310,83 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1045 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0xdb, 0x36,
-	0x14, 0xb6, 0xec, 0xc4, 0x4b, 0x8e, 0x1d, 0xc7, 0xa5, 0x8d, 0xd6, 0x70, 0x5b, 0x37, 0x50, 0x37,
-	0x20, 0x18, 0x10, 0x6b, 0xf1, 0xb0, 0x61, 0x1d, 0xb0, 0x65, 0x4e, 0xe2, 0x76, 0x41, 0x9d, 0xa4,
-	0x50, 0xb0, 0x14, 0x28, 0x30, 0x18, 0xb4, 0xcc, 0x3a, 0xdc, 0x64, 0x49, 0x15, 0x29, 0x63, 0xbe,
-	0xdb, 0x23, 0xec, 0x15, 0x76, 0xbd, 0x27, 0xe8, 0x1b, 0xf4, 0x72, 0x37, 0x03, 0x06, 0xec, 0x66,
-	0xcd, 0x5e, 0x64, 0x10, 0x49, 0x29, 0x92, 0xff, 0x92, 0xa0, 0x77, 0xe4, 0xf1, 0x77, 0x3e, 0x7e,
-	0xe7, 0xf0, 0xe3, 0xb1, 0x60, 0x1d, 0x7b, 0xb4, 0xe9, 0xf9, 0x2e, 0x77, 0xd1, 0xe6, 0xd8, 0xb5,
-	0x83, 0x11, 0x69, 0x8e, 0x77, 0xb1, 0xed, 0x5d, 0xe0, 0xdd, 0xfa, 0xce, 0x90, 0xf2, 0x8b, 0xa0,
-	0xdf, 0xb4, 0xdc, 0x91, 0x31, 0x74, 0x87, 0xae, 0x21, 0x70, 0xfd, 0xe0, 0xb5, 0xd8, 0x89, 0x8d,
-	0x58, 0xc9, 0xfc, 0x7a, 0x3b, 0x01, 0xa7, 0xbe, 0xeb, 0x58, 0xae, 0x4f, 0x76, 0x06, 0x64, 0x1c,
-	0x6f, 0x0c, 0xea, 0x53, 0x03, 0x7b, 0x94, 0x19, 0x23, 0xc2, 0xb1, 0x11, 0x9d, 0x63, 0xc4, 0x12,
-	0xf4, 0xb7, 0x1a, 0x14, 0xcf, 0x85, 0x8a, 0xa7, 0xd4, 0xe6, 0xc4, 0x47, 0x25, 0xc8, 0xd2, 0x41,
-	0x4d, 0xdb, 0xd2, 0xb6, 0xd7, 0xcd, 0x2c, 0x1d, 0xa0, 0x97, 0x50, 0xb2, 0x71, 0x9f, 0xd8, 0x3d,
-	0x46, 0x6c, 0x62, 0x71, 0xd7, 0xaf, 0x65, 0xb7, 0x72, 0xdb, 0x85, 0xd6, 0x67, 0xcd, 0x29, 0xf1,
-	0xcd, 0x24, 0x4d, 0xb3, 0x1b, 0xe6, 0x9c, 0xa9, 0x94, 0x8e, 0xc3, 0xfd, 0x89, 0xb9, 0x61, 0x27,
-	0x63, 0xf5, 0xef, 0x00, 0xcd, 0x82, 0x50, 0x19, 0x72, 0x3f, 0x93, 0x89, 0x3a, 0x3f, 0x5c, 0xa2,
-	0x2a, 0xac, 0x8e, 0xb1, 0x1d, 0x90, 0x5a, 0x56, 0xc4, 0xe4, 0xe6, 0xeb, 0xec, 0x57, 0x9a, 0xfe,
-	0x25, 0x6c, 0xca, 0x33, 0x4d, 0xc2, 0xdc, 0xc0, 0xb7, 0x08, 0x43, 0x8f, 0x61, 0x83, 0x71, 0xd7,
-	0xc7, 0x43, 0xd2, 0xeb, 0x4f, 0x38, 0x61, 0x82, 0x28, 0x67, 0x16, 0x55, 0x70, 0x3f, 0x8c, 0xe9,
-	0xbf, 0x6b, 0x50, 0xea, 0x38, 0x96, 0x3f, 0xf1, 0x38, 0x75, 0x9d, 0x33, 0x8f, 0x58, 0xe8, 0x05,
-	0x14, 0x18, 0xb1, 0x7c, 0xc2, 0x7b, 0x03, 0xcc, 0x71, 0x4d, 0x13, 0x25, 0x1a, 0x33, 0x25, 0xa6,
-	0xb3, 0x9a, 0x67, 0x22, 0xe5, 0x10, 0x73, 0x2c, 0x2b, 0x04, 0x16, 0x07, 0xea, 0xdf, 0xc0, 0xe6,
-	0xd4, 0xcf, 0xd7, 0xd5, 0x56, 0x4c, 0xd6, 0xf6, 0x56, 0x03, 0x90, 0xc5, 0x09, 0x7d, 0x55, 0x58,
-	0xa5, 0x23, 0x3c, 0x24, 0x2a, 0x59, 0x6e, 0xc2, 0xa8, 0x65, 0x63, 0xc6, 0xa2, 0xd6, 0x88, 0x0d,
-	0xfa, 0x16, 0xd6, 0xfd, 0xa8, 0x21, 0xb5, 0xdc, 0x96, 0xb6, 0x5d, 0x68, 0x6d, 0x2d, 0xb8, 0xac,
-	0xb8, 0x71, 0xe6, 0x55, 0x0a, 0xda, 0x03, 0x20, 0x71, 0x9d, 0xb5, 0x15, 0x41, 0xf0, 0xe8, 0x9a,
-	0x56, 0x98, 0x89, 0x14, 0x7d, 0x12, 0x59, 0xea, 0x8c, 0x63, 0x1e, 0x30, 0xd4, 0x82, 0x55, 0xc6,
-	0x31, 0x97, 0xe2, 0x4b, 0xad, 0x07, 0x0b, 0xc4, 0x84, 0x68, 0x62, 0x4a, 0x28, 0xfa, 0x02, 0xf2,
-	0xd8, 0xb2, 0x88, 0xaa, 0xad, 0xd0, 0x7a, 0xb8, 0x20, 0xa9, 0x2d, 0x40, 0xa6, 0x02, 0xeb, 0x7f,
-	0x68, 0x90, 0x97, 0x3f, 0xa0, 0x27, 0xb0, 0x16, 0x9a, 0x5e, 0xdd, 0xa7, 0xe4, 0x08, 0x03, 0x57,
-	0x0c, 0xa7, 0xfd, 0x9f, 0x88, 0xc5, 0x8f, 0x15, 0xc8, 0x8c, 0xe1, 0xc8, 0x80, 0x15, 0xe6, 0x11,
-	0x4b, 0x1d, 0x7d, 0x7f, 0x91, 0xde, 0xb0, 0x6e, 0x01, 0x0c, 0xd5, 0x32, 0x51, 0xab, 0xea, 0xf7,
-	0xc3, 0x25, 0x25, 0x06, 0xcc, 0x54, 0x60, 0x7d, 0x0f, 0xee, 0xc9, 0xf8, 0x41, 0x78, 0x71, 0x07,
-	0xd8, 0xc3, 0x7d, 0x6a, 0x53, 0x4e, 0x09, 0x0b, 0xbd, 0xc2, 0xbd, 0xc8, 0xbe, 0xe1, 0x12, 0x21,
-	0x58, 0xa1, 0xae, 0x27, 0xfb, 0x91, 0x33, 0xc5, 0x5a, 0x77, 0xa1, 0x90, 0x20, 0x08, 0x21, 0x0e,
-	0x1e, 0x45, 0x26, 0x11, 0x6b, 0xd4, 0x85, 0xa2, 0x95, 0x20, 0x56, 0x35, 0x6d, 0x2f, 0x10, 0x38,
-	0x23, 0xc4, 0x4c, 0x65, 0xeb, 0x1e, 0xdc, 0x49, 0x00, 0xd5, 0xfd, 0xee, 0x41, 0x51, 0xb2, 0xf5,
-	0xa4, 0x1b, 0x65, 0xb7, 0x1f, 0x2c, 0x3b, 0xc2, 0x2c, 0x8c, 0x13, 0xba, 0xeb, 0xb0, 0xf6, 0x26,
-	0xc0, 0x0e, 0xa7, 0x7c, 0xa2, 0xca, 0x8b, 0xf7, 0xfa, 0x3f, 0xd9, 0xc8, 0x4d, 0xf2, 0xaa, 0xd1,
-	0x5d, 0xc8, 0x0f, 0x7c, 0x3a, 0x26, 0xbe, 0x2a, 0x53, 0xed, 0xc2, 0xf8, 0x05, 0x76, 0x06, 0x76,
-	0x34, 0x28, 0xd4, 0x0e, 0x1d, 0x03, 0x60, 0xce, 0x7d, 0xda, 0x0f, 0xb8, 0x78, 0x0f, 0xe1, 0xcb,
-	0xde, 0x59, 0xea, 0xa6, 0x66, 0x3b, 0xc6, 0xab, 0x77, 0x7d, 0x45, 0x80, 0x4e, 0xd2, 0x93, 0x62,
-	0xe5, 0x26, 0x7c, 0xd7, 0xcc, 0x89, 0xa9, 0xe3, 0x6e, 0x33, 0x03, 0x3f, 0x74, 0xcc, 0x3c, 0x07,
-	0xd4, 0xa5, 0x8c, 0x4b, 0xb5, 0xcc, 0x24, 0x6f, 0x02, 0xc2, 0x78, 0x68, 0xe7, 0xd7, 0x62, 0x8c,
-	0xc7, 0x0f, 0x67, 0xd9, 0xac, 0x37, 0x15, 0x58, 0xff, 0x1e, 0x2a, 0x29, 0x32, 0xe6, 0xb9, 0x0e,
-	0x23, 0x68, 0x17, 0x3e, 0x92, 0xe9, 0x4c, 0xcd, 0xd5, 0x7b, 0x8b, 0xa6, 0x51, 0x84, 0xd3, 0x9f,
-	0x42, 0xe5, 0xc0, 0x27, 0x98, 0x93, 0x68, 0x4c, 0x49, 0x5d, 0x06, 0xe4, 0x25, 0x42, 0xe9, 0x5a,
-	0x48, 0xa4, 0x60, 0xba, 0x0f, 0x95, 0xce, 0x2f, 0x1e, 0x76, 0x06, 0x69, 0x9e, 0xfb, 0xb0, 0xae,
-	0x0c, 0x1b, 0xff, 0xd5, 0xad, 0xc9, 0xc0, 0xd1, 0x20, 0x3d, 0x3e, 0xb3, 0xb7, 0x1e, 0x9f, 0xfa,
-	0x33, 0xa8, 0xa6, 0xb5, 0xab, 0x36, 0xdc, 0x5a, 0xfc, 0x5d, 0xa8, 0xa6, 0xc5, 0x4b, 0x22, 0xbd,
-	0x05, 0x95, 0x43, 0x62, 0x93, 0xe9, 0xe6, 0x2c, 0x2b, 0x2a, 0xe4, 0x4a, 0xe7, 0x28, 0xae, 0x4d,
-	0xd8, 0x50, 0x33, 0x49, 0xb2, 0xe8, 0x03, 0x28, 0x45, 0x01, 0xa5, 0xdb, 0x84, 0x4a, 0xf2, 0x75,
-	0xf7, 0xd4, 0xa0, 0x93, 0x57, 0xa9, 0x2f, 0x7b, 0xe4, 0x8a, 0xe8, 0xce, 0x78, 0x3a, 0xf4, 0xe9,
-	0x51, 0x34, 0xb7, 0xc4, 0xcc, 0x47, 0x08, 0x4a, 0xe7, 0xa7, 0xdd, 0x1f, 0x8e, 0x3b, 0xbd, 0x17,
-	0x9d, 0x93, 0xc3, 0xa3, 0x93, 0x67, 0xe5, 0x0c, 0xaa, 0x42, 0x59, 0xc5, 0xda, 0xe7, 0xed, 0xa3,
-	0x6e, 0x7b, 0xbf, 0xdb, 0x29, 0x6b, 0xa8, 0x0c, 0x45, 0x15, 0xed, 0x98, 0xe6, 0xa9, 0x59, 0xce,
-	0xb6, 0xfe, 0xca, 0xc1, 0x86, 0x2a, 0x2a, 0x70, 0x38, 0x1d, 0x11, 0xf4, 0x0a, 0x0a, 0x09, 0x1b,
-	0xa2, 0xc7, 0x33, 0x12, 0x67, 0x1d, 0x5f, 0xff, 0x78, 0x39, 0x48, 0x75, 0x2b, 0x83, 0x7e, 0x84,
-	0x62, 0xf2, 0x72, 0xd1, 0x6c, 0xde, 0x1c, 0xdf, 0xd6, 0x3f, 0xb9, 0x06, 0x95, 0xa4, 0x4f, 0x5e,
-	0xf9, 0x1c, 0xfa, 0x39, 0x76, 0x9e, 0x43, 0x3f, 0xd7, 0x37, 0x82, 0x3e, 0xe9, 0x82, 0x39, 0xf4,
-	0x73, 0x8c, 0x35, 0x87, 0x7e, 0xae, 0x95, 0x32, 0xe8, 0x39, 0xe4, 0xd5, 0x3f, 0x42, 0x63, 0x26,
-	0x25, 0xe5, 0xb2, 0xfa, 0xa3, 0x85, 0xbf, 0x47, 0x64, 0xfb, 0x2f, 0xdf, 0xbd, 0x6f, 0x68, 0x7f,
-	0xbf, 0x6f, 0x64, 0x7e, 0xbd, 0x6c, 0x68, 0xef, 0x2e, 0x1b, 0xda, 0x9f, 0x97, 0x0d, 0xed, 0xdf,
-	0xcb, 0x86, 0xf6, 0xdb, 0x7f, 0x8d, 0xcc, 0xab, 0x27, 0x37, 0xff, 0xf2, 0x95, 0x27, 0xc5, 0xdf,
-	0xbe, 0xfd, 0xbc, 0xf8, 0xf0, 0xfd, 0xfc, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x30, 0x73, 0x90,
-	0x3f, 0x88, 0x0b, 0x00, 0x00,
+	// 1209 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x25, 0x5b, 0x89, 0x47, 0xb4, 0xa4, 0xac, 0x84, 0x44, 0x60, 0x52, 0x45, 0x65, 0x6a,
+	0xd4, 0x0d, 0x10, 0x29, 0x56, 0x91, 0xa2, 0x29, 0xd0, 0xba, 0xb2, 0xad, 0xa4, 0x46, 0x64, 0x3b,
+	0xa0, 0x5b, 0x1b, 0x08, 0x10, 0x08, 0x2b, 0x6a, 0x63, 0xb3, 0xa5, 0x48, 0x86, 0xbb, 0x52, 0xab,
+	0x5b, 0x7b, 0xe9, 0xb9, 0xaf, 0xd0, 0x43, 0x4f, 0x7d, 0x82, 0xbc, 0x41, 0x8e, 0x3d, 0x16, 0xe8,
+	0xa5, 0x71, 0x5f, 0xa4, 0xe0, 0xee, 0x92, 0x26, 0xf5, 0x6b, 0xa3, 0xbd, 0x71, 0x87, 0xdf, 0x7c,
+	0xf3, 0xb3, 0x3b, 0xdf, 0x92, 0xb0, 0x8a, 0x3d, 0xab, 0xe6, 0xf9, 0x2e, 0x73, 0x51, 0x7e, 0xe8,
+	0xda, 0x83, 0x3e, 0xa9, 0x0d, 0x37, 0xb1, 0xed, 0x9d, 0xe1, 0x4d, 0xed, 0xc1, 0xa9, 0xc5, 0xce,
+	0x06, 0xdd, 0x9a, 0xe9, 0xf6, 0xeb, 0xa7, 0xee, 0xa9, 0x5b, 0xe7, 0xb8, 0xee, 0xe0, 0x15, 0x5f,
+	0xf1, 0x05, 0x7f, 0x12, 0xfe, 0x5a, 0x33, 0x06, 0xb7, 0x7c, 0xd7, 0x31, 0x5d, 0x9f, 0x3c, 0xe8,
+	0x91, 0x61, 0xb4, 0xa8, 0x5b, 0xbe, 0x55, 0xc7, 0x9e, 0x45, 0xeb, 0x7d, 0xc2, 0x70, 0x3d, 0x8c,
+	0x53, 0x8f, 0x52, 0xd0, 0xef, 0x43, 0xee, 0x98, 0xf8, 0xd4, 0x72, 0x1d, 0x83, 0xbc, 0x1e, 0x10,
+	0xca, 0x50, 0x19, 0xae, 0x0d, 0x85, 0xa5, 0xac, 0x54, 0x95, 0x8d, 0x55, 0x23, 0x5c, 0xea, 0x3f,
+	0x29, 0x90, 0x8f, 0xc0, 0xd4, 0x73, 0x1d, 0x4a, 0xd0, 0xfb, 0xa0, 0xfa, 0x03, 0x87, 0x59, 0x7d,
+	0xd2, 0x71, 0x70, 0x9f, 0x48, 0x97, 0xac, 0xb4, 0x1d, 0xe0, 0x3e, 0x41, 0x1f, 0x42, 0x3e, 0x84,
+	0x84, 0xc4, 0x29, 0x8e, 0xca, 0x49, 0xb3, 0xe4, 0x44, 0x3a, 0xa8, 0x26, 0xf6, 0x70, 0xd7, 0xb2,
+	0x2d, 0x66, 0x11, 0x5a, 0x4e, 0x57, 0xd3, 0x1b, 0xab, 0x46, 0xc2, 0xa6, 0xbf, 0x51, 0x40, 0x3d,
+	0xe6, 0x5d, 0x7b, 0x62, 0xd9, 0x8c, 0xf8, 0x28, 0x07, 0x29, 0xab, 0x27, 0xc3, 0xa6, 0xac, 0x1e,
+	0x3a, 0x81, 0x9c, 0x8d, 0xbb, 0xc4, 0xee, 0x50, 0x62, 0x13, 0x93, 0xb9, 0x7e, 0x39, 0x55, 0x4d,
+	0x6f, 0x64, 0x1b, 0x0f, 0x6b, 0x63, 0xcd, 0xae, 0xc5, 0x69, 0x6a, 0xed, 0xc0, 0xe7, 0x48, 0xba,
+	0xb4, 0x1c, 0xe6, 0x8f, 0x8c, 0x35, 0x3b, 0x6e, 0xd3, 0xbe, 0x04, 0x34, 0x09, 0x42, 0x05, 0x48,
+	0x7f, 0x47, 0x46, 0x32, 0x7e, 0xf0, 0x88, 0x4a, 0xb0, 0x32, 0xc4, 0xf6, 0x80, 0xc8, 0x22, 0xc5,
+	0xe2, 0xb3, 0xd4, 0xa7, 0x8a, 0xfe, 0x09, 0xe4, 0x45, 0x4c, 0x83, 0x50, 0x77, 0xe0, 0x9b, 0x84,
+	0xa2, 0x7b, 0xb0, 0x46, 0x99, 0xeb, 0xe3, 0x53, 0xd2, 0xe9, 0x8e, 0x18, 0xa1, 0x9c, 0x28, 0x6d,
+	0xa8, 0xd2, 0xb8, 0x1d, 0xd8, 0xf4, 0x5f, 0x15, 0xc8, 0xb5, 0x1c, 0xd3, 0x1f, 0x79, 0xcc, 0x72,
+	0x9d, 0x23, 0x8f, 0x98, 0xe8, 0x39, 0x64, 0x29, 0x31, 0x7d, 0xc2, 0x3a, 0x3d, 0xcc, 0x70, 0x59,
+	0xe1, 0x25, 0xd6, 0x27, 0x4a, 0x4c, 0x7a, 0xd5, 0x8e, 0xb8, 0xcb, 0x2e, 0x66, 0x58, 0x54, 0x08,
+	0x34, 0x32, 0x68, 0x9f, 0x43, 0x7e, 0xec, 0xf5, 0xa2, 0xda, 0xd4, 0x78, 0x6d, 0x6f, 0x14, 0x00,
+	0x51, 0x1c, 0xcf, 0xaf, 0x04, 0x2b, 0x56, 0x1f, 0x9f, 0x86, 0xe7, 0x41, 0x2c, 0x02, 0xab, 0x69,
+	0x63, 0x4a, 0xc3, 0xd6, 0xf0, 0x05, 0xfa, 0x02, 0x56, 0xfd, 0xb0, 0x21, 0xe5, 0x74, 0x55, 0xd9,
+	0xc8, 0x36, 0xaa, 0x33, 0x36, 0x2b, 0x6a, 0x9c, 0x71, 0xe1, 0x82, 0xb6, 0x00, 0x48, 0x54, 0x67,
+	0x79, 0x99, 0x13, 0xdc, 0x5d, 0xd0, 0x0a, 0x23, 0xe6, 0xa2, 0x8f, 0xc2, 0x23, 0x75, 0xc4, 0x30,
+	0x1b, 0x50, 0xd4, 0x80, 0x15, 0xca, 0x30, 0x13, 0xc9, 0xe7, 0x1a, 0x77, 0x66, 0x24, 0x13, 0xa0,
+	0x89, 0x21, 0xa0, 0xe8, 0x11, 0x64, 0xb0, 0x69, 0x12, 0x59, 0x5b, 0xb6, 0xf1, 0xde, 0x0c, 0xa7,
+	0x26, 0x07, 0x19, 0x12, 0xac, 0xff, 0xae, 0x40, 0x46, 0xbc, 0x40, 0x8f, 0xe1, 0x7a, 0x30, 0xa4,
+	0x72, 0x3f, 0x05, 0x47, 0x60, 0xb8, 0x60, 0x38, 0xec, 0x7e, 0x4b, 0x4c, 0xb6, 0x2f, 0x41, 0x46,
+	0x04, 0x47, 0x75, 0x58, 0xa6, 0x1e, 0x31, 0x65, 0xe8, 0xdb, 0xb3, 0xf2, 0x0d, 0xea, 0xe6, 0xc0,
+	0x20, 0x5b, 0xca, 0x6b, 0x2d, 0xa7, 0xe7, 0x66, 0x2b, 0x1a, 0x62, 0x48, 0xb0, 0xbe, 0x05, 0xb7,
+	0x84, 0x7d, 0x27, 0xd8, 0xb8, 0x9d, 0xd8, 0x5c, 0x06, 0x67, 0x85, 0x79, 0xe1, 0xf1, 0x0d, 0x1e,
+	0x11, 0x82, 0x65, 0xcb, 0xf5, 0x44, 0x3f, 0xd2, 0x06, 0x7f, 0xd6, 0x5d, 0xc8, 0xc6, 0x08, 0x02,
+	0x48, 0x4c, 0x34, 0xf8, 0x33, 0x6a, 0x8f, 0x89, 0x80, 0xa8, 0x69, 0x63, 0x46, 0x82, 0x13, 0x89,
+	0x8c, 0xc9, 0x85, 0x07, 0x37, 0x62, 0x40, 0xb9, 0xbf, 0x5b, 0xa0, 0x0a, 0xb6, 0x8e, 0x38, 0x8d,
+	0xa2, 0xdb, 0x77, 0xe6, 0x85, 0x30, 0xb2, 0xc3, 0x58, 0xde, 0x1a, 0x5c, 0x7f, 0x3d, 0xc0, 0x0e,
+	0xb3, 0xd8, 0x48, 0x96, 0x17, 0xad, 0xf5, 0xbf, 0x52, 0xa0, 0xc6, 0xb7, 0x1a, 0xdd, 0x84, 0x4c,
+	0xcf, 0xb7, 0x86, 0xc4, 0x97, 0x65, 0xca, 0x55, 0x60, 0x3f, 0xc3, 0x4e, 0xcf, 0x0e, 0x85, 0x42,
+	0xae, 0xd0, 0x3e, 0x00, 0x66, 0xcc, 0xb7, 0xba, 0x03, 0x26, 0x35, 0x30, 0xdb, 0x78, 0x30, 0xf7,
+	0x34, 0xd5, 0x9a, 0x11, 0x5e, 0xce, 0xf5, 0x05, 0x01, 0x3a, 0x48, 0x2a, 0xc5, 0xf2, 0x65, 0xf8,
+	0x16, 0xe8, 0xc4, 0x58, 0xb8, 0xab, 0x68, 0xe0, 0x7f, 0x95, 0x99, 0x67, 0x80, 0xda, 0x16, 0x65,
+	0x22, 0x5b, 0x1a, 0x5e, 0x59, 0x8f, 0x20, 0xf3, 0x8a, 0xcb, 0x78, 0x34, 0x38, 0xf3, 0xb4, 0xde,
+	0x90, 0x60, 0xfd, 0x2b, 0x28, 0x26, 0xc8, 0xe4, 0x95, 0xb6, 0x09, 0xd7, 0x84, 0x3b, 0x95, 0xba,
+	0x7a, 0x6b, 0x96, 0x1a, 0x85, 0x38, 0xfd, 0x7b, 0x28, 0x9e, 0x60, 0x66, 0x9e, 0xfd, 0x2f, 0x79,
+	0xa1, 0x8f, 0xa0, 0x10, 0xaa, 0xdb, 0xd8, 0x8d, 0x99, 0x0f, 0xed, 0xf2, 0xca, 0xd4, 0x7f, 0x53,
+	0xa0, 0x94, 0x8c, 0x1c, 0x15, 0xb1, 0xcc, 0x46, 0x5e, 0x28, 0x61, 0xe3, 0x4a, 0xc2, 0x5d, 0x5a,
+	0x43, 0xe2, 0xb0, 0xaf, 0x47, 0x1e, 0x31, 0x38, 0x14, 0xd5, 0x21, 0x23, 0xd2, 0x93, 0x33, 0x37,
+	0xb3, 0x6c, 0x09, 0x9b, 0x9a, 0x67, 0x7a, 0x7a, 0x9e, 0x4f, 0xa0, 0xb8, 0xe3, 0x13, 0xcc, 0x88,
+	0xa4, 0x90, 0x0d, 0xba, 0x08, 0xa9, 0x5c, 0x2a, 0xa4, 0xee, 0x43, 0xb1, 0xf5, 0x83, 0x87, 0x9d,
+	0x5e, 0x92, 0xe7, 0x36, 0xac, 0xca, 0x89, 0x8e, 0xbe, 0x05, 0xae, 0x0b, 0xc3, 0x5e, 0x2f, 0x79,
+	0xbf, 0xa4, 0xae, 0x7c, 0xbf, 0xe8, 0x4f, 0xa1, 0x94, 0xcc, 0x5d, 0xb6, 0xf8, 0xca, 0xc9, 0xdf,
+	0x84, 0x52, 0x32, 0x79, 0x41, 0xa4, 0x37, 0xa0, 0xb8, 0x4b, 0x6c, 0xc2, 0xc8, 0xe5, 0x8b, 0x0a,
+	0xb8, 0x92, 0x3e, 0x92, 0x2b, 0x0f, 0x6b, 0x52, 0xb4, 0x05, 0x8b, 0xde, 0x83, 0x5c, 0x68, 0x90,
+	0x79, 0x1b, 0x50, 0x8c, 0xcb, 0x5f, 0x47, 0xde, 0x04, 0xe2, 0xac, 0xeb, 0xf3, 0x54, 0x50, 0x12,
+	0xdd, 0x18, 0x8e, 0x9b, 0xee, 0xef, 0x85, 0xc2, 0x7e, 0xc4, 0x6f, 0x43, 0x04, 0xb9, 0xe3, 0xc3,
+	0xf6, 0x37, 0xfb, 0xad, 0xce, 0xf3, 0xd6, 0xc1, 0xee, 0xde, 0xc1, 0xd3, 0xc2, 0x12, 0x2a, 0x41,
+	0x41, 0xda, 0x9a, 0xc7, 0xcd, 0xbd, 0x76, 0x73, 0xbb, 0xdd, 0x2a, 0x28, 0xa8, 0x00, 0xaa, 0xb4,
+	0xb6, 0x0c, 0xe3, 0xd0, 0x28, 0xa4, 0x1a, 0x3f, 0xaf, 0xc0, 0x9a, 0x2c, 0x4a, 0x7c, 0x1e, 0xa2,
+	0x03, 0xb8, 0x16, 0x7e, 0x22, 0x4e, 0xde, 0xeb, 0xc9, 0xaf, 0x57, 0xad, 0x3a, 0x1b, 0x20, 0x3b,
+	0xb4, 0x84, 0x5e, 0x40, 0x36, 0x36, 0xf7, 0xe8, 0xde, 0x84, 0xcb, 0xa4, 0xc4, 0x68, 0x1f, 0xcc,
+	0x07, 0x45, 0xdc, 0x1d, 0x50, 0xe3, 0xf3, 0x88, 0x26, 0xfd, 0xa6, 0x08, 0x85, 0xb6, 0xbe, 0x00,
+	0x15, 0xd2, 0x3f, 0x54, 0xd0, 0x4b, 0x50, 0xe3, 0xa7, 0x71, 0x4a, 0x80, 0x29, 0x83, 0xa6, 0xad,
+	0x2f, 0x40, 0x45, 0xf9, 0xbf, 0x04, 0x35, 0x7e, 0x46, 0xa7, 0xd0, 0x4f, 0x99, 0x3f, 0x6d, 0x7d,
+	0x01, 0x2a, 0x4e, 0x1f, 0x3f, 0xb6, 0x53, 0xe8, 0xa7, 0x4c, 0x82, 0xb6, 0xbe, 0x00, 0x15, 0xd1,
+	0x3f, 0x83, 0x8c, 0xbc, 0xe3, 0x2b, 0x13, 0x2e, 0x89, 0xb1, 0xd0, 0xee, 0xce, 0x7c, 0x1f, 0x92,
+	0x6d, 0x9f, 0xbc, 0x7d, 0x57, 0x51, 0xfe, 0x7c, 0x57, 0x59, 0xfa, 0xf1, 0xbc, 0xa2, 0xbc, 0x3d,
+	0xaf, 0x28, 0x7f, 0x9c, 0x57, 0x94, 0xbf, 0xcf, 0x2b, 0xca, 0x2f, 0xff, 0x54, 0x96, 0x5e, 0x3c,
+	0xbe, 0xfc, 0xbf, 0x97, 0x88, 0x14, 0xfd, 0x7d, 0x75, 0x33, 0xfc, 0xd7, 0xeb, 0xe3, 0x7f, 0x07,
+	0x00, 0x56, 0x8a, 0x85, 0xbf, 0x0a, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1160,7 +1401,9 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type VolumeRuntimeClient interface {
+	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
 	ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error)
+	WatchVolumes(ctx context.Context, in *WatchVolumesRequest, opts ...grpc.CallOption) (VolumeRuntime_WatchVolumesClient, error)
 	CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error)
 	ExpandVolume(ctx context.Context, in *ExpandVolumeRequest, opts ...grpc.CallOption) (*ExpandVolumeResponse, error)
 	DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error)
@@ -1175,6 +1418,15 @@ func NewVolumeRuntimeClient(cc *grpc.ClientConn) VolumeRuntimeClient {
 	return &volumeRuntimeClient{cc}
 }
 
+func (c *volumeRuntimeClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/volume.v1alpha1.VolumeRuntime/Version", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *volumeRuntimeClient) ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error) {
 	out := new(ListVolumesResponse)
 	err := c.cc.Invoke(ctx, "/volume.v1alpha1.VolumeRuntime/ListVolumes", in, out, opts...)
@@ -1184,6 +1436,38 @@ func (c *volumeRuntimeClient) ListVolumes(ctx context.Context, in *ListVolumesRe
 	return out, nil
 }
 
+func (c *volumeRuntimeClient) WatchVolumes(ctx context.Context, in *WatchVolumesRequest, opts ...grpc.CallOption) (VolumeRuntime_WatchVolumesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_VolumeRuntime_serviceDesc.Streams[0], "/volume.v1alpha1.VolumeRuntime/WatchVolumes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &volumeRuntimeWatchVolumesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VolumeRuntime_WatchVolumesClient interface {
+	Recv() (*WatchVolumesResponse, error)
+	grpc.ClientStream
+}
+
+type volumeRuntimeWatchVolumesClient struct {
+	grpc.ClientStream
+}
+
+func (x *volumeRuntimeWatchVolumesClient) Recv() (*WatchVolumesResponse, error) {
+	m := new(WatchVolumesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *volumeRuntimeClient) CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error) {
 	out := new(CreateVolumeResponse)
 	err := c.cc.Invoke(ctx, "/volume.v1alpha1.VolumeRuntime/CreateVolume", in, out, opts...)
@@ -1222,7 +1506,9 @@ func (c *volumeRuntimeClient) Status(ctx context.Context, in *StatusRequest, opt
 
 // VolumeRuntimeServer is the server API for VolumeRuntime service.
 type VolumeRuntimeServer interface {
+	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error)
+	WatchVolumes(*WatchVolumesRequest, VolumeRuntime_WatchVolumesServer) error
 	CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error)
 	ExpandVolume(context.Context, *ExpandVolumeRequest) (*ExpandVolumeResponse, error)
 	DeleteVolume(context.Context, *DeleteVolumeRequest) (*DeleteVolumeResponse, error)
@@ -1233,9 +1519,15 @@ type VolumeRuntimeServer interface {
 type UnimplementedVolumeRuntimeServer struct {
 }
 
+func (*UnimplementedVolumeRuntimeServer) Version(ctx context.Context, req *VersionRequest) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
 func (*UnimplementedVolumeRuntimeServer) ListVolumes(ctx context.Context, req *ListVolumesRequest) (*ListVolumesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVolumes not implemented")
 }
+func (*UnimplementedVolumeRuntimeServer) WatchVolumes(req *WatchVolumesRequest, srv VolumeRuntime_WatchVolumesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchVolumes not implemented")
+}
 func (*UnimplementedVolumeRuntimeServer) CreateVolume(ctx context.Context, req *CreateVolumeRequest) (*CreateVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateVolume not implemented")
 }
@@ -1253,6 +1545,24 @@ func RegisterVolumeRuntimeServer(s *grpc.Server, srv VolumeRuntimeServer) {
 	s.RegisterService(&_VolumeRuntime_serviceDesc, srv)
 }
 
+func _VolumeRuntime_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeRuntimeServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume.v1alpha1.VolumeRuntime/Version",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeRuntimeServer).Version(ctx, req.(*VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VolumeRuntime_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListVolumesRequest)
 	if err := dec(in); err != nil {
@@ -1271,6 +1581,27 @@ func _VolumeRuntime_ListVolumes_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeRuntime_WatchVolumes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchVolumesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VolumeRuntimeServer).WatchVolumes(m, &volumeRuntimeWatchVolumesServer{stream})
+}
+
+type VolumeRuntime_WatchVolumesServer interface {
+	Send(*WatchVolumesResponse) error
+	grpc.ServerStream
+}
+
+type volumeRuntimeWatchVolumesServer struct {
+	grpc.ServerStream
+}
+
+func (x *volumeRuntimeWatchVolumesServer) Send(m *WatchVolumesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _VolumeRuntime_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateVolumeRequest)
 	if err := dec(in); err != nil {
@@ -1347,6 +1678,10 @@ var _VolumeRuntime_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "volume.v1alpha1.VolumeRuntime",
 	HandlerType: (*VolumeRuntimeServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Version",
+			Handler:    _VolumeRuntime_Version_Handler,
+		},
 		{
 			MethodName: "ListVolumes",
 			Handler:    _VolumeRuntime_ListVolumes_Handler,
@@ -1368,11 +1703,17 @@ var _VolumeRuntime_serviceDesc = grpc.ServiceDesc{
 			Handler:    _VolumeRuntime_Status_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchVolumes",
+			Handler:       _VolumeRuntime_WatchVolumes_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api.proto",
 }
 
-func (m *VolumeFilter) Marshal() (dAtA []byte, err error) {
+func (m *VersionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1382,46 +1723,122 @@ func (m *VolumeFilter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VolumeFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *VersionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VolumeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LabelSelector) > 0 {
-		for k := range m.LabelSelector {
-			v := m.LabelSelector[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintApi(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintApi(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintApi(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Version)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VolumeResources) Marshal() (dAtA []byte, err error) {
+func (m *VersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Capabilities[iNdEx])
+			copy(dAtA[i:], m.Capabilities[iNdEx])
+			i = encodeVarintApi(dAtA, i, uint64(len(m.Capabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RuntimeVersion) > 0 {
+		i -= len(m.RuntimeVersion)
+		copy(dAtA[i:], m.RuntimeVersion)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.RuntimeVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RuntimeName) > 0 {
+		i -= len(m.RuntimeName)
+		copy(dAtA[i:], m.RuntimeName)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.RuntimeName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LabelSelector) > 0 {
+		for k := range m.LabelSelector {
+			v := m.LabelSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApi(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeResources) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1917,6 +2334,95 @@ func (m *ListVolumesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WatchVolumesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchVolumesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchVolumesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResourceVersion) > 0 {
+		i -= len(m.ResourceVersion)
+		copy(dAtA[i:], m.ResourceVersion)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ResourceVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchVolumesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchVolumesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchVolumesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResourceVersion) > 0 {
+		i -= len(m.ResourceVersion)
+		copy(dAtA[i:], m.ResourceVersion)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ResourceVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Volume != nil {
+		{
+			size, err := m.Volume.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CreateVolumeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2176,6 +2682,42 @@ func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *VersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *VersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RuntimeName)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.RuntimeVersion)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *VolumeFilter) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2405,6 +2947,43 @@ func (m *ListVolumesResponse) Size() (n int) {
 	return n
 }
 
+func (m *WatchVolumesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ResourceVersion)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchVolumesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovApi(uint64(m.Type))
+	}
+	if m.Volume != nil {
+		l = m.Volume.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ResourceVersion)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
 func (m *CreateVolumeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2509,6 +3088,28 @@ func sovApi(x uint64) (n int) {
 func sozApi(x uint64) (n int) {
 	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *VersionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionRequest{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionResponse{`,
+		`RuntimeName:` + fmt.Sprintf("%v", this.RuntimeName) + `,`,
+		`RuntimeVersion:` + fmt.Sprintf("%v", this.RuntimeVersion) + `,`,
+		`Capabilities:` + fmt.Sprintf("%v", this.Capabilities) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *VolumeFilter) String() string {
 	if this == nil {
 		return "nil"
@@ -2687,6 +3288,29 @@ func (this *ListVolumesResponse) String() string {
 	}, "")
 	return s
 }
+func (this *WatchVolumesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchVolumesRequest{`,
+		`Filter:` + strings.Replace(this.Filter.String(), "VolumeFilter", "VolumeFilter", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchVolumesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchVolumesResponse{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Volume:` + strings.Replace(this.Volume.String(), "Volume", "Volume", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CreateVolumeRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -2775,8 +3399,236 @@ func valueToStringApi(v interface{}) string {
 	if rv.IsNil() {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *VersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *VolumeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -4533,6 +5385,261 @@ func (m *ListVolumesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WatchVolumesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchVolumesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchVolumesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &VolumeFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchVolumesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchVolumesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchVolumesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v1alpha1.WatchEventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Volume == nil {
+				m.Volume = &Volume{}
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CreateVolumeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,7 +10,9 @@ import (
 )
 
 type RuntimeService interface {
+	Version(context.Context, *api.VersionRequest) (*api.VersionResponse, error)
 	ListVolumes(context.Context, *api.ListVolumesRequest) (*api.ListVolumesResponse, error)
+	WatchVolumes(context.Context, *api.WatchVolumesRequest) (api.VolumeRuntime_WatchVolumesClient, error)
 	CreateVolume(context.Context, *api.CreateVolumeRequest) (*api.CreateVolumeResponse, error)
 	ExpandVolume(ctx context.Context, request *api.ExpandVolumeRequest) (*api.ExpandVolumeResponse, error)
 	DeleteVolume(context.Context, *api.DeleteVolumeRequest) (*api.DeleteVolumeResponse, error)
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/ironcore-dev/ironcore/iri/auth"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type keyPair struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newKeyPair(template *x509.Certificate, parent *keyPair) keyPair {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Minute)
+	template.NotAfter = time.Now().Add(time.Hour)
+
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+	return keyPair{cert: cert, key: key}
+}
+
+func newCA() keyPair {
+	return newKeyPair(&x509.Certificate{
+		Subject:               pkix.Name{CommonName: "ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+}
+
+func newLeaf(ca keyPair, commonName string, usage x509.ExtKeyUsage) keyPair {
+	return newKeyPair(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: commonName},
+		DNSNames:    []string{commonName},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{usage},
+	}, &ca)
+}
+
+func writeFile(dir, name string, data []byte) string {
+	path := filepath.Join(dir, name)
+	ExpectWithOffset(1, os.WriteFile(path, data, 0600)).To(Succeed())
+	return path
+}
+
+func writeCert(dir, name string, kp keyPair) string {
+	return writeFile(dir, name, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: kp.cert.Raw}))
+}
+
+func writeKey(dir, name string, kp keyPair) string {
+	der, err := x509.MarshalPKCS8PrivateKey(kp.key)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return writeFile(dir, name, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func serve(network string, opts ServerOptions) string {
+	srvOpts, err := GRPCServerOptions(opts)
+	Expect(err).NotTo(HaveOccurred())
+
+	srv := grpc.NewServer(srvOpts...)
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+
+	address := "127.0.0.1:0"
+	if network == "unix" {
+		address = filepath.Join(GinkgoT().TempDir(), "iri.sock")
+	}
+	l, err := net.Listen(network, address)
+	Expect(err).NotTo(HaveOccurred())
+	go func() {
+		defer GinkgoRecover()
+		_ = srv.Serve(l)
+	}()
+	DeferCleanup(srv.Stop)
+
+	if network == "unix" {
+		return "unix://" + l.Addr().String()
+	}
+	return l.Addr().String()
+}
+
+func check(address string, opts ClientOptions) error {
+	dialOpts, err := DialOptions(opts)
+	Expect(err).NotTo(HaveOccurred())
+
+	conn, err := grpc.Dial(address, dialOpts...)
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+var _ = Describe("Auth", func() {
+	var dir string
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	Context("Token", func() {
+		It("should authenticate requests with a valid bearer token", func() {
+			address := serve("unix", ServerOptions{
+				TokenFile: writeFile(dir, "tokens", []byte("# comment\nfoo\n\nbar\n")),
+			})
+
+			By("using a valid token")
+			Expect(check(address, ClientOptions{TokenFile: writeFile(dir, "valid", []byte("bar\n"))})).To(Succeed())
+
+			By("using an invalid token")
+			err := check(address, ClientOptions{TokenFile: writeFile(dir, "invalid", []byte("baz"))})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+			By("not using any token")
+			err = check(address, ClientOptions{})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should pick up rotated tokens", func() {
+			tokensFile := writeFile(dir, "tokens", []byte("foo"))
+			address := serve("unix", ServerOptions{TokenFile: tokensFile})
+
+			tokenFile := writeFile(dir, "token", []byte("foo"))
+			Expect(check(address, ClientOptions{TokenFile: tokenFile})).To(Succeed())
+
+			By("rotating the token")
+			Expect(os.WriteFile(tokensFile, []byte("foo-rotated"), 0600)).To(Succeed())
+			Expect(os.WriteFile(tokenFile, []byte("foo-rotated"), 0600)).To(Succeed())
+			Eventually(func() error {
+				return check(address, ClientOptions{TokenFile: tokenFile})
+			}).Should(Succeed())
+		})
+	})
+
+	Context("TLS", func() {
+		var (
+			ca                        keyPair
+			caFile, certFile, keyFile string
+		)
+		BeforeEach(func() {
+			ca = newCA()
+			caFile = writeCert(dir, "ca.crt", ca)
+
+			serving := newLeaf(ca, "localhost", x509.ExtKeyUsageServerAuth)
+			certFile = writeCert(dir, "tls.crt", serving)
+			keyFile = writeKey(dir, "tls.key", serving)
+		})
+
+		It("should verify the server and require a client certificate", func() {
+			source, err := NewFileCertificateSource(certFile, keyFile)
+			Expect(err).NotTo(HaveOccurred())
+			address := serve("tcp", ServerOptions{
+				TLS: &ServerTLSOptions{Certificate: source, ClientCAFile: caFile},
+			})
+
+			client := newLeaf(ca, "client", x509.ExtKeyUsageClientAuth)
+			clientSource, err := NewFileCertificateSource(
+				writeCert(dir, "client.crt", client),
+				writeKey(dir, "client.key", client),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("presenting a client certificate")
+			Expect(check(address, ClientOptions{
+				TLS: &ClientTLSOptions{Certificate: clientSource, CAFile: caFile, ServerName: "localhost"},
+			})).To(Succeed())
+
+			By("not presenting a client certificate")
+			Expect(check(address, ClientOptions{
+				TLS: &ClientTLSOptions{CAFile: caFile, ServerName: "localhost"},
+			})).To(HaveOccurred())
+
+			By("not trusting the server certificate")
+			Expect(check(address, ClientOptions{
+				TLS: &ClientTLSOptions{Certificate: clientSource, CAFile: writeCert(dir, "other-ca.crt", newCA()), ServerName: "localhost"},
+			})).To(HaveOccurred())
+		})
+
+		It("should reload rotated certificates", func() {
+			source, err := NewFileCertificateSource(certFile, keyFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(source.Certificate().Leaf.Subject.CommonName).To(Equal("localhost"))
+
+			By("rotating the certificate")
+			rotated := newLeaf(ca, "rotated", x509.ExtKeyUsageServerAuth)
+			writeKey(dir, "tls.key", rotated)
+			writeCert(dir, "tls.crt", rotated)
+
+			Eventually(func() string {
+				return source.Certificate().Leaf.Subject.CommonName
+			}).Should(Equal("rotated"))
+		})
+	})
+})
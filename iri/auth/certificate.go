@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"sync"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var log = ctrl.Log.WithName("iri").WithName("auth")
+
+// CertificateSource provides the current certificate of a TLS endpoint.
+//
+// utils/certificate.Rotator implements CertificateSource, so certificates requested and rotated via
+// certificate signing requests can be used directly.
+type CertificateSource interface {
+	Certificate() *tls.Certificate
+}
+
+// FileCertificateSource is a CertificateSource that loads a certificate from a certificate and key file.
+// The certificate is reloaded whenever one of the files changes.
+type FileCertificateSource struct {
+	certFile *reloadingFile
+	keyFile  *reloadingFile
+
+	mu          sync.Mutex
+	certificate *tls.Certificate
+}
+
+func NewFileCertificateSource(certFile, keyFile string) (*FileCertificateSource, error) {
+	if certFile == "" {
+		return nil, fmt.Errorf("must specify certFile")
+	}
+	if keyFile == "" {
+		return nil, fmt.Errorf("must specify keyFile")
+	}
+
+	cf, err := newReloadingFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+	kf, err := newReloadingFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FileCertificateSource{
+		certFile: cf,
+		keyFile:  kf,
+	}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileCertificateSource) reload() error {
+	certPEM, certChanged, err := s.certFile.read()
+	if err != nil {
+		return err
+	}
+	keyPEM, keyChanged, err := s.keyFile.read()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.certificate != nil && !certChanged && !keyChanged {
+		return nil
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return fmt.Errorf("error loading key pair: %w", err)
+	}
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return fmt.Errorf("error parsing certificate: %w", err)
+	}
+	s.certificate = &cert
+	return nil
+}
+
+// Certificate returns the current certificate. If reloading the certificate fails
+// (e.g. because only one of the files was rotated yet), the previous certificate is returned.
+func (s *FileCertificateSource) Certificate() *tls.Certificate {
+	if err := s.reload(); err != nil {
+		log.V(1).Info("Error reloading certificate, using previous certificate", "Error", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.certificate
+}
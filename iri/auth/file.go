@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// reloadingFile caches the contents of a file and reloads them whenever the file changes.
+type reloadingFile struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	data    []byte
+}
+
+func newReloadingFile(path string) (*reloadingFile, error) {
+	f := &reloadingFile{path: path}
+	if _, _, err := f.read(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// read returns the current contents of the file and whether they changed since the last read.
+// If the file cannot be read, the last successfully read contents are returned alongside the error.
+func (f *reloadingFile) read() (data []byte, changed bool, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stat, err := os.Stat(f.path)
+	if err != nil {
+		return f.data, false, fmt.Errorf("error stat-ing %s: %w", f.path, err)
+	}
+	if f.data != nil && stat.ModTime().Equal(f.modTime) && stat.Size() == f.size {
+		return f.data, false, nil
+	}
+
+	data, err = os.ReadFile(f.path)
+	if err != nil {
+		return f.data, false, fmt.Errorf("error reading %s: %w", f.path, err)
+	}
+
+	f.modTime = stat.ModTime()
+	f.size = stat.Size()
+	f.data = data
+	return data, true, nil
+}
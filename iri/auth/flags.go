@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// ClientFlags are flags for configuring ClientOptions.
+type ClientFlags struct {
+	TLS           bool
+	TLSCertFile   string
+	TLSKeyFile    string
+	TLSCAFile     string
+	TLSServerName string
+	TLSSignerName string
+	TokenFile     string
+}
+
+// BindFlags adds the flags to the pflag.FlagSet. All flags are prefixed with the given prefix,
+// e.g. 'machine-runtime-' results in '--machine-runtime-tls-cert-file'.
+func (o *ClientFlags) BindFlags(fs *pflag.FlagSet, prefix string) {
+	fs.BoolVar(&o.TLS, prefix+"tls", o.TLS, "Whether to connect using TLS. Implied by the other tls flags.")
+	fs.StringVar(&o.TLSCertFile, prefix+"tls-cert-file", o.TLSCertFile, "Path pointing to a PEM-encoded client certificate. "+
+		"The certificate is reloaded when the file changes.")
+	fs.StringVar(&o.TLSKeyFile, prefix+"tls-key-file", o.TLSKeyFile, "Path pointing to the PEM-encoded key of the client certificate.")
+	fs.StringVar(&o.TLSCAFile, prefix+"tls-ca-file", o.TLSCAFile, "Path pointing to a PEM-encoded CA file for verifying the server. "+
+		"If unset, the system roots are used.")
+	fs.StringVar(&o.TLSServerName, prefix+"tls-server-name", o.TLSServerName, "Server name to verify the server certificate for.")
+	fs.StringVar(&o.TokenFile, prefix+"token-file", o.TokenFile, "Path pointing to a file containing a bearer token to authenticate with. "+
+		"The token is reloaded when the file changes.")
+}
+
+// BindSignerNameFlag adds the flag for requesting the client certificate via a certificate signing request
+// to the pflag.FlagSet. It is only meaningful for components using SetupClientOptions.
+func (o *ClientFlags) BindSignerNameFlag(fs *pflag.FlagSet, prefix string) {
+	fs.StringVar(&o.TLSSignerName, prefix+"tls-signer-name", o.TLSSignerName, "Signer name to request a client certificate for "+
+		"via a certificate signing request. The certificate is rotated before it expires. Mutually exclusive with the certificate file flags.")
+}
+
+// TLSEnabled reports whether the flags configure TLS.
+func (o *ClientFlags) TLSEnabled() bool {
+	return o.TLS || o.TLSCertFile != "" || o.TLSCAFile != "" || o.TLSServerName != "" || o.TLSSignerName != ""
+}
+
+// ClientOptions produces ClientOptions. If TLSSignerName is set, the caller has to set the Certificate
+// of the resulting ClientTLSOptions, see SetupClientOptions.
+func (o *ClientFlags) ClientOptions() (ClientOptions, error) {
+	opts := ClientOptions{TokenFile: o.TokenFile}
+	if !o.TLSEnabled() {
+		return opts, nil
+	}
+	if o.TLSSignerName != "" && (o.TLSCertFile != "" || o.TLSKeyFile != "") {
+		return ClientOptions{}, fmt.Errorf("cannot specify both a tls signer name and certificate files")
+	}
+
+	tlsOpts := &ClientTLSOptions{
+		CAFile:     o.TLSCAFile,
+		ServerName: o.TLSServerName,
+	}
+	if o.TLSCertFile != "" || o.TLSKeyFile != "" {
+		source, err := NewFileCertificateSource(o.TLSCertFile, o.TLSKeyFile)
+		if err != nil {
+			return ClientOptions{}, fmt.Errorf("error loading client certificate: %w", err)
+		}
+		tlsOpts.Certificate = source
+	}
+	opts.TLS = tlsOpts
+	return opts, nil
+}
+
+// SetupClientOptions produces ClientOptions. If TLSSignerName is set, it creates a client certificate rotator
+// with the given name and certificate request template, initializes it and adds it and its health check
+// to the manager.
+func (o *ClientFlags) SetupClientOptions(ctx context.Context, mgr manager.Manager, name string, template *x509.CertificateRequest) (ClientOptions, error) {
+	opts, err := o.ClientOptions()
+	if err != nil || o.TLSSignerName == "" {
+		return opts, err
+	}
+
+	rotator, err := NewClientCertificateRotator(name, mgr.GetConfig(), o.TLSSignerName, template)
+	if err != nil {
+		return ClientOptions{}, fmt.Errorf("error creating client certificate rotator: %w", err)
+	}
+	if err := rotator.Init(ctx, false); err != nil {
+		return ClientOptions{}, fmt.Errorf("error initializing client certificate rotator: %w", err)
+	}
+	if err := mgr.Add(rotator); err != nil {
+		return ClientOptions{}, fmt.Errorf("error adding client certificate rotator to manager: %w", err)
+	}
+	if err := mgr.AddHealthzCheck(name, rotator.Check); err != nil {
+		return ClientOptions{}, fmt.Errorf("error adding client certificate rotator healthz check: %w", err)
+	}
+
+	opts.TLS.Certificate = rotator
+	return opts, nil
+}
+
+// ServerFlags are flags for configuring ServerOptions.
+type ServerFlags struct {
+	TLSCertFile   string
+	TLSKeyFile    string
+	ClientCAFile  string
+	TokenAuthFile string
+}
+
+// BindFlags adds the flags to the pflag.FlagSet.
+func (o *ServerFlags) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.TLSCertFile, "tls-cert-file", o.TLSCertFile, "Path pointing to a PEM-encoded serving certificate. "+
+		"If set, the server is served using TLS. The certificate is reloaded when the file changes.")
+	fs.StringVar(&o.TLSKeyFile, "tls-key-file", o.TLSKeyFile, "Path pointing to the PEM-encoded key of the serving certificate.")
+	fs.StringVar(&o.ClientCAFile, "client-ca-file", o.ClientCAFile, "Path pointing to a PEM-encoded CA file for verifying client certificates. "+
+		"If set, clients have to present a certificate signed by it.")
+	fs.StringVar(&o.TokenAuthFile, "token-auth-file", o.TokenAuthFile, "Path pointing to a file containing the bearer tokens "+
+		"(one per line) clients may authenticate with. If set, clients have to present one of the tokens. "+
+		"The tokens are reloaded when the file changes.")
+}
+
+// ServerOptions produces ServerOptions.
+func (o *ServerFlags) ServerOptions() (ServerOptions, error) {
+	opts := ServerOptions{TokenFile: o.TokenAuthFile}
+	if o.TLSCertFile == "" && o.TLSKeyFile == "" {
+		if o.ClientCAFile != "" {
+			return ServerOptions{}, fmt.Errorf("client-ca-file requires tls-cert-file and tls-key-file")
+		}
+		return opts, nil
+	}
+
+	source, err := NewFileCertificateSource(o.TLSCertFile, o.TLSKeyFile)
+	if err != nil {
+		return ServerOptions{}, fmt.Errorf("error loading serving certificate: %w", err)
+	}
+	opts.TLS = &ServerTLSOptions{
+		Certificate:  source,
+		ClientCAFile: o.ClientCAFile,
+	}
+	return opts, nil
+}
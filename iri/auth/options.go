@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package auth implements transport security and authentication for IRI gRPC clients and servers.
+package auth
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials/local"
+)
+
+// ClientOptions are options for securing the connection of an IRI client.
+type ClientOptions struct {
+	// TLS configures TLS for the connection. If nil, TLS is not used.
+	TLS *ClientTLSOptions
+	// TokenFile is the path of a file containing a bearer token to send with every request.
+	TokenFile string
+}
+
+// DialOptions returns the grpc.DialOption for connecting with the given options.
+//
+// Without TLS, bearer tokens can only be sent over unix sockets.
+func DialOptions(opts ClientOptions) ([]grpc.DialOption, error) {
+	var res []grpc.DialOption
+
+	switch {
+	case opts.TLS != nil:
+		cfg, err := NewClientTLSConfig(*opts.TLS)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, grpc.WithTransportCredentials(credentials.NewTLS(cfg)))
+	case opts.TokenFile != "":
+		res = append(res, grpc.WithTransportCredentials(local.NewCredentials()))
+	default:
+		res = append(res, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	if opts.TokenFile != "" {
+		creds, err := NewTokenCredentials(opts.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, grpc.WithPerRPCCredentials(creds))
+	}
+	return res, nil
+}
+
+// ServerOptions are options for securing an IRI server.
+type ServerOptions struct {
+	// TLS configures TLS for the server. If nil, TLS is not used.
+	TLS *ServerTLSOptions
+	// TokenFile is the path of a file containing the bearer tokens clients are authenticated with.
+	// If empty, bearer tokens are not checked.
+	TokenFile string
+}
+
+// GRPCServerOptions returns the grpc.ServerOption for serving with the given options.
+func GRPCServerOptions(opts ServerOptions) ([]grpc.ServerOption, error) {
+	var res []grpc.ServerOption
+
+	if opts.TLS != nil {
+		cfg, err := NewServerTLSConfig(*opts.TLS)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, grpc.Creds(credentials.NewTLS(cfg)))
+	}
+
+	if opts.TokenFile != "" {
+		authenticator, err := NewTokenAuthenticator(opts.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res,
+			grpc.ChainUnaryInterceptor(authenticator.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(authenticator.StreamServerInterceptor()),
+		)
+	}
+	return res, nil
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/ironcore-dev/ironcore/utils/certificate"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewClientCertificateRotator creates a certificate.Rotator that requests a client certificate for connecting to
+// an IRI server via a certificate signing request for the given signer and rotates it before it expires.
+// The rotator has to be started (e.g. by adding it to a manager) and can be used as CertificateSource.
+func NewClientCertificateRotator(name string, cfg *rest.Config, signerName string, template *x509.CertificateRequest) (certificate.Rotator, error) {
+	return certificate.NewRotator(certificate.RotatorOptions{
+		Name: name,
+		NewClient: func(*tls.Certificate) (client.WithWatch, error) {
+			return client.NewWithWatch(cfg, client.Options{})
+		},
+		SignerName: signerName,
+		Template:   template,
+		GetUsages:  certificate.DefaultKubeAPIServerClientGetUsages,
+	})
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// ClientTLSOptions are options for the TLS configuration of an IRI client.
+type ClientTLSOptions struct {
+	// Certificate is the client certificate to present to the server.
+	// If unset, no client certificate is presented.
+	Certificate CertificateSource
+	// CAFile is the path of a PEM-encoded CA bundle to verify the server certificate with.
+	// If empty, the system roots are used.
+	CAFile string
+	// ServerName overrides the name the server certificate is verified for.
+	ServerName string
+}
+
+// NewClientTLSConfig creates the tls.Config for an IRI client.
+// The client certificate is obtained on every handshake, so rotated certificates are used for new connections.
+func NewClientTLSConfig(opts ClientTLSOptions) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: opts.ServerName,
+	}
+
+	if opts.CAFile != "" {
+		pool, err := loadCertPool(opts.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+
+	if opts.Certificate != nil {
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if cert := opts.Certificate.Certificate(); cert != nil {
+				return cert, nil
+			}
+			// No certificate available (yet), continue without presenting one.
+			return &tls.Certificate{}, nil
+		}
+	}
+	return cfg, nil
+}
+
+// ServerTLSOptions are options for the TLS configuration of an IRI server.
+type ServerTLSOptions struct {
+	// Certificate is the serving certificate. Required.
+	Certificate CertificateSource
+	// ClientCAFile is the path of a PEM-encoded CA bundle to verify client certificates with.
+	// If set, clients have to present a certificate signed by one of the CAs.
+	ClientCAFile string
+}
+
+// NewServerTLSConfig creates the tls.Config for an IRI server.
+// The serving certificate is obtained on every handshake, so rotated certificates are used for new connections.
+func NewServerTLSConfig(opts ServerTLSOptions) (*tls.Config, error) {
+	if opts.Certificate == nil {
+		return nil, fmt.Errorf("must specify Certificate")
+	}
+
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert := opts.Certificate.Certificate()
+			if cert == nil {
+				return nil, fmt.Errorf("no serving certificate available")
+			}
+			return cert, nil
+		},
+	}
+
+	if opts.ClientCAFile != "" {
+		pool, err := loadCertPool(opts.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading ca file %s: %w", caFile, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no valid ca certificates in %s", caFile)
+	}
+	return pool, nil
+}
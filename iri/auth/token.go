@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationKey = "authorization"
+	bearerPrefix     = "Bearer "
+)
+
+// TokenCredentials are credentials.PerRPCCredentials that send a bearer token read from a file.
+// The file is reloaded whenever it changes, so rotated tokens are picked up without reconnecting.
+type TokenCredentials struct {
+	file *reloadingFile
+}
+
+var _ credentials.PerRPCCredentials = (*TokenCredentials)(nil)
+
+func NewTokenCredentials(tokenFile string) (*TokenCredentials, error) {
+	f, err := newReloadingFile(tokenFile)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenCredentials{file: f}, nil
+}
+
+func (c *TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	data, _, err := c.file.read()
+	if data == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "error reading token: %v", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "token file is empty")
+	}
+	return map[string]string{authorizationKey: bearerPrefix + token}, nil
+}
+
+// RequireTransportSecurity reports that tokens must only be sent over secure (TLS or local) connections.
+func (c *TokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// TokenAuthenticator authenticates requests by their bearer token.
+//
+// The valid tokens are read from a token file containing one token per line. Empty lines and lines
+// starting with '#' are ignored. The file is reloaded whenever it changes.
+type TokenAuthenticator struct {
+	file *reloadingFile
+
+	mu     sync.RWMutex
+	tokens [][]byte
+}
+
+func NewTokenAuthenticator(tokenFile string) (*TokenAuthenticator, error) {
+	f, err := newReloadingFile(tokenFile)
+	if err != nil {
+		return nil, err
+	}
+
+	data, _, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := parseTokens(data)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenAuthenticator{file: f, tokens: tokens}, nil
+}
+
+func parseTokens(data []byte) ([][]byte, error) {
+	var tokens [][]byte
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		tokens = append(tokens, bytes.Clone(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error parsing tokens: %w", err)
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("no tokens specified")
+	}
+	return tokens, nil
+}
+
+func (a *TokenAuthenticator) reload() error {
+	data, changed, err := a.file.read()
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+
+	tokens, err := parseTokens(data)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.tokens = tokens
+	return nil
+}
+
+// Authenticate checks whether the incoming context carries a valid bearer token.
+func (a *TokenAuthenticator) Authenticate(ctx context.Context) error {
+	if err := a.reload(); err != nil {
+		log.V(1).Info("Error reloading tokens, using previous tokens", "Error", err)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationKey)
+	if len(values) != 1 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	token := []byte(strings.TrimPrefix(values[0], bearerPrefix))
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, valid := range a.tokens {
+		if subtle.ConstantTimeCompare(token, valid) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid bearer token")
+}
+
+// UnaryServerInterceptor returns a grpc.UnaryServerInterceptor rejecting unauthenticated requests.
+func (a *TokenAuthenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := a.Authenticate(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a grpc.StreamServerInterceptor rejecting unauthenticated streams.
+func (a *TokenAuthenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.Authenticate(ss.Context()); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
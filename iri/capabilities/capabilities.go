@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package capabilities contains the optional capabilities an IRI runtime can report in its version response.
+//
+// Operations not covered by a capability have to be supported by every runtime.
+package capabilities
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Machine runtime capabilities.
+const (
+	// MachineWatch indicates the runtime implements WatchMachines.
+	MachineWatch = "machine.watch"
+	// MachineExec indicates the runtime implements Exec.
+	MachineExec = "machine.exec"
+)
+
+// Volume runtime capabilities.
+const (
+	// VolumeWatch indicates the runtime implements WatchVolumes.
+	VolumeWatch = "volume.watch"
+	// VolumeExpand indicates the runtime implements ExpandVolume.
+	VolumeExpand = "volume.expand"
+)
+
+// Bucket runtime capabilities.
+const (
+	// BucketWatch indicates the runtime implements WatchBuckets.
+	BucketWatch = "bucket.watch"
+)
+
+var (
+	// MachineOptional are the machine runtime capabilities the machinepoollet can run without.
+	MachineOptional = []string{MachineWatch, MachineExec}
+	// VolumeOptional are the volume runtime capabilities the volumepoollet can run without.
+	VolumeOptional = []string{VolumeWatch, VolumeExpand}
+	// BucketOptional are the bucket runtime capabilities the bucketpoollet can run without.
+	BucketOptional = []string{BucketWatch}
+
+	// MachineLegacy are the capabilities assumed for machine runtimes that do not report any capabilities,
+	// as they predate capability negotiation.
+	MachineLegacy = []string{MachineExec}
+	// VolumeLegacy are the capabilities assumed for volume runtimes that do not implement Version.
+	VolumeLegacy = []string{VolumeExpand}
+	// BucketLegacy are the capabilities assumed for bucket runtimes that do not implement Version.
+	BucketLegacy []string
+)
+
+// Set is a set of capabilities reported by a runtime.
+type Set struct {
+	set sets.Set[string]
+}
+
+// New returns a Set of the given capabilities.
+func New(capabilities ...string) Set {
+	return Set{set: sets.New(capabilities...)}
+}
+
+// Has reports whether the set contains the given capability.
+func (s Set) Has(capability string) bool {
+	return s.set.Has(capability)
+}
+
+// Missing returns the capabilities of the given ones not contained in the set, in order.
+func (s Set) Missing(capabilities ...string) []string {
+	var missing []string
+	for _, capability := range capabilities {
+		if !s.set.Has(capability) {
+			missing = append(missing, capability)
+		}
+	}
+	return missing
+}
+
+// List returns the sorted capabilities of the set.
+func (s Set) List() []string {
+	return sets.List(s.set)
+}
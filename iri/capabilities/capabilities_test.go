@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package capabilities_test
+
+import (
+	. "github.com/ironcore-dev/ironcore/iri/capabilities"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Set", func() {
+	It("should report contained and missing capabilities", func() {
+		set := New(MachineWatch, "foo.bar")
+
+		Expect(set.Has(MachineWatch)).To(BeTrue())
+		Expect(set.Has(MachineExec)).To(BeFalse())
+		Expect(set.Missing(MachineOptional...)).To(Equal([]string{MachineExec}))
+		Expect(set.List()).To(Equal([]string{"foo.bar", MachineWatch}))
+	})
+
+	It("should treat the zero value as empty set", func() {
+		var set Set
+
+		Expect(set.Has(VolumeExpand)).To(BeFalse())
+		Expect(set.Missing(VolumeOptional...)).To(Equal(VolumeOptional))
+		Expect(set.List()).To(BeEmpty())
+	})
+})
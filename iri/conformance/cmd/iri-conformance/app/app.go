@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	iriauth "github.com/ironcore-dev/ironcore/iri/auth"
+	"github.com/ironcore-dev/ironcore/iri/conformance"
+	iriremotemachine "github.com/ironcore-dev/ironcore/iri/remote/machine"
+	iriremotevolume "github.com/ironcore-dev/ironcore/iri/remote/volume"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+)
+
+type Options struct {
+	Address string
+	Auth    iriauth.ClientFlags
+	Timeout time.Duration
+
+	Focus       []string
+	Skip        []string
+	JUnitReport string
+	Verbose     bool
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Address, "address", "", "Address of the runtime to test.")
+	o.Auth.BindFlags(fs, "")
+	fs.DurationVar(&o.Timeout, "timeout", conformance.DefaultTimeout, "Time to wait for the runtime to reflect a change.")
+
+	fs.StringSliceVar(&o.Focus, "focus", o.Focus, "Only run specs matching any of these regular expressions.")
+	fs.StringSliceVar(&o.Skip, "skip", o.Skip, "Skip specs matching any of these regular expressions.")
+	fs.StringVar(&o.JUnitReport, "junit-report", o.JUnitReport, "If set, write a junit report to this file.")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "Print the progress of every spec.")
+}
+
+func (o *Options) dialOptions() ([]grpc.DialOption, error) {
+	authOpts, err := o.Auth.ClientOptions()
+	if err != nil {
+		return nil, err
+	}
+	return iriauth.DialOptions(authOpts)
+}
+
+// closeRuntime closes the connection of a runtime created by one of the iri/remote packages.
+func closeRuntime(runtime any) {
+	if closer, ok := runtime.(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
+
+// failRecorder is the ginkgo.GinkgoTestingT of a suite run outside of 'go test'.
+type failRecorder struct{}
+
+func (failRecorder) Fail() {}
+
+func (o *Options) runSpecs(description string) error {
+	suiteConfig, reporterConfig := ginkgo.GinkgoConfiguration()
+	suiteConfig.FocusStrings = o.Focus
+	suiteConfig.SkipStrings = o.Skip
+	reporterConfig.JUnitReport = o.JUnitReport
+	reporterConfig.Verbose = o.Verbose
+
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	if !ginkgo.RunSpecs(failRecorder{}, description, suiteConfig, reporterConfig) {
+		return fmt.Errorf("conformance specs failed")
+	}
+	return nil
+}
+
+func Command() *cobra.Command {
+	var opts Options
+
+	cmd := &cobra.Command{
+		Use:   "iri-conformance",
+		Short: "Run the IRI conformance specs against a runtime.",
+	}
+
+	opts.AddFlags(cmd.PersistentFlags())
+
+	cmd.AddCommand(
+		MachineCommand(&opts),
+		VolumeCommand(&opts),
+	)
+
+	return cmd
+}
+
+type MachineOptions struct {
+	MachineClass string
+	Image        string
+}
+
+func (o *MachineOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.MachineClass, "machine-class", o.MachineClass, "Machine class offered by the runtime to create machines with.")
+	fs.StringVar(&o.Image, "image", o.Image, "Image to create machines with.")
+}
+
+func MachineCommand(opts *Options) *cobra.Command {
+	var machineOpts MachineOptions
+
+	cmd := &cobra.Command{
+		Use:   "machine",
+		Short: "Run the conformance specs against a machine runtime.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunMachine(opts, machineOpts)
+		},
+	}
+
+	machineOpts.AddFlags(cmd.Flags())
+	_ = cmd.MarkFlagRequired("machine-class")
+
+	return cmd
+}
+
+func RunMachine(opts *Options, machineOpts MachineOptions) error {
+	address, err := iriremotemachine.GetAddressWithTimeout(3*time.Second, opts.Address)
+	if err != nil {
+		return err
+	}
+
+	dialOpts, err := opts.dialOptions()
+	if err != nil {
+		return err
+	}
+
+	runtime, err := iriremotemachine.NewRemoteRuntime(address, dialOpts...)
+	if err != nil {
+		return fmt.Errorf("error creating remote machine runtime: %w", err)
+	}
+	defer closeRuntime(runtime)
+
+	conformance.DescribeMachineRuntime(func() conformance.MachineRuntimeConfig {
+		return conformance.MachineRuntimeConfig{
+			Runtime:      runtime,
+			MachineClass: machineOpts.MachineClass,
+			Image:        machineOpts.Image,
+			Timeout:      opts.Timeout,
+		}
+	})
+	return opts.runSpecs("Machine Runtime Conformance Suite")
+}
+
+type VolumeOptions struct {
+	VolumeClass string
+	Image       string
+	SizeBytes   int64
+}
+
+func (o *VolumeOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.VolumeClass, "volume-class", o.VolumeClass, "Volume class offered by the runtime to create volumes with. The class has to allow expanding volumes if the runtime reports the volume.expand capability.")
+	fs.StringVar(&o.Image, "image", o.Image, "Image to create volumes with, if any.")
+	fs.Int64Var(&o.SizeBytes, "size-bytes", conformance.DefaultVolumeSizeBytes, "Size of the volumes to create.")
+}
+
+func VolumeCommand(opts *Options) *cobra.Command {
+	var volumeOpts VolumeOptions
+
+	cmd := &cobra.Command{
+		Use:   "volume",
+		Short: "Run the conformance specs against a volume runtime.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunVolume(opts, volumeOpts)
+		},
+	}
+
+	volumeOpts.AddFlags(cmd.Flags())
+	_ = cmd.MarkFlagRequired("volume-class")
+
+	return cmd
+}
+
+func RunVolume(opts *Options, volumeOpts VolumeOptions) error {
+	address, err := iriremotevolume.GetAddressWithTimeout(3*time.Second, opts.Address)
+	if err != nil {
+		return err
+	}
+
+	dialOpts, err := opts.dialOptions()
+	if err != nil {
+		return err
+	}
+
+	runtime, err := iriremotevolume.NewRemoteRuntime(address, dialOpts...)
+	if err != nil {
+		return fmt.Errorf("error creating remote volume runtime: %w", err)
+	}
+	defer closeRuntime(runtime)
+
+	conformance.DescribeVolumeRuntime(func() conformance.VolumeRuntimeConfig {
+		return conformance.VolumeRuntimeConfig{
+			Runtime:     runtime,
+			VolumeClass: volumeOpts.VolumeClass,
+			Image:       volumeOpts.Image,
+			SizeBytes:   volumeOpts.SizeBytes,
+			Timeout:     opts.Timeout,
+		}
+	})
+	return opts.runSpecs("Volume Runtime Conformance Suite")
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformance contains Ginkgo specs validating that an IRI runtime implementation
+// behaves the way the poollets expect it to.
+//
+// The specs are registered via DescribeMachineRuntime / DescribeVolumeRuntime and can be run
+// as part of any Ginkgo suite (e.g. against a fake or a broker) or via the iri-conformance command
+// against a runtime listening on a socket.
+// Every spec labels the objects it creates with a unique RunLabel value and deletes them afterward,
+// so the specs can be run against runtimes that already manage other objects.
+package conformance
+
+import (
+	"context"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+const (
+	// RunLabel is the label set on every object created by a conformance spec.
+	// Its value is unique per spec.
+	RunLabel = "conformance.iri.ironcore.dev/run"
+
+	// DefaultTimeout is the default time the specs wait for a runtime to reflect a change.
+	DefaultTimeout = 30 * time.Second
+
+	pollingInterval = 100 * time.Millisecond
+)
+
+func newRunLabels() map[string]string {
+	return map[string]string{RunLabel: string(uuid.NewUUID())}
+}
+
+func withLabels(base map[string]string, additional map[string]string) map[string]string {
+	res := make(map[string]string, len(base)+len(additional))
+	for k, v := range base {
+		res[k] = v
+	}
+	for k, v := range additional {
+		res[k] = v
+	}
+	return res
+}
+
+func ignoreNotFound(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return err
+}
+
+// watchResult is a single result received from a watch stream.
+type watchResult[R any] struct {
+	Response R
+	Err      error
+}
+
+// startWatch receives from recv until it fails, sending every result to the returned channel.
+func startWatch[R any](ctx context.Context, recv func() (R, error)) <-chan watchResult[R] {
+	results := make(chan watchResult[R], 100)
+	go func() {
+		defer ginkgo.GinkgoRecover()
+		for {
+			res, err := recv()
+			select {
+			case results <- watchResult[R]{Response: res, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return results
+}
@@ -0,0 +1,357 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ironcore-dev/ironcore/iri/apis/machine"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/gcustom"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// MachineRuntimeConfig configures the machine runtime conformance specs.
+type MachineRuntimeConfig struct {
+	// Runtime is the machine runtime under test.
+	Runtime machine.RuntimeService
+	// MachineClass is the name of a machine class the runtime reports in its status.
+	// All machines created by the specs use this class.
+	MachineClass string
+	// Image is the image of the machines created by the specs.
+	Image string
+	// Timeout is the time to wait for the runtime to reflect a change. Defaults to DefaultTimeout.
+	Timeout time.Duration
+}
+
+// DescribeMachineRuntime registers the machine runtime conformance specs.
+// newConfig is called before every spec.
+func DescribeMachineRuntime(newConfig func() MachineRuntimeConfig) bool {
+	return ginkgo.Describe("MachineRuntime", func() {
+		var (
+			cfg       MachineRuntimeConfig
+			runLabels map[string]string
+		)
+
+		ginkgo.BeforeEach(func() {
+			runLabels = newRunLabels()
+			cfg = newConfig()
+			if cfg.Timeout == 0 {
+				cfg.Timeout = DefaultTimeout
+			}
+		})
+
+		deleteMachine := func(ctx context.Context, id string) error {
+			_, err := cfg.Runtime.DeleteMachine(ctx, &iri.DeleteMachineRequest{MachineId: id})
+			return err
+		}
+
+		createMachine := func(ctx context.Context, labels map[string]string) *iri.Machine {
+			res, err := cfg.Runtime.CreateMachine(ctx, &iri.CreateMachineRequest{
+				Machine: &iri.Machine{
+					Metadata: &irimeta.ObjectMetadata{
+						Labels:      withLabels(runLabels, labels),
+						Annotations: map[string]string{"conformance.iri.ironcore.dev/annotation": "foo"},
+					},
+					Spec: &iri.MachineSpec{
+						Power: iri.Power_POWER_ON,
+						Image: &iri.ImageSpec{Image: cfg.Image},
+						Class: cfg.MachineClass,
+					},
+				},
+			})
+			gomega.ExpectWithOffset(1, err).NotTo(gomega.HaveOccurred())
+			gomega.ExpectWithOffset(1, res.GetMachine().GetMetadata().GetId()).NotTo(gomega.BeEmpty())
+
+			id := res.Machine.Metadata.Id
+			ginkgo.DeferCleanup(func(ctx ginkgo.SpecContext) {
+				gomega.Expect(ignoreNotFound(deleteMachine(ctx, id))).To(gomega.Succeed())
+			})
+			return res.Machine
+		}
+
+		listMachines := func(ctx context.Context, filter *iri.MachineFilter) func() ([]*iri.Machine, error) {
+			return func() ([]*iri.Machine, error) {
+				res, err := cfg.Runtime.ListMachines(ctx, &iri.ListMachinesRequest{Filter: filter})
+				if err != nil {
+					return nil, err
+				}
+				return res.Machines, nil
+			}
+		}
+
+		getMachine := func(ctx context.Context, id string) func() (*iri.Machine, error) {
+			return func() (*iri.Machine, error) {
+				machines, err := listMachines(ctx, &iri.MachineFilter{Id: id})()
+				if err != nil || len(machines) == 0 {
+					return nil, err
+				}
+				return machines[0], nil
+			}
+		}
+
+		eventually := func(ctx context.Context, actual interface{}) gomega.AsyncAssertion {
+			return gomega.EventuallyWithOffset(1, ctx, actual).WithTimeout(cfg.Timeout).WithPolling(pollingInterval)
+		}
+
+		haveID := func(id string) gomega.OmegaMatcher {
+			return gomega.HaveField("Metadata.Id", id)
+		}
+
+		unknownID := func() string {
+			return "unknown-" + string(uuid.NewUUID())
+		}
+
+		skipUnlessCapability := func(ctx context.Context, capability string) {
+			res, err := cfg.Runtime.Version(ctx, &iri.VersionRequest{})
+			gomega.ExpectWithOffset(1, err).NotTo(gomega.HaveOccurred())
+			if !slices.Contains(res.Capabilities, capability) {
+				ginkgo.Skip(fmt.Sprintf("runtime does not report capability %s", capability))
+			}
+		}
+
+		ginkgo.It("should report its version", func(ctx ginkgo.SpecContext) {
+			res, err := cfg.Runtime.Version(ctx, &iri.VersionRequest{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(res.RuntimeName).NotTo(gomega.BeEmpty())
+			gomega.Expect(res.RuntimeVersion).NotTo(gomega.BeEmpty())
+			gomega.Expect(res.Capabilities).NotTo(gomega.ContainElement(gomega.BeEmpty()), "runtime reports an empty capability")
+		})
+
+		ginkgo.It("should report the machine class status", func(ctx ginkgo.SpecContext) {
+			res, err := cfg.Runtime.Status(ctx, &iri.StatusRequest{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Expect(res.MachineClassStatus).To(gomega.ContainElement(gomega.HaveField("MachineClass.Name", cfg.MachineClass)),
+				"machine class %s is not reported", cfg.MachineClass)
+			for _, machineClassStatus := range res.MachineClassStatus {
+				gomega.Expect(machineClassStatus.MachineClass).NotTo(gomega.BeNil())
+				gomega.Expect(machineClassStatus.MachineClass.Name).NotTo(gomega.BeEmpty())
+				gomega.Expect(machineClassStatus.Quantity).To(gomega.BeNumerically(">=", 0),
+					"machine class %s reports a negative quantity", machineClassStatus.MachineClass.Name)
+			}
+		})
+
+		ginkgo.It("should create a machine and list it", func(ctx ginkgo.SpecContext) {
+			machine := createMachine(ctx, nil)
+			gomega.Expect(machine.Metadata.CreatedAt).To(gomega.BeNumerically(">", 0))
+			gomega.Expect(machine.Metadata.Labels).To(gomega.HaveKeyWithValue(RunLabel, runLabels[RunLabel]))
+			gomega.Expect(machine.Spec.Class).To(gomega.Equal(cfg.MachineClass))
+
+			eventually(ctx, getMachine(ctx, machine.Metadata.Id)).Should(gomega.And(
+				haveID(machine.Metadata.Id),
+				gomega.HaveField("Metadata.Labels", gomega.HaveKeyWithValue(RunLabel, runLabels[RunLabel])),
+				gomega.HaveField("Metadata.Annotations", gomega.HaveKeyWithValue("conformance.iri.ironcore.dev/annotation", "foo")),
+				gomega.HaveField("Spec.Class", cfg.MachineClass),
+			))
+		})
+
+		ginkgo.It("should assign a new id on every create", func(ctx ginkgo.SpecContext) {
+			first := createMachine(ctx, nil)
+			second := createMachine(ctx, nil)
+			gomega.Expect(second.Metadata.Id).NotTo(gomega.Equal(first.Metadata.Id))
+
+			eventually(ctx, listMachines(ctx, &iri.MachineFilter{LabelSelector: runLabels})).Should(gomega.ConsistOf(
+				haveID(first.Metadata.Id),
+				haveID(second.Metadata.Id),
+			))
+		})
+
+		ginkgo.It("should return an empty list when listing an unknown machine id", func(ctx ginkgo.SpecContext) {
+			gomega.Expect(listMachines(ctx, &iri.MachineFilter{Id: unknownID()})()).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("should filter machines by label selector", func(ctx ginkgo.SpecContext) {
+			machineA := createMachine(ctx, map[string]string{"conformance.iri.ironcore.dev/role": "a"})
+			machineB := createMachine(ctx, map[string]string{"conformance.iri.ironcore.dev/role": "b"})
+
+			eventually(ctx, listMachines(ctx, &iri.MachineFilter{
+				LabelSelector: withLabels(runLabels, map[string]string{"conformance.iri.ironcore.dev/role": "a"}),
+			})).Should(gomega.ConsistOf(haveID(machineA.Metadata.Id)))
+
+			eventually(ctx, listMachines(ctx, &iri.MachineFilter{
+				LabelSelector: runLabels,
+			})).Should(gomega.ConsistOf(haveID(machineA.Metadata.Id), haveID(machineB.Metadata.Id)))
+		})
+
+		ginkgo.It("should delete a machine", func(ctx ginkgo.SpecContext) {
+			machine := createMachine(ctx, nil)
+
+			gomega.Expect(deleteMachine(ctx, machine.Metadata.Id)).To(gomega.Succeed())
+			eventually(ctx, getMachine(ctx, machine.Metadata.Id)).Should(gomega.BeNil())
+
+			ginkgo.By("deleting the machine again")
+			gomega.Expect(status.Code(deleteMachine(ctx, machine.Metadata.Id))).To(gomega.Equal(codes.NotFound))
+		})
+
+		ginkgo.It("should report NotFound when deleting an unknown machine", func(ctx ginkgo.SpecContext) {
+			gomega.Expect(status.Code(deleteMachine(ctx, unknownID()))).To(gomega.Equal(codes.NotFound))
+		})
+
+		ginkgo.It("should update the machine annotations", func(ctx ginkgo.SpecContext) {
+			machine := createMachine(ctx, nil)
+
+			_, err := cfg.Runtime.UpdateMachineAnnotations(ctx, &iri.UpdateMachineAnnotationsRequest{
+				MachineId:   machine.Metadata.Id,
+				Annotations: map[string]string{"conformance.iri.ironcore.dev/annotation": "bar"},
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			eventually(ctx, getMachine(ctx, machine.Metadata.Id)).Should(
+				gomega.HaveField("Metadata.Annotations", gomega.HaveKeyWithValue("conformance.iri.ironcore.dev/annotation", "bar")),
+			)
+
+			ginkgo.By("updating the annotations of an unknown machine")
+			_, err = cfg.Runtime.UpdateMachineAnnotations(ctx, &iri.UpdateMachineAnnotationsRequest{MachineId: unknownID()})
+			gomega.Expect(status.Code(err)).To(gomega.Equal(codes.NotFound))
+		})
+
+		ginkgo.It("should update the machine power", func(ctx ginkgo.SpecContext) {
+			machine := createMachine(ctx, nil)
+
+			_, err := cfg.Runtime.UpdateMachinePower(ctx, &iri.UpdateMachinePowerRequest{
+				MachineId: machine.Metadata.Id,
+				Power:     iri.Power_POWER_OFF,
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			eventually(ctx, getMachine(ctx, machine.Metadata.Id)).Should(gomega.HaveField("Spec.Power", iri.Power_POWER_OFF))
+
+			ginkgo.By("updating the power of an unknown machine")
+			_, err = cfg.Runtime.UpdateMachinePower(ctx, &iri.UpdateMachinePowerRequest{
+				MachineId: unknownID(),
+				Power:     iri.Power_POWER_OFF,
+			})
+			gomega.Expect(status.Code(err)).To(gomega.Equal(codes.NotFound))
+		})
+
+		ginkgo.It("should attach and detach a volume", func(ctx ginkgo.SpecContext) {
+			machine := createMachine(ctx, nil)
+			volume := &iri.Volume{
+				Name:      "conformance-volume",
+				Device:    "oda",
+				EmptyDisk: &iri.EmptyDisk{SizeBytes: 1024 * 1024 * 1024},
+			}
+
+			_, err := cfg.Runtime.AttachVolume(ctx, &iri.AttachVolumeRequest{MachineId: machine.Metadata.Id, Volume: volume})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			eventually(ctx, getMachine(ctx, machine.Metadata.Id)).Should(
+				gomega.HaveField("Spec.Volumes", gomega.ContainElement(gomega.HaveField("Name", volume.Name))),
+			)
+
+			ginkgo.By("attaching the volume again")
+			_, err = cfg.Runtime.AttachVolume(ctx, &iri.AttachVolumeRequest{MachineId: machine.Metadata.Id, Volume: volume})
+			gomega.Expect(status.Code(err)).To(gomega.Equal(codes.AlreadyExists))
+
+			ginkgo.By("detaching the volume")
+			_, err = cfg.Runtime.DetachVolume(ctx, &iri.DetachVolumeRequest{MachineId: machine.Metadata.Id, Name: volume.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			eventually(ctx, getMachine(ctx, machine.Metadata.Id)).Should(
+				gomega.HaveField("Spec.Volumes", gomega.Not(gomega.ContainElement(gomega.HaveField("Name", volume.Name)))),
+			)
+
+			ginkgo.By("detaching the volume again")
+			_, err = cfg.Runtime.DetachVolume(ctx, &iri.DetachVolumeRequest{MachineId: machine.Metadata.Id, Name: volume.Name})
+			gomega.Expect(status.Code(err)).To(gomega.Equal(codes.NotFound))
+		})
+
+		ginkgo.It("should report NotFound when attaching a volume to an unknown machine", func(ctx ginkgo.SpecContext) {
+			_, err := cfg.Runtime.AttachVolume(ctx, &iri.AttachVolumeRequest{
+				MachineId: unknownID(),
+				Volume: &iri.Volume{
+					Name:      "conformance-volume",
+					Device:    "oda",
+					EmptyDisk: &iri.EmptyDisk{SizeBytes: 1024 * 1024 * 1024},
+				},
+			})
+			gomega.Expect(status.Code(err)).To(gomega.Equal(codes.NotFound))
+		})
+
+		ginkgo.It("should attach and detach a network interface", func(ctx ginkgo.SpecContext) {
+			machine := createMachine(ctx, nil)
+			nic := &iri.NetworkInterface{
+				Name:      "conformance-nic",
+				NetworkId: "conformance-network",
+				Ips:       []string{"10.0.0.1"},
+			}
+
+			_, err := cfg.Runtime.AttachNetworkInterface(ctx, &iri.AttachNetworkInterfaceRequest{MachineId: machine.Metadata.Id, NetworkInterface: nic})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			eventually(ctx, getMachine(ctx, machine.Metadata.Id)).Should(
+				gomega.HaveField("Spec.NetworkInterfaces", gomega.ContainElement(gomega.HaveField("Name", nic.Name))),
+			)
+
+			ginkgo.By("attaching the network interface again")
+			_, err = cfg.Runtime.AttachNetworkInterface(ctx, &iri.AttachNetworkInterfaceRequest{MachineId: machine.Metadata.Id, NetworkInterface: nic})
+			gomega.Expect(status.Code(err)).To(gomega.Equal(codes.AlreadyExists))
+
+			ginkgo.By("detaching the network interface")
+			_, err = cfg.Runtime.DetachNetworkInterface(ctx, &iri.DetachNetworkInterfaceRequest{MachineId: machine.Metadata.Id, Name: nic.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			eventually(ctx, getMachine(ctx, machine.Metadata.Id)).Should(
+				gomega.HaveField("Spec.NetworkInterfaces", gomega.Not(gomega.ContainElement(gomega.HaveField("Name", nic.Name)))),
+			)
+
+			ginkgo.By("detaching the network interface again")
+			_, err = cfg.Runtime.DetachNetworkInterface(ctx, &iri.DetachNetworkInterfaceRequest{MachineId: machine.Metadata.Id, Name: nic.Name})
+			gomega.Expect(status.Code(err)).To(gomega.Equal(codes.NotFound))
+		})
+
+		ginkgo.It("should report NotFound when attaching a network interface to an unknown machine", func(ctx ginkgo.SpecContext) {
+			_, err := cfg.Runtime.AttachNetworkInterface(ctx, &iri.AttachNetworkInterfaceRequest{
+				MachineId: unknownID(),
+				NetworkInterface: &iri.NetworkInterface{
+					Name:      "conformance-nic",
+					NetworkId: "conformance-network",
+				},
+			})
+			gomega.Expect(status.Code(err)).To(gomega.Equal(codes.NotFound))
+		})
+
+		ginkgo.It("should watch machines", func(ctx ginkgo.SpecContext) {
+			skipUnlessCapability(ctx, capabilities.MachineWatch)
+
+			watchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			stream, err := cfg.Runtime.WatchMachines(watchCtx, &iri.WatchMachinesRequest{
+				Filter: &iri.MachineFilter{LabelSelector: runLabels},
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			results := startWatch(watchCtx, stream.Recv)
+
+			haveEvent := func(typ irimeta.WatchEventType, id string) gomega.OmegaMatcher {
+				return gcustom.MakeMatcher(func(res watchResult[*iri.WatchMachinesResponse]) (bool, error) {
+					if res.Err != nil {
+						return false, res.Err
+					}
+					if res.Response.Type != typ {
+						return false, nil
+					}
+					return id == "" || res.Response.GetMachine().GetMetadata().GetId() == id, nil
+				}).WithTemplate("Expected a {{.Data}} watch event, got {{.FormattedActual}}", typ)
+			}
+
+			ginkgo.By("waiting for the initial bookmark")
+			var initial watchResult[*iri.WatchMachinesResponse]
+			eventually(ctx, results).Should(gomega.Receive(&initial))
+			gomega.Expect(initial).To(haveEvent(irimeta.WatchEventType_WATCH_EVENT_BOOKMARK, ""))
+
+			ginkgo.By("creating a machine")
+			machine := createMachine(ctx, nil)
+			eventually(ctx, results).Should(gomega.Receive(haveEvent(irimeta.WatchEventType_WATCH_EVENT_ADDED, machine.Metadata.Id)))
+
+			ginkgo.By("deleting the machine")
+			gomega.Expect(deleteMachine(ctx, machine.Metadata.Id)).To(gomega.Succeed())
+			eventually(ctx, results).Should(gomega.Receive(haveEvent(irimeta.WatchEventType_WATCH_EVENT_DELETED, machine.Metadata.Id)))
+		})
+	})
+}
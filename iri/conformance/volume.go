@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/apis/volume"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/gcustom"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// DefaultVolumeSizeBytes is the default size of the volumes created by the specs.
+const DefaultVolumeSizeBytes = 1024 * 1024 * 1024
+
+// VolumeRuntimeConfig configures the volume runtime conformance specs.
+type VolumeRuntimeConfig struct {
+	// Runtime is the volume runtime under test.
+	Runtime volume.RuntimeService
+	// VolumeClass is the name of a volume class the runtime reports in its status.
+	// All volumes created by the specs use this class. If the runtime reports the capabilities.VolumeExpand
+	// capability, the class has to allow expanding volumes.
+	VolumeClass string
+	// Image is the image of the volumes created by the specs. May be empty.
+	Image string
+	// SizeBytes is the size of the volumes created by the specs. Defaults to DefaultVolumeSizeBytes.
+	SizeBytes int64
+	// Timeout is the time to wait for the runtime to reflect a change. Defaults to DefaultTimeout.
+	Timeout time.Duration
+}
+
+// DescribeVolumeRuntime registers the volume runtime conformance specs.
+// newConfig is called before every spec.
+func DescribeVolumeRuntime(newConfig func() VolumeRuntimeConfig) bool {
+	return ginkgo.Describe("VolumeRuntime", func() {
+		var (
+			cfg       VolumeRuntimeConfig
+			runLabels map[string]string
+		)
+
+		ginkgo.BeforeEach(func() {
+			runLabels = newRunLabels()
+			cfg = newConfig()
+			if cfg.SizeBytes == 0 {
+				cfg.SizeBytes = DefaultVolumeSizeBytes
+			}
+			if cfg.Timeout == 0 {
+				cfg.Timeout = DefaultTimeout
+			}
+		})
+
+		deleteVolume := func(ctx context.Context, id string) error {
+			_, err := cfg.Runtime.DeleteVolume(ctx, &iri.DeleteVolumeRequest{VolumeId: id})
+			return err
+		}
+
+		createVolume := func(ctx context.Context, labels map[string]string) *iri.Volume {
+			res, err := cfg.Runtime.CreateVolume(ctx, &iri.CreateVolumeRequest{
+				Volume: &iri.Volume{
+					Metadata: &irimeta.ObjectMetadata{
+						Labels:      withLabels(runLabels, labels),
+						Annotations: map[string]string{"conformance.iri.ironcore.dev/annotation": "foo"},
+					},
+					Spec: &iri.VolumeSpec{
+						Image:     cfg.Image,
+						Class:     cfg.VolumeClass,
+						Resources: &iri.VolumeResources{StorageBytes: cfg.SizeBytes},
+					},
+				},
+			})
+			gomega.ExpectWithOffset(1, err).NotTo(gomega.HaveOccurred())
+			gomega.ExpectWithOffset(1, res.GetVolume().GetMetadata().GetId()).NotTo(gomega.BeEmpty())
+
+			id := res.Volume.Metadata.Id
+			ginkgo.DeferCleanup(func(ctx ginkgo.SpecContext) {
+				gomega.Expect(ignoreNotFound(deleteVolume(ctx, id))).To(gomega.Succeed())
+			})
+			return res.Volume
+		}
+
+		listVolumes := func(ctx context.Context, filter *iri.VolumeFilter) func() ([]*iri.Volume, error) {
+			return func() ([]*iri.Volume, error) {
+				res, err := cfg.Runtime.ListVolumes(ctx, &iri.ListVolumesRequest{Filter: filter})
+				if err != nil {
+					return nil, err
+				}
+				return res.Volumes, nil
+			}
+		}
+
+		getVolume := func(ctx context.Context, id string) func() (*iri.Volume, error) {
+			return func() (*iri.Volume, error) {
+				volumes, err := listVolumes(ctx, &iri.VolumeFilter{Id: id})()
+				if err != nil || len(volumes) == 0 {
+					return nil, err
+				}
+				return volumes[0], nil
+			}
+		}
+
+		eventually := func(ctx context.Context, actual interface{}) gomega.AsyncAssertion {
+			return gomega.EventuallyWithOffset(1, ctx, actual).WithTimeout(cfg.Timeout).WithPolling(pollingInterval)
+		}
+
+		haveID := func(id string) gomega.OmegaMatcher {
+			return gomega.HaveField("Metadata.Id", id)
+		}
+
+		unknownID := func() string {
+			return "unknown-" + string(uuid.NewUUID())
+		}
+
+		skipUnlessCapability := func(ctx context.Context, capability string) {
+			res, err := cfg.Runtime.Version(ctx, &iri.VersionRequest{})
+			gomega.ExpectWithOffset(1, err).NotTo(gomega.HaveOccurred())
+			if !slices.Contains(res.Capabilities, capability) {
+				ginkgo.Skip(fmt.Sprintf("runtime does not report capability %s", capability))
+			}
+		}
+
+		ginkgo.It("should report its version", func(ctx ginkgo.SpecContext) {
+			res, err := cfg.Runtime.Version(ctx, &iri.VersionRequest{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(res.RuntimeName).NotTo(gomega.BeEmpty())
+			gomega.Expect(res.RuntimeVersion).NotTo(gomega.BeEmpty())
+			gomega.Expect(res.Capabilities).NotTo(gomega.ContainElement(gomega.BeEmpty()), "runtime reports an empty capability")
+		})
+
+		ginkgo.It("should report the volume class status", func(ctx ginkgo.SpecContext) {
+			res, err := cfg.Runtime.Status(ctx, &iri.StatusRequest{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Expect(res.VolumeClassStatus).To(gomega.ContainElement(gomega.HaveField("VolumeClass.Name", cfg.VolumeClass)),
+				"volume class %s is not reported", cfg.VolumeClass)
+			for _, volumeClassStatus := range res.VolumeClassStatus {
+				gomega.Expect(volumeClassStatus.VolumeClass).NotTo(gomega.BeNil())
+				gomega.Expect(volumeClassStatus.VolumeClass.Name).NotTo(gomega.BeEmpty())
+				gomega.Expect(volumeClassStatus.Quantity).To(gomega.BeNumerically(">=", 0),
+					"volume class %s reports a negative quantity", volumeClassStatus.VolumeClass.Name)
+			}
+		})
+
+		ginkgo.It("should create a volume and list it", func(ctx ginkgo.SpecContext) {
+			volume := createVolume(ctx, nil)
+			gomega.Expect(volume.Metadata.CreatedAt).To(gomega.BeNumerically(">", 0))
+			gomega.Expect(volume.Metadata.Labels).To(gomega.HaveKeyWithValue(RunLabel, runLabels[RunLabel]))
+			gomega.Expect(volume.Spec.Class).To(gomega.Equal(cfg.VolumeClass))
+
+			eventually(ctx, getVolume(ctx, volume.Metadata.Id)).Should(gomega.And(
+				haveID(volume.Metadata.Id),
+				gomega.HaveField("Metadata.Labels", gomega.HaveKeyWithValue(RunLabel, runLabels[RunLabel])),
+				gomega.HaveField("Metadata.Annotations", gomega.HaveKeyWithValue("conformance.iri.ironcore.dev/annotation", "foo")),
+				gomega.HaveField("Spec.Class", cfg.VolumeClass),
+				gomega.HaveField("Spec.Resources.StorageBytes", cfg.SizeBytes),
+			))
+		})
+
+		ginkgo.It("should assign a new id on every create", func(ctx ginkgo.SpecContext) {
+			first := createVolume(ctx, nil)
+			second := createVolume(ctx, nil)
+			gomega.Expect(second.Metadata.Id).NotTo(gomega.Equal(first.Metadata.Id))
+
+			eventually(ctx, listVolumes(ctx, &iri.VolumeFilter{LabelSelector: runLabels})).Should(gomega.ConsistOf(
+				haveID(first.Metadata.Id),
+				haveID(second.Metadata.Id),
+			))
+		})
+
+		ginkgo.It("should return an empty list when listing an unknown volume id", func(ctx ginkgo.SpecContext) {
+			gomega.Expect(listVolumes(ctx, &iri.VolumeFilter{Id: unknownID()})()).To(gomega.BeEmpty())
+		})
+
+		ginkgo.It("should filter volumes by label selector", func(ctx ginkgo.SpecContext) {
+			volumeA := createVolume(ctx, map[string]string{"conformance.iri.ironcore.dev/role": "a"})
+			volumeB := createVolume(ctx, map[string]string{"conformance.iri.ironcore.dev/role": "b"})
+
+			eventually(ctx, listVolumes(ctx, &iri.VolumeFilter{
+				LabelSelector: withLabels(runLabels, map[string]string{"conformance.iri.ironcore.dev/role": "a"}),
+			})).Should(gomega.ConsistOf(haveID(volumeA.Metadata.Id)))
+
+			eventually(ctx, listVolumes(ctx, &iri.VolumeFilter{
+				LabelSelector: runLabels,
+			})).Should(gomega.ConsistOf(haveID(volumeA.Metadata.Id), haveID(volumeB.Metadata.Id)))
+		})
+
+		ginkgo.It("should expand a volume", func(ctx ginkgo.SpecContext) {
+			skipUnlessCapability(ctx, capabilities.VolumeExpand)
+
+			volume := createVolume(ctx, nil)
+
+			_, err := cfg.Runtime.ExpandVolume(ctx, &iri.ExpandVolumeRequest{
+				VolumeId:  volume.Metadata.Id,
+				Resources: &iri.VolumeResources{StorageBytes: 2 * cfg.SizeBytes},
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			eventually(ctx, getVolume(ctx, volume.Metadata.Id)).Should(gomega.HaveField("Spec.Resources.StorageBytes", 2*cfg.SizeBytes))
+
+			ginkgo.By("expanding an unknown volume")
+			_, err = cfg.Runtime.ExpandVolume(ctx, &iri.ExpandVolumeRequest{
+				VolumeId:  unknownID(),
+				Resources: &iri.VolumeResources{StorageBytes: 2 * cfg.SizeBytes},
+			})
+			gomega.Expect(status.Code(err)).To(gomega.Equal(codes.NotFound))
+		})
+
+		ginkgo.It("should delete a volume", func(ctx ginkgo.SpecContext) {
+			volume := createVolume(ctx, nil)
+
+			gomega.Expect(deleteVolume(ctx, volume.Metadata.Id)).To(gomega.Succeed())
+			eventually(ctx, getVolume(ctx, volume.Metadata.Id)).Should(gomega.BeNil())
+
+			ginkgo.By("deleting the volume again")
+			gomega.Expect(status.Code(deleteVolume(ctx, volume.Metadata.Id))).To(gomega.Equal(codes.NotFound))
+		})
+
+		ginkgo.It("should report NotFound when deleting an unknown volume", func(ctx ginkgo.SpecContext) {
+			gomega.Expect(status.Code(deleteVolume(ctx, unknownID()))).To(gomega.Equal(codes.NotFound))
+		})
+
+		ginkgo.It("should watch volumes", func(ctx ginkgo.SpecContext) {
+			skipUnlessCapability(ctx, capabilities.VolumeWatch)
+
+			watchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			stream, err := cfg.Runtime.WatchVolumes(watchCtx, &iri.WatchVolumesRequest{
+				Filter: &iri.VolumeFilter{LabelSelector: runLabels},
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			results := startWatch(watchCtx, stream.Recv)
+
+			haveEvent := func(typ irimeta.WatchEventType, id string) gomega.OmegaMatcher {
+				return gcustom.MakeMatcher(func(res watchResult[*iri.WatchVolumesResponse]) (bool, error) {
+					if res.Err != nil {
+						return false, res.Err
+					}
+					if res.Response.Type != typ {
+						return false, nil
+					}
+					return id == "" || res.Response.GetVolume().GetMetadata().GetId() == id, nil
+				}).WithTemplate("Expected a {{.Data}} watch event, got {{.FormattedActual}}", typ)
+			}
+
+			ginkgo.By("waiting for the initial bookmark")
+			var initial watchResult[*iri.WatchVolumesResponse]
+			eventually(ctx, results).Should(gomega.Receive(&initial))
+			gomega.Expect(initial).To(haveEvent(irimeta.WatchEventType_WATCH_EVENT_BOOKMARK, ""))
+
+			ginkgo.By("creating a volume")
+			volume := createVolume(ctx, nil)
+			eventually(ctx, results).Should(gomega.Receive(haveEvent(irimeta.WatchEventType_WATCH_EVENT_ADDED, volume.Metadata.Id)))
+
+			ginkgo.By("deleting the volume")
+			gomega.Expect(deleteVolume(ctx, volume.Metadata.Id)).To(gomega.Succeed())
+			eventually(ctx, results).Should(gomega.Receive(haveEvent(irimeta.WatchEventType_WATCH_EVENT_DELETED, volume.Metadata.Id)))
+		})
+	})
+}
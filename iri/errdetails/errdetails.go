@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package errdetails attaches and reads irimeta.ErrorDetail messages to / from the status of failed IRI requests.
+//
+// Runtimes use it to tell clients why a request failed and whether and when retrying it makes sense.
+// Clients fall back to a classification based on the status code for errors without details.
+package errdetails
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// TypeURL is the type url of an irimeta.ErrorDetail packed into the details of a status.
+const TypeURL = "type.googleapis.com/meta.v1alpha1.ErrorDetail"
+
+// New returns a status with the given code and message carrying the given detail.
+func New(code codes.Code, detail *irimeta.ErrorDetail, msg string) *status.Status {
+	st := status.New(code, msg)
+	if detail == nil {
+		return st
+	}
+
+	data, err := proto.Marshal(detail)
+	if err != nil {
+		// Marshalling a message without any nested message cannot fail.
+		panic(fmt.Sprintf("error marshalling error detail: %v", err))
+	}
+
+	p := st.Proto()
+	p.Details = append(p.Details, &anypb.Any{TypeUrl: TypeURL, Value: data})
+	return status.FromProto(p)
+}
+
+// Errorf returns an error with the given code and formatted message carrying the given detail.
+func Errorf(code codes.Code, detail *irimeta.ErrorDetail, format string, args ...interface{}) error {
+	return New(code, detail, fmt.Sprintf(format, args...)).Err()
+}
+
+// InvalidSpec returns a codes.InvalidArgument error for a request that cannot succeed without changing the given field.
+func InvalidSpec(field string, format string, args ...interface{}) error {
+	return Errorf(codes.InvalidArgument, &irimeta.ErrorDetail{
+		Reason: irimeta.ErrorReason_ERROR_REASON_INVALID_SPEC,
+		Field:  field,
+	}, format, args...)
+}
+
+// InsufficientCapacity returns a codes.ResourceExhausted error for a request the runtime currently lacks the
+// capacity for. If retryAfter is non-zero, clients should not retry before it has passed.
+func InsufficientCapacity(retryAfter time.Duration, format string, args ...interface{}) error {
+	return Errorf(codes.ResourceExhausted, &irimeta.ErrorDetail{
+		Reason:            irimeta.ErrorReason_ERROR_REASON_INSUFFICIENT_CAPACITY,
+		RetryAfterSeconds: retryAfterSeconds(retryAfter),
+	}, format, args...)
+}
+
+// Transient returns a codes.Unavailable error for a failure that is expected to resolve on its own.
+// If retryAfter is non-zero, clients should not retry before it has passed.
+func Transient(retryAfter time.Duration, format string, args ...interface{}) error {
+	return Errorf(codes.Unavailable, &irimeta.ErrorDetail{
+		Reason:            irimeta.ErrorReason_ERROR_REASON_TRANSIENT,
+		RetryAfterSeconds: retryAfterSeconds(retryAfter),
+	}, format, args...)
+}
+
+func retryAfterSeconds(d time.Duration) int64 {
+	if d <= 0 {
+		return 0
+	}
+	// Round up to not retry too early.
+	return int64((d + time.Second - 1) / time.Second)
+}
+
+// FromError returns the error detail of the status contained in err, if any.
+func FromError(err error) (*irimeta.ErrorDetail, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st == nil {
+		return nil, false
+	}
+
+	for _, detail := range st.Proto().GetDetails() {
+		if detail.GetTypeUrl() != TypeURL {
+			continue
+		}
+
+		res := &irimeta.ErrorDetail{}
+		if err := proto.Unmarshal(detail.GetValue(), res); err != nil {
+			return nil, false
+		}
+		return res, true
+	}
+	return nil, false
+}
+
+var reasonByCode = map[codes.Code]irimeta.ErrorReason{
+	codes.InvalidArgument:   irimeta.ErrorReason_ERROR_REASON_INVALID_SPEC,
+	codes.ResourceExhausted: irimeta.ErrorReason_ERROR_REASON_INSUFFICIENT_CAPACITY,
+	codes.Unavailable:       irimeta.ErrorReason_ERROR_REASON_TRANSIENT,
+	codes.DeadlineExceeded:  irimeta.ErrorReason_ERROR_REASON_TRANSIENT,
+	codes.Aborted:           irimeta.ErrorReason_ERROR_REASON_TRANSIENT,
+}
+
+// Reason returns the reason why the request failed with err.
+// If err carries no error detail, the reason is derived from its status code.
+func Reason(err error) irimeta.ErrorReason {
+	if err == nil {
+		return irimeta.ErrorReason_ERROR_REASON_UNKNOWN
+	}
+	if detail, ok := FromError(err); ok {
+		return detail.Reason
+	}
+	return reasonByCode[status.Code(err)]
+}
+
+// IsRetryable reports whether retrying the request that failed with err may succeed without changing the request.
+func IsRetryable(err error) bool {
+	return err != nil && Reason(err) != irimeta.ErrorReason_ERROR_REASON_INVALID_SPEC
+}
+
+// RetryAfter returns the minimum time to wait before retrying the request that failed with err.
+// It returns zero if err does not specify a time.
+func RetryAfter(err error) time.Duration {
+	detail, ok := FromError(err)
+	if !ok {
+		return 0
+	}
+	return time.Duration(detail.RetryAfterSeconds) * time.Second
+}
+
+// Field returns the path of the request field that caused the request to fail with err, if specified.
+func Field(err error) string {
+	detail, ok := FromError(err)
+	if !ok {
+		return ""
+	}
+	return detail.Field
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package errdetails_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/iri/errdetails"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// transmit simulates sending the status of err over the wire.
+func transmit(err error) error {
+	data, marshalErr := proto.Marshal(status.Convert(err).Proto())
+	Expect(marshalErr).NotTo(HaveOccurred())
+
+	st := status.New(codes.OK, "").Proto()
+	Expect(proto.Unmarshal(data, st)).To(Succeed())
+	return status.FromProto(st).Err()
+}
+
+var _ = Describe("ErrDetails", func() {
+	It("should transmit the error detail of an invalid spec", func() {
+		err := transmit(InvalidSpec("machine.spec.image", "invalid image %q", "foo"))
+
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		Expect(status.Convert(err).Message()).To(Equal(`invalid image "foo"`))
+		Expect(Reason(err)).To(Equal(irimeta.ErrorReason_ERROR_REASON_INVALID_SPEC))
+		Expect(Field(err)).To(Equal("machine.spec.image"))
+		Expect(IsRetryable(err)).To(BeFalse())
+		Expect(RetryAfter(err)).To(BeZero())
+	})
+
+	It("should transmit the retry after of insufficient capacity", func() {
+		err := transmit(InsufficientCapacity(1500*time.Millisecond, "out of capacity"))
+
+		Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+		Expect(Reason(err)).To(Equal(irimeta.ErrorReason_ERROR_REASON_INSUFFICIENT_CAPACITY))
+		Expect(IsRetryable(err)).To(BeTrue())
+		Expect(RetryAfter(err)).To(Equal(2 * time.Second))
+	})
+
+	It("should read the error detail of a wrapped error", func() {
+		err := fmt.Errorf("error creating machine: %w", Transient(time.Second, "try again"))
+
+		detail, ok := FromError(err)
+		Expect(ok).To(BeTrue())
+		Expect(detail.Reason).To(Equal(irimeta.ErrorReason_ERROR_REASON_TRANSIENT))
+		Expect(RetryAfter(err)).To(Equal(time.Second))
+	})
+
+	It("should derive the reason from the status code of errors without detail", func() {
+		Expect(Reason(status.Error(codes.InvalidArgument, "invalid"))).To(Equal(irimeta.ErrorReason_ERROR_REASON_INVALID_SPEC))
+		Expect(Reason(status.Error(codes.ResourceExhausted, "exhausted"))).To(Equal(irimeta.ErrorReason_ERROR_REASON_INSUFFICIENT_CAPACITY))
+		Expect(Reason(status.Error(codes.Unavailable, "unavailable"))).To(Equal(irimeta.ErrorReason_ERROR_REASON_TRANSIENT))
+		Expect(Reason(status.Error(codes.Internal, "internal"))).To(Equal(irimeta.ErrorReason_ERROR_REASON_UNKNOWN))
+		Expect(Reason(errors.New("plain"))).To(Equal(irimeta.ErrorReason_ERROR_REASON_UNKNOWN))
+
+		_, ok := FromError(errors.New("plain"))
+		Expect(ok).To(BeFalse())
+		Expect(IsRetryable(errors.New("plain"))).To(BeTrue())
+	})
+})
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics contains gRPC interceptors recording Prometheus metrics for IRI requests.
+//
+// The metrics are registered with the controller-runtime metrics registry, so they are exposed
+// on the metrics endpoint of any controller-runtime manager (or metrics server) of the process.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "iri"
+
+	methodLabel = "method"
+	codeLabel   = "code"
+)
+
+// rpcMetrics are the metrics recorded for the requests of one side (client or server).
+type rpcMetrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	inFlight *prometheus.GaugeVec
+}
+
+func newRPCMetrics(subsystem string) *rpcMetrics {
+	return &rpcMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "requests_total",
+			Help:      "Total number of completed IRI requests by method and status code.",
+		}, []string{methodLabel, codeLabel}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of completed IRI requests by method. For streams, this is the lifetime of the stream.",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{methodLabel}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "requests_in_flight",
+			Help:      "Number of IRI requests (or open streams) currently in flight by method.",
+		}, []string{methodLabel}),
+	}
+}
+
+func (m *rpcMetrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.requests, m.duration, m.inFlight}
+}
+
+// start records the start of a request to method and returns the function to record its completion with.
+func (m *rpcMetrics) start(method string) func(err error) {
+	startTime := time.Now()
+	m.inFlight.WithLabelValues(method).Inc()
+	return func(err error) {
+		m.inFlight.WithLabelValues(method).Dec()
+		m.requests.WithLabelValues(method, status.Code(err).String()).Inc()
+		m.duration.WithLabelValues(method).Observe(time.Since(startTime).Seconds())
+	}
+}
+
+var (
+	clientMetrics = newRPCMetrics("client")
+	serverMetrics = newRPCMetrics("server")
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(clientMetrics.collectors()...)
+	ctrlmetrics.Registry.MustRegister(serverMetrics.collectors()...)
+}
+
+// DialOptions returns the grpc.DialOption to record metrics for all requests of a client connection.
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(StreamClientInterceptor()),
+	}
+}
+
+// ServerOptions returns the grpc.ServerOption to record metrics for all requests of a server.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor()),
+	}
+}
+
+// UnaryClientInterceptor records metrics for unary client requests.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		done := clientMetrics.start(method)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		done(err)
+		return err
+	}
+}
+
+// StreamClientInterceptor records metrics for client streams. A stream is considered done once receiving
+// from it fails; io.EOF is recorded as success.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		done := clientMetrics.start(method)
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			done(err)
+			return nil, err
+		}
+		return &monitoredClientStream{ClientStream: stream, done: done}, nil
+	}
+}
+
+type monitoredClientStream struct {
+	grpc.ClientStream
+	once sync.Once
+	done func(err error)
+}
+
+func (s *monitoredClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.once.Do(func() {
+			if errors.Is(err, io.EOF) {
+				s.done(nil)
+			} else {
+				s.done(err)
+			}
+		})
+	}
+	return err
+}
+
+// UnaryServerInterceptor records metrics for unary server requests.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		done := serverMetrics.start(info.FullMethod)
+		resp, err := handler(ctx, req)
+		done(err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records metrics for server streams.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		done := serverMetrics.start(info.FullMethod)
+		err := handler(srv, ss)
+		done(err)
+		return err
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+
+	. "github.com/ironcore-dev/ironcore/iri/metrics"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	checkMethod = "/grpc.health.v1.Health/Check"
+	watchMethod = "/grpc.health.v1.Health/Watch"
+)
+
+// requestCount returns the value of the requests_total counter of the given metric with the given labels.
+func requestCount(name, method, code string) float64 {
+	families, err := ctrlmetrics.Registry.Gather()
+	Expect(err).NotTo(HaveOccurred())
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if hasLabels(metric, map[string]string{"method": method, "code": code}) {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func hasLabels(metric *dto.Metric, labels map[string]string) bool {
+	matched := 0
+	for _, pair := range metric.GetLabel() {
+		if value, ok := labels[pair.GetName()]; ok {
+			if value != pair.GetValue() {
+				return false
+			}
+			matched++
+		}
+	}
+	return matched == len(labels)
+}
+
+var _ = Describe("Metrics", func() {
+	var client healthpb.HealthClient
+
+	BeforeEach(func() {
+		dir, err := os.MkdirTemp("", "metrics")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(os.RemoveAll, dir)
+
+		socket := filepath.Join(dir, "server.sock")
+		lis, err := net.Listen("unix", socket)
+		Expect(err).NotTo(HaveOccurred())
+
+		srv := grpc.NewServer(ServerOptions()...)
+		healthSrv := health.NewServer()
+		healthSrv.SetServingStatus("ready", healthpb.HealthCheckResponse_SERVING)
+		healthpb.RegisterHealthServer(srv, healthSrv)
+		go func() {
+			defer GinkgoRecover()
+			Expect(srv.Serve(lis)).To(Succeed())
+		}()
+		DeferCleanup(srv.Stop)
+
+		conn, err := grpc.Dial("unix://"+socket, append(DialOptions(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)...)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+
+		client = healthpb.NewHealthClient(conn)
+	})
+
+	It("should count unary requests by method and code on both sides", func(ctx SpecContext) {
+		clientOK := requestCount("iri_client_requests_total", checkMethod, codes.OK.String())
+		serverOK := requestCount("iri_server_requests_total", checkMethod, codes.OK.String())
+		clientNotFound := requestCount("iri_client_requests_total", checkMethod, codes.NotFound.String())
+		serverNotFound := requestCount("iri_server_requests_total", checkMethod, codes.NotFound.String())
+
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: "ready"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.Check(ctx, &healthpb.HealthCheckRequest{Service: "unknown"})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		Expect(requestCount("iri_client_requests_total", checkMethod, codes.OK.String())).To(Equal(clientOK + 1))
+		Expect(requestCount("iri_server_requests_total", checkMethod, codes.OK.String())).To(Equal(serverOK + 1))
+		Expect(requestCount("iri_client_requests_total", checkMethod, codes.NotFound.String())).To(Equal(clientNotFound + 1))
+		Expect(requestCount("iri_server_requests_total", checkMethod, codes.NotFound.String())).To(Equal(serverNotFound + 1))
+	})
+
+	It("should count streams once they are done", func(ctx SpecContext) {
+		clientCanceled := requestCount("iri_client_requests_total", watchMethod, codes.Canceled.String())
+
+		streamCtx, cancel := context.WithCancel(ctx)
+		stream, err := client.Watch(streamCtx, &healthpb.HealthCheckRequest{Service: "ready"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = stream.Recv()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requestCount("iri_client_requests_total", watchMethod, codes.Canceled.String())).To(Equal(clientCanceled))
+
+		cancel()
+		_, err = stream.Recv()
+		Expect(status.Code(err)).To(Equal(codes.Canceled))
+		Expect(requestCount("iri_client_requests_total", watchMethod, codes.Canceled.String())).To(Equal(clientCanceled + 1))
+	})
+})
@@ -9,27 +9,38 @@ import (
 
 	"github.com/ironcore-dev/ironcore/iri/apis/machine"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/remote"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 type remoteRuntime struct {
+	conn   *grpc.ClientConn
 	client iri.MachineRuntimeClient
 }
 
-func NewRemoteRuntime(endpoint string) (machine.RuntimeService, error) {
-	conn, err := grpc.Dial(endpoint,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+// NewRemoteRuntime creates a remote runtime connecting to the given endpoint.
+// Without any transport credentials in opts, the connection is insecure (see iri/auth for securing it).
+// The returned runtime implements io.Closer to close the connection.
+func NewRemoteRuntime(endpoint string, opts ...grpc.DialOption) (machine.RuntimeService, error) {
+	conn, err := grpc.Dial(remote.DialTarget(endpoint),
+		append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error dialing: %w", err)
 	}
 
 	return &remoteRuntime{
+		conn:   conn,
 		client: iri.NewMachineRuntimeClient(conn),
 	}, nil
 }
 
+// Close closes the connection to the runtime.
+func (r *remoteRuntime) Close() error {
+	return r.conn.Close()
+}
+
 func (r *remoteRuntime) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
 	return r.client.Version(ctx, req)
 }
@@ -38,6 +49,10 @@ func (r *remoteRuntime) ListMachines(ctx context.Context, req *iri.ListMachinesR
 	return r.client.ListMachines(ctx, req)
 }
 
+func (r *remoteRuntime) WatchMachines(ctx context.Context, req *iri.WatchMachinesRequest) (iri.MachineRuntime_WatchMachinesClient, error) {
+	return r.client.WatchMachines(ctx, req)
+}
+
 func (r *remoteRuntime) CreateMachine(ctx context.Context, req *iri.CreateMachineRequest) (*iri.CreateMachineResponse, error) {
 	return r.client.CreateMachine(ctx, req)
 }
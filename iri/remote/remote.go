@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package remote contains helpers shared by the clients of remote IRI runtimes.
+package remote
+
+import (
+	"strings"
+)
+
+// DialTarget returns the gRPC dial target for the given address.
+//
+// It accepts the addresses IRI servers listen on: 'tcp://<host>:<port>', 'unix://<path>' or an absolute
+// unix socket path. Any other address, e.g. '<host>:<port>', is passed to gRPC as-is.
+func DialTarget(address string) string {
+	if addr, ok := strings.CutPrefix(address, "tcp://"); ok {
+		return "passthrough:///" + addr
+	}
+	if strings.HasPrefix(address, "/") {
+		return "unix://" + address
+	}
+	return address
+}
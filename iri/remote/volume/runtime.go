@@ -9,31 +9,61 @@ import (
 
 	"github.com/ironcore-dev/ironcore/iri/apis/volume"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/remote"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 type remoteRuntime struct {
+	conn   *grpc.ClientConn
 	client iri.VolumeRuntimeClient
 }
 
-func NewRemoteRuntime(endpoint string) (volume.RuntimeService, error) {
-	conn, err := grpc.Dial(endpoint,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+// NewRemoteRuntime creates a remote runtime connecting to the given endpoint.
+// Without any transport credentials in opts, the connection is insecure (see iri/auth for securing it).
+// The returned runtime implements io.Closer to close the connection.
+func NewRemoteRuntime(endpoint string, opts ...grpc.DialOption) (volume.RuntimeService, error) {
+	conn, err := grpc.Dial(remote.DialTarget(endpoint),
+		append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error dialing: %w", err)
 	}
 
 	return &remoteRuntime{
+		conn:   conn,
 		client: iri.NewVolumeRuntimeClient(conn),
 	}, nil
 }
 
+// NewRuntimeFromClient returns a runtime using the given client, e.g. a fake client in tests.
+// Closing the returned runtime is a no-op.
+func NewRuntimeFromClient(client iri.VolumeRuntimeClient) volume.RuntimeService {
+	return &remoteRuntime{
+		client: client,
+	}
+}
+
+// Close closes the connection to the runtime.
+func (r *remoteRuntime) Close() error {
+	if r.conn == nil {
+		return nil
+	}
+	return r.conn.Close()
+}
+
+func (r *remoteRuntime) Version(ctx context.Context, request *iri.VersionRequest) (*iri.VersionResponse, error) {
+	return r.client.Version(ctx, request)
+}
+
 func (r *remoteRuntime) ListVolumes(ctx context.Context, request *iri.ListVolumesRequest) (*iri.ListVolumesResponse, error) {
 	return r.client.ListVolumes(ctx, request)
 }
 
+func (r *remoteRuntime) WatchVolumes(ctx context.Context, request *iri.WatchVolumesRequest) (iri.VolumeRuntime_WatchVolumesClient, error) {
+	return r.client.WatchVolumes(ctx, request)
+}
+
 func (r *remoteRuntime) CreateVolume(ctx context.Context, request *iri.CreateVolumeRequest) (*iri.CreateVolumeResponse, error) {
 	return r.client.CreateVolume(ctx, request)
 }
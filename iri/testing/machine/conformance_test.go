@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machine_test
+
+import (
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/conformance"
+	"github.com/ironcore-dev/ironcore/iri/testing/machine"
+)
+
+var _ = conformance.DescribeMachineRuntime(func() conformance.MachineRuntimeConfig {
+	runtime := machine.NewFakeRuntimeService()
+	runtime.SetMachineClasses([]*machine.FakeMachineClassStatus{
+		{
+			MachineClassStatus: iri.MachineClassStatus{
+				MachineClass: &iri.MachineClass{
+					Name: "machine-class",
+					Capabilities: &iri.MachineClassCapabilities{
+						CpuMillis:   1000,
+						MemoryBytes: 1024 * 1024 * 1024,
+					},
+				},
+				Quantity: 10,
+			},
+		},
+	})
+	return conformance.MachineRuntimeConfig{
+		Runtime:      runtime,
+		MachineClass: "machine-class",
+		Image:        "example.org/foo:latest",
+	}
+})
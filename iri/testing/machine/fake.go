@@ -12,6 +12,8 @@ import (
 	"time"
 
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
+	"github.com/ironcore-dev/ironcore/iri/watch"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/labels"
@@ -65,15 +67,20 @@ type FakeMachineClassStatus struct {
 type FakeRuntimeService struct {
 	sync.Mutex
 
+	hubOnce sync.Once
+	hub     *watch.Hub[*iri.Machine]
+
 	Machines           map[string]*FakeMachine
 	MachineClassStatus map[string]*FakeMachineClassStatus
 	GetExecURL         func(req *iri.ExecRequest) string
+	Capabilities       []string
 }
 
 func NewFakeRuntimeService() *FakeRuntimeService {
 	return &FakeRuntimeService{
 		Machines:           make(map[string]*FakeMachine),
 		MachineClassStatus: make(map[string]*FakeMachineClassStatus),
+		Capabilities:       capabilities.MachineOptional,
 	}
 }
 
@@ -104,10 +111,21 @@ func (r *FakeRuntimeService) SetGetExecURL(f func(req *iri.ExecRequest) string)
 	r.GetExecURL = f
 }
 
+func (r *FakeRuntimeService) SetCapabilities(capabilities []string) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.Capabilities = capabilities
+}
+
 func (r *FakeRuntimeService) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
+	r.Lock()
+	defer r.Unlock()
+
 	return &iri.VersionResponse{
 		RuntimeName:    FakeRuntimeName,
 		RuntimeVersion: FakeVersion,
+		Capabilities:   r.Capabilities,
 	}, nil
 }
 
@@ -205,6 +223,12 @@ func (r *FakeRuntimeService) AttachVolume(ctx context.Context, req *iri.AttachVo
 		return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
 	}
 
+	for _, attachment := range machine.Spec.Volumes {
+		if attachment.Name == req.Volume.Name {
+			return nil, status.Errorf(codes.AlreadyExists, "machine %q volume attachment %q already exists", machineID, req.Volume.Name)
+		}
+	}
+
 	machine.Spec.Volumes = append(machine.Spec.Volumes, req.Volume)
 	return &iri.AttachVolumeResponse{}, nil
 }
@@ -249,6 +273,12 @@ func (r *FakeRuntimeService) AttachNetworkInterface(ctx context.Context, req *ir
 		return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
 	}
 
+	for _, attachment := range machine.Spec.NetworkInterfaces {
+		if attachment.Name == req.NetworkInterface.Name {
+			return nil, status.Errorf(codes.AlreadyExists, "machine %q network interface attachment %q already exists", machineID, req.NetworkInterface.Name)
+		}
+	}
+
 	machine.Spec.NetworkInterfaces = append(machine.Spec.NetworkInterfaces, req.NetworkInterface)
 	return &iri.AttachNetworkInterfaceResponse{}, nil
 }
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machine
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/watch"
+)
+
+func (r *FakeRuntimeService) machineHub() *watch.Hub[*iri.Machine] {
+	r.hubOnce.Do(func() {
+		r.hub = watch.NewHub(r.listMachines, watch.HubOptions{})
+	})
+	return r.hub
+}
+
+func (r *FakeRuntimeService) listMachines(ctx context.Context) ([]*iri.Machine, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	res := make([]*iri.Machine, 0, len(r.Machines))
+	for _, m := range r.Machines {
+		res = append(res, proto.Clone(&m.Machine).(*iri.Machine))
+	}
+	return res, nil
+}
+
+func (r *FakeRuntimeService) WatchMachines(ctx context.Context, req *iri.WatchMachinesRequest) (iri.MachineRuntime_WatchMachinesClient, error) {
+	var filter func(*iri.Machine) bool
+	if f := req.Filter; f != nil {
+		filter = func(machine *iri.Machine) bool {
+			if f.Id != "" && f.Id != machine.Metadata.Id {
+				return false
+			}
+			return f.LabelSelector == nil || filterInLabels(f.LabelSelector, machine.Metadata.Labels)
+		}
+	}
+
+	return watch.NewClientStream(ctx, r.machineHub(), req.ResourceVersion, filter, func(event watch.Event[*iri.Machine]) *iri.WatchMachinesResponse {
+		return &iri.WatchMachinesResponse{
+			Type:            event.Type,
+			Machine:         event.Object,
+			ResourceVersion: event.ResourceVersion,
+		}
+	}), nil
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package volume_test
+
+import (
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/conformance"
+	remotevolume "github.com/ironcore-dev/ironcore/iri/remote/volume"
+	"github.com/ironcore-dev/ironcore/iri/testing/volume"
+)
+
+var _ = conformance.DescribeVolumeRuntime(func() conformance.VolumeRuntimeConfig {
+	runtime := volume.NewFakeRuntimeService()
+	runtime.SetVolumeClasses([]*volume.FakeVolumeClassStatus{
+		{
+			VolumeClassStatus: iri.VolumeClassStatus{
+				VolumeClass: &iri.VolumeClass{
+					Name: "volume-class",
+					Capabilities: &iri.VolumeClassCapabilities{
+						Tps:  100,
+						Iops: 100,
+					},
+				},
+				Quantity: 10,
+			},
+		},
+	})
+	return conformance.VolumeRuntimeConfig{
+		Runtime:     remotevolume.NewRuntimeFromClient(runtime),
+		VolumeClass: "volume-class",
+	}
+})
@@ -10,12 +10,22 @@ import (
 
 	"github.com/ironcore-dev/ironcore/broker/common/idgen"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
+	"github.com/ironcore-dev/ironcore/iri/watch"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
+var (
+	// FakeVersion is the version of the fake runtime.
+	FakeVersion = "0.1.0"
+
+	// FakeRuntimeName is the name of the fake runtime.
+	FakeRuntimeName = "fakeRuntime"
+)
+
 func filterInLabels(labelSelector, lbls map[string]string) bool {
 	return labels.SelectorFromSet(labelSelector).Matches(labels.Set(lbls))
 }
@@ -31,10 +41,14 @@ type FakeVolumeClassStatus struct {
 type FakeRuntimeService struct {
 	sync.Mutex
 
+	hubOnce sync.Once
+	hub     *watch.Hub[*iri.Volume]
+
 	idGen idgen.IDGen
 
 	Volumes             map[string]*FakeVolume
 	VolumeClassesStatus map[string]*FakeVolumeClassStatus
+	Capabilities        []string
 }
 
 func NewFakeRuntimeService() *FakeRuntimeService {
@@ -43,6 +57,7 @@ func NewFakeRuntimeService() *FakeRuntimeService {
 
 		Volumes:             make(map[string]*FakeVolume),
 		VolumeClassesStatus: make(map[string]*FakeVolumeClassStatus),
+		Capabilities:        capabilities.VolumeOptional,
 	}
 }
 
@@ -66,6 +81,24 @@ func (r *FakeRuntimeService) SetVolumeClasses(volumeClassStatus []*FakeVolumeCla
 	}
 }
 
+func (r *FakeRuntimeService) SetCapabilities(capabilities []string) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.Capabilities = capabilities
+}
+
+func (r *FakeRuntimeService) Version(ctx context.Context, req *iri.VersionRequest, opts ...grpc.CallOption) (*iri.VersionResponse, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	return &iri.VersionResponse{
+		RuntimeName:    FakeRuntimeName,
+		RuntimeVersion: FakeVersion,
+		Capabilities:   r.Capabilities,
+	}, nil
+}
+
 func (r *FakeRuntimeService) ListVolumes(ctx context.Context, req *iri.ListVolumesRequest, opts ...grpc.CallOption) (*iri.ListVolumesResponse, error) {
 	r.Lock()
 	defer r.Unlock()
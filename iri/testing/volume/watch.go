@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package volume
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/watch"
+	"google.golang.org/grpc"
+)
+
+func (r *FakeRuntimeService) volumeHub() *watch.Hub[*iri.Volume] {
+	r.hubOnce.Do(func() {
+		r.hub = watch.NewHub(r.listVolumes, watch.HubOptions{})
+	})
+	return r.hub
+}
+
+func (r *FakeRuntimeService) listVolumes(ctx context.Context) ([]*iri.Volume, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	res := make([]*iri.Volume, 0, len(r.Volumes))
+	for _, v := range r.Volumes {
+		res = append(res, proto.Clone(&v.Volume).(*iri.Volume))
+	}
+	return res, nil
+}
+
+func (r *FakeRuntimeService) WatchVolumes(ctx context.Context, req *iri.WatchVolumesRequest, opts ...grpc.CallOption) (iri.VolumeRuntime_WatchVolumesClient, error) {
+	var filter func(*iri.Volume) bool
+	if f := req.Filter; f != nil {
+		filter = func(volume *iri.Volume) bool {
+			if f.Id != "" && f.Id != volume.Metadata.Id {
+				return false
+			}
+			return f.LabelSelector == nil || filterInLabels(f.LabelSelector, volume.Metadata.Labels)
+		}
+	}
+
+	return watch.NewClientStream(ctx, r.volumeHub(), req.ResourceVersion, filter, func(event watch.Event[*iri.Volume]) *iri.WatchVolumesResponse {
+		return &iri.WatchVolumesResponse{
+			Type:            event.Type,
+			Volume:          event.Object,
+			ResourceVersion: event.ResourceVersion,
+		}
+	}), nil
+}
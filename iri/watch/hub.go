@@ -0,0 +1,414 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package watch implements the server side of the IRI watch RPCs for runtimes that can only list their objects.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Event[O irimeta.Object] struct {
+	Type irimeta.WatchEventType
+	// Object is the object of the event. It is unset for bookmarks.
+	Object O
+	// ResourceVersion is the resource version of the event. Resuming a watch from it yields
+	// all events after this event.
+	ResourceVersion string
+}
+
+// DefaultNotifyResyncPeriod is the default resync period of hubs with Notify. As changes are detected
+// by Notify, the resync only is a safety net for changes Notify missed.
+const DefaultNotifyResyncPeriod = 5 * time.Minute
+
+type HubOptions struct {
+	// ResyncPeriod is the period in which the hub lists the objects.
+	// Defaults to 1s, or DefaultNotifyResyncPeriod if Notify is set.
+	ResyncPeriod time.Duration
+	// BookmarkPeriod is the period in which watches without events receive a bookmark.
+	BookmarkPeriod time.Duration
+	// HistorySize is the number of events the hub keeps to resume watches from.
+	HistorySize int
+	// Notify is started while the hub has watchers. It can call resync to make the hub list
+	// the objects before the next resync period, e.g. when notified about a change by an underlying system.
+	Notify func(ctx context.Context, resync func())
+}
+
+func setHubOptionsDefaults(o *HubOptions) {
+	if o.ResyncPeriod <= 0 {
+		if o.Notify != nil {
+			o.ResyncPeriod = DefaultNotifyResyncPeriod
+		} else {
+			o.ResyncPeriod = 1 * time.Second
+		}
+	}
+	if o.BookmarkPeriod <= 0 {
+		o.BookmarkPeriod = 10 * time.Second
+	}
+	if o.HistorySize <= 0 {
+		o.HistorySize = 1024
+	}
+}
+
+type historyEntry[O irimeta.Object] struct {
+	resourceVersion uint64
+	typ             irimeta.WatchEventType
+	object          O
+	oldObject       O
+}
+
+// Hub serves watches by listing the objects, diffing them against the previous listing and
+// keeping the resulting events for watches to resume from.
+//
+// The hub only lists while there are active watches, so the costs of listing are shared by all watches.
+type Hub[O irimeta.Object] struct {
+	list func(ctx context.Context) ([]O, error)
+	opts HubOptions
+
+	// epoch distinguishes the resource versions of different hub instances.
+	epoch string
+
+	mu              sync.Mutex
+	items           map[string]O
+	history         []historyEntry[O]
+	resourceVersion uint64
+	synced          bool
+	// syncErr is the error of the last list if the hub is not synced.
+	syncErr error
+	// changed is closed and replaced whenever new events are available.
+	changed  chan struct{}
+	watchers int
+	cancel   context.CancelFunc
+
+	resyncCh chan struct{}
+}
+
+func NewHub[O irimeta.Object](list func(ctx context.Context) ([]O, error), opts HubOptions) *Hub[O] {
+	setHubOptionsDefaults(&opts)
+	return &Hub[O]{
+		list:     list,
+		opts:     opts,
+		epoch:    strconv.FormatInt(time.Now().UnixNano(), 36),
+		items:    make(map[string]O),
+		changed:  make(chan struct{}),
+		resyncCh: make(chan struct{}, 1),
+	}
+}
+
+// Resync makes the hub list the objects as soon as possible.
+func (h *Hub[O]) Resync() {
+	select {
+	case h.resyncCh <- struct{}{}:
+	default:
+	}
+}
+
+func (h *Hub[O]) acquire() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.watchers++
+	if h.watchers > 1 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h.cancel = cancel
+	go h.run(ctx)
+}
+
+func (h *Hub[O]) release() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.watchers--
+	if h.watchers == 0 {
+		h.cancel()
+		h.cancel = nil
+		h.synced = false
+		h.syncErr = nil
+	}
+}
+
+func (h *Hub[O]) run(ctx context.Context) {
+	if h.opts.Notify != nil {
+		go h.opts.Notify(ctx, h.Resync)
+	}
+
+	t := time.NewTicker(h.opts.ResyncPeriod)
+	defer t.Stop()
+
+	for {
+		_ = h.resync(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		case <-h.resyncCh:
+		}
+	}
+}
+
+func (h *Hub[O]) resync(ctx context.Context) error {
+	objects, err := h.list(ctx)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if ctx.Err() != nil {
+		// The hub was stopped while listing, a new run might already be active.
+		return ctx.Err()
+	}
+	if err != nil {
+		if !h.synced {
+			h.syncErr = err
+			close(h.changed)
+			h.changed = make(chan struct{})
+		}
+		return err
+	}
+	h.syncErr = nil
+
+	current := make(map[string]O, len(objects))
+	for _, object := range objects {
+		current[object.GetMetadata().GetId()] = object
+	}
+
+	var events []historyEntry[O]
+	for _, id := range sortedKeys(current) {
+		object := current[id]
+		old, ok := h.items[id]
+		switch {
+		case !ok:
+			events = append(events, historyEntry[O]{typ: irimeta.WatchEventType_WATCH_EVENT_ADDED, object: object})
+		case !proto.Equal(old, object):
+			events = append(events, historyEntry[O]{typ: irimeta.WatchEventType_WATCH_EVENT_MODIFIED, object: object, oldObject: old})
+		}
+	}
+	for _, id := range sortedKeys(h.items) {
+		if _, ok := current[id]; !ok {
+			events = append(events, historyEntry[O]{typ: irimeta.WatchEventType_WATCH_EVENT_DELETED, object: h.items[id]})
+		}
+	}
+	h.items = current
+
+	for _, event := range events {
+		h.resourceVersion++
+		event.resourceVersion = h.resourceVersion
+		h.history = append(h.history, event)
+	}
+	if overflow := len(h.history) - h.opts.HistorySize; overflow > 0 {
+		h.history = append(h.history[:0:0], h.history[overflow:]...)
+	}
+
+	if len(events) > 0 || !h.synced {
+		h.synced = true
+		close(h.changed)
+		h.changed = make(chan struct{})
+	}
+	return nil
+}
+
+func (h *Hub[O]) formatResourceVersion(resourceVersion uint64) string {
+	return fmt.Sprintf("%s-%d", h.epoch, resourceVersion)
+}
+
+func (h *Hub[O]) parseResourceVersion(resourceVersion string) (uint64, error) {
+	epoch, rv, ok := strings.Cut(resourceVersion, "-")
+	if !ok {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid resource version %q", resourceVersion)
+	}
+
+	res, err := strconv.ParseUint(rv, 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid resource version %q", resourceVersion)
+	}
+	if epoch != h.epoch {
+		return 0, status.Errorf(codes.OutOfRange, "resource version %q is too old", resourceVersion)
+	}
+	return res, nil
+}
+
+// waitSynced waits until the hub listed its objects at least once.
+func (h *Hub[O]) waitSynced(ctx context.Context) error {
+	for {
+		h.mu.Lock()
+		synced, syncErr, changed := h.synced, h.syncErr, h.changed
+		h.mu.Unlock()
+		if synced {
+			return nil
+		}
+		if syncErr != nil {
+			return status.Errorf(codes.Unavailable, "error listing: %v", syncErr)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// eventsSince returns the events after the given resource version that match the filter,
+// the resource version up to which events were considered and the channel to wait on for new events.
+func (h *Hub[O]) eventsSince(resourceVersion uint64, filter func(O) bool) ([]Event[O], uint64, chan struct{}, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if resourceVersion > h.resourceVersion ||
+		(len(h.history) > 0 && resourceVersion+1 < h.history[0].resourceVersion) ||
+		(len(h.history) == 0 && resourceVersion < h.resourceVersion) {
+		return nil, 0, nil, status.Errorf(codes.OutOfRange, "resource version %q is too old", h.formatResourceVersion(resourceVersion))
+	}
+
+	var res []Event[O]
+	for _, entry := range h.history {
+		if entry.resourceVersion <= resourceVersion {
+			continue
+		}
+
+		if event, ok := h.filterEvent(entry, filter); ok {
+			res = append(res, event)
+		}
+	}
+	return res, h.resourceVersion, h.changed, nil
+}
+
+// filterEvent converts the entry to an event as seen by a watch with the given filter.
+// Objects that start or stop matching the filter are reported as added or deleted.
+func (h *Hub[O]) filterEvent(entry historyEntry[O], filter func(O) bool) (Event[O], bool) {
+	event := Event[O]{
+		Type:            entry.typ,
+		Object:          entry.object,
+		ResourceVersion: h.formatResourceVersion(entry.resourceVersion),
+	}
+	if filter == nil {
+		return event, true
+	}
+
+	matches := filter(entry.object)
+	if entry.typ != irimeta.WatchEventType_WATCH_EVENT_MODIFIED {
+		return event, matches
+	}
+
+	switch oldMatches := filter(entry.oldObject); {
+	case oldMatches && matches:
+	case oldMatches:
+		event.Type = irimeta.WatchEventType_WATCH_EVENT_DELETED
+	case matches:
+		event.Type = irimeta.WatchEventType_WATCH_EVENT_ADDED
+	default:
+		return Event[O]{}, false
+	}
+	return event, true
+}
+
+func (h *Hub[O]) snapshot(filter func(O) bool) ([]Event[O], uint64, chan struct{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var res []Event[O]
+	for _, id := range sortedKeys(h.items) {
+		object := h.items[id]
+		if filter != nil && !filter(object) {
+			continue
+		}
+
+		res = append(res, Event[O]{
+			Type:            irimeta.WatchEventType_WATCH_EVENT_ADDED,
+			Object:          object,
+			ResourceVersion: h.formatResourceVersion(h.resourceVersion),
+		})
+	}
+	res = append(res, Event[O]{
+		Type:            irimeta.WatchEventType_WATCH_EVENT_BOOKMARK,
+		ResourceVersion: h.formatResourceVersion(h.resourceVersion),
+	})
+	return res, h.resourceVersion, h.changed
+}
+
+// Watch sends the events matching the filter to send until the context is done or send fails.
+//
+// If resourceVersion is empty, Watch starts with an added event for every object followed by a bookmark.
+// Otherwise, it resumes after the given resource version or fails with codes.OutOfRange if the
+// resource version is no longer available.
+func (h *Hub[O]) Watch(ctx context.Context, resourceVersion string, filter func(O) bool, send func(Event[O]) error) error {
+	var (
+		rv  uint64
+		err error
+	)
+	if resourceVersion != "" {
+		rv, err = h.parseResourceVersion(resourceVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	h.acquire()
+	defer h.release()
+
+	if err := h.waitSynced(ctx); err != nil {
+		return err
+	}
+
+	var (
+		events  []Event[O]
+		changed chan struct{}
+	)
+	if resourceVersion == "" {
+		events, rv, changed = h.snapshot(filter)
+	} else {
+		events, rv, changed, err = h.eventsSince(rv, filter)
+		if err != nil {
+			return err
+		}
+	}
+
+	bookmark := time.NewTicker(h.opts.BookmarkPeriod)
+	defer bookmark.Stop()
+
+	for {
+		for _, event := range events {
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-bookmark.C:
+			events = []Event[O]{{
+				Type:            irimeta.WatchEventType_WATCH_EVENT_BOOKMARK,
+				ResourceVersion: h.formatResourceVersion(rv),
+			}}
+		case <-changed:
+			events, rv, changed, err = h.eventsSince(rv, filter)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func sortedKeys[O any](m map[string]O) []string {
+	res := make([]string, 0, len(m))
+	for key := range m {
+		res = append(res, key)
+	}
+	sort.Strings(res)
+	return res
+}
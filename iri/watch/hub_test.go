@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/iri/watch"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type machines struct {
+	mu    sync.Mutex
+	items map[string]*iri.Machine
+}
+
+func (m *machines) set(id string, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.items[id] = &iri.Machine{Metadata: &irimeta.ObjectMetadata{Id: id, Labels: labels}}
+}
+
+func (m *machines) delete(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.items, id)
+}
+
+func (m *machines) list(context.Context) ([]*iri.Machine, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var res []*iri.Machine
+	for _, machine := range m.items {
+		res = append(res, proto.Clone(machine).(*iri.Machine))
+	}
+	return res, nil
+}
+
+type recorder struct {
+	events chan Event[*iri.Machine]
+	done   chan error
+}
+
+func startWatch(ctx context.Context, hub *Hub[*iri.Machine], resourceVersion string, filter func(*iri.Machine) bool) *recorder {
+	r := &recorder{
+		events: make(chan Event[*iri.Machine], 100),
+		done:   make(chan error, 1),
+	}
+	go func() {
+		defer GinkgoRecover()
+		r.done <- hub.Watch(ctx, resourceVersion, filter, func(event Event[*iri.Machine]) error {
+			r.events <- event
+			return nil
+		})
+	}()
+	return r
+}
+
+func (r *recorder) next() Event[*iri.Machine] {
+	var event Event[*iri.Machine]
+	EventuallyWithOffset(1, r.events).Should(Receive(&event))
+	return event
+}
+
+func haveEvent(typ irimeta.WatchEventType, id string) func(Event[*iri.Machine]) {
+	return func(event Event[*iri.Machine]) {
+		ExpectWithOffset(1, event.Type).To(Equal(typ))
+		ExpectWithOffset(1, event.Object.GetMetadata().GetId()).To(Equal(id))
+	}
+}
+
+var _ = Describe("Hub", func() {
+	var (
+		objects *machines
+		hub     *Hub[*iri.Machine]
+	)
+	BeforeEach(func() {
+		objects = &machines{items: make(map[string]*iri.Machine)}
+		hub = NewHub(objects.list, HubOptions{
+			ResyncPeriod:   10 * time.Millisecond,
+			BookmarkPeriod: time.Hour,
+			HistorySize:    3,
+		})
+	})
+
+	It("should start with the current objects followed by a bookmark and report changes", func(ctx SpecContext) {
+		objects.set("a", nil)
+		objects.set("b", nil)
+
+		r := startWatch(ctx, hub, "", nil)
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_ADDED, "a")(r.next())
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_ADDED, "b")(r.next())
+		Expect(r.next().Type).To(Equal(irimeta.WatchEventType_WATCH_EVENT_BOOKMARK))
+
+		By("modifying and deleting objects")
+		objects.set("a", map[string]string{"foo": "bar"})
+		modified := r.next()
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_MODIFIED, "a")(modified)
+		Expect(modified.Object.Metadata.Labels).To(Equal(map[string]string{"foo": "bar"}))
+
+		objects.delete("b")
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_DELETED, "b")(r.next())
+	})
+
+	It("should resume from a resource version", func(ctx SpecContext) {
+		objects.set("a", nil)
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		r := startWatch(watchCtx, hub, "", nil)
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_ADDED, "a")(r.next())
+		bookmark := r.next()
+		Expect(bookmark.Type).To(Equal(irimeta.WatchEventType_WATCH_EVENT_BOOKMARK))
+
+		By("keeping the hub active while the first watch is stopped")
+		other := startWatch(ctx, hub, "", nil)
+		Expect(other.next().Type).To(Equal(irimeta.WatchEventType_WATCH_EVENT_ADDED))
+
+		cancel()
+		Eventually(r.done).Should(Receive(BeNil()))
+
+		By("changing objects while not watching")
+		objects.set("b", nil)
+		objects.delete("a")
+		Eventually(other.events).Should(HaveLen(3))
+
+		By("resuming the watch")
+		r = startWatch(ctx, hub, bookmark.ResourceVersion, nil)
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_ADDED, "b")(r.next())
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_DELETED, "a")(r.next())
+		Consistently(r.events).ShouldNot(Receive())
+	})
+
+	It("should fail resuming from an unavailable resource version", func(ctx SpecContext) {
+		By("resuming from a resource version of another hub")
+		r := startWatch(ctx, hub, "other-1", nil)
+		var err error
+		Eventually(r.done).Should(Receive(&err))
+		Expect(status.Code(err)).To(Equal(codes.OutOfRange))
+
+		By("resuming from a malformed resource version")
+		r = startWatch(ctx, hub, "foo", nil)
+		Eventually(r.done).Should(Receive(&err))
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		By("resuming from a resource version that is no longer in the history")
+		objects.set("a", nil)
+		active := startWatch(ctx, hub, "", nil)
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_ADDED, "a")(active.next())
+		bookmark := active.next()
+
+		for _, labels := range []map[string]string{{"v": "1"}, {"v": "2"}, {"v": "3"}, {"v": "4"}} {
+			objects.set("a", labels)
+			haveEvent(irimeta.WatchEventType_WATCH_EVENT_MODIFIED, "a")(active.next())
+		}
+
+		r = startWatch(ctx, hub, bookmark.ResourceVersion, nil)
+		Eventually(r.done).Should(Receive(&err))
+		Expect(status.Code(err)).To(Equal(codes.OutOfRange))
+	})
+
+	It("should report objects starting or stopping to match the filter as added or deleted", func(ctx SpecContext) {
+		objects.set("a", nil)
+
+		r := startWatch(ctx, hub, "", func(machine *iri.Machine) bool {
+			return machine.Metadata.Labels["match"] == "true"
+		})
+		Expect(r.next().Type).To(Equal(irimeta.WatchEventType_WATCH_EVENT_BOOKMARK))
+
+		objects.set("a", map[string]string{"match": "true"})
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_ADDED, "a")(r.next())
+
+		objects.set("a", map[string]string{"match": "true", "foo": "bar"})
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_MODIFIED, "a")(r.next())
+
+		objects.set("a", nil)
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_DELETED, "a")(r.next())
+
+		objects.set("b", nil)
+		Consistently(r.events).ShouldNot(Receive())
+	})
+
+	It("should only report changes signaled by notify when relying on the default resync period", func(ctx SpecContext) {
+		notify := make(chan struct{})
+		hub = NewHub(objects.list, HubOptions{
+			BookmarkPeriod: time.Hour,
+			Notify: func(ctx context.Context, resync func()) {
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-notify:
+						resync()
+					}
+				}
+			},
+		})
+		objects.set("a", nil)
+
+		r := startWatch(ctx, hub, "", nil)
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_ADDED, "a")(r.next())
+		Expect(r.next().Type).To(Equal(irimeta.WatchEventType_WATCH_EVENT_BOOKMARK))
+
+		By("changing an object without notifying")
+		objects.set("a", map[string]string{"foo": "bar"})
+		Consistently(r.events).ShouldNot(Receive())
+
+		By("notifying the hub")
+		notify <- struct{}{}
+		haveEvent(irimeta.WatchEventType_WATCH_EVENT_MODIFIED, "a")(r.next())
+	})
+
+	It("should be usable as client stream", func(ctx SpecContext) {
+		objects.set("a", nil)
+
+		stream := NewClientStream(ctx, hub, "", nil, func(event Event[*iri.Machine]) *iri.WatchMachinesResponse {
+			return &iri.WatchMachinesResponse{Type: event.Type, Machine: event.Object, ResourceVersion: event.ResourceVersion}
+		})
+
+		res, err := stream.Recv()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Type).To(Equal(irimeta.WatchEventType_WATCH_EVENT_ADDED))
+		Expect(res.Machine.Metadata.Id).To(Equal("a"))
+
+		res, err = stream.Recv()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Type).To(Equal(irimeta.WatchEventType_WATCH_EVENT_BOOKMARK))
+		Expect(res.ResourceVersion).NotTo(BeEmpty())
+	})
+})
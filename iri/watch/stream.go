@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ClientStream is an in-process client stream of watch responses. It allows in-process runtimes
+// (e.g. fakes) to implement the client side of the IRI watch RPCs.
+type ClientStream[T any] struct {
+	ctx context.Context
+
+	responses chan T
+	done      chan struct{}
+	err       error
+}
+
+// NewClientStream starts watching the hub and returns a client stream of the converted events.
+// The watch stops once the context is done.
+func NewClientStream[O irimeta.Object, T any](
+	ctx context.Context,
+	hub *Hub[O],
+	resourceVersion string,
+	filter func(O) bool,
+	convert func(Event[O]) T,
+) *ClientStream[T] {
+	s := &ClientStream[T]{
+		ctx:       ctx,
+		responses: make(chan T),
+		done:      make(chan struct{}),
+	}
+
+	go func() {
+		defer close(s.done)
+		s.err = hub.Watch(ctx, resourceVersion, filter, func(event Event[O]) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case s.responses <- convert(event):
+				return nil
+			}
+		})
+	}()
+	return s
+}
+
+// Recv receives the next response.
+func (s *ClientStream[T]) Recv() (T, error) {
+	select {
+	case res := <-s.responses:
+		return res, nil
+	case <-s.done:
+		var zero T
+		switch {
+		case s.ctx.Err() != nil:
+			return zero, status.FromContextError(s.ctx.Err()).Err()
+		case s.err != nil:
+			return zero, s.err
+		default:
+			return zero, io.EOF
+		}
+	}
+}
+
+func (s *ClientStream[T]) Header() (metadata.MD, error) {
+	return metadata.MD{}, nil
+}
+
+func (s *ClientStream[T]) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+func (s *ClientStream[T]) CloseSend() error {
+	return nil
+}
+
+func (s *ClientStream[T]) Context() context.Context {
+	return s.ctx
+}
+
+func (s *ClientStream[T]) SendMsg(m any) error {
+	return status.Error(codes.Unimplemented, "sending messages is not supported")
+}
+
+func (s *ClientStream[T]) RecvMsg(m any) error {
+	return fmt.Errorf("receiving untyped messages is not supported")
+}
@@ -4,17 +4,21 @@
 package common
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	iriauth "github.com/ironcore-dev/ironcore/iri/auth"
+	iriremote "github.com/ironcore-dev/ironcore/iri/remote"
 	iriremotebucket "github.com/ironcore-dev/ironcore/iri/remote/bucket"
 	"github.com/ironcore-dev/ironcore/irictl-bucket/renderers"
 	irictlcmd "github.com/ironcore-dev/ironcore/irictl/cmd"
 	"github.com/ironcore-dev/ironcore/irictl/renderer"
+	"github.com/ironcore-dev/ironcore/irictl/watch"
+	"github.com/ironcore-dev/ironcore/poollet/irievent"
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var Renderer = renderer.NewRegistry()
@@ -31,10 +35,13 @@ type ClientFactory interface {
 
 type ClientOptions struct {
 	Address string
+	Auth    iriauth.ClientFlags
 }
 
 func (o *ClientOptions) AddFlags(fs *pflag.FlagSet) {
-	fs.StringVar(&o.Address, "address", "", "Address to the iri server.")
+	fs.StringVar(&o.Address, "address", "", "Address to the iri server. "+
+		"Either a unix socket path, 'unix://<path>' or 'tcp://<host>:<port>'.")
+	o.Auth.BindFlags(fs, "")
 }
 
 func (o *ClientOptions) New() (iri.BucketRuntimeClient, func() error, error) {
@@ -43,7 +50,16 @@ func (o *ClientOptions) New() (iri.BucketRuntimeClient, func() error, error) {
 		return nil, nil, err
 	}
 
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	authOpts, err := o.Auth.ClientOptions()
+	if err != nil {
+		return nil, nil, err
+	}
+	dialOpts, err := iriauth.DialOptions(authOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := grpc.Dial(iriremote.DialTarget(address), dialOpts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error dialing: %w", err)
 	}
@@ -57,6 +73,33 @@ func NewOutputOptions() *irictlcmd.OutputOptions {
 	}
 }
 
+// WatchOptions returns the options to watch the buckets of the given client matching the given id and labels.
+func WatchOptions(client iri.BucketRuntimeClient, id string, labels map[string]string) watch.Options[*iri.Bucket] {
+	var filter *iri.BucketFilter
+	if id != "" || labels != nil {
+		filter = &iri.BucketFilter{
+			Id:            id,
+			LabelSelector: labels,
+		}
+	}
+
+	return watch.Options[*iri.Bucket]{
+		List: func(ctx context.Context) ([]*iri.Bucket, error) {
+			res, err := client.ListBuckets(ctx, &iri.ListBucketsRequest{Filter: filter})
+			if err != nil {
+				return nil, fmt.Errorf("error listing buckets: %w", err)
+			}
+			return res.Buckets, nil
+		},
+		Watch: irievent.NewWatchFunc(func(ctx context.Context, resourceVersion string) (irievent.WatchStream[*iri.WatchBucketsResponse], error) {
+			return client.WatchBuckets(ctx, &iri.WatchBucketsRequest{ResourceVersion: resourceVersion})
+		}, func(res *iri.WatchBucketsResponse) irievent.WatchEvent[*iri.Bucket] {
+			return irievent.WatchEvent[*iri.Bucket]{Type: res.Type, Object: res.Bucket, ResourceVersion: res.ResourceVersion}
+		}),
+		Filter: watch.MetadataFilter[*iri.Bucket](id, labels),
+	}
+}
+
 var (
 	BucketAliases      = []string{"buckets"}
 	BucketClassAliases = []string{"bucketchineclasses"}
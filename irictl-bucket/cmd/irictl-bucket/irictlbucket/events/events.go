@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	"github.com/ironcore-dev/ironcore/irictl-bucket/cmd/irictl-bucket/irictlbucket/common"
+	irictlcmd "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/ironcore-dev/ironcore/irictl/watch"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type Options struct {
+	Labels map[string]string
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringToStringVarP(&o.Labels, "labels", "l", o.Labels, "Labels to filter the buckets by.")
+}
+
+func Command(streams irictlcmd.Streams, clientFactory common.ClientFactory) *cobra.Command {
+	var opts Options
+
+	cmd := &cobra.Command{
+		Use:   "events [bucket-id]",
+		Short: "Print the create, update and delete events of buckets.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.New()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			var bucketID string
+			if len(args) > 0 {
+				bucketID = args[0]
+			}
+
+			return Run(ctx, streams, client, bucketID, opts)
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams irictlcmd.Streams, client iri.BucketRuntimeClient, bucketID string, opts Options) error {
+	return watch.Events[*iri.Bucket](
+		ctx,
+		common.WatchOptions(client, bucketID, opts.Labels),
+		watch.NewPrinter[*iri.Bucket](streams.Out, "bucket"),
+	)
+}
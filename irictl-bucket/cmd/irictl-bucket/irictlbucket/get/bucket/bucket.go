@@ -5,21 +5,23 @@ package bucket
 
 import (
 	"context"
-	"fmt"
 
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
 	"github.com/ironcore-dev/ironcore/irictl-bucket/cmd/irictl-bucket/irictlbucket/common"
 	irictlcmd "github.com/ironcore-dev/ironcore/irictl/cmd"
 	"github.com/ironcore-dev/ironcore/irictl/renderer"
+	"github.com/ironcore-dev/ironcore/irictl/watch"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
 type Options struct {
+	Watch bool
 }
 
 func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVarP(&o.Watch, "watch", "w", o.Watch, "Watch the buckets and render them again on every change.")
 }
 
 func Command(streams irictlcmd.Streams, clientFactory common.ClientFactory) *cobra.Command {
@@ -61,10 +63,15 @@ func Command(streams irictlcmd.Streams, clientFactory common.ClientFactory) *cob
 }
 
 func Run(ctx context.Context, streams irictlcmd.Streams, client iri.BucketRuntimeClient, render renderer.Renderer, opts Options) error {
-	res, err := client.ListBuckets(ctx, &iri.ListBucketsRequest{})
+	watchOpts := common.WatchOptions(client, "", nil)
+	if opts.Watch {
+		return watch.Objects(ctx, watchOpts, watch.RenderFunc[*iri.Bucket](streams.Out, render))
+	}
+
+	buckets, err := watchOpts.List(ctx)
 	if err != nil {
-		return fmt.Errorf("error listing buckets: %w", err)
+		return err
 	}
 
-	return render.Render(res.Buckets, streams.Out)
+	return render.Render(buckets, streams.Out)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/ironcore-dev/ironcore/irictl-bucket/cmd/irictl-bucket/irictlbucket/common"
 	"github.com/ironcore-dev/ironcore/irictl-bucket/cmd/irictl-bucket/irictlbucket/create"
 	delete2 "github.com/ironcore-dev/ironcore/irictl-bucket/cmd/irictl-bucket/irictlbucket/delete"
+	"github.com/ironcore-dev/ironcore/irictl-bucket/cmd/irictl-bucket/irictlbucket/events"
 	"github.com/ironcore-dev/ironcore/irictl-bucket/cmd/irictl-bucket/irictlbucket/get"
 	irictlcmd "github.com/ironcore-dev/ironcore/irictl/cmd"
 	"github.com/spf13/cobra"
@@ -37,11 +38,17 @@ func Command(streams irictlcmd.Streams) *cobra.Command {
 	cmd.PersistentFlags().AddGoFlagSet(goFlags)
 	clientOpts.AddFlags(cmd.PersistentFlags())
 
-	cmd.AddCommand(
-		get.Command(streams, &clientOpts),
-		delete2.Command(streams, &clientOpts),
-		create.Command(streams, &clientOpts),
-	)
+	cmd.AddCommand(Commands(streams, &clientOpts)...)
 
 	return cmd
 }
+
+// Commands returns the subcommands of irictl-bucket using the given client factory.
+func Commands(streams irictlcmd.Streams, clientFactory common.ClientFactory) []*cobra.Command {
+	return []*cobra.Command{
+		get.Command(streams, clientFactory),
+		delete2.Command(streams, clientFactory),
+		create.Command(streams, clientFactory),
+		events.Command(streams, clientFactory),
+	}
+}
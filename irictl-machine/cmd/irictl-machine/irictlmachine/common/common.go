@@ -4,20 +4,25 @@
 package common
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"text/template"
 	"time"
 
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	iriauth "github.com/ironcore-dev/ironcore/iri/auth"
+	iriremote "github.com/ironcore-dev/ironcore/iri/remote"
 	iriremotemachine "github.com/ironcore-dev/ironcore/iri/remote/machine"
 	"github.com/ironcore-dev/ironcore/irictl-machine/clientcmd"
 	"github.com/ironcore-dev/ironcore/irictl-machine/tableconverters"
 	"github.com/ironcore-dev/ironcore/irictl/renderer"
 	"github.com/ironcore-dev/ironcore/irictl/tableconverter"
+	"github.com/ironcore-dev/ironcore/irictl/watch"
+	"github.com/ironcore-dev/ironcore/poollet/irievent"
 	"github.com/ironcore-dev/ironcore/utils/generic"
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Factory interface {
@@ -30,11 +35,14 @@ type Factory interface {
 type Options struct {
 	Address    string
 	ConfigFile string
+	Auth       iriauth.ClientFlags
 }
 
 func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.ConfigFile, clientcmd.RecommendedConfigPathFlag, "", "Config file to use")
-	fs.StringVar(&o.Address, "address", "", "Address to the iri server.")
+	fs.StringVar(&o.Address, "address", "", "Address to the iri server. "+
+		"Either a unix socket path, 'unix://<path>' or 'tcp://<host>:<port>'.")
+	o.Auth.BindFlags(fs, "")
 }
 
 func (o *Options) Config() (*clientcmd.Config, error) {
@@ -195,7 +203,16 @@ func (o *Options) Client() (iri.MachineRuntimeClient, func() error, error) {
 		return nil, nil, err
 	}
 
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	authOpts, err := o.Auth.ClientOptions()
+	if err != nil {
+		return nil, nil, err
+	}
+	dialOpts, err := iriauth.DialOptions(authOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := grpc.Dial(iriremote.DialTarget(address), dialOpts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error dialing: %w", err)
 	}
@@ -246,7 +263,60 @@ func (o *OutputOptions) RendererOrNil() (renderer.Renderer, error) {
 	return r.Get(output)
 }
 
+// GetMachine returns the machine with the given id.
+func GetMachine(ctx context.Context, client iri.MachineRuntimeClient, id string) (*iri.Machine, error) {
+	res, err := client.ListMachines(ctx, &iri.ListMachinesRequest{Filter: &iri.MachineFilter{Id: id}})
+	if err != nil {
+		return nil, fmt.Errorf("error getting machine %s: %w", id, err)
+	}
+	if len(res.Machines) == 0 {
+		return nil, fmt.Errorf("machine %s not found", id)
+	}
+	return res.Machines[0], nil
+}
+
+// WatchOptions returns the options to watch the machines of the given client matching the given id and labels.
+func WatchOptions(client iri.MachineRuntimeClient, id string, labels map[string]string) watch.Options[*iri.Machine] {
+	var filter *iri.MachineFilter
+	if id != "" || labels != nil {
+		filter = &iri.MachineFilter{
+			Id:            id,
+			LabelSelector: labels,
+		}
+	}
+
+	return watch.Options[*iri.Machine]{
+		List: func(ctx context.Context) ([]*iri.Machine, error) {
+			res, err := client.ListMachines(ctx, &iri.ListMachinesRequest{Filter: filter})
+			if err != nil {
+				return nil, fmt.Errorf("error listing machines: %w", err)
+			}
+			return res.Machines, nil
+		},
+		Watch: irievent.NewWatchFunc(func(ctx context.Context, resourceVersion string) (irievent.WatchStream[*iri.WatchMachinesResponse], error) {
+			return client.WatchMachines(ctx, &iri.WatchMachinesRequest{ResourceVersion: resourceVersion})
+		}, func(res *iri.WatchMachinesResponse) irievent.WatchEvent[*iri.Machine] {
+			return irievent.WatchEvent[*iri.Machine]{Type: res.Type, Object: res.Machine, ResourceVersion: res.ResourceVersion}
+		}),
+		Filter: watch.MetadataFilter[*iri.Machine](id, labels),
+	}
+}
+
+// ParsePower parses the given power state ('on' or 'off').
+func ParsePower(s string) (iri.Power, error) {
+	switch strings.ToLower(s) {
+	case "on":
+		return iri.Power_POWER_ON, nil
+	case "off":
+		return iri.Power_POWER_OFF, nil
+	default:
+		return 0, fmt.Errorf("unknown power state %q, expected 'on' or 'off'", s)
+	}
+}
+
 var (
+	PowerStates = []string{"on", "off"}
+
 	MachineAliases          = []string{"machines", "mach", "machs"}
 	VolumeAliases           = []string{"volumes", "vol", "vols"}
 	NetworkInterfaceAliases = []string{"networkinterfaces", "nic", "nics"}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machine
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/gogo/protobuf/proto"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/common"
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/ironcore-dev/ironcore/irictl/decoder"
+	"github.com/ironcore-dev/ironcore/irictl/editor"
+	"github.com/ironcore-dev/ironcore/irictl/renderer"
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const header = `# Please edit the machine below. Only metadata.annotations and spec.power (0: on, 1: off)
+# can be changed, changes to other fields are rejected and changes to the status are ignored.
+# Lines beginning with '#' are ignored. Leaving the file unchanged cancels the edit.
+#
+`
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var (
+		outputOpts = clientFactory.OutputOptions()
+	)
+
+	cmd := &cobra.Command{
+		Use:     "machine machine-id",
+		Short:   "Edit a machine in the editor set via $IRICTL_EDITOR or $EDITOR.",
+		Aliases: common.MachineAliases,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.Client()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			r, err := outputOpts.RendererOrNil()
+			if err != nil {
+				return err
+			}
+
+			return Run(ctx, streams, client, r, args[0])
+		},
+	}
+
+	outputOpts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// Changes returns the power state and annotations to update machine with to reflect edited.
+// A nil result means the field does not need to be updated. Changes to any other field except the
+// status (which is ignored) are rejected, since there is no way to update them.
+func Changes(machine, edited *iri.Machine) (*iri.Power, map[string]string, error) {
+	if !proto.Equal(withoutMutableFields(machine), withoutMutableFields(edited)) {
+		return nil, nil, fmt.Errorf("only metadata.annotations and spec.power can be edited")
+	}
+
+	var power *iri.Power
+	if editedPower := edited.GetSpec().GetPower(); editedPower != machine.GetSpec().GetPower() {
+		power = &editedPower
+	}
+
+	var annotations map[string]string
+	if editedAnnotations := edited.GetMetadata().GetAnnotations(); !maps.Equal(editedAnnotations, machine.GetMetadata().GetAnnotations()) {
+		annotations = editedAnnotations
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+	}
+	return power, annotations, nil
+}
+
+// withoutMutableFields returns a copy of the machine without the fields that can be edited or are ignored.
+func withoutMutableFields(machine *iri.Machine) *iri.Machine {
+	machine = proto.Clone(machine).(*iri.Machine)
+	if machine.Metadata != nil {
+		machine.Metadata.Annotations = nil
+	}
+	if machine.Spec != nil {
+		machine.Spec.Power = iri.Power_POWER_ON
+	}
+	machine.Status = nil
+	return machine
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.MachineRuntimeClient, r renderer.Renderer, machineID string) error {
+	machine, err := common.GetMachine(ctx, client, machineID)
+	if err != nil {
+		return err
+	}
+
+	data := bytes.NewBufferString(header)
+	if err := renderer.YAML.Render(machine, data); err != nil {
+		return fmt.Errorf("error encoding machine: %w", err)
+	}
+
+	editedData, err := editor.Edit(streams, data.Bytes(), ".yaml")
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(editedData, data.Bytes()) {
+		_, _ = fmt.Fprintln(streams.Out, "Edit cancelled, no changes made.")
+		return nil
+	}
+
+	edited := &iri.Machine{}
+	if err := decoder.Decode(editedData, edited); err != nil {
+		return fmt.Errorf("error decoding edited machine: %w", err)
+	}
+
+	power, annotations, err := Changes(machine, edited)
+	if err != nil {
+		return err
+	}
+	if power == nil && annotations == nil {
+		_, _ = fmt.Fprintln(streams.Out, "Edit cancelled, no changes made.")
+		return nil
+	}
+
+	if annotations != nil {
+		if _, err := client.UpdateMachineAnnotations(ctx, &iri.UpdateMachineAnnotationsRequest{
+			MachineId:   machineID,
+			Annotations: annotations,
+		}); err != nil {
+			return fmt.Errorf("error updating annotations of machine %s: %w", machineID, err)
+		}
+	}
+	if power != nil {
+		if _, err := client.UpdateMachinePower(ctx, &iri.UpdateMachinePowerRequest{
+			MachineId: machineID,
+			Power:     *power,
+		}); err != nil {
+			return fmt.Errorf("error updating power of machine %s: %w", machineID, err)
+		}
+	}
+
+	if r != nil {
+		machine, err := common.GetMachine(ctx, client, machineID)
+		if err != nil {
+			return err
+		}
+		return r.Render(machine, streams.Out)
+	}
+	_, _ = fmt.Fprintf(streams.Out, "Edited machine %s\n", machineID)
+	return nil
+}
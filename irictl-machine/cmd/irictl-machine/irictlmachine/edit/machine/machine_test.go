@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machine_test
+
+import (
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/edit/machine"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+)
+
+func newMachine() *iri.Machine {
+	return &iri.Machine{
+		Metadata: &irimeta.ObjectMetadata{
+			Id:          "foo",
+			Labels:      map[string]string{"app": "web"},
+			Annotations: map[string]string{"foo": "bar"},
+		},
+		Spec: &iri.MachineSpec{
+			Power: iri.Power_POWER_ON,
+			Image: &iri.ImageSpec{Image: "example.org/foo:latest"},
+			Class: "machine-class",
+		},
+		Status: &iri.MachineStatus{
+			State: iri.MachineState_MACHINE_PENDING,
+		},
+	}
+}
+
+var _ = Describe("Changes", func() {
+	powerOff := iri.Power_POWER_OFF
+
+	DescribeTable("should compute the changes of an edited machine",
+		func(edit func(machine *iri.Machine), matchPower, matchAnnotations types.GomegaMatcher) {
+			edited := newMachine()
+			edit(edited)
+
+			power, annotations, err := Changes(newMachine(), edited)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(power).To(matchPower)
+			Expect(annotations).To(matchAnnotations)
+		},
+		Entry("no changes",
+			func(machine *iri.Machine) {},
+			BeNil(), BeNil(),
+		),
+		Entry("changed power",
+			func(machine *iri.Machine) { machine.Spec.Power = iri.Power_POWER_OFF },
+			Equal(&powerOff), BeNil(),
+		),
+		Entry("changed annotations",
+			func(machine *iri.Machine) {
+				machine.Metadata.Annotations = map[string]string{"foo": "baz", "bar": "qux"}
+			},
+			BeNil(), Equal(map[string]string{"foo": "baz", "bar": "qux"}),
+		),
+		Entry("removed all annotations",
+			func(machine *iri.Machine) { machine.Metadata.Annotations = nil },
+			BeNil(), Equal(map[string]string{}),
+		),
+		Entry("ignored status changes",
+			func(machine *iri.Machine) {
+				machine.Status = &iri.MachineStatus{State: iri.MachineState_MACHINE_RUNNING}
+			},
+			BeNil(), BeNil(),
+		),
+		Entry("removed status",
+			func(machine *iri.Machine) { machine.Status = nil },
+			BeNil(), BeNil(),
+		),
+	)
+
+	DescribeTable("should reject changes to immutable fields",
+		func(edit func(machine *iri.Machine)) {
+			edited := newMachine()
+			edit(edited)
+
+			_, _, err := Changes(newMachine(), edited)
+			Expect(err).To(MatchError(ContainSubstring("only metadata.annotations and spec.power can be edited")))
+		},
+		Entry("id", func(machine *iri.Machine) { machine.Metadata.Id = "bar" }),
+		Entry("labels", func(machine *iri.Machine) { machine.Metadata.Labels["app"] = "db" }),
+		Entry("image", func(machine *iri.Machine) { machine.Spec.Image = &iri.ImageSpec{Image: "example.org/bar:latest"} }),
+		Entry("class", func(machine *iri.Machine) { machine.Spec.Class = "other-machine-class" }),
+		Entry("power and class", func(machine *iri.Machine) {
+			machine.Spec.Power = iri.Power_POWER_OFF
+			machine.Spec.Class = "other-machine-class"
+		}),
+	)
+})
@@ -5,12 +5,12 @@ package machine
 
 import (
 	"context"
-	"fmt"
 
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/common"
 	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
 	"github.com/ironcore-dev/ironcore/irictl/renderer"
+	"github.com/ironcore-dev/ironcore/irictl/watch"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -18,10 +18,12 @@ import (
 
 type Options struct {
 	Labels map[string]string
+	Watch  bool
 }
 
 func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringToStringVarP(&o.Labels, "labels", "l", o.Labels, "Labels to filter the machines by.")
+	fs.BoolVarP(&o.Watch, "watch", "w", o.Watch, "Watch the machines and render them again on every change.")
 }
 
 func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
@@ -76,18 +78,15 @@ func Run(
 	name string,
 	opts Options,
 ) error {
-	var filter *iri.MachineFilter
-	if name != "" || opts.Labels != nil {
-		filter = &iri.MachineFilter{
-			Id:            name,
-			LabelSelector: opts.Labels,
-		}
+	watchOpts := common.WatchOptions(client, name, opts.Labels)
+	if opts.Watch {
+		return watch.Objects(ctx, watchOpts, watch.RenderFunc[*iri.Machine](streams.Out, render))
 	}
 
-	res, err := client.ListMachines(ctx, &iri.ListMachinesRequest{Filter: filter})
+	machines, err := watchOpts.List(ctx)
 	if err != nil {
-		return fmt.Errorf("error listing machines: %w", err)
+		return err
 	}
 
-	return render.Render(res.Machines, streams.Out)
+	return render.Render(machines, streams.Out)
 }
@@ -11,6 +11,8 @@ import (
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/create"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/delete"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/detach"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/edit"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/events"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/exec"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/get"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/update"
@@ -41,15 +43,22 @@ func Command(streams clicommon.Streams) *cobra.Command {
 	cmd.PersistentFlags().AddGoFlagSet(goFlags)
 	clientOpts.AddFlags(cmd.PersistentFlags())
 
-	cmd.AddCommand(
-		get.Command(streams, &clientOpts),
-		create.Command(streams, &clientOpts),
-		delete.Command(streams, &clientOpts),
-		update.Command(streams, &clientOpts),
-		exec.Command(streams, &clientOpts),
-		attach.Command(streams, &clientOpts),
-		detach.Command(streams, &clientOpts),
-	)
+	cmd.AddCommand(Commands(streams, &clientOpts)...)
 
 	return cmd
 }
+
+// Commands returns the subcommands of irictl-machine using the given client factory.
+func Commands(streams clicommon.Streams, clientFactory common.Factory) []*cobra.Command {
+	return []*cobra.Command{
+		get.Command(streams, clientFactory),
+		create.Command(streams, clientFactory),
+		delete.Command(streams, clientFactory),
+		update.Command(streams, clientFactory),
+		edit.Command(streams, clientFactory),
+		events.Command(streams, clientFactory),
+		exec.Command(streams, clientFactory),
+		attach.Command(streams, clientFactory),
+		detach.Command(streams, clientFactory),
+	}
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package annotations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/common"
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/ironcore-dev/ironcore/irictl/renderer"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type Options struct {
+	Overwrite bool
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Overwrite, "overwrite", o.Overwrite, "Whether to overwrite annotations that already exist with a different value.")
+}
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var (
+		outputOpts = clientFactory.OutputOptions()
+		opts       Options
+	)
+
+	cmd := &cobra.Command{
+		Use:   "annotations machine-id KEY=VALUE... KEY-...",
+		Short: "Update the annotations of a machine. 'KEY=VALUE' sets an annotation, 'KEY-' removes it.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			set, remove, err := ParseChanges(args[1:])
+			if err != nil {
+				return err
+			}
+
+			client, cleanup, err := clientFactory.Client()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			r, err := outputOpts.RendererOrNil()
+			if err != nil {
+				return err
+			}
+
+			return Run(ctx, streams, client, r, args[0], set, remove, opts)
+		},
+	}
+
+	outputOpts.AddFlags(cmd.Flags())
+	opts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// ParseChanges parses annotation changes of the form 'KEY=VALUE' (set) and 'KEY-' (remove).
+func ParseChanges(args []string) (set map[string]string, remove []string, err error) {
+	set = make(map[string]string)
+	for _, arg := range args {
+		if key, value, ok := strings.Cut(arg, "="); ok {
+			if key == "" {
+				return nil, nil, fmt.Errorf("invalid annotation %q: empty key", arg)
+			}
+			set[key] = value
+			continue
+		}
+		if key, ok := strings.CutSuffix(arg, "-"); ok && key != "" {
+			remove = append(remove, key)
+			continue
+		}
+		return nil, nil, fmt.Errorf("invalid annotation %q: expected 'KEY=VALUE' or 'KEY-'", arg)
+	}
+
+	for _, key := range remove {
+		if _, ok := set[key]; ok {
+			return nil, nil, fmt.Errorf("annotation %q is both set and removed", key)
+		}
+	}
+	return set, remove, nil
+}
+
+// Apply applies the changes to the given annotations and returns the resulting annotations.
+// Unless overwrite is true, setting an existing annotation to a different value fails.
+func Apply(annotations, set map[string]string, remove []string, overwrite bool) (map[string]string, error) {
+	res := make(map[string]string, len(annotations)+len(set))
+	for key, value := range annotations {
+		res[key] = value
+	}
+
+	for key, value := range set {
+		if existing, ok := res[key]; ok && existing != value && !overwrite {
+			return nil, fmt.Errorf("annotation %q already has value %q, use --overwrite to overwrite it", key, existing)
+		}
+		res[key] = value
+	}
+	for _, key := range remove {
+		delete(res, key)
+	}
+	return res, nil
+}
+
+func Run(
+	ctx context.Context,
+	streams clicommon.Streams,
+	client iri.MachineRuntimeClient,
+	r renderer.Renderer,
+	machineID string,
+	set map[string]string,
+	remove []string,
+	opts Options,
+) error {
+	machine, err := common.GetMachine(ctx, client, machineID)
+	if err != nil {
+		return err
+	}
+
+	annotations, err := Apply(machine.GetMetadata().GetAnnotations(), set, remove, opts.Overwrite)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.UpdateMachineAnnotations(ctx, &iri.UpdateMachineAnnotationsRequest{
+		MachineId:   machineID,
+		Annotations: annotations,
+	}); err != nil {
+		return fmt.Errorf("error updating annotations of machine %s: %w", machineID, err)
+	}
+
+	if r != nil {
+		machine, err := common.GetMachine(ctx, client, machineID)
+		if err != nil {
+			return err
+		}
+		return r.Render(machine, streams.Out)
+	}
+	_, _ = fmt.Fprintf(streams.Out, "Updated annotations of machine %s\n", machineID)
+	return nil
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package annotations_test
+
+import (
+	"maps"
+
+	. "github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/update/annotations"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Annotations", func() {
+	DescribeTable("ParseChanges",
+		func(args []string, expectedSet map[string]string, expectedRemove []string) {
+			set, remove, err := ParseChanges(args)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(set).To(Equal(expectedSet))
+			Expect(remove).To(Equal(expectedRemove))
+		},
+		Entry("set and remove",
+			[]string{"foo=bar", "baz-"},
+			map[string]string{"foo": "bar"}, []string{"baz"},
+		),
+		Entry("empty value",
+			[]string{"foo="},
+			map[string]string{"foo": ""}, nil,
+		),
+		Entry("value containing '=' and '-'",
+			[]string{"foo=bar=baz-"},
+			map[string]string{"foo": "bar=baz-"}, nil,
+		),
+		Entry("only removals",
+			[]string{"foo-", "bar-"},
+			map[string]string{}, []string{"foo", "bar"},
+		),
+	)
+
+	DescribeTable("ParseChanges errors",
+		func(args []string, expectedErr string) {
+			_, _, err := ParseChanges(args)
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("empty key", []string{"=bar"}, "empty key"),
+		Entry("neither set nor remove", []string{"foo"}, "expected 'KEY=VALUE' or 'KEY-'"),
+		Entry("removal without key", []string{"-"}, "expected 'KEY=VALUE' or 'KEY-'"),
+		Entry("key both set and removed", []string{"foo=bar", "foo-"}, `annotation "foo" is both set and removed`),
+	)
+
+	DescribeTable("Apply",
+		func(annotations, set map[string]string, remove []string, overwrite bool, expected map[string]string) {
+			original := maps.Clone(annotations)
+
+			res, err := Apply(annotations, set, remove, overwrite)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(Equal(expected))
+			Expect(annotations).To(Equal(original), "must not modify the given annotations")
+		},
+		Entry("adding an annotation",
+			map[string]string{"foo": "bar"}, map[string]string{"baz": "qux"}, nil, false,
+			map[string]string{"foo": "bar", "baz": "qux"},
+		),
+		Entry("setting an annotation to its current value without overwrite",
+			map[string]string{"foo": "bar"}, map[string]string{"foo": "bar"}, nil, false,
+			map[string]string{"foo": "bar"},
+		),
+		Entry("overwriting an annotation",
+			map[string]string{"foo": "bar"}, map[string]string{"foo": "baz"}, nil, true,
+			map[string]string{"foo": "baz"},
+		),
+		Entry("removing an annotation",
+			map[string]string{"foo": "bar", "baz": "qux"}, map[string]string{}, []string{"baz"}, false,
+			map[string]string{"foo": "bar"},
+		),
+		Entry("removing a missing annotation",
+			map[string]string{"foo": "bar"}, map[string]string{}, []string{"baz"}, false,
+			map[string]string{"foo": "bar"},
+		),
+		Entry("removing all annotations",
+			map[string]string{"foo": "bar", "baz": "qux"}, map[string]string{}, []string{"foo", "baz"}, false,
+			map[string]string{},
+		),
+		Entry("adding to a machine without annotations",
+			nil, map[string]string{"foo": "bar"}, nil, false,
+			map[string]string{"foo": "bar"},
+		),
+	)
+
+	It("should fail setting an existing annotation to a different value without overwrite", func() {
+		_, err := Apply(map[string]string{"foo": "bar"}, map[string]string{"foo": "baz"}, nil, false)
+		Expect(err).To(MatchError(`annotation "foo" already has value "bar", use --overwrite to overwrite it`))
+	})
+})
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/common"
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/ironcore-dev/ironcore/irictl/renderer"
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var (
+		outputOpts = clientFactory.OutputOptions()
+	)
+
+	cmd := &cobra.Command{
+		Use:       fmt.Sprintf("power machine-id %s", strings.Join(common.PowerStates, "|")),
+		Short:     "Update the power state of a machine.",
+		Args:      cobra.ExactArgs(2),
+		ValidArgs: common.PowerStates,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			power, err := common.ParsePower(args[1])
+			if err != nil {
+				return err
+			}
+
+			client, cleanup, err := clientFactory.Client()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			r, err := outputOpts.RendererOrNil()
+			if err != nil {
+				return err
+			}
+
+			return Run(ctx, streams, client, r, args[0], power)
+		},
+	}
+
+	outputOpts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.MachineRuntimeClient, r renderer.Renderer, machineID string, power iri.Power) error {
+	if _, err := client.UpdateMachinePower(ctx, &iri.UpdateMachinePowerRequest{
+		MachineId: machineID,
+		Power:     power,
+	}); err != nil {
+		return fmt.Errorf("error updating power of machine %s: %w", machineID, err)
+	}
+
+	if r != nil {
+		machine, err := common.GetMachine(ctx, client, machineID)
+		if err != nil {
+			return err
+		}
+		return r.Render(machine, streams.Out)
+	}
+	_, _ = fmt.Fprintf(streams.Out, "Updated power of machine %s to %s\n", machineID, power)
+	return nil
+}
@@ -5,6 +5,8 @@ package update
 
 import (
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/common"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/update/annotations"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/update/power"
 	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
 	"github.com/spf13/cobra"
 )
@@ -14,7 +16,10 @@ func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Com
 		Use: "update",
 	}
 
-	cmd.AddCommand()
+	cmd.AddCommand(
+		power.Command(streams, clientFactory),
+		annotations.Command(streams, clientFactory),
+	)
 
 	return cmd
 }
@@ -4,17 +4,21 @@
 package common
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	iriauth "github.com/ironcore-dev/ironcore/iri/auth"
+	iriremote "github.com/ironcore-dev/ironcore/iri/remote"
 	iriremotevolume "github.com/ironcore-dev/ironcore/iri/remote/volume"
 	"github.com/ironcore-dev/ironcore/irictl-volume/renderers"
 	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
 	"github.com/ironcore-dev/ironcore/irictl/renderer"
+	"github.com/ironcore-dev/ironcore/irictl/watch"
+	"github.com/ironcore-dev/ironcore/poollet/irievent"
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var Renderer = renderer.NewRegistry()
@@ -31,10 +35,13 @@ type ClientFactory interface {
 
 type ClientOptions struct {
 	Address string
+	Auth    iriauth.ClientFlags
 }
 
 func (o *ClientOptions) AddFlags(fs *pflag.FlagSet) {
-	fs.StringVar(&o.Address, "address", "", "Address to the iri server.")
+	fs.StringVar(&o.Address, "address", "", "Address to the iri server. "+
+		"Either a unix socket path, 'unix://<path>' or 'tcp://<host>:<port>'.")
+	o.Auth.BindFlags(fs, "")
 }
 
 func (o *ClientOptions) New() (iri.VolumeRuntimeClient, func() error, error) {
@@ -43,7 +50,16 @@ func (o *ClientOptions) New() (iri.VolumeRuntimeClient, func() error, error) {
 		return nil, nil, err
 	}
 
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	authOpts, err := o.Auth.ClientOptions()
+	if err != nil {
+		return nil, nil, err
+	}
+	dialOpts, err := iriauth.DialOptions(authOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := grpc.Dial(iriremote.DialTarget(address), dialOpts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error dialing: %w", err)
 	}
@@ -57,6 +73,33 @@ func NewOutputOptions() *clicommon.OutputOptions {
 	}
 }
 
+// WatchOptions returns the options to watch the volumes of the given client matching the given id and labels.
+func WatchOptions(client iri.VolumeRuntimeClient, id string, labels map[string]string) watch.Options[*iri.Volume] {
+	var filter *iri.VolumeFilter
+	if id != "" || labels != nil {
+		filter = &iri.VolumeFilter{
+			Id:            id,
+			LabelSelector: labels,
+		}
+	}
+
+	return watch.Options[*iri.Volume]{
+		List: func(ctx context.Context) ([]*iri.Volume, error) {
+			res, err := client.ListVolumes(ctx, &iri.ListVolumesRequest{Filter: filter})
+			if err != nil {
+				return nil, fmt.Errorf("error listing volumes: %w", err)
+			}
+			return res.Volumes, nil
+		},
+		Watch: irievent.NewWatchFunc(func(ctx context.Context, resourceVersion string) (irievent.WatchStream[*iri.WatchVolumesResponse], error) {
+			return client.WatchVolumes(ctx, &iri.WatchVolumesRequest{ResourceVersion: resourceVersion})
+		}, func(res *iri.WatchVolumesResponse) irievent.WatchEvent[*iri.Volume] {
+			return irievent.WatchEvent[*iri.Volume]{Type: res.Type, Object: res.Volume, ResourceVersion: res.ResourceVersion}
+		}),
+		Filter: watch.MetadataFilter[*iri.Volume](id, labels),
+	}
+}
+
 var (
 	VolumeAliases = []string{"volumes", "vol", "vols"}
 )
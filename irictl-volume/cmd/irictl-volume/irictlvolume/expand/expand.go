@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package expand
+
+import (
+	"context"
+	"fmt"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/common"
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type Options struct {
+	Size resource.QuantityValue
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.Var(&o.Size, "size", "New size of the volume, e.g. '20Gi'.")
+}
+
+func Command(streams clicommon.Streams, clientFactory common.ClientFactory) *cobra.Command {
+	var opts Options
+
+	cmd := &cobra.Command{
+		Use:   "expand volume-id --size size",
+		Short: "Expand a volume to the given size.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.New()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			volumeID := args[0]
+
+			return Run(ctx, streams, client, volumeID, opts)
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+	_ = cmd.MarkFlagRequired("size")
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.VolumeRuntimeClient, volumeID string, opts Options) error {
+	size := opts.Size.Value()
+	if size <= 0 {
+		return fmt.Errorf("size must be greater than zero")
+	}
+
+	if _, err := client.ExpandVolume(ctx, &iri.ExpandVolumeRequest{
+		VolumeId: volumeID,
+		Resources: &iri.VolumeResources{
+			StorageBytes: size,
+		},
+	}); err != nil {
+		return fmt.Errorf("error expanding volume %s: %w", volumeID, err)
+	}
+
+	_, _ = fmt.Fprintf(streams.Out, "Volume %s expanded to %s\n", volumeID, opts.Size.String())
+	return nil
+}
@@ -5,21 +5,23 @@ package volume
 
 import (
 	"context"
-	"fmt"
 
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
 	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/common"
 	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
 	"github.com/ironcore-dev/ironcore/irictl/renderer"
+	"github.com/ironcore-dev/ironcore/irictl/watch"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
 type Options struct {
+	Watch bool
 }
 
 func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVarP(&o.Watch, "watch", "w", o.Watch, "Watch the volumes and render them again on every change.")
 }
 
 func Command(streams clicommon.Streams, clientFactory common.ClientFactory) *cobra.Command {
@@ -61,10 +63,15 @@ func Command(streams clicommon.Streams, clientFactory common.ClientFactory) *cob
 }
 
 func Run(ctx context.Context, streams clicommon.Streams, client iri.VolumeRuntimeClient, render renderer.Renderer, opts Options) error {
-	res, err := client.ListVolumes(ctx, &iri.ListVolumesRequest{})
+	watchOpts := common.WatchOptions(client, "", nil)
+	if opts.Watch {
+		return watch.Objects(ctx, watchOpts, watch.RenderFunc[*iri.Volume](streams.Out, render))
+	}
+
+	volumes, err := watchOpts.List(ctx)
 	if err != nil {
-		return fmt.Errorf("error listing volumes: %w", err)
+		return err
 	}
 
-	return render.Render(res.Volumes, streams.Out)
+	return render.Render(volumes, streams.Out)
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/common"
 	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/create"
 	delete2 "github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/delete"
+	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/events"
+	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/expand"
 	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/get"
 	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
 	"github.com/spf13/cobra"
@@ -37,11 +39,18 @@ func Command(streams clicommon.Streams) *cobra.Command {
 	cmd.PersistentFlags().AddGoFlagSet(goFlags)
 	clientOpts.AddFlags(cmd.PersistentFlags())
 
-	cmd.AddCommand(
-		get.Command(streams, &clientOpts),
-		delete2.Command(streams, &clientOpts),
-		create.Command(streams, &clientOpts),
-	)
+	cmd.AddCommand(Commands(streams, &clientOpts)...)
 
 	return cmd
 }
+
+// Commands returns the subcommands of irictl-volume using the given client factory.
+func Commands(streams clicommon.Streams, clientFactory common.ClientFactory) []*cobra.Command {
+	return []*cobra.Command{
+		get.Command(streams, clientFactory),
+		delete2.Command(streams, clientFactory),
+		create.Command(streams, clientFactory),
+		expand.Command(streams, clientFactory),
+		events.Command(streams, clientFactory),
+	}
+}
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package clientcmd implements the configuration file of irictl, holding the runtime endpoints
+// to connect to grouped in named contexts.
+package clientcmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	iriauth "github.com/ironcore-dev/ironcore/iri/auth"
+	"github.com/ironcore-dev/ironcore/irictl/decoder"
+	"k8s.io/client-go/util/homedir"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	RecommendedConfigPathFlag   = "config"
+	RecommendedContextFlag      = "context"
+	RecommendedConfigPathEnvVar = "IRICTL_CONFIG"
+	RecommendedHomeDir          = ".irictl"
+	RecommendedFileName         = "config"
+)
+
+var (
+	RecommendedConfigDir = filepath.Join(homedir.HomeDir(), RecommendedHomeDir)
+	RecommendedHomeFile  = filepath.Join(RecommendedConfigDir, RecommendedFileName)
+)
+
+// Endpoint is the address and authentication of a runtime.
+type Endpoint struct {
+	Address       string `json:"address,omitempty"`
+	TLS           bool   `json:"tls,omitempty"`
+	TLSCertFile   string `json:"tlsCertFile,omitempty"`
+	TLSKeyFile    string `json:"tlsKeyFile,omitempty"`
+	TLSCAFile     string `json:"tlsCAFile,omitempty"`
+	TLSServerName string `json:"tlsServerName,omitempty"`
+	TokenFile     string `json:"tokenFile,omitempty"`
+}
+
+// ClientFlags returns the authentication of the endpoint as iriauth.ClientFlags.
+func (e *Endpoint) ClientFlags() iriauth.ClientFlags {
+	return iriauth.ClientFlags{
+		TLS:           e.TLS,
+		TLSCertFile:   e.TLSCertFile,
+		TLSKeyFile:    e.TLSKeyFile,
+		TLSCAFile:     e.TLSCAFile,
+		TLSServerName: e.TLSServerName,
+		TokenFile:     e.TokenFile,
+	}
+}
+
+// Context is a named set of endpoints of the machine, volume and bucket runtimes.
+type Context struct {
+	Name    string    `json:"name"`
+	Machine *Endpoint `json:"machine,omitempty"`
+	Volume  *Endpoint `json:"volume,omitempty"`
+	Bucket  *Endpoint `json:"bucket,omitempty"`
+}
+
+// Runtime is the kind of runtime an endpoint belongs to.
+type Runtime string
+
+const (
+	RuntimeMachine Runtime = "machine"
+	RuntimeVolume  Runtime = "volume"
+	RuntimeBucket  Runtime = "bucket"
+)
+
+// Endpoint returns the endpoint of the given runtime, nil if the context does not specify it.
+func (c *Context) Endpoint(runtime Runtime) *Endpoint {
+	switch runtime {
+	case RuntimeMachine:
+		return c.Machine
+	case RuntimeVolume:
+		return c.Volume
+	case RuntimeBucket:
+		return c.Bucket
+	default:
+		return nil
+	}
+}
+
+type Config struct {
+	CurrentContext string    `json:"currentContext,omitempty"`
+	Contexts       []Context `json:"contexts,omitempty"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+// Context returns the context with the given name.
+func (c *Config) Context(name string) (*Context, error) {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			return &c.Contexts[i], nil
+		}
+	}
+	return nil, fmt.Errorf("context %q not found", name)
+}
+
+// Endpoint returns the endpoint of the given runtime in the context with the given name.
+// If name is empty, the current context is used. If there is no current context or the
+// context does not specify the runtime, an empty endpoint is returned.
+func (c *Config) Endpoint(name string, runtime Runtime) (*Endpoint, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return &Endpoint{}, nil
+	}
+
+	ctx, err := c.Context(name)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint := ctx.Endpoint(runtime); endpoint != nil {
+		return endpoint, nil
+	}
+	return &Endpoint{}, nil
+}
+
+func ReadConfig(data []byte) (*Config, error) {
+	cfg := &Config{}
+	if err := decoder.Decode(data, cfg); err != nil {
+		return nil, fmt.Errorf("error decoding config: %w", err)
+	}
+	return cfg, nil
+}
+
+func ReadConfigFile(filename string) (*Config, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file: %w", err)
+	}
+	return ReadConfig(data)
+}
+
+func WriteConfigFile(filename string, cfg *Config) error {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("error encoding config: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return fmt.Errorf("error creating config directory: %w", err)
+	}
+	if err := os.WriteFile(filename, data, 0600); err != nil {
+		return fmt.Errorf("error writing config file: %w", err)
+	}
+	return nil
+}
+
+// ConfigPath returns the path of the config file to use: The given filename if set, otherwise
+// the path in the RecommendedConfigPathEnvVar environment variable, otherwise RecommendedHomeFile.
+func ConfigPath(filename string) string {
+	if filename != "" {
+		return filename
+	}
+	if configPath := os.Getenv(RecommendedConfigPathEnvVar); configPath != "" {
+		return configPath
+	}
+	return RecommendedHomeFile
+}
+
+// GetConfig reads the config at the ConfigPath of the given filename. If filename is empty and
+// no config file exists, the DefaultConfig is returned.
+func GetConfig(filename string) (*Config, error) {
+	cfg, err := ReadConfigFile(ConfigPath(filename))
+	if err != nil {
+		if filename == "" && errors.Is(err, os.ErrNotExist) {
+			return DefaultConfig(), nil
+		}
+		return nil, err
+	}
+	return cfg, nil
+}
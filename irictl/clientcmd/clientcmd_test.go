@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package clientcmd_test
+
+import (
+	"path/filepath"
+
+	"github.com/ironcore-dev/ironcore/irictl/clientcmd"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Clientcmd", func() {
+	const data = `
+currentContext: local
+contexts:
+- name: local
+  machine:
+    address: unix:///var/run/machine.sock
+  volume:
+    address: unix:///var/run/volume.sock
+    tokenFile: /var/run/token
+- name: remote
+  machine:
+    address: dns:///machines.example.org:443
+    tls: true
+`
+
+	var cfg *clientcmd.Config
+
+	BeforeEach(func() {
+		var err error
+		cfg, err = clientcmd.ReadConfig([]byte(data))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Endpoint", func() {
+		It("should return the endpoint of the current context", func() {
+			Expect(cfg.Endpoint("", clientcmd.RuntimeVolume)).To(Equal(&clientcmd.Endpoint{
+				Address:   "unix:///var/run/volume.sock",
+				TokenFile: "/var/run/token",
+			}))
+		})
+
+		It("should return the endpoint of the given context", func() {
+			endpoint, err := cfg.Endpoint("remote", clientcmd.RuntimeMachine)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoint.Address).To(Equal("dns:///machines.example.org:443"))
+			flags := endpoint.ClientFlags()
+			Expect(flags.TLSEnabled()).To(BeTrue())
+		})
+
+		It("should return an empty endpoint if the context does not specify the runtime", func() {
+			Expect(cfg.Endpoint("", clientcmd.RuntimeBucket)).To(Equal(&clientcmd.Endpoint{}))
+			Expect(clientcmd.DefaultConfig().Endpoint("", clientcmd.RuntimeMachine)).To(Equal(&clientcmd.Endpoint{}))
+		})
+
+		It("should error if the context does not exist", func() {
+			_, err := cfg.Endpoint("unknown", clientcmd.RuntimeMachine)
+			Expect(err).To(MatchError(`context "unknown" not found`))
+		})
+	})
+
+	Describe("GetConfig", func() {
+		It("should return the default config if no config file exists", func() {
+			GinkgoT().Setenv("HOME", GinkgoT().TempDir())
+			GinkgoT().Setenv(clientcmd.RecommendedConfigPathEnvVar, filepath.Join(GinkgoT().TempDir(), "config"))
+			Expect(clientcmd.GetConfig("")).To(Equal(clientcmd.DefaultConfig()))
+		})
+
+		It("should error if the given config file does not exist", func() {
+			_, err := clientcmd.GetConfig(filepath.Join(GinkgoT().TempDir(), "config"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should read a written config", func() {
+			filename := filepath.Join(GinkgoT().TempDir(), "irictl", "config")
+			Expect(clientcmd.WriteConfigFile(filename, cfg)).To(Succeed())
+			Expect(clientcmd.GetConfig(filename)).To(Equal(cfg))
+		})
+	})
+})
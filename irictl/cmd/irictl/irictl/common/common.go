@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	iribucket "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	irimachine "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irivolume "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	iriauth "github.com/ironcore-dev/ironcore/iri/auth"
+	bucketcommon "github.com/ironcore-dev/ironcore/irictl-bucket/cmd/irictl-bucket/irictlbucket/common"
+	machineclientcmd "github.com/ironcore-dev/ironcore/irictl-machine/clientcmd"
+	machinecommon "github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/common"
+	volumecommon "github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/common"
+	"github.com/ironcore-dev/ironcore/irictl/clientcmd"
+	"github.com/ironcore-dev/ironcore/irictl/renderer"
+	"github.com/spf13/pflag"
+)
+
+type ConfigFactory interface {
+	ConfigPath() string
+	Config() (*clientcmd.Config, error)
+}
+
+// Options are the options selecting the runtime endpoints irictl connects to.
+type Options struct {
+	ConfigFile string
+	Context    string
+	Address    string
+	Auth       iriauth.ClientFlags
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.ConfigFile, clientcmd.RecommendedConfigPathFlag, "", "Config file to use.")
+	fs.StringVar(&o.Context, clientcmd.RecommendedContextFlag, "", "Context of the config file to use. Defaults to the current context.")
+	fs.StringVar(&o.Address, "address", "", "Address to the iri server. Overrides the endpoint of the context, including its authentication.")
+	o.Auth.BindFlags(fs, "")
+}
+
+func (o *Options) ConfigPath() string {
+	return clientcmd.ConfigPath(o.ConfigFile)
+}
+
+func (o *Options) Config() (*clientcmd.Config, error) {
+	return clientcmd.GetConfig(o.ConfigFile)
+}
+
+// Endpoint returns the address and authentication to connect to the given runtime with.
+// If an address is given via flags, the flags are used. Otherwise, the endpoint is taken
+// from the selected context.
+func (o *Options) Endpoint(runtime clientcmd.Runtime) (string, iriauth.ClientFlags, error) {
+	if o.Address != "" {
+		return o.Address, o.Auth, nil
+	}
+
+	cfg, err := o.Config()
+	if err != nil {
+		return "", iriauth.ClientFlags{}, err
+	}
+	endpoint, err := cfg.Endpoint(o.Context, runtime)
+	if err != nil {
+		return "", iriauth.ClientFlags{}, err
+	}
+	return endpoint.Address, endpoint.ClientFlags(), nil
+}
+
+// MachineFactory returns a machinecommon.Factory connecting to the machine runtime of the options.
+func (o *Options) MachineFactory() machinecommon.Factory {
+	return &machineFactory{options: o}
+}
+
+// VolumeFactory returns a volumecommon.ClientFactory connecting to the volume runtime of the options.
+func (o *Options) VolumeFactory() volumecommon.ClientFactory {
+	return &volumeFactory{options: o}
+}
+
+// BucketFactory returns a bucketcommon.ClientFactory connecting to the bucket runtime of the options.
+func (o *Options) BucketFactory() bucketcommon.ClientFactory {
+	return &bucketFactory{options: o}
+}
+
+type machineFactory struct {
+	options *Options
+
+	// machineOptions are used for everything but the client, i.e. the table config
+	// of irictl-machine is used.
+	machineOptions machinecommon.Options
+}
+
+func (f *machineFactory) Client() (irimachine.MachineRuntimeClient, func() error, error) {
+	address, auth, err := f.options.Endpoint(clientcmd.RuntimeMachine)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts := &machinecommon.Options{Address: address, Auth: auth}
+	return opts.Client()
+}
+
+func (f *machineFactory) Config() (*machineclientcmd.Config, error) {
+	return f.machineOptions.Config()
+}
+
+func (f *machineFactory) Registry() (*renderer.Registry, error) {
+	return f.machineOptions.Registry()
+}
+
+func (f *machineFactory) OutputOptions() *machinecommon.OutputOptions {
+	return f.machineOptions.OutputOptions()
+}
+
+type volumeFactory struct {
+	options *Options
+}
+
+func (f *volumeFactory) New() (irivolume.VolumeRuntimeClient, func() error, error) {
+	address, auth, err := f.options.Endpoint(clientcmd.RuntimeVolume)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts := &volumecommon.ClientOptions{Address: address, Auth: auth}
+	return opts.New()
+}
+
+type bucketFactory struct {
+	options *Options
+}
+
+func (f *bucketFactory) New() (iribucket.BucketRuntimeClient, func() error, error) {
+	address, auth, err := f.options.Endpoint(clientcmd.RuntimeBucket)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts := &bucketcommon.ClientOptions{Address: address, Auth: auth}
+	return opts.New()
+}
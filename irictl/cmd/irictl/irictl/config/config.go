@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/irictl/clientcmd"
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/ironcore-dev/ironcore/irictl/cmd/irictl/irictl/common"
+	"github.com/ironcore-dev/ironcore/irictl/tabwriter"
+	"github.com/spf13/cobra"
+)
+
+func Command(streams clicommon.Streams, configFactory common.ConfigFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect and modify the contexts of the irictl config file.",
+	}
+
+	cmd.AddCommand(
+		GetContextsCommand(streams, configFactory),
+		CurrentContextCommand(streams, configFactory),
+		UseContextCommand(streams, configFactory),
+	)
+
+	return cmd
+}
+
+func GetContextsCommand(streams clicommon.Streams, configFactory common.ConfigFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-contexts",
+		Short: "List the contexts of the config file.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := configFactory.Config()
+			if err != nil {
+				return err
+			}
+
+			return GetContexts(streams, cfg)
+		},
+	}
+
+	return cmd
+}
+
+func GetContexts(streams clicommon.Streams, cfg *clientcmd.Config) error {
+	tw := tabwriter.New(streams.Out)
+	_, _ = fmt.Fprintln(tw, "CURRENT\tNAME\tMACHINE\tVOLUME\tBUCKET")
+	for i := range cfg.Contexts {
+		ctx := &cfg.Contexts[i]
+
+		var current string
+		if ctx.Name == cfg.CurrentContext {
+			current = "*"
+		}
+
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+			current,
+			ctx.Name,
+			endpointAddress(ctx.Machine),
+			endpointAddress(ctx.Volume),
+			endpointAddress(ctx.Bucket),
+		)
+	}
+	return tw.Flush()
+}
+
+func endpointAddress(endpoint *clientcmd.Endpoint) string {
+	if endpoint == nil || endpoint.Address == "" {
+		return "<default>"
+	}
+	return endpoint.Address
+}
+
+func CurrentContextCommand(streams clicommon.Streams, configFactory common.ConfigFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-context",
+		Short: "Print the current context of the config file.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := configFactory.Config()
+			if err != nil {
+				return err
+			}
+
+			if cfg.CurrentContext == "" {
+				return fmt.Errorf("current context is not set")
+			}
+			_, _ = fmt.Fprintln(streams.Out, cfg.CurrentContext)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func UseContextCommand(streams clicommon.Streams, configFactory common.ConfigFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use-context name",
+		Short: "Set the current context of the config file.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := configFactory.Config()
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			if _, err := cfg.Context(name); err != nil {
+				return err
+			}
+
+			cfg.CurrentContext = name
+			if err := clientcmd.WriteConfigFile(configFactory.ConfigPath(), cfg); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(streams.Out, "Switched to context %q\n", name)
+			return nil
+		},
+	}
+
+	return cmd
+}
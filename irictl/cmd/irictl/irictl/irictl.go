@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package irictl
+
+import (
+	goflag "flag"
+
+	"github.com/ironcore-dev/ironcore/irictl-bucket/cmd/irictl-bucket/irictlbucket"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine"
+	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume"
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/ironcore-dev/ironcore/irictl/cmd/irictl/irictl/common"
+	"github.com/ironcore-dev/ironcore/irictl/cmd/irictl/irictl/config"
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func Command(streams clicommon.Streams) *cobra.Command {
+	var (
+		zapOpts zap.Options
+		opts    common.Options
+	)
+
+	cmd := &cobra.Command{
+		Use:   "irictl",
+		Short: "Command line client for the machine, volume and bucket runtimes.",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			logger := zap.New(zap.UseFlagOptions(&zapOpts))
+			ctrl.SetLogger(logger)
+			cmd.SetContext(ctrl.LoggerInto(cmd.Context(), ctrl.Log))
+		},
+	}
+
+	goFlags := goflag.NewFlagSet("", 0)
+	zapOpts.BindFlags(goFlags)
+
+	cmd.PersistentFlags().AddGoFlagSet(goFlags)
+	opts.AddFlags(cmd.PersistentFlags())
+
+	machineCmd := &cobra.Command{
+		Use:   "machine",
+		Short: "Commands for the machine runtime.",
+	}
+	machineCmd.AddCommand(irictlmachine.Commands(streams, opts.MachineFactory())...)
+
+	volumeCmd := &cobra.Command{
+		Use:   "volume",
+		Short: "Commands for the volume runtime.",
+	}
+	volumeCmd.AddCommand(irictlvolume.Commands(streams, opts.VolumeFactory())...)
+
+	bucketCmd := &cobra.Command{
+		Use:   "bucket",
+		Short: "Commands for the bucket runtime.",
+	}
+	bucketCmd.AddCommand(irictlbucket.Commands(streams, opts.BucketFactory())...)
+
+	cmd.AddCommand(
+		machineCmd,
+		volumeCmd,
+		bucketCmd,
+		config.Command(streams, &opts),
+	)
+
+	return cmd
+}
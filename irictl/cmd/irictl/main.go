@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/ironcore-dev/ironcore/irictl/cmd/irictl/irictl"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func main() {
+	ctx := ctrl.SetupSignalHandler()
+	if err := irictl.Command(clicommon.OSStreams).ExecuteContext(ctx); err != nil {
+		ctrl.Log.Error(err, "Error running command")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package editor lets users edit data in their editor of choice.
+package editor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+)
+
+const (
+	// EditorEnv is the environment variable to take the editor command from.
+	EditorEnv = "IRICTL_EDITOR"
+	// FallbackEditorEnv is the environment variable to take the editor command from if EditorEnv is not set.
+	FallbackEditorEnv = "EDITOR"
+	// DefaultEditor is the editor used if neither EditorEnv nor FallbackEditorEnv are set.
+	DefaultEditor = "vi"
+)
+
+// Command returns the editor command and its arguments. The command may contain arguments separated by whitespace,
+// e.g. 'code --wait'.
+func Command() []string {
+	for _, env := range []string{EditorEnv, FallbackEditorEnv} {
+		if fields := strings.Fields(os.Getenv(env)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{DefaultEditor}
+}
+
+// Edit writes data to a temporary file with the given suffix (e.g. '.yaml'), opens it in the editor
+// and returns the edited contents.
+func Edit(streams clicommon.Streams, data []byte, suffix string) ([]byte, error) {
+	f, err := os.CreateTemp("", "irictl-edit-*"+suffix)
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary file: %w", err)
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("error writing temporary file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("error closing temporary file: %w", err)
+	}
+
+	command := Command()
+	cmd := exec.Command(command[0], append(command[1:], f.Name())...)
+	cmd.Stdin = streams.In
+	cmd.Stdout = streams.Out
+	cmd.Stderr = streams.Err
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error running editor %s: %w", strings.Join(command, " "), err)
+	}
+
+	return os.ReadFile(f.Name())
+}
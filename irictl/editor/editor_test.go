@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package editor_test
+
+import (
+	"bytes"
+
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	. "github.com/ironcore-dev/ironcore/irictl/editor"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Editor", func() {
+	var streams clicommon.Streams
+
+	BeforeEach(func() {
+		streams = clicommon.Streams{
+			In:  &bytes.Buffer{},
+			Out: &bytes.Buffer{},
+			Err: &bytes.Buffer{},
+		}
+	})
+
+	It("should prefer the irictl editor over the default editor", func() {
+		GinkgoT().Setenv(EditorEnv, "code --wait")
+		GinkgoT().Setenv(FallbackEditorEnv, "nano")
+		Expect(Command()).To(Equal([]string{"code", "--wait"}))
+
+		GinkgoT().Setenv(EditorEnv, "")
+		Expect(Command()).To(Equal([]string{"nano"}))
+
+		GinkgoT().Setenv(FallbackEditorEnv, "")
+		Expect(Command()).To(Equal([]string{DefaultEditor}))
+	})
+
+	It("should return the edited data", func() {
+		GinkgoT().Setenv(EditorEnv, "sed -i s/foo/bar/")
+
+		Expect(Edit(streams, []byte("value: foo\n"), ".yaml")).To(Equal([]byte("value: bar\n")))
+	})
+
+	It("should fail if the editor fails", func() {
+		GinkgoT().Setenv(EditorEnv, "false")
+
+		_, err := Edit(streams, []byte("value: foo\n"), ".yaml")
+		Expect(err).To(HaveOccurred())
+	})
+})
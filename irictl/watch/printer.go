@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"github.com/ironcore-dev/ironcore/irictl/renderer"
+	"github.com/ironcore-dev/ironcore/poollet/irievent"
+)
+
+const (
+	EventTypeAdded    = "ADDED"
+	EventTypeModified = "MODIFIED"
+	EventTypeDeleted  = "DELETED"
+)
+
+// Printer is an irievent.Handler printing a line per event to a writer.
+// For modifications, the changed lines of the YAML representation of the object are printed as well.
+type Printer[O irimeta.Object] struct {
+	mu   sync.Mutex
+	w    io.Writer
+	kind string
+
+	// Now returns the time an event is printed with. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewPrinter creates a new Printer writing events of objects of the given kind to w.
+func NewPrinter[O irimeta.Object](w io.Writer, kind string) *Printer[O] {
+	return &Printer[O]{
+		w:    w,
+		kind: kind,
+		Now:  time.Now,
+	}
+}
+
+func (p *Printer[O]) print(eventType string, obj O, diff []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, _ = fmt.Fprintf(p.w, "%s\t%s\t%s\t%s\n",
+		p.Now().Format(time.RFC3339),
+		eventType,
+		p.kind,
+		obj.GetMetadata().GetId(),
+	)
+	for _, line := range diff {
+		_, _ = fmt.Fprintf(p.w, "\t%s\n", line)
+	}
+}
+
+func (p *Printer[O]) Create(evt irievent.CreateEvent[O]) {
+	p.print(EventTypeAdded, evt.Object, nil)
+}
+
+func (p *Printer[O]) Update(evt irievent.UpdateEvent[O]) {
+	diff, err := yamlDiff(evt.ObjectOld, evt.ObjectNew)
+	if err != nil {
+		diff = []string{fmt.Sprintf("error computing diff: %v", err)}
+	}
+	p.print(EventTypeModified, evt.ObjectNew, diff)
+}
+
+func (p *Printer[O]) Delete(evt irievent.DeleteEvent[O]) {
+	p.print(EventTypeDeleted, evt.Object, nil)
+}
+
+func (p *Printer[O]) Generic(evt irievent.GenericEvent[O]) {
+	p.print(EventTypeAdded, evt.Object, nil)
+}
+
+func yamlLines(obj any) ([]string, error) {
+	var buf bytes.Buffer
+	if err := renderer.YAML.Render(obj, &buf); err != nil {
+		return nil, err
+	}
+	return strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n"), nil
+}
+
+func yamlDiff(oldObj, newObj any) ([]string, error) {
+	oldLines, err := yamlLines(oldObj)
+	if err != nil {
+		return nil, err
+	}
+	newLines, err := yamlLines(newObj)
+	if err != nil {
+		return nil, err
+	}
+	return Diff(oldLines, newLines), nil
+}
+
+// Diff returns the lines removed from a (prefixed with "-") and added in b (prefixed with "+"),
+// based on the longest common subsequence of both.
+func Diff(a, b []string) []string {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var (
+		res  []string
+		i, j int
+	)
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			res = append(res, "-"+a[i])
+			i++
+		default:
+			res = append(res, "+"+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		res = append(res, "-"+a[i])
+	}
+	for ; j < len(b); j++ {
+		res = append(res, "+"+b[j])
+	}
+	return res
+}
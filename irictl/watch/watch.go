@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package watch implements watching the objects of an IRI runtime for the irictl commands,
+// using the poollet event generators.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"github.com/ironcore-dev/ironcore/irictl/renderer"
+	"github.com/ironcore-dev/ironcore/poollet/irievent"
+	"golang.org/x/term"
+)
+
+// Options are options for watching the objects of a runtime.
+type Options[O irimeta.Object] struct {
+	// List lists the objects.
+	List func(ctx context.Context) ([]O, error)
+	// Watch watches the objects. If nil or not implemented by the runtime, the objects are relisted periodically.
+	Watch irievent.WatchFunc[O]
+	// Filter selects the objects to watch. If nil, all objects are watched.
+	Filter func(O) bool
+}
+
+// MetadataFilter returns a filter selecting the objects with the given id and labels.
+// An empty id selects objects with any id.
+func MetadataFilter[O irimeta.Object](id string, labels map[string]string) func(O) bool {
+	return func(obj O) bool {
+		metadata := obj.GetMetadata()
+		if id != "" && metadata.GetId() != id {
+			return false
+		}
+		for key, value := range labels {
+			if actual, ok := metadata.GetLabels()[key]; !ok || actual != value {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func (o *Options[O]) matches(obj O) bool {
+	return o.Filter == nil || o.Filter(obj)
+}
+
+func (o *Options[O]) start(ctx context.Context, handler irievent.Handler[O]) error {
+	generator := irievent.NewWatchingGenerator(o.List, o.Watch, irievent.GeneratorOptions{})
+	if _, err := generator.AddHandler(handler); err != nil {
+		return fmt.Errorf("error adding handler: %w", err)
+	}
+	if err := generator.Start(ctx); err != nil {
+		return fmt.Errorf("error starting event generator: %w", err)
+	}
+	return nil
+}
+
+func id[O irimeta.Object](obj O) string {
+	return obj.GetMetadata().GetId()
+}
+
+// Objects calls render with the matching objects (sorted by id) initially and whenever they change,
+// until the context is done. The events of the generator only trigger the rendering, the rendered
+// objects are always listed freshly so that no change between two lists is missed.
+func Objects[O irimeta.Object](ctx context.Context, opts Options[O], render func([]O) error) error {
+	changed := make(chan struct{}, 1)
+	changed <- struct{}{}
+
+	listener := irievent.EnqueueFunc{EnqueueFunc: func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}}
+	if err := opts.start(ctx, irievent.HandlerFuncs[O]{
+		CreateFunc:  func(irievent.CreateEvent[O]) { listener.Enqueue() },
+		UpdateFunc:  func(irievent.UpdateEvent[O]) { listener.Enqueue() },
+		DeleteFunc:  func(irievent.DeleteEvent[O]) { listener.Enqueue() },
+		GenericFunc: func(irievent.GenericEvent[O]) { listener.Enqueue() },
+	}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+			objects, err := opts.List(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+
+			matching := make([]O, 0, len(objects))
+			for _, obj := range objects {
+				if opts.matches(obj) {
+					matching = append(matching, obj)
+				}
+			}
+			slices.SortFunc(matching, func(a, b O) int { return strings.Compare(id(a), id(b)) })
+			if err := render(matching); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// RenderFunc returns a function rendering objects with r to w, for use with Objects.
+// If w is a terminal, the screen is cleared before each rendering. Otherwise, renderings are
+// separated by an empty line.
+func RenderFunc[O irimeta.Object](w io.Writer, r renderer.Renderer) func([]O) error {
+	f, ok := w.(*os.File)
+	isTerminal := ok && term.IsTerminal(int(f.Fd()))
+
+	first := true
+	return func(objects []O) error {
+		switch {
+		case isTerminal:
+			_, _ = fmt.Fprint(w, "\033[H\033[2J")
+		case !first:
+			_, _ = fmt.Fprintln(w)
+		}
+		first = false
+		return r.Render(objects, w)
+	}
+}
+
+// Events calls handler for every change of the objects until the context is done.
+// Objects that stop matching the filter are reported as deleted.
+func Events[O irimeta.Object](ctx context.Context, opts Options[O], handler irievent.Handler[O]) error {
+	if err := opts.start(ctx, irievent.HandlerFuncs[O]{
+		CreateFunc: func(evt irievent.CreateEvent[O]) {
+			if opts.matches(evt.Object) {
+				handler.Create(evt)
+			}
+		},
+		UpdateFunc: func(evt irievent.UpdateEvent[O]) {
+			oldMatches, newMatches := opts.matches(evt.ObjectOld), opts.matches(evt.ObjectNew)
+			switch {
+			case oldMatches && newMatches:
+				handler.Update(evt)
+			case oldMatches:
+				handler.Delete(irievent.DeleteEvent[O]{Object: evt.ObjectOld})
+			case newMatches:
+				handler.Create(irievent.CreateEvent[O]{Object: evt.ObjectNew})
+			}
+		},
+		DeleteFunc: func(evt irievent.DeleteEvent[O]) {
+			if opts.matches(evt.Object) {
+				handler.Delete(evt)
+			}
+		},
+		GenericFunc: func(evt irievent.GenericEvent[O]) {
+			if opts.matches(evt.Object) {
+				handler.Generic(evt)
+			}
+		},
+	}); err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+	return nil
+}
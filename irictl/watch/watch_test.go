@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch_test
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/irictl/watch"
+	"github.com/ironcore-dev/ironcore/poollet/irievent"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func newMachine(id string, labels map[string]string) *iri.Machine {
+	return &iri.Machine{
+		Metadata: &irimeta.ObjectMetadata{Id: id, Labels: labels},
+	}
+}
+
+func machineIDs(machines []*iri.Machine) []string {
+	ids := make([]string, 0, len(machines))
+	for _, machine := range machines {
+		ids = append(ids, machine.Metadata.Id)
+	}
+	return ids
+}
+
+var _ = Describe("Watch", func() {
+	var (
+		mu       sync.Mutex
+		machines []*iri.Machine
+		opts     Options[*iri.Machine]
+	)
+
+	setMachines := func(ms ...*iri.Machine) {
+		mu.Lock()
+		defer mu.Unlock()
+		machines = ms
+	}
+
+	BeforeEach(func() {
+		setMachines()
+		opts = Options[*iri.Machine]{
+			List: func(ctx context.Context) ([]*iri.Machine, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				return machines, nil
+			},
+			Filter: func(machine *iri.Machine) bool {
+				return machine.Metadata.Labels["watch"] == "true"
+			},
+		}
+	})
+
+	Describe("Objects", func() {
+		It("should render the matching objects whenever they change", func(specCtx SpecContext) {
+			setMachines(
+				newMachine("b", map[string]string{"watch": "true"}),
+				newMachine("a", map[string]string{"watch": "true"}),
+				newMachine("c", nil),
+			)
+
+			var (
+				renderMu  sync.Mutex
+				rendering []string
+			)
+			latest := func() []string {
+				renderMu.Lock()
+				defer renderMu.Unlock()
+				return rendering
+			}
+			ctx, cancel := context.WithCancel(specCtx)
+			defer cancel()
+			done := make(chan error, 1)
+			go func() {
+				done <- Objects(ctx, opts, func(machines []*iri.Machine) error {
+					renderMu.Lock()
+					defer renderMu.Unlock()
+					rendering = machineIDs(machines)
+					return nil
+				})
+			}()
+
+			Eventually(latest).Should(Equal([]string{"a", "b"}))
+
+			By("deleting a machine and unlabelling another")
+			setMachines(
+				newMachine("b", nil),
+				newMachine("c", nil),
+			)
+			Eventually(latest).WithTimeout(5 * time.Second).Should(BeEmpty())
+
+			cancel()
+			Eventually(done).Should(Receive(BeNil()))
+		})
+	})
+
+	Describe("Events", func() {
+		It("should print events for the matching objects", func(specCtx SpecContext) {
+			setMachines(newMachine("a", map[string]string{"watch": "true"}))
+
+			var (
+				outMu sync.Mutex
+				out   bytes.Buffer
+			)
+			output := func() string {
+				outMu.Lock()
+				defer outMu.Unlock()
+				return out.String()
+			}
+			printer := NewPrinter[*iri.Machine](writerFunc(func(p []byte) (int, error) {
+				outMu.Lock()
+				defer outMu.Unlock()
+				return out.Write(p)
+			}), "machine")
+			printer.Now = func() time.Time { return time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+			ctx, cancel := context.WithCancel(specCtx)
+			defer cancel()
+			go func() {
+				defer GinkgoRecover()
+				Expect(Events[*iri.Machine](ctx, opts, printer)).To(Succeed())
+			}()
+
+			Eventually(output).WithTimeout(5 * time.Second).Should(Equal("2023-01-01T00:00:00Z\tADDED\tmachine\ta\n"))
+
+			By("updating the machine")
+			setMachines(
+				newMachine("a", map[string]string{"watch": "true", "foo": "bar"}),
+				newMachine("b", nil),
+			)
+			Eventually(output).WithTimeout(5 * time.Second).Should(HaveSuffix(
+				"2023-01-01T00:00:00Z\tMODIFIED\tmachine\ta\n" +
+					"\t+    foo: bar\n",
+			))
+
+			By("unlabelling the machine")
+			setMachines(newMachine("a", nil))
+			Eventually(output).WithTimeout(5 * time.Second).Should(HaveSuffix(
+				"2023-01-01T00:00:00Z\tDELETED\tmachine\ta\n",
+			))
+			Expect(output()).NotTo(ContainSubstring("\tb\n"))
+		})
+	})
+
+	Describe("Diff", func() {
+		It("should only report changed lines", func() {
+			Expect(Diff(
+				[]string{"a", "b", "c", "d"},
+				[]string{"a", "c", "x", "d", "e"},
+			)).To(Equal([]string{"-b", "+x", "+e"}))
+		})
+
+		It("should report nothing for equal lines", func() {
+			Expect(Diff([]string{"a", "b"}, []string{"a", "b"})).To(BeEmpty())
+		})
+	})
+})
+
+var _ irievent.Handler[*iri.Machine] = &Printer[*iri.Machine]{}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
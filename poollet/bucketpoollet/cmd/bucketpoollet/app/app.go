@@ -5,26 +5,35 @@ package app
 
 import (
 	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	goflag "flag"
 	"fmt"
 	"os"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/controller-utils/configutils"
 	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	iriauth "github.com/ironcore-dev/ironcore/iri/auth"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
+	irimetrics "github.com/ironcore-dev/ironcore/iri/metrics"
+	iriremote "github.com/ironcore-dev/ironcore/iri/remote"
 	iriremotebucket "github.com/ironcore-dev/ironcore/iri/remote/bucket"
 	"github.com/ironcore-dev/ironcore/poollet/bucketpoollet/bcm"
 	bucketpoolletconfig "github.com/ironcore-dev/ironcore/poollet/bucketpoollet/client/config"
 	"github.com/ironcore-dev/ironcore/poollet/bucketpoollet/controllers"
 	"github.com/ironcore-dev/ironcore/poollet/irievent"
 	"github.com/ironcore-dev/ironcore/utils/client/config"
+	"github.com/ironcore-dev/ironcore/utils/tracing"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -53,13 +62,16 @@ type Options struct {
 	LeaderElectionNamespace  string
 	LeaderElectionKubeconfig string
 	ProbeAddr                string
+	Tracing                  tracing.Options
 
 	BucketPoolName                      string
 	ProviderID                          string
 	BucketRuntimeEndpoint               string
 	DialTimeout                         time.Duration
 	BucketRuntimeSocketDiscoveryTimeout time.Duration
+	BucketRuntimeAuth                   iriauth.ClientFlags
 	BucketClassMapperSyncTimeout        time.Duration
+	RequiredBucketRuntimeCapabilities   []string
 
 	WatchFilterValue string
 }
@@ -73,13 +85,19 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 			"Enabling this will ensure there is only one active controller manager.")
 	fs.StringVar(&o.LeaderElectionNamespace, "leader-election-namespace", "", "Namespace to do leader election in.")
 	fs.StringVar(&o.LeaderElectionKubeconfig, "leader-election-kubeconfig", "", "Path pointing to a kubeconfig to use for leader election.")
+	o.Tracing.BindFlags(fs)
 
 	fs.StringVar(&o.BucketPoolName, "bucket-pool-name", o.BucketPoolName, "Name of the bucket pool to announce / watch")
 	fs.StringVar(&o.ProviderID, "provider-id", "", "Provider id to announce on the bucket pool.")
-	fs.StringVar(&o.BucketRuntimeEndpoint, "bucket-runtime-endpoint", o.BucketRuntimeEndpoint, "Endpoint of the remote bucket runtime service.")
+	fs.StringVar(&o.BucketRuntimeEndpoint, "bucket-runtime-endpoint", o.BucketRuntimeEndpoint, "Endpoint of the remote bucket runtime service. "+
+		"Either a unix socket path, 'unix://<path>' or 'tcp://<host>:<port>'.")
 	fs.DurationVar(&o.DialTimeout, "dial-timeout", 1*time.Second, "Timeout for dialing to the bucket runtime endpoint.")
 	fs.DurationVar(&o.BucketRuntimeSocketDiscoveryTimeout, "bucket-runtime-discovery-timeout", 20*time.Second, "Timeout for discovering the bucket runtime socket.")
+	o.BucketRuntimeAuth.BindFlags(fs, "bucket-runtime-")
+	o.BucketRuntimeAuth.BindSignerNameFlag(fs, "bucket-runtime-")
 	fs.DurationVar(&o.BucketClassMapperSyncTimeout, "bcm-sync-timeout", 10*time.Second, "Timeout waiting for the bucket class mapper to sync.")
+	fs.StringSliceVar(&o.RequiredBucketRuntimeCapabilities, "required-bucket-runtime-capabilities", o.RequiredBucketRuntimeCapabilities,
+		fmt.Sprintf("Capabilities the bucket runtime has to support. Optional capabilities: %v", capabilities.BucketOptional))
 
 	fs.StringVar(&o.WatchFilterValue, "watch-filter", "", "Value to filter for while watching.")
 }
@@ -133,24 +151,21 @@ func Run(ctx context.Context, opts Options) error {
 		return fmt.Errorf("error detecting bucket runtime endpoint: %w", err)
 	}
 
-	conn, err := grpc.Dial(endpoint,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	shutdownTracing, err := tracing.Setup(ctx, "bucketpoollet", opts.Tracing)
 	if err != nil {
-		return fmt.Errorf("error dialing: %w", err)
+		return fmt.Errorf("error setting up tracing: %w", err)
 	}
 	defer func() {
-		if err := conn.Close(); err != nil {
-			setupLog.Error(err, "Error closing bucket runtime connection")
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "Error shutting down tracing")
 		}
 	}()
 
-	bucketRuntime := iri.NewBucketRuntimeClient(conn)
-
 	cfg, configCtrl, err := getter.GetConfig(ctx, &opts.GetConfigOptions)
 	if err != nil {
 		return fmt.Errorf("error getting config: %w", err)
 	}
+	tracing.WrapConfig(cfg)
 
 	leaderElectionCfg, err := configutils.GetConfig(
 		configutils.Kubeconfig(opts.LeaderElectionKubeconfig),
@@ -176,18 +191,65 @@ func Run(ctx context.Context, opts Options) error {
 		return err
 	}
 
+	bucketRuntimeAuthOpts, err := opts.BucketRuntimeAuth.SetupClientOptions(ctx, mgr, "bucket-runtime-client-certificate", &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   storagev1alpha1.BucketPoolCommonName(opts.BucketPoolName),
+			Organization: []string{storagev1alpha1.BucketPoolsGroup},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting up bucket runtime auth: %w", err)
+	}
+	bucketRuntimeDialOpts, err := iriauth.DialOptions(bucketRuntimeAuthOpts)
+	if err != nil {
+		return fmt.Errorf("error getting bucket runtime dial options: %w", err)
+	}
+	bucketRuntimeDialOpts = append(bucketRuntimeDialOpts, irimetrics.DialOptions()...)
+	bucketRuntimeDialOpts = append(bucketRuntimeDialOpts, tracing.DialOptions()...)
+
+	conn, err := grpc.Dial(iriremote.DialTarget(endpoint), bucketRuntimeDialOpts...)
+	if err != nil {
+		return fmt.Errorf("error dialing: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			setupLog.Error(err, "Error closing bucket runtime connection")
+		}
+	}()
+
+	bucketRuntime := iri.NewBucketRuntimeClient(conn)
+
+	bucketRuntimeCapabilities, err := getBucketRuntimeCapabilities(ctx, setupLog, bucketRuntime)
+	if err != nil {
+		return err
+	}
+	if missing := bucketRuntimeCapabilities.Missing(opts.RequiredBucketRuntimeCapabilities...); len(missing) > 0 {
+		return fmt.Errorf("bucket runtime does not support required capabilities %v", missing)
+	}
+	if missing := bucketRuntimeCapabilities.Missing(capabilities.BucketOptional...); len(missing) > 0 {
+		setupLog.Info("Bucket runtime does not support optional capabilities, running degraded", "MissingCapabilities", missing)
+	}
+
 	bucketClassMapper := bcm.NewGeneric(bucketRuntime, bcm.GenericOptions{})
 	if err := mgr.Add(bucketClassMapper); err != nil {
 		return fmt.Errorf("error adding bucket class mapper: %w", err)
 	}
 
-	bucketEvents := irievent.NewGenerator(func(ctx context.Context) ([]*iri.Bucket, error) {
+	var watchBuckets irievent.WatchFunc[*iri.Bucket]
+	if bucketRuntimeCapabilities.Has(capabilities.BucketWatch) {
+		watchBuckets = irievent.NewWatchFunc(func(ctx context.Context, resourceVersion string) (irievent.WatchStream[*iri.WatchBucketsResponse], error) {
+			return bucketRuntime.WatchBuckets(ctx, &iri.WatchBucketsRequest{ResourceVersion: resourceVersion})
+		}, func(res *iri.WatchBucketsResponse) irievent.WatchEvent[*iri.Bucket] {
+			return irievent.WatchEvent[*iri.Bucket]{Type: res.Type, Object: res.Bucket, ResourceVersion: res.ResourceVersion}
+		})
+	}
+	bucketEvents := irievent.NewWatchingGenerator(func(ctx context.Context) ([]*iri.Bucket, error) {
 		res, err := bucketRuntime.ListBuckets(ctx, &iri.ListBucketsRequest{})
 		if err != nil {
 			return nil, err
 		}
 		return res.Buckets, nil
-	}, irievent.GeneratorOptions{})
+	}, watchBuckets, irievent.GeneratorOptions{})
 	if err := mgr.Add(bucketEvents); err != nil {
 		return fmt.Errorf("error adding bucket event generator: %w", err)
 	}
@@ -223,10 +285,12 @@ func Run(ctx context.Context, opts Options) error {
 		}
 
 		if err := (&controllers.BucketPoolReconciler{
-			Client:            mgr.GetClient(),
-			BucketPoolName:    opts.BucketPoolName,
-			BucketClassMapper: bucketClassMapper,
-			BucketRuntime:     bucketRuntime,
+			EventRecorder:             mgr.GetEventRecorderFor("bucketpools"),
+			Client:                    mgr.GetClient(),
+			BucketPoolName:            opts.BucketPoolName,
+			BucketClassMapper:         bucketClassMapper,
+			BucketRuntime:             bucketRuntime,
+			BucketRuntimeCapabilities: bucketRuntimeCapabilities,
 		}).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("error setting up bucket pool reconciler with manager: %w", err)
 		}
@@ -259,3 +323,20 @@ func Run(ctx context.Context, opts Options) error {
 	}
 	return nil
 }
+
+// getBucketRuntimeCapabilities returns the capabilities reported by the bucket runtime.
+// Runtimes not implementing Version predate capability negotiation and are assumed to support capabilities.BucketLegacy.
+func getBucketRuntimeCapabilities(ctx context.Context, log logr.Logger, bucketRuntime iri.BucketRuntimeClient) (capabilities.Set, error) {
+	res, err := bucketRuntime.Version(ctx, &iri.VersionRequest{})
+	if err != nil {
+		if status.Code(err) != codes.Unimplemented {
+			return capabilities.Set{}, fmt.Errorf("error getting bucket runtime version: %w", err)
+		}
+
+		log.Info("Bucket runtime does not implement version, assuming legacy capabilities", "Capabilities", capabilities.BucketLegacy)
+		return capabilities.New(capabilities.BucketLegacy...), nil
+	}
+
+	log.Info("Got bucket runtime version", "RuntimeName", res.RuntimeName, "RuntimeVersion", res.RuntimeVersion, "Capabilities", res.Capabilities)
+	return capabilities.New(res.Capabilities...), nil
+}
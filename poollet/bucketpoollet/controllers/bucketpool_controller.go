@@ -7,12 +7,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/go-logr/logr"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
 	"github.com/ironcore-dev/ironcore/poollet/bucketpoollet/bcm"
+	"github.com/ironcore-dev/ironcore/poollet/bucketpoollet/controllers/events"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,10 +26,18 @@ import (
 )
 
 type BucketPoolReconciler struct {
+	record.EventRecorder
 	client.Client
 	BucketPoolName    string
 	BucketRuntime     iri.BucketRuntimeClient
 	BucketClassMapper bcm.BucketClassMapper
+
+	// BucketRuntimeCapabilities are the capabilities reported by the BucketRuntime.
+	BucketRuntimeCapabilities capabilities.Set
+
+	// reportMissingCapabilitiesOnce ensures missing capabilities are reported once per start.
+	// BucketPools have no conditions to track this in.
+	reportMissingCapabilitiesOnce sync.Once
 }
 
 //+kubebuilder:rbac:groups=storage.ironcore.dev,resources=bucketpools,verbs=get;list;watch;update;patch
@@ -70,9 +83,23 @@ func (r *BucketPoolReconciler) supportsBucketClass(ctx context.Context, log logr
 	return true, nil
 }
 
+func (r *BucketPoolReconciler) reportMissingCapabilities(bucketPool *storagev1alpha1.BucketPool) {
+	missing := r.BucketRuntimeCapabilities.Missing(capabilities.BucketOptional...)
+	if len(missing) == 0 {
+		return
+	}
+
+	r.reportMissingCapabilitiesOnce.Do(func() {
+		r.Eventf(bucketPool, corev1.EventTypeWarning, events.MissingRuntimeCapabilities,
+			"The bucket runtime does not support %s. Running degraded.", strings.Join(missing, ", "))
+	})
+}
+
 func (r *BucketPoolReconciler) reconcile(ctx context.Context, log logr.Logger, bucketPool *storagev1alpha1.BucketPool) (ctrl.Result, error) {
 	log.V(1).Info("Reconcile")
 
+	r.reportMissingCapabilities(bucketPool)
+
 	log.V(1).Info("Listing bucket classes")
 	bucketClassList := &storagev1alpha1.BucketClassList{}
 	if err := r.List(ctx, bucketClassList); err != nil {
@@ -5,4 +5,6 @@ package events
 
 const (
 	BucketClassNotReady = "BucketClassNotReady"
+
+	MissingRuntimeCapabilities = "MissingRuntimeCapabilities"
 )
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package irierror turns errors returned by IRI runtimes into reconcile results.
+package irierror
+
+import (
+	"context"
+	"time"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/errdetails"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// InvalidSpec is the event reason for requests the runtime rejected as invalid.
+	InvalidSpec = "InvalidSpec"
+	// InsufficientCapacity is the event reason for requests the runtime lacks the capacity for.
+	InsufficientCapacity = "InsufficientCapacity"
+)
+
+// InvalidSpecRequeueAfter is the time after which requests the runtime rejected as invalid are retried.
+// Retrying them usually cannot succeed until the object changes (which triggers a new reconciliation anyway),
+// but the runtime may have rejected them because of a state it has since left.
+const InvalidSpecRequeueAfter = 10 * time.Minute
+
+// Handle classifies err returned by reconciling obj against an IRI runtime and returns the result to
+// back off with. Errors not originating from a runtime are returned unchanged.
+//
+// Requests rejected as invalid are reported as warning event and retried after InvalidSpecRequeueAfter
+// instead of the rate-limited back-off.
+// Requests failing for a lack of capacity are reported as warning event.
+// If the runtime specified when to retry, the request is requeued after that time instead of
+// being subject to the rate-limited back-off.
+func Handle(ctx context.Context, recorder record.EventRecorder, obj client.Object, res ctrl.Result, err error) (ctrl.Result, error) {
+	if err == nil {
+		return res, nil
+	}
+	if _, ok := status.FromError(err); !ok {
+		return res, err
+	}
+
+	switch errdetails.Reason(err) {
+	case irimeta.ErrorReason_ERROR_REASON_INVALID_SPEC:
+		if field := errdetails.Field(err); field != "" {
+			recorder.Eventf(obj, corev1.EventTypeWarning, InvalidSpec, "Runtime rejected field %s: %v", field, err)
+		} else {
+			recorder.Eventf(obj, corev1.EventTypeWarning, InvalidSpec, "Runtime rejected spec: %v", err)
+		}
+		ctrl.LoggerFrom(ctx).Error(err, "Runtime rejected spec, retrying later", "RetryAfter", InvalidSpecRequeueAfter)
+		return ctrl.Result{RequeueAfter: InvalidSpecRequeueAfter}, nil
+	case irimeta.ErrorReason_ERROR_REASON_INSUFFICIENT_CAPACITY:
+		recorder.Eventf(obj, corev1.EventTypeWarning, InsufficientCapacity, "Runtime has insufficient capacity: %v", err)
+	}
+
+	if retryAfter := errdetails.RetryAfter(err); retryAfter > 0 {
+		ctrl.LoggerFrom(ctx).Error(err, "Runtime request failed, retrying later", "RetryAfter", retryAfter)
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+	return res, err
+}
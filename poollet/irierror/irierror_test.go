@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package irierror_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ironcore-dev/ironcore/iri/errdetails"
+	. "github.com/ironcore-dev/ironcore/poollet/irierror"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("Handle", func() {
+	var (
+		recorder *record.FakeRecorder
+		obj      *corev1.ConfigMap
+	)
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+		obj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"}}
+	})
+
+	It("should pass through successful results and non-runtime errors", func(ctx SpecContext) {
+		Expect(Handle(ctx, recorder, obj, ctrl.Result{Requeue: true}, nil)).To(Equal(ctrl.Result{Requeue: true}))
+
+		err := errors.New("foo")
+		_, actualErr := Handle(ctx, recorder, obj, ctrl.Result{}, err)
+		Expect(actualErr).To(BeIdenticalTo(err))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should record an event and retry invalid specs after a long back-off", func(ctx SpecContext) {
+		err := fmt.Errorf("error creating machine: %w", errdetails.InvalidSpec("power", "unknown power state"))
+
+		res, actualErr := Handle(ctx, recorder, obj, ctrl.Result{}, err)
+		Expect(actualErr).NotTo(HaveOccurred())
+		Expect(res).To(Equal(ctrl.Result{RequeueAfter: InvalidSpecRequeueAfter}))
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning InvalidSpec Runtime rejected field power")))
+	})
+
+	It("should record an event and back off on insufficient capacity", func(ctx SpecContext) {
+		err := errdetails.InsufficientCapacity(0, "no capacity")
+
+		_, actualErr := Handle(ctx, recorder, obj, ctrl.Result{}, err)
+		Expect(actualErr).To(BeIdenticalTo(err))
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning InsufficientCapacity")))
+	})
+
+	It("should requeue after the time specified by the runtime", func(ctx SpecContext) {
+		err := fmt.Errorf("error(s) updating machine: %w", errors.Join(
+			errors.New("foo"),
+			errdetails.Transient(3*time.Second, "try again later"),
+		))
+
+		res, actualErr := Handle(ctx, recorder, obj, ctrl.Result{}, err)
+		Expect(actualErr).NotTo(HaveOccurred())
+		Expect(res).To(Equal(ctrl.Result{RequeueAfter: 3 * time.Second}))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should return transient errors without details for the rate-limited back-off", func(ctx SpecContext) {
+		err := status.Error(codes.Unavailable, "unavailable")
+
+		_, actualErr := Handle(ctx, recorder, obj, ctrl.Result{}, err)
+		Expect(actualErr).To(BeIdenticalTo(err))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
@@ -5,7 +5,9 @@ package irievent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"strings"
@@ -16,6 +18,8 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/gogo/protobuf/proto"
 	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/server/healthz"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -119,7 +123,8 @@ type generator[O irimeta.Object] struct {
 
 	items oldNewMap[O]
 
-	list func(ctx context.Context) ([]O, error)
+	list  func(ctx context.Context) ([]O, error)
+	watch WatchFunc[O]
 }
 
 type GeneratorOptions struct {
@@ -141,6 +146,13 @@ func setGeneratorOptionsDefaults(o *GeneratorOptions) {
 }
 
 func NewGenerator[O irimeta.Object](list func(ctx context.Context) ([]O, error), opts GeneratorOptions) Generator[O] {
+	return NewWatchingGenerator(list, nil, opts)
+}
+
+// NewWatchingGenerator creates a generator that prefers watching the objects and only relists
+// if the watch is not possible. If the runtime does not implement watching, the generator
+// permanently falls back to relisting. A nil watch behaves like NewGenerator.
+func NewWatchingGenerator[O irimeta.Object](list func(ctx context.Context) ([]O, error), watch WatchFunc[O], opts GeneratorOptions) Generator[O] {
 	setGeneratorOptionsDefaults(&opts)
 
 	return &generator[O]{
@@ -151,6 +163,7 @@ func NewGenerator[O irimeta.Object](list func(ctx context.Context) ([]O, error),
 		firstListTime:   time.Time{},
 		items:           make(oldNewMap[O]),
 		list:            list,
+		watch:           watch,
 		handlers:        sets.New[*handler[O]](),
 	}
 }
@@ -208,33 +221,166 @@ func (g *generator[O]) Start(ctx context.Context) error {
 	go func() {
 		defer close(g.eventChannel)
 
-		t := time.NewTicker(g.relistPeriod)
-		defer t.Stop()
+		if g.watch != nil && g.runWatch(ctx, log) {
+			return
+		}
+		g.runRelist(ctx, log)
+	}()
+
+	return nil
+}
+
+func (g *generator[O]) runRelist(ctx context.Context, log logr.Logger) {
+	t := time.NewTicker(g.relistPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := g.relist(ctx, log); err != nil {
+				log.Error(err, "Error relisting")
+			}
+		}
+	}
+}
+
+// runWatch watches the objects until the context is done. If the watch fails, the generator relists
+// and retries watching after the relist period. runWatch returns false if the runtime does not
+// support watching.
+func (g *generator[O]) runWatch(ctx context.Context, log logr.Logger) bool {
+	var resourceVersion string
+	for {
+		err := g.watchOnce(ctx, log, &resourceVersion)
+		if ctx.Err() != nil {
+			return true
+		}
+
+		switch status.Code(err) {
+		case codes.Unimplemented:
+			log.Info("Runtime does not support watching, falling back to relisting")
+			return false
+		case codes.OutOfRange:
+			log.V(1).Info("Resource version is no longer available, restarting watch", "ResourceVersion", resourceVersion)
+			resourceVersion = ""
+			continue
+		}
+		if !errors.Is(err, io.EOF) {
+			log.Error(err, "Error watching")
+			if err := g.relist(ctx, log); err != nil {
+				log.Error(err, "Error relisting")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return true
+		case <-time.After(g.relistPeriod):
+		}
+	}
+}
 
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-t.C:
-				if err := g.relist(ctx, log); err != nil {
-					log.Error(err, "Error relisting")
+// watchOnce runs a single watch starting after the given resource version and updates the resource version
+// with every event. Watches without resource version start with the current objects, which are
+// synced like a relist once the initial bookmark is received.
+func (g *generator[O]) watchOnce(ctx context.Context, log logr.Logger, resourceVersion *string) error {
+	var (
+		synced  = *resourceVersion != ""
+		initial []O
+	)
+	return g.watch(ctx, *resourceVersion, func(evt WatchEvent[O]) error {
+		timestamp := time.Now()
+		if !synced {
+			switch evt.Type {
+			case irimeta.WatchEventType_WATCH_EVENT_ADDED:
+				initial = append(initial, evt.Object)
+				return nil
+			case irimeta.WatchEventType_WATCH_EVENT_BOOKMARK:
+				synced = true
+				g.firstListTime = timestamp
+				if err := g.sync(ctx, log, initial); err != nil {
+					return err
 				}
+				initial = nil
+			default:
+				return fmt.Errorf("unexpected %s event before initial bookmark", evt.Type)
 			}
+		} else if err := g.apply(ctx, log, evt); err != nil {
+			return err
 		}
-	}()
 
+		*resourceVersion = evt.ResourceVersion
+		g.relistTime.Store(&timestamp)
+		return nil
+	})
+}
+
+// apply applies a single watch event to the known objects and emits the resulting event.
+// Events that don't change the known objects (e.g. after a relist) are dropped.
+func (g *generator[O]) apply(ctx context.Context, log logr.Logger, evt WatchEvent[O]) error {
+	if evt.Type == irimeta.WatchEventType_WATCH_EVENT_BOOKMARK {
+		return nil
+	}
+
+	obj := evt.Object
+	id := g.items.id(obj)
+	old, ok := g.items.getOld(id)
+	switch evt.Type {
+	case irimeta.WatchEventType_WATCH_EVENT_ADDED, irimeta.WatchEventType_WATCH_EVENT_MODIFIED:
+		g.items[id] = &oldNewMapEntry[O]{Old: &obj}
+		switch {
+		case !ok:
+			return g.emit(ctx, log, id, g.addedEvent(obj))
+		case !proto.Equal(old, obj):
+			return g.emit(ctx, log, id, &event[O]{Update: &UpdateEvent[O]{ObjectOld: old, ObjectNew: obj}})
+		}
+	case irimeta.WatchEventType_WATCH_EVENT_DELETED:
+		if ok {
+			delete(g.items, id)
+			return g.emit(ctx, log, id, &event[O]{Delete: &DeleteEvent[O]{Object: old}})
+		}
+	}
+	return nil
+}
+
+func (g *generator[O]) addedEvent(obj O) *event[O] {
+	createdAt := time.Unix(0, obj.GetMetadata().CreatedAt)
+	if createdAt.Before(g.firstListTime) {
+		return &event[O]{Create: &CreateEvent[O]{Object: obj}}
+	}
+	return &event[O]{Generic: &GenericEvent[O]{Object: obj}}
+}
+
+func (g *generator[O]) emit(ctx context.Context, log logr.Logger, id string, evt *event[O]) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case g.eventChannel <- evt:
+		eventsTotal.WithLabelValues(g.Name(), eventType(evt)).Inc()
+	default:
+		discardedEventsTotal.WithLabelValues(g.Name()).Inc()
+		log.Info("Event channel is full, discarding event", "ID", id)
+	}
 	return nil
 }
 
 func (g *generator[O]) relist(ctx context.Context, log logr.Logger) error {
 	timestamp := time.Now()
 	objects, err := g.list(ctx)
+	relistDuration.WithLabelValues(g.Name()).Observe(time.Since(timestamp).Seconds())
 	if err != nil {
 		return fmt.Errorf("error listing: %w", err)
 	}
 	g.relistTime.Store(&timestamp)
 	g.firstListTime = timestamp
 
+	return g.sync(ctx, log, objects)
+}
+
+// sync replaces the known objects with the given objects and emits the events for the differences.
+func (g *generator[O]) sync(ctx context.Context, log logr.Logger, objects []O) error {
+
 	g.items.setCurrent(objects)
 
 	eventsByKey := make(map[string][]*event[O])
@@ -243,12 +389,7 @@ func (g *generator[O]) relist(ctx context.Context, log logr.Logger) error {
 		itemNew, newOK := g.items.getCurrent(key)
 		switch {
 		case !oldOK && newOK:
-			createdAt := time.Unix(0, itemNew.GetMetadata().CreatedAt)
-			if createdAt.Before(g.firstListTime) {
-				eventsByKey[key] = []*event[O]{{Create: &CreateEvent[O]{Object: itemNew}}}
-			} else {
-				eventsByKey[key] = []*event[O]{{Generic: &GenericEvent[O]{Object: itemNew}}}
-			}
+			eventsByKey[key] = []*event[O]{g.addedEvent(itemNew)}
 		case oldOK && !newOK:
 			eventsByKey[key] = []*event[O]{{Delete: &DeleteEvent[O]{Object: itemOld}}}
 		case oldOK && newOK:
@@ -261,12 +402,8 @@ func (g *generator[O]) relist(ctx context.Context, log logr.Logger) error {
 	for machineID, events := range eventsByKey {
 		g.items.update(machineID)
 		for i := range events {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case g.eventChannel <- events[i]:
-			default:
-				log.Info("Event channel is full, discarding event", "MachineID", machineID)
+			if err := g.emit(ctx, log, machineID, events[i]); err != nil {
+				return err
 			}
 		}
 	}
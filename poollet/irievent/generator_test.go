@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package irievent_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"time"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/poollet/irievent"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func machine(id string, labels map[string]string) *iri.Machine {
+	return &iri.Machine{Metadata: &irimeta.ObjectMetadata{Id: id, Labels: labels}}
+}
+
+// watchCall is the behavior of a single call of a fakeWatch.
+type watchCall func(handle func(WatchEvent[*iri.Machine]) error) error
+
+// fakeWatch serves its calls in order and blocks until the context is done once all calls are served.
+type fakeWatch struct {
+	calls            chan watchCall
+	resourceVersions chan string
+}
+
+func newFakeWatch(calls ...watchCall) *fakeWatch {
+	w := &fakeWatch{
+		calls:            make(chan watchCall, len(calls)),
+		resourceVersions: make(chan string, 100),
+	}
+	for _, call := range calls {
+		w.calls <- call
+	}
+	return w
+}
+
+func (w *fakeWatch) watch(ctx context.Context, resourceVersion string, handle func(WatchEvent[*iri.Machine]) error) error {
+	w.resourceVersions <- resourceVersion
+	select {
+	case call := <-w.calls:
+		return call(handle)
+	default:
+		<-ctx.Done()
+		return ctx.Err()
+	}
+}
+
+// endWith returns a watchCall that sends the given events and ends the watch with err.
+func endWith(err error, events ...WatchEvent[*iri.Machine]) watchCall {
+	return func(handle func(WatchEvent[*iri.Machine]) error) error {
+		for _, event := range events {
+			if err := handle(event); err != nil {
+				return err
+			}
+		}
+		return err
+	}
+}
+
+func added(obj *iri.Machine, resourceVersion string) WatchEvent[*iri.Machine] {
+	return WatchEvent[*iri.Machine]{Type: irimeta.WatchEventType_WATCH_EVENT_ADDED, Object: obj, ResourceVersion: resourceVersion}
+}
+
+func modified(obj *iri.Machine, resourceVersion string) WatchEvent[*iri.Machine] {
+	return WatchEvent[*iri.Machine]{Type: irimeta.WatchEventType_WATCH_EVENT_MODIFIED, Object: obj, ResourceVersion: resourceVersion}
+}
+
+func deleted(obj *iri.Machine, resourceVersion string) WatchEvent[*iri.Machine] {
+	return WatchEvent[*iri.Machine]{Type: irimeta.WatchEventType_WATCH_EVENT_DELETED, Object: obj, ResourceVersion: resourceVersion}
+}
+
+func bookmark(resourceVersion string) WatchEvent[*iri.Machine] {
+	return WatchEvent[*iri.Machine]{Type: irimeta.WatchEventType_WATCH_EVENT_BOOKMARK, ResourceVersion: resourceVersion}
+}
+
+type recordedEvent struct {
+	Type string
+	ID   string
+}
+
+func startGenerator(ctx context.Context, list func(ctx context.Context) ([]*iri.Machine, error), watch WatchFunc[*iri.Machine]) chan recordedEvent {
+	events := make(chan recordedEvent, 100)
+	record := func(typ string) func(*iri.Machine) {
+		return func(obj *iri.Machine) {
+			events <- recordedEvent{Type: typ, ID: obj.GetMetadata().GetId()}
+		}
+	}
+
+	gen := NewWatchingGenerator(list, watch, GeneratorOptions{RelistPeriod: 10 * time.Millisecond})
+	_, err := gen.AddHandler(HandlerFuncs[*iri.Machine]{
+		CreateFunc:  func(event CreateEvent[*iri.Machine]) { record("create")(event.Object) },
+		UpdateFunc:  func(event UpdateEvent[*iri.Machine]) { record("update")(event.ObjectNew) },
+		DeleteFunc:  func(event DeleteEvent[*iri.Machine]) { record("delete")(event.Object) },
+		GenericFunc: func(event GenericEvent[*iri.Machine]) { record("generic")(event.Object) },
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(gen.Start(ctx)).To(Succeed())
+	return events
+}
+
+func receiveN[T any](ch chan T, n int) []T {
+	res := make([]T, n)
+	for i := range res {
+		EventuallyWithOffset(1, ch).Should(Receive(&res[i]))
+	}
+	return res
+}
+
+var _ = Describe("Generator", func() {
+	var (
+		objects atomic.Pointer[[]*iri.Machine]
+		lists   atomic.Int32
+		list    func(ctx context.Context) ([]*iri.Machine, error)
+	)
+	BeforeEach(func() {
+		objects.Store(&[]*iri.Machine{})
+		lists.Store(0)
+		list = func(ctx context.Context) ([]*iri.Machine, error) {
+			lists.Add(1)
+			return *objects.Load(), nil
+		}
+	})
+
+	It("should sync the initial objects and apply subsequent events without relisting", func(ctx SpecContext) {
+		w := newFakeWatch(endWith(io.EOF,
+			added(machine("a", nil), "1"),
+			added(machine("b", nil), "2"),
+			bookmark("2"),
+			modified(machine("a", map[string]string{"foo": "bar"}), "3"),
+			bookmark("4"),
+			modified(machine("a", map[string]string{"foo": "bar"}), "5"),
+			deleted(machine("b", nil), "6"),
+			bookmark("7"),
+		))
+		events := startGenerator(ctx, list, w.watch)
+
+		By("syncing the objects received before the initial bookmark")
+		Expect(receiveN(events, 2)).To(ConsistOf(
+			recordedEvent{"create", "a"},
+			recordedEvent{"create", "b"},
+		))
+
+		By("applying the events after the initial bookmark, ignoring bookmarks and unchanged objects")
+		Eventually(events).Should(Receive(Equal(recordedEvent{"update", "a"})))
+		Eventually(events).Should(Receive(Equal(recordedEvent{"delete", "b"})))
+		Consistently(events).ShouldNot(Receive())
+
+		By("resuming the watch after the last received resource version")
+		Eventually(w.resourceVersions).Should(Receive(Equal("")))
+		Eventually(w.resourceVersions).Should(Receive(Equal("7")))
+		Expect(lists.Load()).To(BeZero())
+	})
+
+	It("should restart the watch from the current objects if the resource version is out of range", func(ctx SpecContext) {
+		w := newFakeWatch(
+			endWith(io.EOF,
+				added(machine("a", nil), "1"),
+				added(machine("b", nil), "2"),
+				bookmark("2"),
+			),
+			endWith(status.Error(codes.OutOfRange, "resource version 2 is no longer available")),
+			endWith(io.EOF,
+				added(machine("a", map[string]string{"foo": "bar"}), "10"),
+				bookmark("10"),
+			),
+		)
+		events := startGenerator(ctx, list, w.watch)
+		Expect(receiveN(events, 2)).To(ConsistOf(
+			recordedEvent{"create", "a"},
+			recordedEvent{"create", "b"},
+		))
+
+		By("reporting the differences to the restarted watch")
+		Expect(receiveN(events, 2)).To(ConsistOf(
+			recordedEvent{"update", "a"},
+			recordedEvent{"delete", "b"},
+		))
+
+		Expect(receiveN(w.resourceVersions, 4)).To(Equal([]string{"", "2", "", "10"}))
+		Expect(lists.Load()).To(BeZero())
+	})
+
+	It("should relist and retry watching from the same resource version on errors", func(ctx SpecContext) {
+		w := newFakeWatch(
+			endWith(io.EOF,
+				added(machine("a", nil), "1"),
+				bookmark("1"),
+			),
+			endWith(errors.New("connection reset")),
+		)
+		events := startGenerator(ctx, list, w.watch)
+		Eventually(events).Should(Receive(Equal(recordedEvent{"create", "a"})))
+
+		By("relisting after the watch failed")
+		Eventually(events).Should(Receive(Equal(recordedEvent{"delete", "a"})))
+		Expect(lists.Load()).To(BeEquivalentTo(1))
+
+		Expect(receiveN(w.resourceVersions, 3)).To(Equal([]string{"", "1", "1"}))
+	})
+
+	It("should fall back to relisting if the runtime does not implement watching", func(ctx SpecContext) {
+		objects.Store(&[]*iri.Machine{machine("a", nil)})
+		w := newFakeWatch(endWith(status.Error(codes.Unimplemented, "watching is not implemented")))
+		events := startGenerator(ctx, list, w.watch)
+
+		Eventually(events).Should(Receive(Equal(recordedEvent{"create", "a"})))
+
+		By("reporting changes by relisting")
+		objects.Store(&[]*iri.Machine{machine("a", map[string]string{"foo": "bar"})})
+		Eventually(events).Should(Receive(Equal(recordedEvent{"update", "a"})))
+		Eventually(lists.Load).Should(BeNumerically(">", 1))
+
+		By("not watching again")
+		Expect(w.resourceVersions).To(Receive(Equal("")))
+		Consistently(w.resourceVersions).ShouldNot(Receive())
+	})
+})
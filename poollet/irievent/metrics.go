@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package irievent
+
+import (
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	generatorLabel = "generator"
+	typeLabel      = "type"
+)
+
+var (
+	relistDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "iri",
+		Subsystem: "event_generator",
+		Name:      "relist_duration_seconds",
+		Help:      "Duration of listing the objects of the runtime by generator.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{generatorLabel})
+
+	eventsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "iri",
+		Subsystem: "event_generator",
+		Name:      "events_total",
+		Help:      "Total number of events emitted by generator and event type.",
+	}, []string{generatorLabel, typeLabel})
+
+	discardedEventsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "iri",
+		Subsystem: "event_generator",
+		Name:      "discarded_events_total",
+		Help:      "Total number of events discarded because the event channel was full by generator.",
+	}, []string{generatorLabel})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(relistDuration, eventsTotal, discardedEventsTotal)
+}
+
+// eventType returns the type of evt used as metric label.
+func eventType[O irimeta.Object](evt *event[O]) string {
+	switch {
+	case evt.Create != nil:
+		return "create"
+	case evt.Update != nil:
+		return "update"
+	case evt.Delete != nil:
+		return "delete"
+	default:
+		return "generic"
+	}
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package irievent
+
+import (
+	"context"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+)
+
+// WatchEvent is an event received from a runtime watch.
+type WatchEvent[O irimeta.Object] struct {
+	Type            irimeta.WatchEventType
+	Object          O
+	ResourceVersion string
+}
+
+// WatchFunc watches the objects of a runtime, starting after the given resource version, and calls
+// handle for every received event. It returns once the watch ends or handle returns an error.
+type WatchFunc[O irimeta.Object] func(ctx context.Context, resourceVersion string, handle func(WatchEvent[O]) error) error
+
+// WatchStream is a stream of watch responses, as returned by the IRI watch RPCs.
+type WatchStream[R any] interface {
+	Recv() (R, error)
+}
+
+// NewWatchFunc returns a WatchFunc that opens a stream using watch and converts the received responses using convert.
+func NewWatchFunc[O irimeta.Object, R any](
+	watch func(ctx context.Context, resourceVersion string) (WatchStream[R], error),
+	convert func(R) WatchEvent[O],
+) WatchFunc[O] {
+	return func(ctx context.Context, resourceVersion string, handle func(WatchEvent[O]) error) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := watch(ctx, resourceVersion)
+		if err != nil {
+			return err
+		}
+
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+
+			if err := handle(convert(res)); err != nil {
+				return err
+			}
+		}
+	}
+}
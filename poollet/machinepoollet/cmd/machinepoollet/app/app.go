@@ -5,6 +5,8 @@ package app
 
 import (
 	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	goflag "flag"
 	"fmt"
 	"net"
@@ -18,6 +20,9 @@ import (
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	iriauth "github.com/ironcore-dev/ironcore/iri/auth"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
+	irimetrics "github.com/ironcore-dev/ironcore/iri/metrics"
 	iriremotemachine "github.com/ironcore-dev/ironcore/iri/remote/machine"
 	"github.com/ironcore-dev/ironcore/poollet/irievent"
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/addresses"
@@ -27,6 +32,7 @@ import (
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/mcm"
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/server"
 	"github.com/ironcore-dev/ironcore/utils/client/config"
+	"github.com/ironcore-dev/ironcore/utils/tracing"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/fields"
@@ -61,6 +67,7 @@ type Options struct {
 	LeaderElectionNamespace  string
 	LeaderElectionKubeconfig string
 	ProbeAddr                string
+	Tracing                  tracing.Options
 
 	MachinePoolName                      string
 	MachineDownwardAPILabels             map[string]string
@@ -68,8 +75,10 @@ type Options struct {
 	ProviderID                           string
 	MachineRuntimeEndpoint               string
 	MachineRuntimeSocketDiscoveryTimeout time.Duration
+	MachineRuntimeAuth                   iriauth.ClientFlags
 	DialTimeout                          time.Duration
 	MachineClassMapperSyncTimeout        time.Duration
+	RequiredMachineRuntimeCapabilities   []string
 
 	ServerFlags server.Flags
 
@@ -87,15 +96,21 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 			"Enabling this will ensure there is only one active controller manager.")
 	fs.StringVar(&o.LeaderElectionNamespace, "leader-election-namespace", "", "Namespace to do leader election in.")
 	fs.StringVar(&o.LeaderElectionKubeconfig, "leader-election-kubeconfig", "", "Path pointing to a kubeconfig to use for leader election.")
+	o.Tracing.BindFlags(fs)
 
 	fs.StringVar(&o.MachinePoolName, "machine-pool-name", o.MachinePoolName, "Name of the machine pool to announce / watch")
 	fs.StringToStringVar(&o.MachineDownwardAPILabels, "machine-downward-api-label", o.MachineDownwardAPILabels, "Downward-API labels to set on the iri machine.")
 	fs.StringToStringVar(&o.MachineDownwardAPIAnnotations, "machine-downward-api-annotation", o.MachineDownwardAPIAnnotations, "Downward-API annotations to set on the iri machine.")
 	fs.StringVar(&o.ProviderID, "provider-id", "", "Provider id to announce on the machine pool.")
-	fs.StringVar(&o.MachineRuntimeEndpoint, "machine-runtime-endpoint", o.MachineRuntimeEndpoint, "Endpoint of the remote machine runtime service.")
+	fs.StringVar(&o.MachineRuntimeEndpoint, "machine-runtime-endpoint", o.MachineRuntimeEndpoint, "Endpoint of the remote machine runtime service. "+
+		"Either a unix socket path, 'unix://<path>' or 'tcp://<host>:<port>'.")
 	fs.DurationVar(&o.MachineRuntimeSocketDiscoveryTimeout, "machine-runtime-socket-discovery-timeout", 20*time.Second, "Timeout for discovering the machine runtime socket.")
+	o.MachineRuntimeAuth.BindFlags(fs, "machine-runtime-")
+	o.MachineRuntimeAuth.BindSignerNameFlag(fs, "machine-runtime-")
 	fs.DurationVar(&o.DialTimeout, "dial-timeout", 1*time.Second, "Timeout for dialing to the machine runtime endpoint.")
 	fs.DurationVar(&o.MachineClassMapperSyncTimeout, "mcm-sync-timeout", 10*time.Second, "Timeout waiting for the machine class mapper to sync.")
+	fs.StringSliceVar(&o.RequiredMachineRuntimeCapabilities, "required-machine-runtime-capabilities", o.RequiredMachineRuntimeCapabilities,
+		fmt.Sprintf("Capabilities the machine runtime has to support. Optional capabilities: %v", capabilities.MachineOptional))
 
 	o.ServerFlags.BindFlags(fs)
 
@@ -187,15 +202,21 @@ func Run(ctx context.Context, opts Options) error {
 
 	setupLog.V(1).Info("Discovered addresses to report", "MachinePoolAddresses", machinePoolAddresses)
 
-	machineRuntime, err := iriremotemachine.NewRemoteRuntime(endpoint)
+	shutdownTracing, err := tracing.Setup(ctx, "machinepoollet", opts.Tracing)
 	if err != nil {
-		return fmt.Errorf("error creating remote machine runtime: %w", err)
+		return fmt.Errorf("error setting up tracing: %w", err)
 	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "Error shutting down tracing")
+		}
+	}()
 
 	cfg, configCtrl, err := getter.GetConfig(ctx, &opts.GetConfigOptions)
 	if err != nil {
 		return fmt.Errorf("error getting config: %w", err)
 	}
+	tracing.WrapConfig(cfg)
 
 	leaderElectionCfg, err := configutils.GetConfig(
 		configutils.Kubeconfig(opts.LeaderElectionKubeconfig),
@@ -231,16 +252,50 @@ func Run(ctx context.Context, opts Options) error {
 		return err
 	}
 
+	machineRuntimeAuthOpts, err := opts.MachineRuntimeAuth.SetupClientOptions(ctx, mgr, "machine-runtime-client-certificate", &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   computev1alpha1.MachinePoolCommonName(opts.MachinePoolName),
+			Organization: []string{computev1alpha1.MachinePoolsGroup},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting up machine runtime auth: %w", err)
+	}
+	machineRuntimeDialOpts, err := iriauth.DialOptions(machineRuntimeAuthOpts)
+	if err != nil {
+		return fmt.Errorf("error getting machine runtime dial options: %w", err)
+	}
+	machineRuntimeDialOpts = append(machineRuntimeDialOpts, irimetrics.DialOptions()...)
+	machineRuntimeDialOpts = append(machineRuntimeDialOpts, tracing.DialOptions()...)
+
+	machineRuntime, err := iriremotemachine.NewRemoteRuntime(endpoint, machineRuntimeDialOpts...)
+	if err != nil {
+		return fmt.Errorf("error creating remote machine runtime: %w", err)
+	}
+
 	version, err := machineRuntime.Version(ctx, &iri.VersionRequest{})
 	if err != nil {
 		return fmt.Errorf("error getting machine runtime version: %w", err)
 	}
 
+	machineRuntimeCapabilities := capabilities.New(version.Capabilities...)
+	if len(version.Capabilities) == 0 {
+		setupLog.Info("Machine runtime does not report capabilities, assuming legacy capabilities", "Capabilities", capabilities.MachineLegacy)
+		machineRuntimeCapabilities = capabilities.New(capabilities.MachineLegacy...)
+	}
+	if missing := machineRuntimeCapabilities.Missing(opts.RequiredMachineRuntimeCapabilities...); len(missing) > 0 {
+		return fmt.Errorf("machine runtime %s %s does not support required capabilities %v", version.RuntimeName, version.RuntimeVersion, missing)
+	}
+	if missing := machineRuntimeCapabilities.Missing(capabilities.MachineOptional...); len(missing) > 0 {
+		setupLog.Info("Machine runtime does not support optional capabilities, running degraded", "MissingCapabilities", missing)
+	}
+
 	srvOpts := opts.ServerFlags.ServerOptions(
 		opts.MachinePoolName,
 		machineRuntime,
 		logger.WithName("server"),
 	)
+	srvOpts.DisableExec = !machineRuntimeCapabilities.Has(capabilities.MachineExec)
 	srv, err := server.New(cfg, srvOpts)
 	if err != nil {
 		return fmt.Errorf("error creating machinepoollet server: %w", err)
@@ -255,13 +310,21 @@ func Run(ctx context.Context, opts Options) error {
 		return fmt.Errorf("error adding machine class mapper: %w", err)
 	}
 
-	machineEvents := irievent.NewGenerator(func(ctx context.Context) ([]*iri.Machine, error) {
+	var watchMachines irievent.WatchFunc[*iri.Machine]
+	if machineRuntimeCapabilities.Has(capabilities.MachineWatch) {
+		watchMachines = irievent.NewWatchFunc(func(ctx context.Context, resourceVersion string) (irievent.WatchStream[*iri.WatchMachinesResponse], error) {
+			return machineRuntime.WatchMachines(ctx, &iri.WatchMachinesRequest{ResourceVersion: resourceVersion})
+		}, func(res *iri.WatchMachinesResponse) irievent.WatchEvent[*iri.Machine] {
+			return irievent.WatchEvent[*iri.Machine]{Type: res.Type, Object: res.Machine, ResourceVersion: res.ResourceVersion}
+		})
+	}
+	machineEvents := irievent.NewWatchingGenerator(func(ctx context.Context) ([]*iri.Machine, error) {
 		res, err := machineRuntime.ListMachines(ctx, &iri.ListMachinesRequest{})
 		if err != nil {
 			return nil, err
 		}
 		return res.Machines, nil
-	}, irievent.GeneratorOptions{})
+	}, watchMachines, irievent.GeneratorOptions{})
 	if err := mgr.Add(machineEvents); err != nil {
 		return fmt.Errorf("error adding machine event generator: %w", err)
 	}
@@ -315,12 +378,14 @@ func Run(ctx context.Context, opts Options) error {
 		}
 
 		if err := (&controllers.MachinePoolReconciler{
-			Client:             mgr.GetClient(),
-			MachinePoolName:    opts.MachinePoolName,
-			Addresses:          machinePoolAddresses,
-			Port:               port,
-			MachineRuntime:     machineRuntime,
-			MachineClassMapper: machineClassMapper,
+			EventRecorder:              mgr.GetEventRecorderFor("machinepools"),
+			Client:                     mgr.GetClient(),
+			MachinePoolName:            opts.MachinePoolName,
+			Addresses:                  machinePoolAddresses,
+			Port:                       port,
+			MachineRuntime:             machineRuntime,
+			MachineClassMapper:         machineClassMapper,
+			MachineRuntimeCapabilities: machineRuntimeCapabilities,
 		}).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("error setting up machine pool reconciler with manager: %w", err)
 		}
@@ -18,6 +18,7 @@ import (
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
 	"github.com/ironcore-dev/ironcore/iri/testing/machine"
 	"github.com/ironcore-dev/ironcore/poollet/irievent"
 	machinepoolletclient "github.com/ironcore-dev/ironcore/poollet/machinepoollet/client"
@@ -112,6 +113,7 @@ var _ = BeforeSuite(func() {
 		MainPath:     "github.com/ironcore-dev/ironcore/cmd/ironcore-apiserver",
 		BuildOptions: []buildutils.BuildOption{buildutils.ModModeMod},
 		ETCDServers:  []string{testEnv.ControlPlane.Etcd.URL.String()},
+		Args:         apiserver.EmptyProcessArgs().Set("enable-machinepool-authorizer", "true"),
 		Host:         testEnvExt.APIServiceInstallOptions.LocalServingHost,
 		Port:         testEnvExt.APIServiceInstallOptions.LocalServingPort,
 		CertDir:      testEnvExt.APIServiceInstallOptions.LocalServingCertDir,
@@ -137,6 +139,35 @@ var _ = BeforeSuite(func() {
 })
 
 func SetupTest() (*corev1.Namespace, *computev1alpha1.MachinePool, *computev1alpha1.MachineClass, *machine.FakeRuntimeService) {
+	return SetupTestWithRuntimeCapabilities(capabilities.New(capabilities.MachineOptional...), &record.FakeRecorder{})
+}
+
+// SetupTestWithRuntimeCapabilities is like SetupTest but runs the reconcilers with the given machine runtime
+// capabilities and event recorder.
+func SetupTestWithRuntimeCapabilities(runtimeCapabilities capabilities.Set, recorder record.EventRecorder) (*corev1.Namespace, *computev1alpha1.MachinePool, *computev1alpha1.MachineClass, *machine.FakeRuntimeService) {
+	return setupTest(runtimeCapabilities, recorder, func(*computev1alpha1.MachinePool) *rest.Config { return cfg })
+}
+
+// SetupTestAsMachinePool is like SetupTest but runs the reconcilers with the identity of the machine pool,
+// making their requests subject to the machine pool authorizer of the apiserver.
+func SetupTestAsMachinePool() (*corev1.Namespace, *computev1alpha1.MachinePool, *computev1alpha1.MachineClass, *machine.FakeRuntimeService) {
+	return setupTest(capabilities.New(capabilities.MachineOptional...), &record.FakeRecorder{}, func(mp *computev1alpha1.MachinePool) *rest.Config {
+		machinePoolCfg := rest.CopyConfig(cfg)
+		machinePoolCfg.Impersonate = rest.ImpersonationConfig{
+			UserName: computev1alpha1.MachinePoolCommonName(mp.Name),
+			// system:masters lets the delegated authorization allow everything the machine pool authorizer
+			// does not decide on.
+			Groups: []string{computev1alpha1.MachinePoolsGroup, "system:masters"},
+		}
+		return machinePoolCfg
+	})
+}
+
+func setupTest(
+	runtimeCapabilities capabilities.Set,
+	recorder record.EventRecorder,
+	managerConfig func(mp *computev1alpha1.MachinePool) *rest.Config,
+) (*corev1.Namespace, *computev1alpha1.MachinePool, *computev1alpha1.MachineClass, *machine.FakeRuntimeService) {
 	var (
 		ns  = &corev1.Namespace{}
 		mp  = &computev1alpha1.MachinePool{}
@@ -188,7 +219,7 @@ func SetupTest() (*corev1.Namespace, *computev1alpha1.MachinePool, *computev1alp
 			},
 		})
 
-		k8sManager, err := ctrl.NewManager(cfg, ctrl.Options{
+		k8sManager, err := ctrl.NewManager(managerConfig(mp), ctrl.Options{
 			Scheme: scheme.Scheme,
 			Metrics: metricserver.Options{
 				BindAddress: "0",
@@ -211,7 +242,7 @@ func SetupTest() (*corev1.Namespace, *computev1alpha1.MachinePool, *computev1alp
 		DeferCleanup(cancel)
 
 		Expect((&controllers.MachineReconciler{
-			EventRecorder:         &record.FakeRecorder{},
+			EventRecorder:         recorder,
 			Client:                k8sManager.GetClient(),
 			MachineRuntime:        srv,
 			MachineRuntimeName:    machine.FakeRuntimeName,
@@ -223,13 +254,17 @@ func SetupTest() (*corev1.Namespace, *computev1alpha1.MachinePool, *computev1alp
 			},
 		}).SetupWithManager(k8sManager)).To(Succeed())
 
-		machineEvents := irievent.NewGenerator(func(ctx context.Context) ([]*iri.Machine, error) {
+		machineEvents := irievent.NewWatchingGenerator(func(ctx context.Context) ([]*iri.Machine, error) {
 			res, err := srv.ListMachines(ctx, &iri.ListMachinesRequest{})
 			if err != nil {
 				return nil, err
 			}
 			return res.Machines, nil
-		}, irievent.GeneratorOptions{})
+		}, irievent.NewWatchFunc(func(ctx context.Context, resourceVersion string) (irievent.WatchStream[*iri.WatchMachinesResponse], error) {
+			return srv.WatchMachines(ctx, &iri.WatchMachinesRequest{ResourceVersion: resourceVersion})
+		}, func(res *iri.WatchMachinesResponse) irievent.WatchEvent[*iri.Machine] {
+			return irievent.WatchEvent[*iri.Machine]{Type: res.Type, Object: res.Machine, ResourceVersion: res.ResourceVersion}
+		}), irievent.GeneratorOptions{})
 
 		Expect(k8sManager.Add(machineEvents)).To(Succeed())
 
@@ -239,10 +274,12 @@ func SetupTest() (*corev1.Namespace, *computev1alpha1.MachinePool, *computev1alp
 		}).SetupWithManager(k8sManager)).To(Succeed())
 
 		Expect((&controllers.MachinePoolReconciler{
-			Client:             k8sManager.GetClient(),
-			MachineRuntime:     srv,
-			MachineClassMapper: machineClassMapper,
-			MachinePoolName:    mp.Name,
+			EventRecorder:              recorder,
+			Client:                     k8sManager.GetClient(),
+			MachineRuntime:             srv,
+			MachineRuntimeCapabilities: runtimeCapabilities,
+			MachineClassMapper:         machineClassMapper,
+			MachinePoolName:            mp.Name,
 		}).SetupWithManager(k8sManager)).To(Succeed())
 
 		Expect((&controllers.MachinePoolAnnotatorReconciler{
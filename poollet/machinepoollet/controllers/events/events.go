@@ -8,4 +8,6 @@ const (
 	NetworkInterfaceNotReady = "NetworkInterfaceNotReady"
 	VolumeNotReady           = "VolumeNotReady"
 	IgnitionNotReady         = "IgnitionNotReady"
+
+	MissingRuntimeCapabilities = "MissingRuntimeCapabilities"
 )
@@ -19,6 +19,7 @@ import (
 	irimachine "github.com/ironcore-dev/ironcore/iri/apis/machine"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
 	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"github.com/ironcore-dev/ironcore/poollet/irierror"
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
 	machinepoolletclient "github.com/ironcore-dev/ironcore/poollet/machinepoollet/client"
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/controllers/events"
@@ -27,6 +28,10 @@ import (
 	utilclient "github.com/ironcore-dev/ironcore/utils/client"
 	utilmaps "github.com/ironcore-dev/ironcore/utils/maps"
 	"github.com/ironcore-dev/ironcore/utils/predicates"
+	"github.com/ironcore-dev/ironcore/utils/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -44,6 +49,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
+var tracer = otel.Tracer("github.com/ironcore-dev/ironcore/poollet/machinepoollet/controllers")
+
 type MachineReconciler struct {
 	record.EventRecorder
 	client.Client
@@ -95,7 +102,8 @@ func (r *MachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		}
 		return r.deleteGone(ctx, log, req.NamespacedName)
 	}
-	return r.reconcileExists(ctx, log, machine)
+	res, err := r.reconcileExists(ctx, log, machine)
+	return irierror.Handle(ctx, r.EventRecorder, machine, res, err)
 }
 
 func (r *MachineReconciler) getIRIMachinesForMachine(ctx context.Context, machine *computev1alpha1.Machine) ([]*iri.Machine, error) {
@@ -379,7 +387,14 @@ func (r *MachineReconciler) create(
 	machine *computev1alpha1.Machine,
 	nics []networkingv1alpha1.NetworkInterface,
 	volumes []storagev1alpha1.Volume,
-) (ctrl.Result, error) {
+) (_ ctrl.Result, retErr error) {
+	ctx, span := tracer.Start(ctx, "MachineReconciler.create", trace.WithAttributes(
+		attribute.String("machine.namespace", machine.Namespace),
+		attribute.String("machine.name", machine.Name),
+		attribute.String("machine.uid", string(machine.UID)),
+	))
+	defer func() { tracing.End(span, retErr) }()
+
 	log.V(1).Info("Create")
 
 	log.V(1).Info("Getting machine config")
@@ -658,7 +673,7 @@ func (r *MachineReconciler) update(
 	}
 
 	if len(errs) > 0 {
-		return ctrl.Result{}, fmt.Errorf("error(s) updating machine: %v", errs)
+		return ctrl.Result{}, fmt.Errorf("error(s) updating machine: %w", errors.Join(errs...))
 	}
 
 	log.V(1).Info("Updating annotations")
@@ -214,6 +214,58 @@ func (r *MachineReconciler) getNetworkInterfaceIPs(
 	return ips, true, nil
 }
 
+func (r *MachineReconciler) getNetworkInterfaceDelegatedPrefix(
+	ctx context.Context,
+	machine *computev1alpha1.Machine,
+	nic *networkingv1alpha1.NetworkInterface,
+	idx int,
+) (commonv1alpha1.IPPrefix, bool, error) {
+	prefix := &ipamv1alpha1.Prefix{}
+	prefixName := networkingv1alpha1.NetworkInterfaceDelegatedPrefixIPAMPrefixName(nic.Name, idx)
+	prefixKey := client.ObjectKey{Namespace: nic.Namespace, Name: prefixName}
+	if err := r.Get(ctx, prefixKey, prefix); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return commonv1alpha1.IPPrefix{}, false, fmt.Errorf("error getting prefix %s: %w", prefixName, err)
+		}
+
+		r.Eventf(machine, corev1.EventTypeNormal, events.NetworkInterfaceNotReady, "Network interface delegated prefix %s not found", prefixName)
+		return commonv1alpha1.IPPrefix{}, false, nil
+	}
+
+	if !metav1.IsControlledBy(prefix, nic) {
+		r.Eventf(machine, corev1.EventTypeNormal, events.NetworkInterfaceNotReady, "Network interface delegated prefix %s not controlled by network interface %s", prefixName, nic.Name)
+		return commonv1alpha1.IPPrefix{}, false, nil
+	}
+
+	if prefix.Status.Phase != ipamv1alpha1.PrefixPhaseAllocated {
+		r.Eventf(machine, corev1.EventTypeNormal, events.NetworkInterfaceNotReady, "Network interface delegated prefix %s is not yet allocated", prefixName)
+		return commonv1alpha1.IPPrefix{}, false, nil
+	}
+
+	return *prefix.Spec.Prefix, true, nil
+}
+
+func (r *MachineReconciler) getNetworkInterfaceDelegatedPrefixes(
+	ctx context.Context,
+	machine *computev1alpha1.Machine,
+	nic *networkingv1alpha1.NetworkInterface,
+) ([]commonv1alpha1.IPPrefix, bool, error) {
+	var prefixes []commonv1alpha1.IPPrefix
+	for i, nicIP := range nic.Spec.IPs {
+		if nicIP.Ephemeral == nil || nicIP.Ephemeral.DelegatedPrefixLength == nil {
+			continue
+		}
+
+		prefix, ok, err := r.getNetworkInterfaceDelegatedPrefix(ctx, machine, nic, i)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes, true, nil
+}
+
 func (r *MachineReconciler) prepareIRINetworkInterface(
 	ctx context.Context,
 	machine *computev1alpha1.Machine,
@@ -235,11 +287,17 @@ func (r *MachineReconciler) prepareIRINetworkInterface(
 		return nil, false, err
 	}
 
+	delegatedPrefixes, ok, err := r.getNetworkInterfaceDelegatedPrefixes(ctx, machine, nic)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
 	return &iri.NetworkInterface{
-		Name:       machineNicName,
-		NetworkId:  network.Spec.ProviderID,
-		Ips:        utilslices.Map(ips, commonv1alpha1.IP.String),
-		Attributes: nic.Spec.Attributes,
+		Name:              machineNicName,
+		NetworkId:         network.Spec.ProviderID,
+		Ips:               utilslices.Map(ips, commonv1alpha1.IP.String),
+		Attributes:        nic.Spec.Attributes,
+		DelegatedPrefixes: utilslices.Map(delegatedPrefixes, commonv1alpha1.IPPrefix.String),
 	}, true, nil
 }
 
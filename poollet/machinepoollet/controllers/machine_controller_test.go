@@ -249,6 +249,119 @@ var _ = Describe("MachineController", func() {
 	})
 })
 
+var _ = Describe("MachineController with the machine pool identity", func() {
+	ns, mp, mc, srv := SetupTestAsMachinePool()
+
+	It("should create a machine with the volumes and network interfaces reachable by the machine pool", func(ctx SpecContext) {
+		By("creating a network")
+		network := &networkingv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "network-",
+			},
+			Spec: networkingv1alpha1.NetworkSpec{
+				ProviderID: "foo",
+			},
+		}
+		Expect(k8sClient.Create(ctx, network)).To(Succeed())
+
+		By("patching the network to be available")
+		Eventually(UpdateStatus(network, func() {
+			network.Status.State = networkingv1alpha1.NetworkStateAvailable
+		})).Should(Succeed())
+
+		By("creating a network interface")
+		nic := &networkingv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "nic-",
+			},
+			Spec: networkingv1alpha1.NetworkInterfaceSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: network.Name},
+				IPs: []networkingv1alpha1.IPSource{
+					{Value: commonv1alpha1.MustParseNewIP("10.0.0.1")},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nic)).To(Succeed())
+
+		By("creating a volume")
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "volume-",
+			},
+			Spec: storagev1alpha1.VolumeSpec{},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed())
+
+		By("patching the volume to be available")
+		Eventually(UpdateStatus(volume, func() {
+			volume.Status.State = storagev1alpha1.VolumeStateAvailable
+			volume.Status.Access = &storagev1alpha1.VolumeAccess{
+				Driver: "test",
+				Handle: "testhandle",
+			}
+		})).Should(Succeed())
+
+		By("waiting for the machine pool to report the machine class")
+		Eventually(Object(mp)).Should(HaveField("Status.AvailableMachineClasses", ConsistOf(
+			corev1.LocalObjectReference{Name: mc.Name},
+		)))
+
+		By("creating a machine")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "machine-",
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: mc.Name},
+				MachinePoolRef:  &corev1.LocalObjectReference{Name: mp.Name},
+				Volumes: []computev1alpha1.Volume{
+					{
+						Name: "primary",
+						VolumeSource: computev1alpha1.VolumeSource{
+							VolumeRef: &corev1.LocalObjectReference{Name: volume.Name},
+						},
+					},
+				},
+				NetworkInterfaces: []computev1alpha1.NetworkInterface{
+					{
+						Name: "primary",
+						NetworkInterfaceSource: computev1alpha1.NetworkInterfaceSource{
+							NetworkInterfaceRef: &corev1.LocalObjectReference{Name: nic.Name},
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+
+		By("waiting for the runtime to report the machine with its volume and network interface")
+		Eventually(srv).Should(HaveField("Machines", HaveLen(1)))
+		_, iriMachine := GetSingleMapEntry(srv.Machines)
+		Eventually(iriMachine).Should(SatisfyAll(
+			HaveField("Spec.Volumes", ConsistOf(&iri.Volume{
+				Name:   "primary",
+				Device: "oda",
+				Connection: &iri.VolumeConnection{
+					Driver: "test",
+					Handle: "testhandle",
+				},
+			})),
+			HaveField("Spec.NetworkInterfaces", ConsistOf(&iri.NetworkInterface{
+				Name:      "primary",
+				NetworkId: "foo",
+				Ips:       []string{"10.0.0.1"},
+			})),
+		))
+
+		By("waiting for the ironcore machine status to be up-to-date")
+		Eventually(Object(machine)).Should(HaveField("Status.ObservedGeneration", machine.Generation))
+	})
+})
+
 func GetSingleMapEntry[K comparable, V any](m map[K]V) (K, V) {
 	if n := len(m); n != 1 {
 		Fail(fmt.Sprintf("Expected for map to have a single entry but got %d", n), 1)
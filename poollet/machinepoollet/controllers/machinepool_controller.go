@@ -7,18 +7,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/controller-utils/conditionutils"
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
 	"github.com/ironcore-dev/ironcore/iri/apis/machine"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
+	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/controllers/events"
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/mcm"
 	ironcoreclient "github.com/ironcore-dev/ironcore/utils/client"
 	"github.com/ironcore-dev/ironcore/utils/quota"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,6 +32,7 @@ import (
 )
 
 type MachinePoolReconciler struct {
+	record.EventRecorder
 	client.Client
 
 	// MachinePoolName is the name of the computev1alpha1.MachinePool to report / update.
@@ -38,6 +44,9 @@ type MachinePoolReconciler struct {
 
 	MachineRuntime     machine.RuntimeService
 	MachineClassMapper mcm.MachineClassMapper
+
+	// MachineRuntimeCapabilities are the capabilities reported by the MachineRuntime.
+	MachineRuntimeCapabilities capabilities.Set
 }
 
 //+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinepools,verbs=get;list;watch;update;patch
@@ -133,6 +142,7 @@ func (r *MachinePoolReconciler) updateStatus(ctx context.Context, log logr.Logge
 	machinePool.Status.Capacity = capacity
 	machinePool.Status.Allocatable = allocatable
 	machinePool.Status.DaemonEndpoints.MachinepoolletEndpoint.Port = r.Port
+	r.updateRuntimeCapabilitiesCondition(machinePool)
 
 	if err := r.Status().Patch(ctx, machinePool, client.MergeFrom(base)); err != nil {
 		return fmt.Errorf("error patching machine pool status: %w", err)
@@ -141,6 +151,30 @@ func (r *MachinePoolReconciler) updateStatus(ctx context.Context, log logr.Logge
 	return nil
 }
 
+func (r *MachinePoolReconciler) updateRuntimeCapabilitiesCondition(machinePool *computev1alpha1.MachinePool) {
+	var (
+		status  = corev1.ConditionTrue
+		reason  = "AllSupported"
+		message = "The machine runtime supports all optional capabilities."
+	)
+	if missing := r.MachineRuntimeCapabilities.Missing(capabilities.MachineOptional...); len(missing) > 0 {
+		status = corev1.ConditionFalse
+		reason = "MissingCapabilities"
+		message = fmt.Sprintf("The machine runtime does not support %s.", strings.Join(missing, ", "))
+	}
+
+	typ := string(computev1alpha1.MachinePoolRuntimeCapabilitiesSupported)
+	if status == corev1.ConditionFalse && conditionutils.MustFindSliceStatus(machinePool.Status.Conditions, typ) != corev1.ConditionFalse {
+		r.Eventf(machinePool, corev1.EventTypeWarning, events.MissingRuntimeCapabilities, "%s Running degraded.", message)
+	}
+	conditionutils.MustUpdateSlice(&machinePool.Status.Conditions, typ,
+		conditionutils.UpdateStatus(status),
+		conditionutils.UpdateReason(reason),
+		conditionutils.UpdateMessage(message),
+		conditionutils.UpdateObserved(machinePool),
+	)
+}
+
 func (r *MachinePoolReconciler) reconcile(ctx context.Context, log logr.Logger, machinePool *computev1alpha1.MachinePool) (ctrl.Result, error) {
 	log.V(1).Info("Reconcile")
 
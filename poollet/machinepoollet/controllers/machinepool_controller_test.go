@@ -7,6 +7,7 @@ import (
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
 	"github.com/ironcore-dev/ironcore/iri/testing/machine"
 	testingmachine "github.com/ironcore-dev/ironcore/iri/testing/machine"
 	"github.com/ironcore-dev/ironcore/utils/quota"
@@ -15,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 )
 
@@ -222,4 +224,28 @@ var _ = Describe("MachinePoolController", func() {
 		)
 	})
 
+	It("should report that the machine runtime supports all optional capabilities", func(ctx SpecContext) {
+		Eventually(Object(machinePool)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", computev1alpha1.MachinePoolRuntimeCapabilitiesSupported),
+			HaveField("Status", corev1.ConditionTrue),
+		))))
+	})
+})
+
+var _ = Describe("MachinePoolController with a degraded machine runtime", func() {
+	recorder := record.NewFakeRecorder(1024)
+	_, machinePool, _, _ := SetupTestWithRuntimeCapabilities(capabilities.New(capabilities.MachineWatch), recorder)
+
+	It("should report the missing optional capabilities", func(ctx SpecContext) {
+		Eventually(Object(machinePool)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", computev1alpha1.MachinePoolRuntimeCapabilitiesSupported),
+			HaveField("Status", corev1.ConditionFalse),
+			HaveField("Reason", "MissingCapabilities"),
+			HaveField("Message", ContainSubstring(capabilities.MachineExec)),
+		))))
+		Eventually(recorder.Events).Should(Receive(SatisfyAll(
+			HavePrefix("Warning MissingRuntimeCapabilities"),
+			ContainSubstring(capabilities.MachineExec),
+		)))
+	})
 })
@@ -20,6 +20,11 @@ func (s *Server) serveExec(w http.ResponseWriter, req *http.Request, namespace,
 	ctx := req.Context()
 	log := ctrl.LoggerFrom(ctx)
 
+	if s.disableExec {
+		http.Error(w, "machine runtime does not support exec", http.StatusNotImplemented)
+		return
+	}
+
 	listMachinesRes, err := s.machineRuntime.ListMachines(ctx, &iri.ListMachinesRequest{
 		Filter: &iri.MachineFilter{
 			LabelSelector: map[string]string{
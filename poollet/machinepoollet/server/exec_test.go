@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/ironcore-dev/ironcore/iri/testing/machine"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+)
+
+var _ = Describe("Exec", func() {
+	newServer := func(disableExec bool) *Server {
+		srv, err := New(&rest.Config{}, Options{
+			MachineRuntime: machine.NewFakeRuntimeService(),
+			DisableExec:    disableExec,
+			DisableAuth:    true,
+			CertDir:        GinkgoT().TempDir(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return srv
+	}
+
+	exec := func(srv *Server) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/apis/compute.ironcore.dev/namespaces/foo/machines/bar/exec", nil)
+		srv.router().ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("should answer exec requests with not implemented if exec is disabled", func() {
+		rec := exec(newServer(true))
+		Expect(rec.Code).To(Equal(http.StatusNotImplemented))
+		Expect(rec.Body.String()).To(ContainSubstring("machine runtime does not support exec"))
+	})
+
+	It("should look up the machine in the runtime if exec is enabled", func() {
+		rec := exec(newServer(false))
+		Expect(rec.Code).To(Equal(http.StatusNotFound))
+	})
+})
@@ -43,6 +43,8 @@ var log = logf.Log.WithName("machinepoollet").WithName("server")
 type Options struct {
 	// MachineRuntime is the iri-machine runtime service.
 	MachineRuntime irimachine.RuntimeService
+	// DisableExec disables exec into machines, e.g. because the machine runtime does not support it.
+	DisableExec bool
 
 	// Log is the logger to use in the server.
 	// If unset, a package-global router will be used.
@@ -97,6 +99,7 @@ type Server struct {
 	auth Auth
 
 	machineRuntime irimachine.RuntimeService
+	disableExec    bool
 
 	cacheTTL time.Duration
 
@@ -249,6 +252,7 @@ func New(cfg *rest.Config, opts Options) (*Server, error) {
 		log:                     opts.Log,
 		auth:                    auth,
 		machineRuntime:          opts.MachineRuntime,
+		disableExec:             opts.DisableExec,
 		address:                 opts.Address,
 		certDir:                 opts.CertDir,
 		clientCACertificateFile: caCertificateFile,
@@ -5,27 +5,36 @@ package app
 
 import (
 	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	goflag "flag"
 	"fmt"
 	"os"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/controller-utils/configutils"
 	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	storageclient "github.com/ironcore-dev/ironcore/internal/client/storage"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	iriauth "github.com/ironcore-dev/ironcore/iri/auth"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
+	irimetrics "github.com/ironcore-dev/ironcore/iri/metrics"
+	iriremote "github.com/ironcore-dev/ironcore/iri/remote"
 	iriremotevolume "github.com/ironcore-dev/ironcore/iri/remote/volume"
 	"github.com/ironcore-dev/ironcore/poollet/irievent"
 	volumepoolletconfig "github.com/ironcore-dev/ironcore/poollet/volumepoollet/client/config"
 	"github.com/ironcore-dev/ironcore/poollet/volumepoollet/controllers"
 	"github.com/ironcore-dev/ironcore/poollet/volumepoollet/vcm"
 	"github.com/ironcore-dev/ironcore/utils/client/config"
+	"github.com/ironcore-dev/ironcore/utils/tracing"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -54,13 +63,16 @@ type Options struct {
 	LeaderElectionNamespace  string
 	LeaderElectionKubeconfig string
 	ProbeAddr                string
+	Tracing                  tracing.Options
 
 	VolumePoolName                      string
 	ProviderID                          string
 	VolumeRuntimeEndpoint               string
 	DialTimeout                         time.Duration
 	VolumeRuntimeSocketDiscoveryTimeout time.Duration
+	VolumeRuntimeAuth                   iriauth.ClientFlags
 	VolumeClassMapperSyncTimeout        time.Duration
+	RequiredVolumeRuntimeCapabilities   []string
 
 	WatchFilterValue string
 }
@@ -74,13 +86,19 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 			"Enabling this will ensure there is only one active controller manager.")
 	fs.StringVar(&o.LeaderElectionNamespace, "leader-election-namespace", "", "Namespace to do leader election in.")
 	fs.StringVar(&o.LeaderElectionKubeconfig, "leader-election-kubeconfig", "", "Path pointing to a kubeconfig to use for leader election.")
+	o.Tracing.BindFlags(fs)
 
 	fs.StringVar(&o.VolumePoolName, "volume-pool-name", o.VolumePoolName, "Name of the volume pool to announce / watch")
 	fs.StringVar(&o.ProviderID, "provider-id", "", "Provider id to announce on the volume pool.")
-	fs.StringVar(&o.VolumeRuntimeEndpoint, "volume-runtime-endpoint", o.VolumeRuntimeEndpoint, "Endpoint of the remote volume runtime service.")
+	fs.StringVar(&o.VolumeRuntimeEndpoint, "volume-runtime-endpoint", o.VolumeRuntimeEndpoint, "Endpoint of the remote volume runtime service. "+
+		"Either a unix socket path, 'unix://<path>' or 'tcp://<host>:<port>'.")
 	fs.DurationVar(&o.DialTimeout, "dial-timeout", 1*time.Second, "Timeout for dialing to the volume runtime endpoint.")
 	fs.DurationVar(&o.VolumeRuntimeSocketDiscoveryTimeout, "volume-runtime-discovery-timeout", 20*time.Second, "Timeout for discovering the volume runtime socket.")
+	o.VolumeRuntimeAuth.BindFlags(fs, "volume-runtime-")
+	o.VolumeRuntimeAuth.BindSignerNameFlag(fs, "volume-runtime-")
 	fs.DurationVar(&o.VolumeClassMapperSyncTimeout, "vcm-sync-timeout", 10*time.Second, "Timeout waiting for the volume class mapper to sync.")
+	fs.StringSliceVar(&o.RequiredVolumeRuntimeCapabilities, "required-volume-runtime-capabilities", o.RequiredVolumeRuntimeCapabilities,
+		fmt.Sprintf("Capabilities the volume runtime has to support. Optional capabilities: %v", capabilities.VolumeOptional))
 	fs.StringVar(&o.WatchFilterValue, "watch-filter", "", "Value to filter for while watching.")
 }
 
@@ -133,24 +151,21 @@ func Run(ctx context.Context, opts Options) error {
 		return fmt.Errorf("error detecting volume runtime endpoint: %w", err)
 	}
 
-	conn, err := grpc.Dial(endpoint,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	shutdownTracing, err := tracing.Setup(ctx, "volumepoollet", opts.Tracing)
 	if err != nil {
-		return fmt.Errorf("error dialing: %w", err)
+		return fmt.Errorf("error setting up tracing: %w", err)
 	}
 	defer func() {
-		if err := conn.Close(); err != nil {
-			setupLog.Error(err, "Error closing volume runtime connection")
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "Error shutting down tracing")
 		}
 	}()
 
-	volumeRuntime := iri.NewVolumeRuntimeClient(conn)
-
 	cfg, configCtrl, err := getter.GetConfig(ctx, &opts.GetConfigOptions)
 	if err != nil {
 		return fmt.Errorf("error getting config: %w", err)
 	}
+	tracing.WrapConfig(cfg)
 
 	leaderElectionCfg, err := configutils.GetConfig(
 		configutils.Kubeconfig(opts.LeaderElectionKubeconfig),
@@ -176,18 +191,65 @@ func Run(ctx context.Context, opts Options) error {
 		return err
 	}
 
+	volumeRuntimeAuthOpts, err := opts.VolumeRuntimeAuth.SetupClientOptions(ctx, mgr, "volume-runtime-client-certificate", &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   storagev1alpha1.VolumePoolCommonName(opts.VolumePoolName),
+			Organization: []string{storagev1alpha1.VolumePoolsGroup},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting up volume runtime auth: %w", err)
+	}
+	volumeRuntimeDialOpts, err := iriauth.DialOptions(volumeRuntimeAuthOpts)
+	if err != nil {
+		return fmt.Errorf("error getting volume runtime dial options: %w", err)
+	}
+	volumeRuntimeDialOpts = append(volumeRuntimeDialOpts, irimetrics.DialOptions()...)
+	volumeRuntimeDialOpts = append(volumeRuntimeDialOpts, tracing.DialOptions()...)
+
+	conn, err := grpc.Dial(iriremote.DialTarget(endpoint), volumeRuntimeDialOpts...)
+	if err != nil {
+		return fmt.Errorf("error dialing: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			setupLog.Error(err, "Error closing volume runtime connection")
+		}
+	}()
+
+	volumeRuntime := iri.NewVolumeRuntimeClient(conn)
+
+	volumeRuntimeCapabilities, err := getVolumeRuntimeCapabilities(ctx, setupLog, volumeRuntime)
+	if err != nil {
+		return err
+	}
+	if missing := volumeRuntimeCapabilities.Missing(opts.RequiredVolumeRuntimeCapabilities...); len(missing) > 0 {
+		return fmt.Errorf("volume runtime does not support required capabilities %v", missing)
+	}
+	if missing := volumeRuntimeCapabilities.Missing(capabilities.VolumeOptional...); len(missing) > 0 {
+		setupLog.Info("Volume runtime does not support optional capabilities, running degraded", "MissingCapabilities", missing)
+	}
+
 	volumeClassMapper := vcm.NewGeneric(volumeRuntime, vcm.GenericOptions{})
 	if err := mgr.Add(volumeClassMapper); err != nil {
 		return fmt.Errorf("error adding volume class mapper: %w", err)
 	}
 
-	volumeEvents := irievent.NewGenerator(func(ctx context.Context) ([]*iri.Volume, error) {
+	var watchVolumes irievent.WatchFunc[*iri.Volume]
+	if volumeRuntimeCapabilities.Has(capabilities.VolumeWatch) {
+		watchVolumes = irievent.NewWatchFunc(func(ctx context.Context, resourceVersion string) (irievent.WatchStream[*iri.WatchVolumesResponse], error) {
+			return volumeRuntime.WatchVolumes(ctx, &iri.WatchVolumesRequest{ResourceVersion: resourceVersion})
+		}, func(res *iri.WatchVolumesResponse) irievent.WatchEvent[*iri.Volume] {
+			return irievent.WatchEvent[*iri.Volume]{Type: res.Type, Object: res.Volume, ResourceVersion: res.ResourceVersion}
+		})
+	}
+	volumeEvents := irievent.NewWatchingGenerator(func(ctx context.Context) ([]*iri.Volume, error) {
 		res, err := volumeRuntime.ListVolumes(ctx, &iri.ListVolumesRequest{})
 		if err != nil {
 			return nil, err
 		}
 		return res.Volumes, nil
-	}, irievent.GeneratorOptions{})
+	}, watchVolumes, irievent.GeneratorOptions{})
 	if err := mgr.Add(volumeEvents); err != nil {
 		return fmt.Errorf("error adding volume event generator: %w", err)
 	}
@@ -209,13 +271,14 @@ func Run(ctx context.Context, opts Options) error {
 		}
 
 		if err := (&controllers.VolumeReconciler{
-			EventRecorder:     mgr.GetEventRecorderFor("volumes"),
-			Client:            mgr.GetClient(),
-			Scheme:            scheme,
-			VolumeRuntime:     volumeRuntime,
-			VolumeClassMapper: volumeClassMapper,
-			VolumePoolName:    opts.VolumePoolName,
-			WatchFilterValue:  opts.WatchFilterValue,
+			EventRecorder:             mgr.GetEventRecorderFor("volumes"),
+			Client:                    mgr.GetClient(),
+			Scheme:                    scheme,
+			VolumeRuntime:             volumeRuntime,
+			VolumeRuntimeCapabilities: volumeRuntimeCapabilities,
+			VolumeClassMapper:         volumeClassMapper,
+			VolumePoolName:            opts.VolumePoolName,
+			WatchFilterValue:          opts.WatchFilterValue,
 		}).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("error setting up volume reconciler with manager: %w", err)
 		}
@@ -228,10 +291,12 @@ func Run(ctx context.Context, opts Options) error {
 		}
 
 		if err := (&controllers.VolumePoolReconciler{
-			Client:            mgr.GetClient(),
-			VolumePoolName:    opts.VolumePoolName,
-			VolumeClassMapper: volumeClassMapper,
-			VolumeRuntime:     volumeRuntime,
+			EventRecorder:             mgr.GetEventRecorderFor("volumepools"),
+			Client:                    mgr.GetClient(),
+			VolumePoolName:            opts.VolumePoolName,
+			VolumeClassMapper:         volumeClassMapper,
+			VolumeRuntime:             volumeRuntime,
+			VolumeRuntimeCapabilities: volumeRuntimeCapabilities,
 		}).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("error setting up volume pool reconciler with manager: %w", err)
 		}
@@ -272,3 +337,20 @@ func Run(ctx context.Context, opts Options) error {
 	}
 	return nil
 }
+
+// getVolumeRuntimeCapabilities returns the capabilities reported by the volume runtime.
+// Runtimes not implementing Version predate capability negotiation and are assumed to support capabilities.VolumeLegacy.
+func getVolumeRuntimeCapabilities(ctx context.Context, log logr.Logger, volumeRuntime iri.VolumeRuntimeClient) (capabilities.Set, error) {
+	res, err := volumeRuntime.Version(ctx, &iri.VersionRequest{})
+	if err != nil {
+		if status.Code(err) != codes.Unimplemented {
+			return capabilities.Set{}, fmt.Errorf("error getting volume runtime version: %w", err)
+		}
+
+		log.Info("Volume runtime does not implement version, assuming legacy capabilities", "Capabilities", capabilities.VolumeLegacy)
+		return capabilities.New(capabilities.VolumeLegacy...), nil
+	}
+
+	log.Info("Got volume runtime version", "RuntimeName", res.RuntimeName, "RuntimeVersion", res.RuntimeVersion, "Capabilities", res.Capabilities)
+	return capabilities.New(res.Capabilities...), nil
+}
@@ -14,6 +14,7 @@ import (
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	storageclient "github.com/ironcore-dev/ironcore/internal/client/storage"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
 	"github.com/ironcore-dev/ironcore/iri/testing/volume"
 	"github.com/ironcore-dev/ironcore/poollet/volumepoollet/controllers"
 	"github.com/ironcore-dev/ironcore/poollet/volumepoollet/vcm"
@@ -126,6 +127,12 @@ var _ = BeforeSuite(func() {
 })
 
 func SetupTest() (*corev1.Namespace, *storagev1alpha1.VolumePool, *storagev1alpha1.VolumeClass, *storagev1alpha1.VolumeClass, *volume.FakeRuntimeService) {
+	return SetupTestWithRuntimeCapabilities(capabilities.New(capabilities.VolumeOptional...), &record.FakeRecorder{})
+}
+
+// SetupTestWithRuntimeCapabilities is like SetupTest but runs the reconcilers with the given volume runtime
+// capabilities and event recorder.
+func SetupTestWithRuntimeCapabilities(runtimeCapabilities capabilities.Set, recorder record.EventRecorder) (*corev1.Namespace, *storagev1alpha1.VolumePool, *storagev1alpha1.VolumeClass, *storagev1alpha1.VolumeClass, *volume.FakeRuntimeService) {
 	var (
 		ns           = &corev1.Namespace{}
 		vp           = &storagev1alpha1.VolumePool{}
@@ -223,19 +230,22 @@ func SetupTest() (*corev1.Namespace, *storagev1alpha1.VolumePool, *storagev1alph
 		DeferCleanup(cancel)
 
 		Expect((&controllers.VolumeReconciler{
-			EventRecorder:     &record.FakeRecorder{},
-			Client:            k8sManager.GetClient(),
-			Scheme:            scheme.Scheme,
-			VolumeRuntime:     srv,
-			VolumeClassMapper: volumeClassMapper,
-			VolumePoolName:    vp.Name,
+			EventRecorder:             recorder,
+			Client:                    k8sManager.GetClient(),
+			Scheme:                    scheme.Scheme,
+			VolumeRuntime:             srv,
+			VolumeRuntimeCapabilities: runtimeCapabilities,
+			VolumeClassMapper:         volumeClassMapper,
+			VolumePoolName:            vp.Name,
 		}).SetupWithManager(k8sManager)).To(Succeed())
 
 		Expect((&controllers.VolumePoolReconciler{
-			Client:            k8sManager.GetClient(),
-			VolumeRuntime:     srv,
-			VolumeClassMapper: volumeClassMapper,
-			VolumePoolName:    vp.Name,
+			EventRecorder:             recorder,
+			Client:                    k8sManager.GetClient(),
+			VolumeRuntime:             srv,
+			VolumeRuntimeCapabilities: runtimeCapabilities,
+			VolumeClassMapper:         volumeClassMapper,
+			VolumePoolName:            vp.Name,
 		}).SetupWithManager(k8sManager)).To(Succeed())
 
 		Expect((&controllers.VolumePoolAnnotatorReconciler{
@@ -6,4 +6,7 @@ package events
 const (
 	VolumeClassNotReady            = "VolumeClassNotReady"
 	VolumeEncryptionSecretNotReady = "VolumeEncryptionSecretNotReady"
+	VolumeExpansionNotSupported    = "VolumeExpansionNotSupported"
+
+	MissingRuntimeCapabilities = "MissingRuntimeCapabilities"
 )
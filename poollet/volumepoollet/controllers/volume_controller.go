@@ -15,6 +15,8 @@ import (
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
+	"github.com/ironcore-dev/ironcore/poollet/irierror"
 	volumepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/volumepoollet/api/v1alpha1"
 	"github.com/ironcore-dev/ironcore/poollet/volumepoollet/controllers/events"
 	"github.com/ironcore-dev/ironcore/poollet/volumepoollet/vcm"
@@ -41,6 +43,8 @@ type VolumeReconciler struct {
 	Scheme *runtime.Scheme
 
 	VolumeRuntime iri.VolumeRuntimeClient
+	// VolumeRuntimeCapabilities are the capabilities reported by the VolumeRuntime.
+	VolumeRuntimeCapabilities capabilities.Set
 
 	VolumeClassMapper vcm.VolumeClassMapper
 
@@ -105,7 +109,8 @@ func (r *VolumeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}
 		return r.deleteGone(ctx, log, req.NamespacedName)
 	}
-	return r.reconcileExists(ctx, log, volume)
+	res, err := r.reconcileExists(ctx, log, volume)
+	return irierror.Handle(ctx, r.EventRecorder, volume, res, err)
 }
 
 func (r *VolumeReconciler) deleteGone(ctx context.Context, log logr.Logger, volumeKey client.ObjectKey) (ctrl.Result, error) {
@@ -427,6 +432,12 @@ func (r *VolumeReconciler) update(ctx context.Context, log logr.Logger, volume *
 	storageBytes := volume.Spec.Resources.Storage().Value()
 	oldStorageBytes := iriVolume.Spec.Resources.StorageBytes
 	if storageBytes != oldStorageBytes {
+		if !r.VolumeRuntimeCapabilities.Has(capabilities.VolumeExpand) {
+			log.V(1).Info("Volume runtime does not support expanding volumes", "StorageBytes", storageBytes, "OldStorageBytes", oldStorageBytes)
+			r.Eventf(volume, corev1.EventTypeWarning, events.VolumeExpansionNotSupported, "Volume runtime does not support expanding volumes")
+			return nil
+		}
+
 		log.V(1).Info("Expanding volume", "StorageBytes", storageBytes, "OldStorageBytes", oldStorageBytes)
 		if _, err := r.VolumeRuntime.ExpandVolume(ctx, &iri.ExpandVolumeRequest{
 			VolumeId: iriVolume.Metadata.Id,
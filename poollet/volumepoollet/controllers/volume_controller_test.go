@@ -9,12 +9,14 @@ import (
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
 	ironcoreclient "github.com/ironcore-dev/ironcore/utils/client"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 )
@@ -207,6 +209,49 @@ var _ = Describe("VolumeController", func() {
 
 })
 
+var _ = Describe("VolumeController with a volume runtime not supporting expansion", func() {
+	recorder := record.NewFakeRecorder(1024)
+	ns, vp, _, expandableVc, srv := SetupTestWithRuntimeCapabilities(capabilities.New(capabilities.VolumeWatch), recorder)
+
+	It("should report that the volume cannot be expanded", func(ctx SpecContext) {
+		size := resource.MustParse("100Mi")
+
+		By("creating a volume")
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "volume-",
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: expandableVc.Name},
+				VolumePoolRef:  &corev1.LocalObjectReference{Name: vp.Name},
+				Resources: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceStorage: size,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed())
+		DeferCleanup(expectVolumeDeleted, volume)
+
+		By("waiting for the runtime to report the volume")
+		Eventually(srv).Should(HaveField("Volumes", HaveLen(1)))
+
+		By("increasing the storage resource")
+		baseVolume := volume.DeepCopy()
+		volume.Spec.Resources = corev1alpha1.ResourceList{
+			corev1alpha1.ResourceStorage: resource.MustParse("200Mi"),
+		}
+		Expect(k8sClient.Patch(ctx, volume, client.MergeFrom(baseVolume))).To(Succeed())
+
+		By("asserting an event is emitted and the volume is not expanded")
+		Eventually(recorder.Events).Should(Receive(HavePrefix("Warning VolumeExpansionNotSupported")))
+		Consistently(func() int64 {
+			_, iriVolume := GetSingleMapEntry(srv.Volumes)
+			return iriVolume.Spec.Resources.StorageBytes
+		}).Should(Equal(size.Value()))
+	})
+})
+
 func GetSingleMapEntry[K comparable, V any](m map[K]V) (K, V) {
 	if n := len(m); n != 1 {
 		Fail(fmt.Sprintf("Expected for map to have a single entry but got %d", n), 1)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	storageclient "github.com/ironcore-dev/ironcore/internal/client/storage"
@@ -14,11 +15,15 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/controller-utils/conditionutils"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
+	"github.com/ironcore-dev/ironcore/poollet/volumepoollet/controllers/events"
 	"github.com/ironcore-dev/ironcore/poollet/volumepoollet/vcm"
 	ironcoreclient "github.com/ironcore-dev/ironcore/utils/client"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,10 +32,14 @@ import (
 )
 
 type VolumePoolReconciler struct {
+	record.EventRecorder
 	client.Client
 	VolumePoolName    string
 	VolumeRuntime     iri.VolumeRuntimeClient
 	VolumeClassMapper vcm.VolumeClassMapper
+
+	// VolumeRuntimeCapabilities are the capabilities reported by the VolumeRuntime.
+	VolumeRuntimeCapabilities capabilities.Set
 }
 
 //+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumepools,verbs=get;list;watch;update;patch
@@ -124,6 +133,7 @@ func (r *VolumePoolReconciler) updateStatus(ctx context.Context, log logr.Logger
 	volumePool.Status.AvailableVolumeClasses = supported
 	volumePool.Status.Capacity = capacity
 	volumePool.Status.Allocatable = allocatable
+	r.updateRuntimeCapabilitiesCondition(volumePool)
 
 	if err := r.Status().Patch(ctx, volumePool, client.MergeFrom(base)); err != nil {
 		return fmt.Errorf("error patching volume pool status: %w", err)
@@ -132,6 +142,30 @@ func (r *VolumePoolReconciler) updateStatus(ctx context.Context, log logr.Logger
 	return nil
 }
 
+func (r *VolumePoolReconciler) updateRuntimeCapabilitiesCondition(volumePool *storagev1alpha1.VolumePool) {
+	var (
+		status  = corev1.ConditionTrue
+		reason  = "AllSupported"
+		message = "The volume runtime supports all optional capabilities."
+	)
+	if missing := r.VolumeRuntimeCapabilities.Missing(capabilities.VolumeOptional...); len(missing) > 0 {
+		status = corev1.ConditionFalse
+		reason = "MissingCapabilities"
+		message = fmt.Sprintf("The volume runtime does not support %s.", strings.Join(missing, ", "))
+	}
+
+	typ := string(storagev1alpha1.VolumePoolRuntimeCapabilitiesSupported)
+	if status == corev1.ConditionFalse && conditionutils.MustFindSliceStatus(volumePool.Status.Conditions, typ) != corev1.ConditionFalse {
+		r.Eventf(volumePool, corev1.EventTypeWarning, events.MissingRuntimeCapabilities, "%s Running degraded.", message)
+	}
+	conditionutils.MustUpdateSlice(&volumePool.Status.Conditions, typ,
+		conditionutils.UpdateStatus(status),
+		conditionutils.UpdateReason(reason),
+		conditionutils.UpdateMessage(message),
+		conditionutils.UpdateObserved(volumePool),
+	)
+}
+
 func (r *VolumePoolReconciler) reconcile(ctx context.Context, log logr.Logger, volumePool *storagev1alpha1.VolumePool) (ctrl.Result, error) {
 	log.V(1).Info("Reconcile")
 
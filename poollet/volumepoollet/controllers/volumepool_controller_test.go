@@ -7,6 +7,7 @@ import (
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capabilities"
 	"github.com/ironcore-dev/ironcore/iri/testing/volume"
 	"github.com/ironcore-dev/ironcore/utils/quota"
 	. "github.com/onsi/ginkgo/v2"
@@ -14,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 )
 
@@ -284,4 +286,29 @@ var _ = Describe("VolumePoolController", func() {
 			})),
 		))
 	})
+
+	It("should report that the volume runtime supports all optional capabilities", func(ctx SpecContext) {
+		Eventually(Object(volumePool)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", storagev1alpha1.VolumePoolRuntimeCapabilitiesSupported),
+			HaveField("Status", corev1.ConditionTrue),
+		))))
+	})
+})
+
+var _ = Describe("VolumePoolController with a degraded volume runtime", func() {
+	recorder := record.NewFakeRecorder(1024)
+	_, volumePool, _, _, _ := SetupTestWithRuntimeCapabilities(capabilities.New(capabilities.VolumeWatch), recorder)
+
+	It("should report the missing optional capabilities", func(ctx SpecContext) {
+		Eventually(Object(volumePool)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", storagev1alpha1.VolumePoolRuntimeCapabilitiesSupported),
+			HaveField("Status", corev1.ConditionFalse),
+			HaveField("Reason", "MissingCapabilities"),
+			HaveField("Message", ContainSubstring(capabilities.VolumeExpand)),
+		))))
+		Eventually(recorder.Events).Should(Receive(SatisfyAll(
+			HavePrefix("Warning MissingRuntimeCapabilities"),
+			ContainSubstring(capabilities.VolumeExpand),
+		)))
+	})
 })
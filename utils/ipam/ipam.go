@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"math/big"
+
+	"go4.org/netipx"
+)
+
+// IPRangeSize returns the number of addresses contained in the range.
+func IPRangeSize(rng netipx.IPRange) *big.Int {
+	from := new(big.Int).SetBytes(rng.From().AsSlice())
+	size := new(big.Int).SetBytes(rng.To().AsSlice())
+	size.Sub(size, from)
+	return size.Add(size, big.NewInt(1))
+}
@@ -17,6 +17,14 @@ type Evaluator interface {
 	Usage(ctx context.Context, item client.Object) (corev1alpha1.ResourceList, error)
 }
 
+// ScopesChangedEvaluator is an Evaluator for objects whose scopes may change during their lifetime,
+// e.g. the power state of a machine.
+type ScopesChangedEvaluator interface {
+	Evaluator
+	// ScopesChanged reports whether any scope of oldItem and newItem differs.
+	ScopesChanged(oldItem, newItem client.Object) (bool, error)
+}
+
 type Registry interface {
 	// Add to registry
 	Add(e Evaluator) error
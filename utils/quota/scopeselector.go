@@ -4,7 +4,10 @@
 package quota
 
 import (
+	"slices"
+
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func GetResourceScopeSelectorRequirements(scopeSelector *corev1alpha1.ResourceScopeSelector) []corev1alpha1.ResourceScopeSelectorRequirement {
@@ -14,3 +17,30 @@ func GetResourceScopeSelectorRequirements(scopeSelector *corev1alpha1.ResourceSc
 
 	return scopeSelector.MatchExpressions
 }
+
+// MatchesScopeValue reports whether a scope value matches the given operator and values.
+// present indicates whether the scope value is present at all for the object.
+func MatchesScopeValue(op corev1alpha1.ResourceScopeSelectorOperator, value string, present bool, values []string) bool {
+	switch op {
+	case corev1alpha1.ResourceScopeSelectorOperatorExists:
+		return present
+	case corev1alpha1.ResourceScopeSelectorOperatorDoesNotExist:
+		return !present
+	case corev1alpha1.ResourceScopeSelectorOperatorIn:
+		return present && slices.Contains(values, value)
+	case corev1alpha1.ResourceScopeSelectorOperatorNotIn:
+		return present && !slices.Contains(values, value)
+	default:
+		return false
+	}
+}
+
+// EvaluatorScopesChanged reports whether the scopes of oldItem and newItem differ.
+// If the evaluator does not implement ScopesChangedEvaluator, the scopes are considered unchanged.
+func EvaluatorScopesChanged(evaluator Evaluator, oldItem, newItem client.Object) (bool, error) {
+	scopesChangedEvaluator, ok := evaluator.(ScopesChangedEvaluator)
+	if !ok {
+		return false, nil
+	}
+	return scopesChangedEvaluator.ScopesChanged(oldItem, newItem)
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing sets up OpenTelemetry tracing for the ironcore components.
+//
+// Spans are exported via OTLP to a collector. The trace context is propagated using the W3C trace context
+// headers, both via HTTP (to the ironcore API) and via gRPC metadata (between poollets and IRI runtimes).
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/rest"
+)
+
+// Options are options for exporting traces.
+type Options struct {
+	// Endpoint is the host:port of the OTLP gRPC collector to export spans to.
+	// If empty, no spans are exported (the trace context is still propagated).
+	Endpoint string
+	// Insecure disables TLS when connecting to the collector.
+	Insecure bool
+	// SamplingRatio is the ratio of traces started by the component that are sampled.
+	// Traces started by a caller follow the sampling decision of the caller.
+	SamplingRatio float64
+}
+
+func (o *Options) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Endpoint, "tracing-endpoint", o.Endpoint, "host:port of the OTLP gRPC collector to export traces to. "+
+		"If empty, no traces are exported.")
+	fs.BoolVar(&o.Insecure, "tracing-insecure", o.Insecure, "Whether to connect to the tracing endpoint without TLS.")
+	fs.Float64Var(&o.SamplingRatio, "tracing-sampling-ratio", 1, "Ratio of traces started by this component to sample. "+
+		"Traces started by a caller follow the sampling decision of the caller.")
+}
+
+// Setup sets up the global tracer provider and propagator for the component with the given service name.
+// The returned function flushes all pending spans and shuts the tracer provider down.
+func Setup(ctx context.Context, serviceName string, opts Options) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if opts.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	if opts.SamplingRatio < 0 || opts.SamplingRatio > 1 {
+		return nil, fmt.Errorf("sampling ratio %v has to be between 0 and 1", opts.SamplingRatio)
+	}
+
+	exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Endpoint)}
+	if opts.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error creating resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SamplingRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// WrapConfig instruments the transport of the given config so requests to the API server are traced
+// and carry the trace context.
+func WrapConfig(cfg *rest.Config) {
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return otelhttp.NewTransport(rt)
+	})
+}
+
+// DialOptions returns the grpc.DialOption to trace all requests of a client connection and
+// to propagate the trace context via gRPC metadata.
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{grpc.WithStatsHandler(otelgrpc.NewClientHandler())}
+}
+
+// ServerOptions returns the grpc.ServerOption to trace all requests of a server, continuing
+// the trace context propagated by the client.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{grpc.StatsHandler(otelgrpc.NewServerHandler())}
+}
+
+// End ends the span, recording err as its status if non-nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+
+	. "github.com/ironcore-dev/ironcore/utils/tracing"
+	"github.com/ironcore-dev/ironcore/utils/tracing/tracingtest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func findSpan(spans []*tracev1.Span, name string, kind tracev1.Span_SpanKind) *tracev1.Span {
+	for _, span := range spans {
+		if span.GetName() == name && span.GetKind() == kind {
+			return span
+		}
+	}
+	return nil
+}
+
+var _ = Describe("Tracing", func() {
+	var (
+		collector *tracingtest.Collector
+		shutdown  func(context.Context) error
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		var err error
+		collector, err = tracingtest.NewCollector()
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(collector.Stop)
+
+		shutdown, err = Setup(ctx, "test", Options{
+			Endpoint:      collector.Endpoint(),
+			Insecure:      true,
+			SamplingRatio: 1,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(shutdown)
+	})
+
+	It("should export spans and propagate the trace context via grpc metadata", func(ctx SpecContext) {
+		By("serving a grpc server on a unix socket")
+		dir, err := os.MkdirTemp("", "tracing")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(os.RemoveAll, dir)
+
+		socket := filepath.Join(dir, "server.sock")
+		lis, err := net.Listen("unix", socket)
+		Expect(err).NotTo(HaveOccurred())
+
+		srv := grpc.NewServer(ServerOptions()...)
+		healthpb.RegisterHealthServer(srv, health.NewServer())
+		go func() {
+			defer GinkgoRecover()
+			Expect(srv.Serve(lis)).To(Succeed())
+		}()
+		DeferCleanup(srv.Stop)
+
+		conn, err := grpc.Dial("unix://"+socket, append(DialOptions(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)...)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+
+		By("calling the server within a span")
+		spanCtx, span := otel.Tracer("test").Start(ctx, "parent")
+		_, err = healthpb.NewHealthClient(conn).Check(spanCtx, &healthpb.HealthCheckRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		End(span, errors.New("some error"))
+
+		By("flushing the spans")
+		Expect(shutdown(ctx)).To(Succeed())
+
+		spans := collector.Spans()
+		parentSpan := findSpan(spans, "parent", tracev1.Span_SPAN_KIND_INTERNAL)
+		Expect(parentSpan).NotTo(BeNil())
+		Expect(parentSpan.GetStatus().GetMessage()).To(Equal("some error"))
+
+		clientSpan := findSpan(spans, "grpc.health.v1.Health/Check", tracev1.Span_SPAN_KIND_CLIENT)
+		Expect(clientSpan).NotTo(BeNil())
+		Expect(clientSpan.GetParentSpanId()).To(Equal(parentSpan.GetSpanId()))
+
+		serverSpan := findSpan(spans, "grpc.health.v1.Health/Check", tracev1.Span_SPAN_KIND_SERVER)
+		Expect(serverSpan).NotTo(BeNil())
+		Expect(serverSpan.GetTraceId()).To(Equal(parentSpan.GetTraceId()))
+		Expect(serverSpan.GetParentSpanId()).To(Equal(clientSpan.GetSpanId()))
+	})
+})
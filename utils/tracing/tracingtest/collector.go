@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracingtest contains an in-process OTLP collector for testing exported traces.
+package tracingtest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	collectortracev1 "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+)
+
+// Collector is an in-process OTLP gRPC collector recording all exported spans.
+type Collector struct {
+	collectortracev1.UnimplementedTraceServiceServer
+
+	listener net.Listener
+	server   *grpc.Server
+
+	mu    sync.Mutex
+	spans []*tracev1.Span
+}
+
+// NewCollector starts a collector listening on a random local port.
+func NewCollector() (*Collector, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("error listening: %w", err)
+	}
+
+	c := &Collector{
+		listener: lis,
+		server:   grpc.NewServer(),
+	}
+	collectortracev1.RegisterTraceServiceServer(c.server, c)
+	go func() { _ = c.server.Serve(lis) }()
+	return c, nil
+}
+
+// Endpoint returns the host:port the collector listens on.
+func (c *Collector) Endpoint() string {
+	return c.listener.Addr().String()
+}
+
+// Stop stops the collector.
+func (c *Collector) Stop() {
+	c.server.Stop()
+}
+
+func (c *Collector) Export(_ context.Context, req *collectortracev1.ExportTraceServiceRequest) (*collectortracev1.ExportTraceServiceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, resourceSpans := range req.GetResourceSpans() {
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			c.spans = append(c.spans, scopeSpans.GetSpans()...)
+		}
+	}
+	return &collectortracev1.ExportTraceServiceResponse{}, nil
+}
+
+// Spans returns all spans received so far.
+func (c *Collector) Spans() []*tracev1.Span {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*tracev1.Span(nil), c.spans...)
+}
+
+// SpanNames returns the names of all spans received so far.
+func (c *Collector) SpanNames() []string {
+	spans := c.Spans()
+	names := make([]string, 0, len(spans))
+	for _, span := range spans {
+		names = append(names, span.GetName())
+	}
+	return names
+}